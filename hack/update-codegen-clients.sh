@@ -28,6 +28,8 @@ popd
 SDK_PKG=${SDK_PKG:-$(cd "${SCRIPT_ROOT}"; go list -f '{{.Dir}}' -m github.com/kcp-dev/sdk)}
 CODEGEN_PKG=${CODEGEN_PKG:-$(cd "${SCRIPT_ROOT}"; go list -f '{{.Dir}}' -m k8s.io/code-generator)}
 CLUSTER_CODEGEN_PKG=${CLUSTER_CODEGEN_PKG:-$(cd "${SCRIPT_ROOT}"; go list -f '{{.Dir}}' -m github.com/kcp-dev/code-generator/v3)}
+# LogicalClusterLimits is a singular kind ending in "s".
+SDK_PLURAL_EXCEPTIONS="Endpoints:Endpoints,LogicalClusterLimits:LogicalClusterLimits"
 OPENAPI_PKG=${OPENAPI_PKG:-$(cd "${SCRIPT_ROOT}"; go list -f '{{.Dir}}' -m k8s.io/kube-openapi)}
 
 go install "${CODEGEN_PKG}"/cmd/applyconfiguration-gen
@@ -71,6 +73,7 @@ mkdir -p ${SDK_PKG}/client/{clientset,applyconfiguration,listers,informers}
   --input github.com/kcp-dev/sdk/apis/migration/v1alpha1 \
   --input-base="" \
   --apply-configuration-package=github.com/kcp-dev/sdk/client/applyconfiguration \
+  --plural-exceptions "${SDK_PLURAL_EXCEPTIONS}" \
   --clientset-name "versioned"
 
 kube::codegen::gen_helpers \
@@ -89,6 +92,7 @@ cluster::codegen::gen_client \
   --with-watch \
   --single-cluster-versioned-clientset-pkg github.com/kcp-dev/sdk/client/clientset/versioned \
   --single-cluster-applyconfigurations-pkg github.com/kcp-dev/sdk/client/applyconfiguration \
+  --plural-exceptions "${SDK_PLURAL_EXCEPTIONS}" \
   apis
 cd -
 
@@ -47,7 +47,7 @@ done
 
 (
   ${KCP_APIGEN_GEN} --input-dir "${REPO_ROOT}"/config/crds --output-dir "${REPO_ROOT}"/config/root-phase0 \
-  --ignore-export-schemas cachedobjects.cache.kcp.io
+  --ignore-export-schemas cachedobjects.cache.kcp.io,workspacequotausages.tenancy.kcp.io
 )
 
 
@@ -23,15 +23,20 @@ import (
 	"io"
 	"strings"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/admission"
 
+	"github.com/kcp-dev/logicalcluster/v3"
 	"github.com/kcp-dev/sdk/apis/apis"
 	apisv1alpha1 "github.com/kcp-dev/sdk/apis/apis/v1alpha1"
 	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
 
+	"github.com/kcp-dev/kcp/pkg/admission/initializers"
 	builtinapiexport "github.com/kcp-dev/kcp/pkg/virtual/apiexport/schemas/builtin"
 )
 
@@ -51,6 +56,11 @@ type APIExportAdmission struct {
 	*admission.Handler
 
 	isBuiltIn func(apis.GroupResource) bool
+
+	getAPIResourceSchema func(clusterName logicalcluster.Name, name string) (*apisv1alpha1.APIResourceSchema, error)
+	listAPIBindings      func(clusterName logicalcluster.Name, export *apisv1alpha2.APIExport) ([]*apisv1alpha2.APIBinding, error)
+	listObjects          func(ctx context.Context, clusterName logicalcluster.Name, gvr schema.GroupVersionResource, continueToken string) (*unstructured.UnstructuredList, error)
+	listShards           func() ([]*corev1alpha1.Shard, error)
 }
 
 // NewAPIExportAdmission constructs a new APIExportAdmission admission plugin.
@@ -63,6 +73,8 @@ func NewAPIExportAdmission(isBuiltIn func(apis.GroupResource) bool) *APIExportAd
 
 // Ensure that the required admission interfaces are implemented.
 var _ = admission.ValidationInterface(&APIExportAdmission{})
+var _ = initializers.WantsKcpInformers(&APIExportAdmission{})
+var _ = initializers.WantsDynamicClusterClient(&APIExportAdmission{})
 
 // Validate ensures that the APIExport is valid.
 func (e *APIExportAdmission) Validate(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) (err error) {
@@ -75,6 +87,7 @@ func (e *APIExportAdmission) Validate(ctx context.Context, a admission.Attribute
 		return fmt.Errorf("unexpected type %T", a.GetObject())
 	}
 
+	var export *apisv1alpha2.APIExport
 	switch a.GetKind().GroupVersion().Version {
 	case apisv1alpha1.SchemeGroupVersion.Version:
 		// v1alpha1 is deprecated, but we still need to support it for a while
@@ -104,6 +117,7 @@ func (e *APIExportAdmission) Validate(ctx context.Context, a admission.Attribute
 		if err := e.validatev1alpha2(ctx, a, v2); err != nil {
 			return err
 		}
+		export = v2
 	case apisv1alpha2.SchemeGroupVersion.Version:
 		// v1alpha2 is the current version.
 		ae := &apisv1alpha2.APIExport{}
@@ -113,6 +127,7 @@ func (e *APIExportAdmission) Validate(ctx context.Context, a admission.Attribute
 		if err := e.validatev1alpha2(ctx, a, ae); err != nil {
 			return err
 		}
+		export = ae
 
 	default:
 		return admission.NewForbidden(a,
@@ -121,9 +136,44 @@ func (e *APIExportAdmission) Validate(ctx context.Context, a admission.Attribute
 				a.GetKind().GroupVersion().String(),
 				fmt.Sprintf("unsupported API version %s", a.GetKind().GroupVersion().String())))
 	}
+
+	if a.GetOperation() == admission.Update && e.getAPIResourceSchema != nil {
+		old, err := oldAPIExport(a)
+		if err != nil {
+			return err
+		}
+		e.warnAboutBreakingSchemaChanges(ctx, a, old, export)
+	}
+
 	return nil
 }
 
+// oldAPIExport returns the old object of an update request as v1alpha2 APIExport.
+func oldAPIExport(a admission.Attributes) (*apisv1alpha2.APIExport, error) {
+	u, ok := a.GetOldObject().(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T", a.GetOldObject())
+	}
+
+	if a.GetKind().GroupVersion().Version == apisv1alpha1.SchemeGroupVersion.Version {
+		ae := &apisv1alpha1.APIExport{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, ae); err != nil {
+			return nil, fmt.Errorf("failed to convert unstructured to APIExport: %w", err)
+		}
+		v2 := new(apisv1alpha2.APIExport)
+		if err := apisv1alpha2.Convert_v1alpha1_APIExport_To_v1alpha2_APIExport(ae, v2, nil); err != nil {
+			return nil, fmt.Errorf("failed to convert v1alpha1 APIExport to v1alpha2: %w", err)
+		}
+		return v2, nil
+	}
+
+	ae := &apisv1alpha2.APIExport{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, ae); err != nil {
+		return nil, fmt.Errorf("failed to convert unstructured to APIExport: %w", err)
+	}
+	return ae, nil
+}
+
 func (e *APIExportAdmission) validatev1alpha2(_ context.Context, a admission.Attributes, ae *apisv1alpha2.APIExport) (err error) {
 	for i, pc := range ae.Spec.PermissionClaims {
 		if pc.IdentityHash == "" && !e.isBuiltIn(pc.GroupResource) && pc.Group != apis.GroupName {
@@ -144,6 +194,20 @@ func (e *APIExportAdmission) validatev1alpha2(_ context.Context, a admission.Att
 		}
 	}
 
+	if rollout := ae.Spec.Rollout; rollout != nil {
+		rolloutPath := field.NewPath("spec").Child("rollout")
+		for i, rs := range rollout.PreviousResources {
+			if err := validateResourceSchema(rs, rolloutPath.Child("previousResources").Index(i)); err != nil {
+				return admission.NewForbidden(a, err)
+			}
+		}
+		if rollout.Selector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(rollout.Selector); err != nil {
+				return admission.NewForbidden(a, field.Invalid(rolloutPath.Child("selector"), rollout.Selector, err.Error()))
+			}
+		}
+	}
+
 	return nil
 }
 
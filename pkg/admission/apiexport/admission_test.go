@@ -208,6 +208,48 @@ func TestAdmission(t *testing.T) {
 				})
 			},
 		},
+		"ForbiddenInvalidRolloutResourceSchema": {
+			update:      true,
+			kind:        "APIExport",
+			resource:    "apiexports",
+			isBuiltIn:   false,
+			hasIdentity: true,
+			modifyExport: func(ae *apisv1alpha2.APIExport) {
+				ae.Spec.Rollout = &apisv1alpha2.APIExportRollout{
+					PreviousResources: []apisv1alpha2.ResourceSchema{{
+						Name:   "foo",
+						Group:  "bar",
+						Schema: "this.is.invalid",
+					}},
+				}
+			},
+			want: field.Invalid(
+				field.NewPath("spec").
+					Child("rollout").
+					Child("previousResources").
+					Index(0).
+					Child("schema"),
+				"this.is.invalid",
+				"must end in .foo.bar"),
+		},
+		"ValidRollout": {
+			update:      true,
+			kind:        "APIExport",
+			resource:    "apiexports",
+			isBuiltIn:   false,
+			hasIdentity: true,
+			modifyExport: func(ae *apisv1alpha2.APIExport) {
+				ae.Spec.Rollout = &apisv1alpha2.APIExportRollout{
+					PreviousResources: []apisv1alpha2.ResourceSchema{{
+						Name:   "foo",
+						Group:  "bar",
+						Schema: "v1.foo.bar",
+					}},
+					Percentage: 10,
+					Selector:   &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}},
+				}
+			},
+		},
 		"ValidCreateNonBuiltIn": {
 			kind:        "APIExport",
 			resource:    "apiexports",
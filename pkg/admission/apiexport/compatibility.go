@@ -0,0 +1,363 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiexport
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/admission"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/warning"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	kcpdynamic "github.com/kcp-dev/client-go/dynamic"
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha1 "github.com/kcp-dev/sdk/apis/apis/v1alpha1"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+	"github.com/kcp-dev/sdk/apis/core"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	kcpinformers "github.com/kcp-dev/sdk/client/informers/externalversions"
+
+	"github.com/kcp-dev/kcp/pkg/indexers"
+	"github.com/kcp-dev/kcp/pkg/reconciler/apis/apibinding"
+	"github.com/kcp-dev/kcp/pkg/schemacompat"
+)
+
+// maxCheckedBindings is the maximum number of APIBindings whose objects are checked against a new
+// schema on dry-run.
+const maxCheckedBindings = 10
+
+// SetKcpInformers is an admission plugin initializer function that injects the informers used to
+// look up the schemas and bindings of an APIExport when warning about breaking schema changes, and
+// the shards whose bindings are not checked.
+func (e *APIExportAdmission) SetKcpInformers(local, global kcpinformers.SharedInformerFactory) {
+	apiResourceSchemasReady := local.Apis().V1alpha1().APIResourceSchemas().Informer().HasSynced
+	apiBindingsReady := local.Apis().V1alpha2().APIBindings().Informer().HasSynced
+	shardsReady := global.Core().V1alpha1().Shards().Informer().HasSynced
+	e.SetReadyFunc(func() bool {
+		return apiResourceSchemasReady() && apiBindingsReady() && shardsReady()
+	})
+
+	indexers.AddIfNotPresentOrDie(local.Apis().V1alpha2().APIBindings().Informer().GetIndexer(), cache.Indexers{
+		indexers.APIBindingsByAPIExport: indexers.IndexAPIBindingByAPIExport,
+	})
+
+	apiResourceSchemaLister := local.Apis().V1alpha1().APIResourceSchemas().Lister()
+	e.getAPIResourceSchema = func(clusterName logicalcluster.Name, name string) (*apisv1alpha1.APIResourceSchema, error) {
+		return apiResourceSchemaLister.Cluster(clusterName).Get(name)
+	}
+	apiBindingIndexer := local.Apis().V1alpha2().APIBindings().Informer().GetIndexer()
+	e.listAPIBindings = func(clusterName logicalcluster.Name, export *apisv1alpha2.APIExport) ([]*apisv1alpha2.APIBinding, error) {
+		return listAPIBindingsByAPIExport(apiBindingIndexer, clusterName, export)
+	}
+	shardLister := global.Core().V1alpha1().Shards().Lister()
+	e.listShards = func() ([]*corev1alpha1.Shard, error) {
+		return shardLister.List(labels.Everything())
+	}
+}
+
+// SetDynamicClusterClient is an admission plugin initializer function that injects the client used
+// to list bound objects when checking them against a new schema on dry-run.
+func (e *APIExportAdmission) SetDynamicClusterClient(client kcpdynamic.ClusterInterface) {
+	e.listObjects = func(ctx context.Context, clusterName logicalcluster.Name, gvr schema.GroupVersionResource, continueToken string) (*unstructured.UnstructuredList, error) {
+		return client.Cluster(clusterName.Path()).Resource(gvr).List(ctx, metav1.ListOptions{Limit: 500, Continue: continueToken})
+	}
+}
+
+func listAPIBindingsByAPIExport(indexer cache.Indexer, clusterName logicalcluster.Name, export *apisv1alpha2.APIExport) ([]*apisv1alpha2.APIBinding, error) {
+	// binding keys by full path
+	keys := sets.New[string]()
+	if path := logicalcluster.NewPath(export.Annotations[core.LogicalClusterPathAnnotationKey]); !path.Empty() {
+		pathKeys, err := indexer.IndexKeys(indexers.APIBindingsByAPIExport, path.Join(export.Name).String())
+		if err != nil {
+			return nil, err
+		}
+		keys.Insert(pathKeys...)
+	}
+	clusterKeys, err := indexer.IndexKeys(indexers.APIBindingsByAPIExport, clusterName.Path().Join(export.Name).String())
+	if err != nil {
+		return nil, err
+	}
+	keys.Insert(clusterKeys...)
+
+	bindings := make([]*apisv1alpha2.APIBinding, 0, keys.Len())
+	for _, key := range sets.List(keys) {
+		obj, exists, err := indexer.GetByKey(key)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			bindings = append(bindings, obj.(*apisv1alpha2.APIBinding))
+		}
+	}
+	return bindings, nil
+}
+
+// warnAboutBreakingSchemaChanges adds a warning for every change of the resource schemas of the
+// APIExport that objects valid under the previous schema might not survive. On dry-run, it also checks
+// the objects in the workspaces bound on this shard against the new schema, and warns about the
+// workspaces holding objects that would violate it. Failures to compute the warnings are logged, but
+// never fail the request.
+func (e *APIExportAdmission) warnAboutBreakingSchemaChanges(ctx context.Context, a admission.Attributes, oldExport, newExport *apisv1alpha2.APIExport) {
+	logger := klog.FromContext(ctx).WithValues("apiexport", newExport.Name)
+
+	clusterName, err := genericapirequest.ClusterNameFrom(ctx)
+	if err != nil {
+		logger.Error(err, "failed to determine logical cluster")
+		return
+	}
+
+	oldSchemas := map[schema.GroupResource]string{}
+	for _, rs := range oldExport.Spec.Resources {
+		oldSchemas[schema.GroupResource{Group: rs.Group, Resource: rs.Name}] = rs.Schema
+	}
+
+	for _, rs := range newExport.Spec.Resources {
+		gr := schema.GroupResource{Group: rs.Group, Resource: rs.Name}
+		oldName, ok := oldSchemas[gr]
+		if !ok || oldName == rs.Schema {
+			continue
+		}
+		oldSchema, err := e.getAPIResourceSchema(clusterName, oldName)
+		if err != nil {
+			logger.Error(err, "failed to get APIResourceSchema", "name", oldName)
+			continue
+		}
+		newSchema, err := e.getAPIResourceSchema(clusterName, rs.Schema)
+		if err != nil {
+			logger.Error(err, "failed to get APIResourceSchema", "name", rs.Schema)
+			continue
+		}
+
+		changes, err := breakingChanges(oldSchema, newSchema)
+		if err != nil {
+			logger.Error(err, "failed to compare APIResourceSchemas", "old", oldName, "new", rs.Schema)
+			continue
+		}
+		for _, change := range changes {
+			warning.AddWarning(ctx, "", fmt.Sprintf("%s: breaking change from APIResourceSchema %s to %s: %s", gr, oldName, rs.Schema, change))
+		}
+
+		if len(changes) > 0 && a.IsDryRun() && e.listAPIBindings != nil && e.listObjects != nil {
+			for _, violation := range e.boundObjectViolations(ctx, clusterName, newExport, gr, oldSchema, newSchema) {
+				warning.AddWarning(ctx, "", fmt.Sprintf("%s: %s", gr, violation))
+			}
+		}
+	}
+}
+
+// breakingChanges returns the served versions of the existing schema that the new schema does not
+// serve anymore, and the breaking changes of the OpenAPI schemas of the versions served by both.
+func breakingChanges(existing, new *apisv1alpha1.APIResourceSchema) ([]string, error) {
+	var changes []string
+	for _, existingVersion := range existing.Spec.Versions {
+		if !existingVersion.Served {
+			continue
+		}
+		newVersion := servedVersion(new, existingVersion.Name)
+		if newVersion == nil {
+			changes = append(changes, fmt.Sprintf("version %s is no longer served", existingVersion.Name))
+			continue
+		}
+
+		existingOpenAPI, err := existingVersion.GetSchema()
+		if err != nil {
+			return nil, err
+		}
+		newOpenAPI, err := newVersion.GetSchema()
+		if err != nil {
+			return nil, err
+		}
+		if existingOpenAPI == nil || newOpenAPI == nil {
+			continue
+		}
+
+		errs, err := schemacompat.BreakingChanges(field.NewPath("versions").Key(existingVersion.Name).Child("schema"), existingOpenAPI, newOpenAPI)
+		if err != nil {
+			return nil, err
+		}
+		for _, err := range errs {
+			changes = append(changes, err.Error())
+		}
+	}
+	return changes, nil
+}
+
+// boundObjectViolations lists the objects of the given resource in the workspaces bound to the
+// APIExport on this shard, in a version served by both schemas, and returns one entry per workspace
+// holding objects that would violate the new schema, and one if there are other shards that are not
+// checked. Workspaces whose APIBinding stays on another schema, because it pins one or is not selected
+// by the rollout of the APIExport, are skipped.
+func (e *APIExportAdmission) boundObjectViolations(ctx context.Context, clusterName logicalcluster.Name, export *apisv1alpha2.APIExport, gr schema.GroupResource, oldSchema, newSchema *apisv1alpha1.APIResourceSchema) []string {
+	logger := klog.FromContext(ctx).WithValues("apiexport", export.Name, "resource", gr)
+
+	version, newOpenAPI, err := commonVersionSchema(oldSchema, newSchema)
+	if err != nil {
+		logger.Error(err, "failed to get OpenAPI schema", "schema", newSchema.Name)
+		return nil
+	}
+	if version == "" || newOpenAPI == nil {
+		return nil
+	}
+	gvr := gr.WithVersion(version)
+
+	bindings, err := e.listAPIBindings(clusterName, export)
+	if err != nil {
+		logger.Error(err, "failed to list APIBindings")
+		return nil
+	}
+	var boundClusterNames []logicalcluster.Name
+	for _, binding := range bindings {
+		if !movesToSchema(export, binding, gr, newSchema) {
+			continue
+		}
+		for _, br := range binding.Status.BoundResources {
+			if br.Group == gr.Group && br.Resource == gr.Resource {
+				boundClusterNames = append(boundClusterNames, logicalcluster.From(binding))
+				break
+			}
+		}
+	}
+	sort.Slice(boundClusterNames, func(i, j int) bool { return boundClusterNames[i] < boundClusterNames[j] })
+
+	var violations []string
+	for i, boundClusterName := range boundClusterNames {
+		if i == maxCheckedBindings {
+			violations = append(violations, fmt.Sprintf("objects in %d more bound workspaces have not been checked", len(boundClusterNames)-i))
+			break
+		}
+
+		count, example, err := e.countViolatingObjects(ctx, boundClusterName, gvr, newOpenAPI)
+		if err != nil {
+			logger.Error(err, "failed to check bound objects", "cluster", boundClusterName)
+			continue
+		}
+		if count > 0 {
+			violations = append(violations, fmt.Sprintf("%d objects in bound workspace %s would violate APIResourceSchema %s, e.g. %s", count, boundClusterName, newSchema.Name, example))
+		}
+	}
+
+	// APIBindings are not replicated to the cache server, so the ones on other shards are unknown here.
+	if e.listShards != nil {
+		shards, err := e.listShards()
+		if err != nil {
+			logger.Error(err, "failed to list shards")
+		} else if len(shards) > 1 {
+			violations = append(violations, fmt.Sprintf("objects in bound workspaces on the %d other shards have not been checked", len(shards)-1))
+		}
+	}
+	return violations
+}
+
+// movesToSchema returns whether the APIBinding is going to be bound to the new schema of the given
+// resource, i.e. whether it is selected by a rollout of the APIExport and does not pin another schema.
+func movesToSchema(export *apisv1alpha2.APIExport, binding *apisv1alpha2.APIBinding, gr schema.GroupResource, newSchema *apisv1alpha1.APIResourceSchema) bool {
+	resources, _, err := apibinding.RolloutResources(export, binding)
+	if err != nil {
+		return false
+	}
+	i := slices.IndexFunc(resources, func(res apisv1alpha2.ResourceSchema) bool {
+		return res.Group == gr.Group && res.Name == gr.Resource
+	})
+	if i < 0 || resources[i].Schema != newSchema.Name {
+		return false
+	}
+
+	i = slices.IndexFunc(binding.Spec.PinnedSchemas, func(pin apisv1alpha2.PinnedSchema) bool {
+		return pin.Group == gr.Group && pin.Resource == gr.Resource
+	})
+	if i < 0 {
+		return true
+	}
+	pin := binding.Spec.PinnedSchemas[i]
+	if pin.Schema != "" {
+		return pin.Schema == newSchema.Name
+	}
+	return pin.SchemaUID == string(newSchema.UID)
+}
+
+// countViolatingObjects returns the number of objects of the given resource in the logical cluster
+// that would violate the given schema, and a description of the first violation.
+func (e *APIExportAdmission) countViolatingObjects(ctx context.Context, clusterName logicalcluster.Name, gvr schema.GroupVersionResource, s *apiextensionsv1.JSONSchemaProps) (int, string, error) {
+	count := 0
+	example := ""
+	continueToken := ""
+	for {
+		list, err := e.listObjects(ctx, clusterName, gvr, continueToken)
+		if err != nil {
+			return 0, "", err
+		}
+		for _, obj := range list.Items {
+			violations, err := schemacompat.ObjectViolations(obj.Object, s)
+			if err != nil {
+				return 0, "", err
+			}
+			if len(violations) == 0 {
+				continue
+			}
+			if count == 0 {
+				example = fmt.Sprintf("%s: %s", cache.NewObjectName(obj.GetNamespace(), obj.GetName()), violations[0])
+			}
+			count++
+		}
+		continueToken = list.GetContinue()
+		if continueToken == "" {
+			return count, example, nil
+		}
+	}
+}
+
+// commonVersionSchema returns a version served by both schemas, preferring the storage version of the
+// new schema, and the OpenAPI schema of the new schema for it.
+func commonVersionSchema(oldSchema, newSchema *apisv1alpha1.APIResourceSchema) (string, *apiextensionsv1.JSONSchemaProps, error) {
+	var candidates []apisv1alpha1.APIResourceVersion
+	for _, v := range newSchema.Spec.Versions {
+		if v.Served && servedVersion(oldSchema, v.Name) != nil {
+			candidates = append(candidates, v)
+		}
+	}
+	if len(candidates) == 0 {
+		return "", nil, nil
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].Storage && !candidates[j].Storage })
+
+	s, err := candidates[0].GetSchema()
+	if err != nil {
+		return "", nil, err
+	}
+	return candidates[0].Name, s, nil
+}
+
+func servedVersion(s *apisv1alpha1.APIResourceSchema, name string) *apisv1alpha1.APIResourceVersion {
+	for i := range s.Spec.Versions {
+		if v := &s.Spec.Versions[i]; v.Name == name && v.Served {
+			return v
+		}
+	}
+	return nil
+}
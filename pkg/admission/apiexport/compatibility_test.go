@@ -0,0 +1,261 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiexport
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/warning"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	"github.com/kcp-dev/sdk/apis/apis"
+	apisv1alpha1 "github.com/kcp-dev/sdk/apis/apis/v1alpha1"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+
+	"github.com/kcp-dev/kcp/pkg/admission/helpers"
+)
+
+type warningRecorder []string
+
+func (r *warningRecorder) AddWarning(_, text string) {
+	*r = append(*r, text)
+}
+
+func widgetsSchema(t *testing.T, name string, spec map[string]apiextensionsv1.JSONSchemaProps) *apisv1alpha1.APIResourceSchema {
+	t.Helper()
+	version := apisv1alpha1.APIResourceVersion{Name: "v1", Served: true, Storage: true}
+	require.NoError(t, version.SetSchema(&apiextensionsv1.JSONSchemaProps{
+		Type: "object",
+		Properties: map[string]apiextensionsv1.JSONSchemaProps{
+			"apiVersion": {Type: "string"},
+			"kind":       {Type: "string"},
+			"metadata":   {Type: "object"},
+			"spec":       {Type: "object", Properties: spec},
+		},
+	}))
+	return &apisv1alpha1.APIResourceSchema{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: apisv1alpha1.APIResourceSchemaSpec{
+			Group:    "example.io",
+			Names:    apiextensionsv1.CustomResourceDefinitionNames{Plural: "widgets", Kind: "Widget"},
+			Scope:    apiextensionsv1.NamespaceScoped,
+			Versions: []apisv1alpha1.APIResourceVersion{version},
+		},
+	}
+}
+
+func widget(name string, spec map[string]interface{}) unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.io/v1",
+		"kind":       "Widget",
+		"metadata":   map[string]interface{}{"name": name, "namespace": "default"},
+		"spec":       spec,
+	}}
+}
+
+func TestBreakingSchemaChangeWarnings(t *testing.T) {
+	t.Parallel()
+
+	schemas := map[string]*apisv1alpha1.APIResourceSchema{
+		"v1.widgets.example.io": widgetsSchema(t, "v1.widgets.example.io", map[string]apiextensionsv1.JSONSchemaProps{
+			"size":  {Type: "integer"},
+			"color": {Type: "string"},
+		}),
+		"v2.widgets.example.io": widgetsSchema(t, "v2.widgets.example.io", map[string]apiextensionsv1.JSONSchemaProps{
+			"size": {Type: "integer"},
+		}),
+		"v3.widgets.example.io": widgetsSchema(t, "v3.widgets.example.io", map[string]apiextensionsv1.JSONSchemaProps{
+			"size":  {Type: "integer"},
+			"color": {Type: "string"},
+			"label": {Type: "string"},
+		}),
+	}
+	objects := map[logicalcluster.Name][]unstructured.Unstructured{
+		"consumer-a": {widget("plain", map[string]interface{}{"size": int64(1)})},
+		"consumer-b": {
+			widget("red", map[string]interface{}{"size": int64(1), "color": "red"}),
+			widget("blue", map[string]interface{}{"size": int64(2), "color": "blue"}),
+		},
+	}
+
+	export := func(schema string, rollout *apisv1alpha2.APIExportRollout) *apisv1alpha2.APIExport {
+		return &apisv1alpha2.APIExport{
+			ObjectMeta: metav1.ObjectMeta{Name: "widgets"},
+			Spec: apisv1alpha2.APIExportSpec{
+				Resources: []apisv1alpha2.ResourceSchema{{Group: "example.io", Name: "widgets", Schema: schema, Storage: apisv1alpha2.ResourceSchemaStorage{CRD: &apisv1alpha2.ResourceSchemaStorageCRD{}}}},
+				Rollout:   rollout,
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		oldSchema, newSchema string
+		rollout              *apisv1alpha2.APIExportRollout
+		pinned               map[string]apisv1alpha2.PinnedSchema
+		shards               int
+		dryRun               bool
+		wantWarnings         []string
+	}{
+		"unchanged schema": {
+			oldSchema: "v1.widgets.example.io",
+			newSchema: "v1.widgets.example.io",
+		},
+		"compatible change": {
+			oldSchema: "v1.widgets.example.io",
+			newSchema: "v3.widgets.example.io",
+			dryRun:    true,
+		},
+		"removed field": {
+			oldSchema: "v1.widgets.example.io",
+			newSchema: "v2.widgets.example.io",
+			wantWarnings: []string{
+				`widgets.example.io: breaking change from APIResourceSchema v1.widgets.example.io to v2.widgets.example.io: versions[v1].schema.properties[spec].properties: Invalid value: ["color"]: properties have been removed in an incompatible way`,
+			},
+		},
+		"removed field on dry-run": {
+			oldSchema: "v1.widgets.example.io",
+			newSchema: "v2.widgets.example.io",
+			dryRun:    true,
+			wantWarnings: []string{
+				`widgets.example.io: breaking change from APIResourceSchema v1.widgets.example.io to v2.widgets.example.io: versions[v1].schema.properties[spec].properties: Invalid value: ["color"]: properties have been removed in an incompatible way`,
+				`widgets.example.io: 2 objects in bound workspace consumer-b would violate APIResourceSchema v2.widgets.example.io, e.g. default/red: spec.color: field would be pruned`,
+			},
+		},
+		"removed field on dry-run with other shards": {
+			oldSchema: "v1.widgets.example.io",
+			newSchema: "v2.widgets.example.io",
+			shards:    3,
+			dryRun:    true,
+			wantWarnings: []string{
+				`widgets.example.io: breaking change from APIResourceSchema v1.widgets.example.io to v2.widgets.example.io: versions[v1].schema.properties[spec].properties: Invalid value: ["color"]: properties have been removed in an incompatible way`,
+				`widgets.example.io: 2 objects in bound workspace consumer-b would violate APIResourceSchema v2.widgets.example.io, e.g. default/red: spec.color: field would be pruned`,
+				`widgets.example.io: objects in bound workspaces on the 2 other shards have not been checked`,
+			},
+		},
+		"removed field on dry-run with a binding pinned to the old schema": {
+			oldSchema: "v1.widgets.example.io",
+			newSchema: "v2.widgets.example.io",
+			pinned: map[string]apisv1alpha2.PinnedSchema{
+				"consumer-b": {Group: "example.io", Resource: "widgets", Schema: "v1.widgets.example.io"},
+			},
+			dryRun: true,
+			wantWarnings: []string{
+				`widgets.example.io: breaking change from APIResourceSchema v1.widgets.example.io to v2.widgets.example.io: versions[v1].schema.properties[spec].properties: Invalid value: ["color"]: properties have been removed in an incompatible way`,
+			},
+		},
+		"removed field on dry-run with a binding pinned to the new schema": {
+			oldSchema: "v1.widgets.example.io",
+			newSchema: "v2.widgets.example.io",
+			pinned: map[string]apisv1alpha2.PinnedSchema{
+				"consumer-b": {Group: "example.io", Resource: "widgets", Schema: "v2.widgets.example.io"},
+			},
+			dryRun: true,
+			wantWarnings: []string{
+				`widgets.example.io: breaking change from APIResourceSchema v1.widgets.example.io to v2.widgets.example.io: versions[v1].schema.properties[spec].properties: Invalid value: ["color"]: properties have been removed in an incompatible way`,
+				`widgets.example.io: 2 objects in bound workspace consumer-b would violate APIResourceSchema v2.widgets.example.io, e.g. default/red: spec.color: field would be pruned`,
+			},
+		},
+		"removed field on dry-run with a binding not selected by the rollout": {
+			oldSchema: "v1.widgets.example.io",
+			newSchema: "v2.widgets.example.io",
+			rollout: &apisv1alpha2.APIExportRollout{
+				PreviousResources: []apisv1alpha2.ResourceSchema{{Group: "example.io", Name: "widgets", Schema: "v1.widgets.example.io"}},
+				Selector:          &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}},
+			},
+			dryRun: true,
+			wantWarnings: []string{
+				`widgets.example.io: breaking change from APIResourceSchema v1.widgets.example.io to v2.widgets.example.io: versions[v1].schema.properties[spec].properties: Invalid value: ["color"]: properties have been removed in an incompatible way`,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plugin := NewAPIExportAdmission(func(apis.GroupResource) bool { return false })
+			plugin.getAPIResourceSchema = func(clusterName logicalcluster.Name, name string) (*apisv1alpha1.APIResourceSchema, error) {
+				require.Equal(t, logicalcluster.Name("provider"), clusterName)
+				if s, ok := schemas[name]; ok {
+					return s, nil
+				}
+				return nil, apierrors.NewNotFound(apisv1alpha1.Resource("apiresourceschemas"), name)
+			}
+			plugin.listAPIBindings = func(clusterName logicalcluster.Name, export *apisv1alpha2.APIExport) ([]*apisv1alpha2.APIBinding, error) {
+				var bindings []*apisv1alpha2.APIBinding
+				for _, consumer := range []string{"consumer-b", "consumer-a"} {
+					binding := &apisv1alpha2.APIBinding{
+						ObjectMeta: metav1.ObjectMeta{Name: "widgets", Annotations: map[string]string{logicalcluster.AnnotationKey: consumer}},
+						Status: apisv1alpha2.APIBindingStatus{BoundResources: []apisv1alpha2.BoundAPIResource{
+							{Group: "example.io", Resource: "widgets"},
+						}},
+					}
+					if pin, ok := tc.pinned[consumer]; ok {
+						binding.Spec.PinnedSchemas = []apisv1alpha2.PinnedSchema{pin}
+					}
+					bindings = append(bindings, binding)
+				}
+				return bindings, nil
+			}
+			plugin.listObjects = func(ctx context.Context, clusterName logicalcluster.Name, gvr schema.GroupVersionResource, continueToken string) (*unstructured.UnstructuredList, error) {
+				require.Equal(t, schema.GroupVersionResource{Group: "example.io", Version: "v1", Resource: "widgets"}, gvr)
+				return &unstructured.UnstructuredList{Items: objects[clusterName]}, nil
+			}
+
+			plugin.listShards = func() ([]*corev1alpha1.Shard, error) {
+				shards := []*corev1alpha1.Shard{{ObjectMeta: metav1.ObjectMeta{Name: "root"}}}
+				for i := 1; i < tc.shards; i++ {
+					shards = append(shards, &corev1alpha1.Shard{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("shard-%d", i)}})
+				}
+				return shards, nil
+			}
+
+			var recorder warningRecorder
+			ctx := warning.WithWarningRecorder(context.Background(), &recorder)
+			ctx = genericapirequest.WithCluster(ctx, genericapirequest.Cluster{Name: "provider"})
+
+			attr := admission.NewAttributesRecord(
+				helpers.ToUnstructuredOrDie(export(tc.newSchema, tc.rollout)),
+				helpers.ToUnstructuredOrDie(export(tc.oldSchema, nil)),
+				apisv1alpha2.Kind("APIExport").WithVersion("v1alpha2"),
+				"",
+				"widgets",
+				apisv1alpha2.Resource("apiexports").WithVersion("v1alpha2"),
+				"",
+				admission.Update,
+				&metav1.UpdateOptions{},
+				tc.dryRun,
+				&user.DefaultInfo{},
+			)
+			require.NoError(t, plugin.Validate(ctx, attr, nil))
+			require.Equal(t, tc.wantWarnings, []string(recorder))
+		})
+	}
+}
@@ -33,6 +33,7 @@ import (
 	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
 
 	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
 	kcpinformers "github.com/kcp-dev/sdk/client/informers/externalversions"
 	corev1alpha1listers "github.com/kcp-dev/sdk/client/listers/core/v1alpha1"
 
@@ -67,6 +68,17 @@ var _ = admission.MutationInterface(&plugin{})
 var _ = admission.InitializationValidator(&plugin{})
 var _ = kcpinitializers.WantsKcpInformers(&plugin{})
 
+// protectedAnnotations can only be changed by system users, as they lift the
+// limits enforced on the logical cluster, or its retention after deletion,
+// and its owner can update the LogicalCluster.
+var protectedAnnotations = []string{
+	corev1alpha1.LogicalClusterMaxTotalObjectsAnnotationKey,
+	corev1alpha1.LogicalClusterMaxObjectsPerResourceAnnotationKey,
+	corev1alpha1.LogicalClusterMaxStorageBytesAnnotationKey,
+	tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey,
+	corev1alpha1.LogicalClusterDeletedAnnotationKey,
+}
+
 var phaseOrdinal = map[corev1alpha1.LogicalClusterPhaseType]int{
 	corev1alpha1.LogicalClusterPhaseType(""):     1,
 	corev1alpha1.LogicalClusterPhaseScheduling:   2,
@@ -161,6 +173,14 @@ func (o *plugin) Validate(ctx context.Context, a admission.Attributes, _ admissi
 			return admission.NewForbidden(a, errs.ToAggregate())
 		}
 
+		for _, key := range protectedAnnotations {
+			oldValue, oldFound := old.Annotations[key]
+			newValue, newFound := logicalCluster.Annotations[key]
+			if oldFound != newFound || oldValue != newValue {
+				return admission.NewForbidden(a, fmt.Errorf("annotation %s can only be changed by system users", key))
+			}
+		}
+
 		oldSpec := toSet(old.Spec.Initializers)
 		newSpec := toSet(logicalCluster.Spec.Initializers)
 		oldStatus := toSet(old.Status.Initializers)
@@ -42,6 +42,10 @@ import (
 )
 
 func updateAttr(obj, old *corev1alpha1.LogicalCluster) admission.Attributes {
+	return updateAttrAs(obj, old, &kuser.DefaultInfo{})
+}
+
+func updateAttrAs(obj, old *corev1alpha1.LogicalCluster, userInfo *kuser.DefaultInfo) admission.Attributes {
 	return admission.NewAttributesRecord(
 		helpers.ToUnstructuredOrDie(obj),
 		helpers.ToUnstructuredOrDie(old),
@@ -53,7 +57,7 @@ func updateAttr(obj, old *corev1alpha1.LogicalCluster) admission.Attributes {
 		admission.Update,
 		&metav1.CreateOptions{},
 		false,
-		&kuser.DefaultInfo{},
+		userInfo,
 	)
 }
 
@@ -379,6 +383,96 @@ func TestValidate(t *testing.T) {
 				}).LogicalCluster,
 			),
 		},
+		{
+			name:        "fails to change the per-resource object limits as another user",
+			clusterName: "root:org:ws",
+			attr: updateAttr(
+				newLogicalCluster("root:org:ws").withAnnotation(corev1alpha1.LogicalClusterMaxObjectsPerResourceAnnotationKey, "secrets=1000").LogicalCluster,
+				newLogicalCluster("root:org:ws").withAnnotation(corev1alpha1.LogicalClusterMaxObjectsPerResourceAnnotationKey, "secrets=10").LogicalCluster,
+			),
+			wantErr: "annotation core.kcp.io/max-objects-per-resource can only be changed by system users",
+		},
+		{
+			name:        "fails to remove the total object limit as another user",
+			clusterName: "root:org:ws",
+			attr: updateAttr(
+				newLogicalCluster("root:org:ws").LogicalCluster,
+				newLogicalCluster("root:org:ws").withAnnotation(corev1alpha1.LogicalClusterMaxTotalObjectsAnnotationKey, "100").LogicalCluster,
+			),
+			wantErr: "annotation core.kcp.io/max-total-objects can only be changed by system users",
+		},
+		{
+			name:        "passes changing the per-resource object limits as system:masters",
+			clusterName: "root:org:ws",
+			attr: updateAttrAs(
+				newLogicalCluster("root:org:ws").withAnnotation(corev1alpha1.LogicalClusterMaxObjectsPerResourceAnnotationKey, "secrets=1000").LogicalCluster,
+				newLogicalCluster("root:org:ws").LogicalCluster,
+				&kuser.DefaultInfo{Groups: []string{kuser.SystemPrivilegedGroup}},
+			),
+		},
+		{
+			name:        "fails to lift the storage limit as another user",
+			clusterName: "root:org:ws",
+			attr: updateAttr(
+				newLogicalCluster("root:org:ws").withAnnotation(corev1alpha1.LogicalClusterMaxStorageBytesAnnotationKey, "0").LogicalCluster,
+				newLogicalCluster("root:org:ws").LogicalCluster,
+			),
+			wantErr: "annotation core.kcp.io/max-storage-bytes can only be changed by system users",
+		},
+		{
+			name:        "passes changing the storage limit as system:kcp:logical-cluster-admin",
+			clusterName: "root:org:ws",
+			attr: updateAttrAs(
+				newLogicalCluster("root:org:ws").withAnnotation(corev1alpha1.LogicalClusterMaxStorageBytesAnnotationKey, "2Gi").LogicalCluster,
+				newLogicalCluster("root:org:ws").withAnnotation(corev1alpha1.LogicalClusterMaxStorageBytesAnnotationKey, "1Gi").LogicalCluster,
+				&kuser.DefaultInfo{Groups: []string{"system:kcp:logical-cluster-admin"}},
+			),
+		},
+		{
+			name:        "fails to remove the max depth as another user",
+			clusterName: "root:org:ws",
+			attr: updateAttr(
+				newLogicalCluster("root:org:ws").LogicalCluster,
+				newLogicalCluster("root:org:ws").withAnnotation(tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey, "1").LogicalCluster,
+			),
+			wantErr: "annotation internal.tenancy.kcp.io/max-depth can only be changed by system users",
+		},
+		{
+			name:        "fails to raise the max depth as another user",
+			clusterName: "root:org:ws",
+			attr: updateAttr(
+				newLogicalCluster("root:org:ws").withAnnotation(tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey, "10").LogicalCluster,
+				newLogicalCluster("root:org:ws").withAnnotation(tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey, "1").LogicalCluster,
+			),
+			wantErr: "annotation internal.tenancy.kcp.io/max-depth can only be changed by system users",
+		},
+		{
+			name:        "fails to mark as deleted as another user",
+			clusterName: "root:org:ws",
+			attr: updateAttr(
+				newLogicalCluster("root:org:ws").withAnnotation(corev1alpha1.LogicalClusterDeletedAnnotationKey, "2999-01-01T00:00:00Z").LogicalCluster,
+				newLogicalCluster("root:org:ws").LogicalCluster,
+			),
+			wantErr: "annotation core.kcp.io/deleted can only be changed by system users",
+		},
+		{
+			name:        "fails to extend the retention as another user",
+			clusterName: "root:org:ws",
+			attr: updateAttr(
+				newLogicalCluster("root:org:ws").withAnnotation(corev1alpha1.LogicalClusterDeletedAnnotationKey, "2999-01-01T00:00:00Z").LogicalCluster,
+				newLogicalCluster("root:org:ws").withAnnotation(corev1alpha1.LogicalClusterDeletedAnnotationKey, "2026-01-01T00:00:00Z").LogicalCluster,
+			),
+			wantErr: "annotation core.kcp.io/deleted can only be changed by system users",
+		},
+		{
+			name:        "passes marking as deleted as system:kcp:logical-cluster-admin",
+			clusterName: "root:org:ws",
+			attr: updateAttrAs(
+				newLogicalCluster("root:org:ws").withAnnotation(corev1alpha1.LogicalClusterDeletedAnnotationKey, "2026-01-01T00:00:00Z").LogicalCluster,
+				newLogicalCluster("root:org:ws").LogicalCluster,
+				&kuser.DefaultInfo{Groups: []string{"system:kcp:logical-cluster-admin"}},
+			),
+		},
 		{
 			name:        "fails deletion as another user",
 			clusterName: "root:org:ws",
@@ -497,6 +591,14 @@ func (b thisWsBuilder) withStatus(status corev1alpha1.LogicalClusterStatus) this
 	return b
 }
 
+func (b thisWsBuilder) withAnnotation(key, value string) thisWsBuilder {
+	if b.Annotations == nil {
+		b.Annotations = map[string]string{}
+	}
+	b.Annotations[key] = value
+	return b
+}
+
 func (b thisWsBuilder) inactive() thisWsBuilder {
 	if b.Annotations == nil {
 		b.Annotations = map[string]string{}
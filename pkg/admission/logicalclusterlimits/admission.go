@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logicalclusterlimits
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/admission"
+	kuser "k8s.io/apiserver/pkg/authentication/user"
+
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+
+	"github.com/kcp-dev/kcp/pkg/authorization/bootstrap"
+)
+
+// Protects LogicalClusterLimits from changes by users of the logical cluster,
+// which would otherwise be able to lift their own limits.
+
+const (
+	PluginName = "core.kcp.io/LogicalClusterLimits"
+)
+
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName,
+		func(_ io.Reader) (admission.Interface, error) {
+			return &plugin{
+				Handler: admission.NewHandler(admission.Create, admission.Update, admission.Delete),
+			}, nil
+		})
+}
+
+type plugin struct {
+	*admission.Handler
+}
+
+// Ensure that the required admission interfaces are implemented.
+var _ = admission.ValidationInterface(&plugin{})
+
+// privilegedGroups may change LogicalClusterLimits, including their status.
+var privilegedGroups = []string{
+	kuser.SystemPrivilegedGroup,
+	bootstrap.SystemLogicalClusterAdmin,
+	bootstrap.SystemExternalLogicalClusterAdmin,
+}
+
+// Validate only admits changes to LogicalClusterLimits by privileged system
+// users, and only for the "cluster" singleton.
+func (o *plugin) Validate(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	if a.GetResource().GroupResource() != corev1alpha1.Resource("logicalclusterlimits") {
+		return nil
+	}
+
+	if a.GetOperation() == admission.Create && a.GetName() != corev1alpha1.LogicalClusterLimitsName {
+		return admission.NewForbidden(a, fmt.Errorf("LogicalClusterLimits must be named %q", corev1alpha1.LogicalClusterLimitsName))
+	}
+
+	if !sets.New(a.GetUserInfo().GetGroups()...).HasAny(privilegedGroups...) {
+		return admission.NewForbidden(a, fmt.Errorf("LogicalClusterLimits can only be changed by system users"))
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logicalclusterlimits
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/admission"
+	kuser "k8s.io/apiserver/pkg/authentication/user"
+
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+
+	"github.com/kcp-dev/kcp/pkg/authorization/bootstrap"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	limits := corev1alpha1.Resource("logicalclusterlimits").WithVersion("v1alpha1")
+
+	tests := map[string]struct {
+		resource    schema.GroupVersionResource
+		name        string
+		subresource string
+		operation   admission.Operation
+		groups      []string
+		wantErr     bool
+	}{
+		"privileged user creates": {
+			resource:  limits,
+			name:      corev1alpha1.LogicalClusterLimitsName,
+			operation: admission.Create,
+			groups:    []string{kuser.SystemPrivilegedGroup},
+		},
+		"logical cluster admin updates": {
+			resource:  limits,
+			name:      corev1alpha1.LogicalClusterLimitsName,
+			operation: admission.Update,
+			groups:    []string{bootstrap.SystemLogicalClusterAdmin},
+		},
+		"privileged user creates with another name": {
+			resource:  limits,
+			name:      "other",
+			operation: admission.Create,
+			groups:    []string{kuser.SystemPrivilegedGroup},
+			wantErr:   true,
+		},
+		"workspace user creates": {
+			resource:  limits,
+			name:      corev1alpha1.LogicalClusterLimitsName,
+			operation: admission.Create,
+			groups:    []string{"system:authenticated"},
+			wantErr:   true,
+		},
+		"workspace user updates the status": {
+			resource:    limits,
+			name:        corev1alpha1.LogicalClusterLimitsName,
+			subresource: "status",
+			operation:   admission.Update,
+			groups:      []string{"system:authenticated"},
+			wantErr:     true,
+		},
+		"workspace user deletes": {
+			resource:  limits,
+			name:      corev1alpha1.LogicalClusterLimitsName,
+			operation: admission.Delete,
+			groups:    []string{"system:authenticated"},
+			wantErr:   true,
+		},
+		"other resources are ignored": {
+			resource:  corev1alpha1.Resource("logicalclusters").WithVersion("v1alpha1"),
+			name:      "other",
+			operation: admission.Create,
+			groups:    []string{"system:authenticated"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			a := admission.NewAttributesRecord(
+				nil,
+				nil,
+				corev1alpha1.Kind("LogicalClusterLimits").WithVersion("v1alpha1"),
+				"",
+				tc.name,
+				tc.resource,
+				tc.subresource,
+				tc.operation,
+				nil,
+				false,
+				&kuser.DefaultInfo{Name: "user", Groups: tc.groups},
+			)
+			err := (&plugin{}).Validate(context.Background(), a, nil)
+			if tc.wantErr {
+				require.Error(t, err)
+				require.True(t, apierrors.IsForbidden(err))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -25,10 +25,12 @@ import (
 	eventsv1 "k8s.io/api/events/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apiserver/pkg/admission"
 	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
 	"k8s.io/klog/v2"
 
+	"github.com/kcp-dev/logicalcluster/v3"
 	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
 	kcpinformers "github.com/kcp-dev/sdk/client/informers/externalversions"
 	corev1alpha1listers "github.com/kcp-dev/sdk/client/listers/core/v1alpha1"
@@ -52,16 +54,20 @@ func Register(plugins *admission.Plugins) {
 }
 
 // objectCountLimit is a validating admission plugin enforcing a hard limit on
-// the total number of objects in a logical cluster. The current count is
-// tracked by the objectcount.Registry: an authoritative base from a periodic
-// etcd scan plus a delta maintained here (+1 per admitted create, -1 per
-// delete). The limit is resolved from the core.kcp.io/max-total-objects
-// annotation on the LogicalCluster, falling back to the shard-wide default.
+// the total number of objects in a logical cluster, and on the number of
+// objects per resource. The current counts are tracked by the
+// objectcount.Registry: an authoritative base from a periodic etcd scan plus a
+// delta maintained here (+1 per admitted create, -1 per delete). The total
+// limit is resolved from the core.kcp.io/max-total-objects annotation on the
+// LogicalCluster, falling back to the shard-wide default. The per-resource
+// limits are resolved from the core.kcp.io/max-objects-per-resource annotation
+// and the LogicalClusterLimits of the logical cluster.
 type objectCountLimit struct {
 	*admission.Handler
 
-	registry             *objectcount.Registry
-	logicalClusterLister corev1alpha1listers.LogicalClusterClusterLister
+	registry                   *objectcount.Registry
+	logicalClusterLister       corev1alpha1listers.LogicalClusterClusterLister
+	logicalClusterLimitsLister corev1alpha1listers.LogicalClusterLimitsClusterLister
 }
 
 var _ admission.ValidationInterface = &objectCountLimit{}
@@ -76,11 +82,15 @@ func (o *objectCountLimit) ValidateInitialization() error {
 	if o.logicalClusterLister == nil {
 		return fmt.Errorf("missing logicalClusterLister")
 	}
+	if o.logicalClusterLimitsLister == nil {
+		return fmt.Errorf("missing logicalClusterLimitsLister")
+	}
 	return nil
 }
 
-// Validate enforces the total object count limit of the logical cluster of
-// the request. Deletes are always allowed and decrement the tracked count.
+// Validate enforces the total and the per-resource object count limits of the
+// logical cluster of the request. Deletes are always allowed and decrement the
+// tracked counts.
 func (o *objectCountLimit) Validate(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
 	if !o.registry.EnforcementActive() {
 		return nil
@@ -94,8 +104,10 @@ func (o *objectCountLimit) Validate(ctx context.Context, a admission.Attributes,
 	// Skip workspace bootstrapping resources (like the kubequota plugin does)
 	// and events, which are short-lived and needed to debug an exhausted
 	// logical cluster.
-	switch a.GetResource().GroupResource() {
-	case corev1alpha1.SchemeGroupVersion.WithResource("logicalclusters").GroupResource():
+	resource := a.GetResource().GroupResource()
+	switch resource {
+	case corev1alpha1.SchemeGroupVersion.WithResource("logicalclusters").GroupResource(),
+		corev1alpha1.SchemeGroupVersion.WithResource("logicalclusterlimits").GroupResource():
 		return nil
 	case rbacv1.SchemeGroupVersion.WithResource("clusterrolebindings").GroupResource():
 		if a.GetName() == "workspace-admin" {
@@ -113,6 +125,7 @@ func (o *objectCountLimit) Validate(ctx context.Context, a admission.Attributes,
 
 	if a.GetOperation() == admission.Delete {
 		o.registry.Dec(cluster.Name)
+		o.registry.DecResource(cluster.Name, resource)
 		return nil
 	}
 
@@ -124,36 +137,57 @@ func (o *objectCountLimit) Validate(ctx context.Context, a admission.Attributes,
 			// Fail open: enforcing quota is less important than availability.
 			klog.FromContext(ctx).Error(err, "failed to get LogicalCluster, skipping object count limit", "cluster", cluster.Name)
 		}
-		o.registry.Inc(cluster.Name)
+		o.inc(cluster.Name, resource)
 		return nil
 	}
 
-	// Don't enforce before the logical cluster is fully bootstrapped.
-	if logicalCluster.Status.Phase != corev1alpha1.LogicalClusterPhaseReady {
-		o.registry.Inc(cluster.Name)
+	if !corev1alpha1.IsLogicalClusterLimitEnforced(logicalCluster.Status.Phase) {
+		o.inc(cluster.Name, resource)
 		return nil
 	}
 
-	limit := o.registry.LimitFor(cluster.Name, logicalCluster.Annotations)
-	if limit <= 0 {
-		o.registry.Inc(cluster.Name)
-		return nil
+	if limit := o.registry.LimitFor(cluster.Name, logicalCluster.Annotations); limit > 0 {
+		if count := o.registry.Count(cluster.Name); count >= limit {
+			return admission.NewForbidden(a, fmt.Errorf(
+				"logical cluster %q has reached its total object count limit (%d/%d objects); delete objects to free up capacity",
+				cluster.Name, count, limit))
+		}
 	}
 
-	if count := o.registry.Count(cluster.Name); count >= limit {
-		return admission.NewForbidden(a, fmt.Errorf(
-			"logical cluster %q has reached its total object count limit (%d/%d objects); delete objects to free up capacity",
-			cluster.Name, count, limit))
+	limits, err := o.logicalClusterLimitsLister.Cluster(cluster.Name).Get(corev1alpha1.LogicalClusterLimitsName)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.FromContext(ctx).Error(err, "failed to get LogicalClusterLimits, skipping its object count limits", "cluster", cluster.Name)
+		}
+		limits = nil
+	}
+	if limit, ok := objectcount.ResourceLimitsFor(logicalCluster.Annotations, limits)[resource]; ok {
+		// Counts are only known from the first scan after the limit was set.
+		if count, scanned := o.registry.ResourceCount(cluster.Name, resource); scanned && count >= limit {
+			return admission.NewForbidden(a, fmt.Errorf(
+				"logical cluster %q has reached its object count limit for %s (%d/%d objects); delete objects to free up capacity",
+				cluster.Name, resource, count, limit))
+		}
 	}
 
-	o.registry.Inc(cluster.Name)
+	o.inc(cluster.Name, resource)
 	return nil
 }
 
+// inc records an admitted creation of an object of the given resource.
+func (o *objectCountLimit) inc(cluster logicalcluster.Name, resource schema.GroupResource) {
+	o.registry.Inc(cluster)
+	o.registry.IncResource(cluster, resource)
+}
+
 func (o *objectCountLimit) SetKcpInformers(local, _ kcpinformers.SharedInformerFactory) {
 	logicalClusterInformer := local.Core().V1alpha1().LogicalClusters()
+	logicalClusterLimitsInformer := local.Core().V1alpha1().LogicalClusterLimits()
 	o.logicalClusterLister = logicalClusterInformer.Lister()
-	o.SetReadyFunc(logicalClusterInformer.Informer().HasSynced)
+	o.logicalClusterLimitsLister = logicalClusterLimitsInformer.Lister()
+	o.SetReadyFunc(func() bool {
+		return logicalClusterInformer.Informer().HasSynced() && logicalClusterLimitsInformer.Informer().HasSynced()
+	})
 }
 
 func (o *objectCountLimit) SetObjectCountRegistry(registry *objectcount.Registry) {
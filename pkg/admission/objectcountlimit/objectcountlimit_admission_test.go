@@ -18,6 +18,7 @@ package objectcountlimit
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -62,9 +63,10 @@ func configMapCreate(name string) admission.Attributes {
 
 func newPlugin(registry *objectcount.Registry, logicalClusters ...*corev1alpha1.LogicalCluster) *objectCountLimit {
 	return &objectCountLimit{
-		Handler:              admission.NewHandler(admission.Create, admission.Delete),
-		registry:             registry,
-		logicalClusterLister: fakeLogicalClusterClusterLister(logicalClusters),
+		Handler:                    admission.NewHandler(admission.Create, admission.Delete),
+		registry:                   registry,
+		logicalClusterLister:       fakeLogicalClusterClusterLister(logicalClusters),
+		logicalClusterLimitsLister: fakeLogicalClusterLimitsClusterLister{},
 	}
 }
 
@@ -119,6 +121,24 @@ func TestValidateRejectsOverLimit(t *testing.T) {
 	require.Contains(t, err.Error(), "2/2")
 }
 
+func TestValidateRejectsOverLimitWhenHibernated(t *testing.T) {
+	t.Parallel()
+
+	registry := objectcount.NewRegistry(1)
+	registry.SetEnforcementActive(true)
+
+	p := newPlugin(registry, newLogicalCluster(corev1alpha1.LogicalClusterPhaseInactive, map[string]string{
+		corev1alpha1.LogicalClusterHibernatedAnnotationKey: "2026-10-18T00:00:00Z",
+	}))
+	ctx := ctxWithCluster(t)
+
+	require.NoError(t, p.Validate(ctx, configMapCreate("cm1"), nil))
+
+	err := p.Validate(ctx, configMapCreate("cm2"), nil)
+	require.Error(t, err, "the request waking up a hibernated logical cluster must not skip the limit")
+	require.True(t, apierrors.IsForbidden(err))
+}
+
 func TestValidateDeleteAlwaysAllowedAndDecrements(t *testing.T) {
 	t.Parallel()
 
@@ -163,6 +183,10 @@ func TestValidateSkips(t *testing.T) {
 			name: "logicalclusters",
 			attr: newAttr(corev1alpha1.SchemeGroupVersion.WithResource("logicalclusters"), "cluster", admission.Create, ""),
 		},
+		{
+			name: "logicalclusterlimits",
+			attr: newAttr(corev1alpha1.SchemeGroupVersion.WithResource("logicalclusterlimits"), "cluster", admission.Create, ""),
+		},
 		{
 			name: "workspace-admin clusterrolebinding",
 			attr: newAttr(schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterrolebindings"}, "workspace-admin", admission.Create, ""),
@@ -246,6 +270,105 @@ func TestValidateAnnotationOverride(t *testing.T) {
 	})
 }
 
+func TestValidateResourceLimits(t *testing.T) {
+	t.Parallel()
+
+	secrets := corev1.SchemeGroupVersion.WithResource("secrets")
+	secretCreate := func(name string) admission.Attributes {
+		return newAttr(secrets, name, admission.Create, "")
+	}
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		limits      []corev1alpha1.ResourceObjectCountLimit
+		scanned     bool
+		wantAllowed int
+	}{
+		{
+			name:        "annotation",
+			annotations: map[string]string{corev1alpha1.LogicalClusterMaxObjectsPerResourceAnnotationKey: "secrets=2"},
+			scanned:     true,
+			wantAllowed: 2,
+		},
+		{
+			name:        "LogicalClusterLimits",
+			limits:      []corev1alpha1.ResourceObjectCountLimit{{Resource: "secrets", Max: 1}},
+			scanned:     true,
+			wantAllowed: 1,
+		},
+		{
+			name:        "LogicalClusterLimits take precedence over the annotation",
+			annotations: map[string]string{corev1alpha1.LogicalClusterMaxObjectsPerResourceAnnotationKey: "secrets=1"},
+			limits:      []corev1alpha1.ResourceObjectCountLimit{{Resource: "secrets", Max: 3}},
+			scanned:     true,
+			wantAllowed: 3,
+		},
+		{
+			name:        "zero forbids the resource",
+			annotations: map[string]string{corev1alpha1.LogicalClusterMaxObjectsPerResourceAnnotationKey: "secrets=0"},
+			scanned:     true,
+			wantAllowed: 0,
+		},
+		{
+			name:        "not enforced before the first scan",
+			annotations: map[string]string{corev1alpha1.LogicalClusterMaxObjectsPerResourceAnnotationKey: "secrets=0"},
+			scanned:     false,
+			wantAllowed: 5,
+		},
+		{
+			name:        "other resources are not limited",
+			annotations: map[string]string{corev1alpha1.LogicalClusterMaxObjectsPerResourceAnnotationKey: "configmaps=0"},
+			scanned:     true,
+			wantAllowed: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			registry := objectcount.NewRegistry(0)
+			registry.SetEnforcementActive(true)
+			if tt.scanned {
+				registry.ReplaceResourceBase(map[logicalcluster.Name]map[schema.GroupResource]int64{testCluster: {}})
+			}
+
+			p := newPlugin(registry, newLogicalCluster(corev1alpha1.LogicalClusterPhaseReady, tt.annotations))
+			if tt.limits != nil {
+				p.logicalClusterLimitsLister = fakeLogicalClusterLimitsClusterLister{{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        corev1alpha1.LogicalClusterLimitsName,
+						Annotations: map[string]string{logicalcluster.AnnotationKey: string(testCluster)},
+					},
+					Spec: corev1alpha1.LogicalClusterLimitsSpec{ObjectCounts: tt.limits},
+				}}
+			}
+			ctx := ctxWithCluster(t)
+
+			allowed := 0
+			for i := range 5 {
+				err := p.Validate(ctx, secretCreate(fmt.Sprintf("s%d", i)), nil)
+				if err != nil {
+					require.True(t, apierrors.IsForbidden(err))
+					require.Contains(t, err.Error(), "object count limit for secrets")
+					break
+				}
+				allowed++
+			}
+			require.Equal(t, tt.wantAllowed, allowed)
+
+			if allowed > 0 && allowed < 5 {
+				del := newAttr(secrets, "s0", admission.Delete, "")
+				require.NoError(t, p.Validate(ctx, del, nil))
+				require.NoError(t, p.Validate(ctx, secretCreate("again"), nil), "delete must free up capacity")
+			}
+			count, _ := registry.ResourceCount(testCluster, secrets.GroupResource())
+			require.Equal(t, int64(allowed), count)
+		})
+	}
+}
+
 func TestValidateInitialization(t *testing.T) {
 	t.Parallel()
 
@@ -256,6 +379,9 @@ func TestValidateInitialization(t *testing.T) {
 	require.Error(t, p.ValidateInitialization())
 
 	p.logicalClusterLister = fakeLogicalClusterClusterLister{}
+	require.Error(t, p.ValidateInitialization())
+
+	p.logicalClusterLimitsLister = fakeLogicalClusterLimitsClusterLister{}
 	require.NoError(t, p.ValidateInitialization())
 }
 
@@ -275,6 +401,37 @@ func (l fakeLogicalClusterClusterLister) Cluster(cluster logicalcluster.Name) co
 	return fakeLogicalClusterLister(perCluster)
 }
 
+type fakeLogicalClusterLimitsClusterLister []*corev1alpha1.LogicalClusterLimits
+
+func (l fakeLogicalClusterLimitsClusterLister) List(_ labels.Selector) ([]*corev1alpha1.LogicalClusterLimits, error) {
+	return l, nil
+}
+
+func (l fakeLogicalClusterLimitsClusterLister) Cluster(cluster logicalcluster.Name) corev1alpha1listers.LogicalClusterLimitsLister {
+	var perCluster []*corev1alpha1.LogicalClusterLimits
+	for _, limits := range l {
+		if logicalcluster.From(limits) == cluster {
+			perCluster = append(perCluster, limits)
+		}
+	}
+	return fakeLogicalClusterLimitsLister(perCluster)
+}
+
+type fakeLogicalClusterLimitsLister []*corev1alpha1.LogicalClusterLimits
+
+func (l fakeLogicalClusterLimitsLister) List(_ labels.Selector) ([]*corev1alpha1.LogicalClusterLimits, error) {
+	return l, nil
+}
+
+func (l fakeLogicalClusterLimitsLister) Get(name string) (*corev1alpha1.LogicalClusterLimits, error) {
+	for _, limits := range l {
+		if limits.Name == name {
+			return limits, nil
+		}
+	}
+	return nil, apierrors.NewNotFound(corev1alpha1.Resource("logicalclusterlimits"), name)
+}
+
 type fakeLogicalClusterLister []*corev1alpha1.LogicalCluster
 
 func (l fakeLogicalClusterLister) List(_ labels.Selector) ([]*corev1alpha1.LogicalCluster, error) {
@@ -73,6 +73,7 @@ var pathAnnotationResources = sets.New[string](
 	cachev1alpha1.Resource("clustercachedresources").String(),
 	apisv1alpha2.Resource("apibindings").String(),
 	tenancyv1alpha1.Resource("workspacetypes").String(),
+	tenancyv1alpha1.Resource("workspacequotas").String(),
 )
 
 // Ensure that the required admission interfaces are implemented.
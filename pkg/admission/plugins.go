@@ -58,6 +58,7 @@ import (
 	"github.com/kcp-dev/kcp/pkg/admission/kubequota"
 	"github.com/kcp-dev/kcp/pkg/admission/logicalcluster"
 	"github.com/kcp-dev/kcp/pkg/admission/logicalclusterfinalizer"
+	"github.com/kcp-dev/kcp/pkg/admission/logicalclusterlimits"
 	kcpmutatingadmissionpolicy "github.com/kcp-dev/kcp/pkg/admission/mutatingadmissionpolicy"
 	kcpmutatingwebhook "github.com/kcp-dev/kcp/pkg/admission/mutatingwebhook"
 	workspacenamespacelifecycle "github.com/kcp-dev/kcp/pkg/admission/namespacelifecycle"
@@ -69,9 +70,11 @@ import (
 	"github.com/kcp-dev/kcp/pkg/admission/reservedmetadata"
 	"github.com/kcp-dev/kcp/pkg/admission/reservednames"
 	"github.com/kcp-dev/kcp/pkg/admission/shard"
+	"github.com/kcp-dev/kcp/pkg/admission/storagelimit"
 	kcpvalidatingadmissionpolicy "github.com/kcp-dev/kcp/pkg/admission/validatingadmissionpolicy"
 	kcpvalidatingwebhook "github.com/kcp-dev/kcp/pkg/admission/validatingwebhook"
 	"github.com/kcp-dev/kcp/pkg/admission/workspace"
+	"github.com/kcp-dev/kcp/pkg/admission/workspacequota"
 	"github.com/kcp-dev/kcp/pkg/admission/workspacetype"
 	"github.com/kcp-dev/kcp/pkg/admission/workspacetypeexists"
 )
@@ -87,6 +90,7 @@ var AllOrderedPlugins = beforeWebhooks(
 	workspacetype.PluginName,
 	workspacetypeexists.PluginName,
 	logicalcluster.PluginName,
+	logicalclusterlimits.PluginName,
 	apiexport.PluginName,
 	apibinding.PluginName,
 	apibindingfinalizer.PluginName,
@@ -103,6 +107,8 @@ var AllOrderedPlugins = beforeWebhooks(
 	permissionclaims.PluginName,
 	pathannotation.PluginName,
 	objectcountlimit.PluginName,
+	storagelimit.PluginName,
+	workspacequota.PluginName,
 	kubequota.PluginName,
 	mutatingadmissionpolicy.PluginName,
 	clustercachedresource.PluginName,
@@ -128,6 +134,7 @@ func RegisterAllKcpAdmissionPlugins(plugins *admission.Plugins) {
 	workspacetype.Register(plugins)
 	workspacetypeexists.Register(plugins)
 	logicalcluster.Register(plugins)
+	logicalclusterlimits.Register(plugins)
 	apiresourceschema.Register(plugins)
 	apiexport.Register(plugins)
 	apibinding.Register(plugins)
@@ -146,6 +153,8 @@ func RegisterAllKcpAdmissionPlugins(plugins *admission.Plugins) {
 	permissionclaims.Register(plugins)
 	pathannotation.Register(plugins)
 	objectcountlimit.Register(plugins)
+	storagelimit.Register(plugins)
+	workspacequota.Register(plugins)
 	kubequota.Register(plugins)
 	clustercachedresource.Register(plugins)
 }
@@ -163,6 +172,7 @@ var defaultOnPluginsInKcp = sets.New[string](
 	workspacetype.PluginName,
 	workspacetypeexists.PluginName,
 	logicalcluster.PluginName,
+	logicalclusterlimits.PluginName,
 	apiresourceschema.PluginName,
 	apiexport.PluginName,
 	apibinding.PluginName,
@@ -178,6 +188,8 @@ var defaultOnPluginsInKcp = sets.New[string](
 	permissionclaims.PluginName,
 	pathannotation.PluginName,
 	objectcountlimit.PluginName,
+	storagelimit.PluginName,
+	workspacequota.PluginName,
 	kubequota.PluginName,
 	clustercachedresource.PluginName,
 )
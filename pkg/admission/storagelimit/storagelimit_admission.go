@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagelimit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/admission"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+	kcpinformers "github.com/kcp-dev/sdk/client/informers/externalversions"
+	corev1alpha1listers "github.com/kcp-dev/sdk/client/listers/core/v1alpha1"
+
+	"github.com/kcp-dev/kcp/pkg/admission/initializers"
+	"github.com/kcp-dev/kcp/pkg/indexers"
+	"github.com/kcp-dev/kcp/pkg/objectcount"
+)
+
+// PluginName is the name of this admission plugin.
+const PluginName = "core.kcp.io/LogicalClusterStorageLimit"
+
+// Register registers this admission plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName,
+		func(_ io.Reader) (admission.Interface, error) {
+			plugin := &storageLimit{
+				Handler: admission.NewHandler(admission.Create, admission.Update, admission.Delete),
+			}
+			plugin.getWorkspaceType = func(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error) {
+				return indexers.ByPathAndNameWithFallback[*tenancyv1alpha1.WorkspaceType](tenancyv1alpha1.Resource("workspacetypes"), plugin.typeIndexer, plugin.globalTypeIndexer, path, name)
+			}
+			return plugin, nil
+		},
+	)
+}
+
+// storageLimit is a validating admission plugin enforcing a limit on the bytes
+// the objects of a logical cluster occupy in storage. The current bytes are
+// tracked by the objectcount.Registry: an authoritative base from a periodic
+// etcd scan plus a delta maintained here from the JSON size of the admitted
+// objects. The limit is resolved from the core.kcp.io/max-storage-bytes
+// annotation on the LogicalCluster, falling back to the maxStorageBytes of its
+// WorkspaceType.
+type storageLimit struct {
+	*admission.Handler
+
+	registry             *objectcount.Registry
+	logicalClusterLister corev1alpha1listers.LogicalClusterClusterLister
+	getWorkspaceType     func(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error)
+
+	typeIndexer       cache.Indexer
+	globalTypeIndexer cache.Indexer
+}
+
+var _ admission.ValidationInterface = &storageLimit{}
+var _ = initializers.WantsKcpInformers(&storageLimit{})
+var _ = initializers.WantsObjectCountRegistry(&storageLimit{})
+
+// ValidateInitialization validates all the expected fields are set.
+func (o *storageLimit) ValidateInitialization() error {
+	if o.registry == nil {
+		return fmt.Errorf("missing objectCountRegistry")
+	}
+	if o.logicalClusterLister == nil {
+		return fmt.Errorf("missing logicalClusterLister")
+	}
+	if o.getWorkspaceType == nil {
+		return fmt.Errorf("missing getWorkspaceType")
+	}
+	return nil
+}
+
+// Validate rejects creates and growing updates which would push the logical
+// cluster of the request past its storage limit. Deletes and shrinking updates
+// are always allowed.
+func (o *storageLimit) Validate(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	if !o.registry.StorageEnforcementActive() {
+		return nil
+	}
+
+	// Only the status subresource is persisted as part of the object.
+	if subresource := a.GetSubresource(); subresource != "" && (a.GetOperation() != admission.Update || subresource != "status") {
+		return nil
+	}
+
+	// Like the object count limit, skip workspace bootstrapping resources and
+	// events, which are short-lived and needed to debug an exhausted logical
+	// cluster.
+	switch a.GetResource().GroupResource() {
+	case corev1alpha1.SchemeGroupVersion.WithResource("logicalclusters").GroupResource(),
+		corev1alpha1.SchemeGroupVersion.WithResource("logicalclusterlimits").GroupResource(),
+		corev1.SchemeGroupVersion.WithResource("events").GroupResource(),
+		eventsv1.SchemeGroupVersion.WithResource("events").GroupResource():
+		return nil
+	}
+
+	cluster, err := genericapirequest.ValidClusterFrom(ctx)
+	if err != nil {
+		return err
+	}
+
+	var growth int64
+	switch a.GetOperation() {
+	case admission.Create:
+		growth = sizeOf(a.GetObject())
+	case admission.Update:
+		growth = sizeOf(a.GetObject()) - sizeOf(a.GetOldObject())
+	case admission.Delete:
+		growth = -sizeOf(a.GetOldObject())
+	}
+	// The scan corrects drift from writes that fail after admission, so record
+	// the growth optimistically in all allowed paths below.
+	if growth <= 0 {
+		o.registry.AddBytes(cluster.Name, growth)
+		return nil
+	}
+
+	logicalCluster, err := o.logicalClusterLister.Cluster(cluster.Name).Get(corev1alpha1.LogicalClusterName)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			// Fail open: enforcing quota is less important than availability.
+			klog.FromContext(ctx).Error(err, "failed to get LogicalCluster, skipping storage limit", "cluster", cluster.Name)
+		}
+		o.registry.AddBytes(cluster.Name, growth)
+		return nil
+	}
+
+	if !corev1alpha1.IsLogicalClusterLimitEnforced(logicalCluster.Status.Phase) {
+		o.registry.AddBytes(cluster.Name, growth)
+		return nil
+	}
+
+	if limit := objectcount.StorageLimitFor(logicalCluster.Annotations, o.getWorkspaceType); limit > 0 {
+		// Bytes are only known from the first scan after a limit was set.
+		if bytes, scanned := o.registry.Bytes(cluster.Name); scanned && bytes+growth > limit {
+			return admission.NewForbidden(a, fmt.Errorf(
+				"logical cluster %q would exceed its storage limit (%d/%d bytes used, the request adds %d bytes); delete objects or reduce their size to free up capacity",
+				cluster.Name, bytes, limit, growth))
+		}
+	}
+
+	o.registry.AddBytes(cluster.Name, growth)
+	return nil
+}
+
+// sizeOf approximates the bytes obj occupies in storage by its JSON size.
+func sizeOf(obj runtime.Object) int64 {
+	if obj == nil {
+		return 0
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return 0
+	}
+	return int64(len(data))
+}
+
+func (o *storageLimit) SetKcpInformers(local, global kcpinformers.SharedInformerFactory) {
+	logicalClusterInformer := local.Core().V1alpha1().LogicalClusters()
+	typeInformer := local.Tenancy().V1alpha1().WorkspaceTypes()
+	globalTypeInformer := global.Tenancy().V1alpha1().WorkspaceTypes()
+
+	o.logicalClusterLister = logicalClusterInformer.Lister()
+	o.typeIndexer = typeInformer.Informer().GetIndexer()
+	o.globalTypeIndexer = globalTypeInformer.Informer().GetIndexer()
+
+	indexers.AddIfNotPresentOrDie(typeInformer.Informer().GetIndexer(), cache.Indexers{
+		indexers.ByLogicalClusterPathAndName: indexers.IndexByLogicalClusterPathAndName,
+	})
+	indexers.AddIfNotPresentOrDie(globalTypeInformer.Informer().GetIndexer(), cache.Indexers{
+		indexers.ByLogicalClusterPathAndName: indexers.IndexByLogicalClusterPathAndName,
+	})
+
+	o.SetReadyFunc(func() bool {
+		return logicalClusterInformer.Informer().HasSynced() &&
+			typeInformer.Informer().HasSynced() &&
+			globalTypeInformer.Informer().HasSynced()
+	})
+}
+
+func (o *storageLimit) SetObjectCountRegistry(registry *objectcount.Registry) {
+	o.registry = registry
+}
@@ -0,0 +1,344 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagelimit
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+	corev1alpha1listers "github.com/kcp-dev/sdk/client/listers/core/v1alpha1"
+
+	"github.com/kcp-dev/kcp/pkg/objectcount"
+)
+
+const testCluster = logicalcluster.Name("root:ws")
+
+var configMaps = corev1.SchemeGroupVersion.WithResource("configmaps")
+
+func newAttr(gvr schema.GroupVersionResource, obj, old runtime.Object, op admission.Operation, subresource string) admission.Attributes {
+	return admission.NewAttributesRecord(
+		obj,
+		old,
+		gvr.GroupVersion().WithKind("ConfigMap"),
+		"default",
+		"cm",
+		gvr,
+		subresource,
+		op,
+		nil,
+		false,
+		&user.DefaultInfo{Name: "user"},
+	)
+}
+
+// configMap returns a ConfigMap with size bytes of data.
+func configMap(size int) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "default"},
+		Data:       map[string]string{"data": strings.Repeat("x", size)},
+	}
+}
+
+func newPlugin(registry *objectcount.Registry, logicalClusters ...*corev1alpha1.LogicalCluster) *storageLimit {
+	return &storageLimit{
+		Handler:              admission.NewHandler(admission.Create, admission.Update, admission.Delete),
+		registry:             registry,
+		logicalClusterLister: fakeLogicalClusterClusterLister(logicalClusters),
+		getWorkspaceType: func(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error) {
+			if path.Join(name).String() != "root:limited" {
+				return nil, apierrors.NewNotFound(tenancyv1alpha1.Resource("workspacetypes"), name)
+			}
+			limit := resource.MustParse("2Ki")
+			return &tenancyv1alpha1.WorkspaceType{Spec: tenancyv1alpha1.WorkspaceTypeSpec{MaxStorageBytes: &limit}}, nil
+		},
+	}
+}
+
+// newRegistry returns a registry with storage enforcement active and bytes
+// scanned.
+func newRegistry(bytes int64) *objectcount.Registry {
+	registry := objectcount.NewRegistry(0)
+	registry.SetStorageEnforcementActive(true)
+	registry.ReplaceBytesBase(map[logicalcluster.Name]int64{testCluster: bytes})
+	return registry
+}
+
+func newLogicalCluster(phase corev1alpha1.LogicalClusterPhaseType, annotations map[string]string) *corev1alpha1.LogicalCluster {
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[logicalcluster.AnnotationKey] = string(testCluster)
+	return &corev1alpha1.LogicalCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        corev1alpha1.LogicalClusterName,
+			Annotations: annotations,
+		},
+		Status: corev1alpha1.LogicalClusterStatus{
+			Phase: phase,
+		},
+	}
+}
+
+func limitedLogicalCluster(limit string) *corev1alpha1.LogicalCluster {
+	return newLogicalCluster(corev1alpha1.LogicalClusterPhaseReady, map[string]string{corev1alpha1.LogicalClusterMaxStorageBytesAnnotationKey: limit})
+}
+
+func ctxWithCluster(t *testing.T) context.Context {
+	t.Helper()
+	return genericapirequest.WithCluster(context.Background(), genericapirequest.Cluster{Name: testCluster})
+}
+
+func TestValidateInactiveFastPath(t *testing.T) {
+	t.Parallel()
+
+	registry := objectcount.NewRegistry(0)
+	// enforcement not activated by the scanner
+
+	p := newPlugin(registry, limitedLogicalCluster("1"))
+	require.NoError(t, p.Validate(ctxWithCluster(t), newAttr(configMaps, configMap(100), nil, admission.Create, ""), nil))
+	bytes, _ := registry.Bytes(testCluster)
+	require.Equal(t, int64(0), bytes, "inactive plugin must not track deltas")
+}
+
+func TestValidateCreate(t *testing.T) {
+	t.Parallel()
+
+	registry := newRegistry(0)
+	p := newPlugin(registry, limitedLogicalCluster("1Ki"))
+	ctx := ctxWithCluster(t)
+
+	require.NoError(t, p.Validate(ctx, newAttr(configMaps, configMap(400), nil, admission.Create, ""), nil))
+	require.NoError(t, p.Validate(ctx, newAttr(configMaps, configMap(400), nil, admission.Create, ""), nil))
+
+	err := p.Validate(ctx, newAttr(configMaps, configMap(400), nil, admission.Create, ""), nil)
+	require.Error(t, err)
+	require.True(t, apierrors.IsForbidden(err))
+	require.Contains(t, err.Error(), "would exceed its storage limit")
+
+	require.NoError(t, p.Validate(ctx, newAttr(configMaps, configMap(10), nil, admission.Create, ""), nil), "smaller objects must still fit")
+}
+
+func TestValidateCreateWhenHibernated(t *testing.T) {
+	t.Parallel()
+
+	registry := newRegistry(1000)
+	p := newPlugin(registry, newLogicalCluster(corev1alpha1.LogicalClusterPhaseInactive, map[string]string{
+		corev1alpha1.LogicalClusterMaxStorageBytesAnnotationKey: "1Ki",
+		corev1alpha1.LogicalClusterHibernatedAnnotationKey:      "2026-10-18T00:00:00Z",
+	}))
+
+	err := p.Validate(ctxWithCluster(t), newAttr(configMaps, configMap(100), nil, admission.Create, ""), nil)
+	require.Error(t, err, "the request waking up a hibernated logical cluster must not skip the limit")
+	require.True(t, apierrors.IsForbidden(err))
+}
+
+func TestValidateUpdate(t *testing.T) {
+	t.Parallel()
+
+	registry := newRegistry(1000)
+	p := newPlugin(registry, limitedLogicalCluster("1Ki"))
+	ctx := ctxWithCluster(t)
+
+	require.Error(t, p.Validate(ctx, newAttr(configMaps, configMap(100), configMap(50), admission.Update, ""), nil), "growing updates must be rejected")
+	require.Error(t, p.Validate(ctx, newAttr(configMaps, configMap(100), configMap(50), admission.Update, "status"), nil), "growing status updates must be rejected")
+	require.NoError(t, p.Validate(ctx, newAttr(configMaps, configMap(100), configMap(50), admission.Update, "scale"), nil), "other subresources must be skipped")
+
+	require.NoError(t, p.Validate(ctx, newAttr(configMaps, configMap(10), configMap(100), admission.Update, ""), nil), "shrinking updates must be allowed")
+	bytes, _ := registry.Bytes(testCluster)
+	require.Equal(t, int64(910), bytes)
+
+	require.NoError(t, p.Validate(ctx, newAttr(configMaps, configMap(100), configMap(50), admission.Update, ""), nil), "freed bytes must be available")
+}
+
+func TestValidateDelete(t *testing.T) {
+	t.Parallel()
+
+	registry := newRegistry(1000)
+	p := newPlugin(registry, limitedLogicalCluster("1"))
+	ctx := ctxWithCluster(t)
+
+	require.NoError(t, p.Validate(ctx, newAttr(configMaps, nil, configMap(100), admission.Delete, ""), nil), "deletes must always be allowed")
+	bytes, _ := registry.Bytes(testCluster)
+	require.Less(t, bytes, int64(900))
+}
+
+func TestValidateWorkspaceTypeLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		wantErr     bool
+	}{
+		{
+			name:        "limit of the WorkspaceType",
+			annotations: map[string]string{tenancyv1alpha1.LogicalClusterTypeAnnotationKey: "root:limited"},
+			wantErr:     true,
+		},
+		{
+			name:        "WorkspaceType without limit",
+			annotations: map[string]string{tenancyv1alpha1.LogicalClusterTypeAnnotationKey: "root:universal"},
+		},
+		{
+			name: "annotation takes precedence",
+			annotations: map[string]string{
+				tenancyv1alpha1.LogicalClusterTypeAnnotationKey:         "root:limited",
+				corev1alpha1.LogicalClusterMaxStorageBytesAnnotationKey: "1Mi",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := newPlugin(newRegistry(2000), newLogicalCluster(corev1alpha1.LogicalClusterPhaseReady, tt.annotations))
+			err := p.Validate(ctxWithCluster(t), newAttr(configMaps, configMap(100), nil, admission.Create, ""), nil)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateAllows(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		registry func() *objectcount.Registry
+		lcs      []*corev1alpha1.LogicalCluster
+		attr     admission.Attributes
+	}{
+		{
+			name: "before the bytes were scanned",
+			registry: func() *objectcount.Registry {
+				registry := objectcount.NewRegistry(0)
+				registry.SetStorageEnforcementActive(true)
+				return registry
+			},
+			lcs:  []*corev1alpha1.LogicalCluster{limitedLogicalCluster("1")},
+			attr: newAttr(configMaps, configMap(100), nil, admission.Create, ""),
+		},
+		{
+			name: "without LogicalCluster",
+			attr: newAttr(configMaps, configMap(100), nil, admission.Create, ""),
+		},
+		{
+			name: "non-ready LogicalCluster",
+			lcs: []*corev1alpha1.LogicalCluster{newLogicalCluster(corev1alpha1.LogicalClusterPhaseInitializing,
+				map[string]string{corev1alpha1.LogicalClusterMaxStorageBytesAnnotationKey: "1"})},
+			attr: newAttr(configMaps, configMap(100), nil, admission.Create, ""),
+		},
+		{
+			name: "logicalclusters",
+			lcs:  []*corev1alpha1.LogicalCluster{limitedLogicalCluster("1")},
+			attr: newAttr(corev1alpha1.SchemeGroupVersion.WithResource("logicalclusters"), configMap(100), configMap(0), admission.Update, ""),
+		},
+		{
+			name: "core events",
+			lcs:  []*corev1alpha1.LogicalCluster{limitedLogicalCluster("1")},
+			attr: newAttr(corev1.SchemeGroupVersion.WithResource("events"), configMap(100), nil, admission.Create, ""),
+		},
+		{
+			name: "events.k8s.io events",
+			lcs:  []*corev1alpha1.LogicalCluster{limitedLogicalCluster("1")},
+			attr: newAttr(schema.GroupVersionResource{Group: "events.k8s.io", Version: "v1", Resource: "events"}, configMap(100), nil, admission.Create, ""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			registry := newRegistry(1000)
+			if tt.registry != nil {
+				registry = tt.registry()
+			}
+			p := newPlugin(registry, tt.lcs...)
+			require.NoError(t, p.Validate(ctxWithCluster(t), tt.attr, nil))
+		})
+	}
+}
+
+func TestValidateInitialization(t *testing.T) {
+	t.Parallel()
+
+	p := &storageLimit{Handler: admission.NewHandler(admission.Create, admission.Update, admission.Delete)}
+	require.Error(t, p.ValidateInitialization())
+
+	p.registry = objectcount.NewRegistry(0)
+	require.Error(t, p.ValidateInitialization())
+
+	p.logicalClusterLister = fakeLogicalClusterClusterLister{}
+	require.Error(t, p.ValidateInitialization())
+
+	p.getWorkspaceType = func(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error) {
+		return nil, apierrors.NewNotFound(tenancyv1alpha1.Resource("workspacetypes"), name)
+	}
+	require.NoError(t, p.ValidateInitialization())
+}
+
+type fakeLogicalClusterClusterLister []*corev1alpha1.LogicalCluster
+
+func (l fakeLogicalClusterClusterLister) List(_ labels.Selector) ([]*corev1alpha1.LogicalCluster, error) {
+	return l, nil
+}
+
+func (l fakeLogicalClusterClusterLister) Cluster(cluster logicalcluster.Name) corev1alpha1listers.LogicalClusterLister {
+	var perCluster []*corev1alpha1.LogicalCluster
+	for _, lc := range l {
+		if logicalcluster.From(lc) == cluster {
+			perCluster = append(perCluster, lc)
+		}
+	}
+	return fakeLogicalClusterLister(perCluster)
+}
+
+type fakeLogicalClusterLister []*corev1alpha1.LogicalCluster
+
+func (l fakeLogicalClusterLister) List(_ labels.Selector) ([]*corev1alpha1.LogicalCluster, error) {
+	return l, nil
+}
+
+func (l fakeLogicalClusterLister) Get(name string) (*corev1alpha1.LogicalCluster, error) {
+	for _, lc := range l {
+		if lc.Name == name {
+			return lc, nil
+		}
+	}
+	return nil, apierrors.NewNotFound(corev1alpha1.Resource("logicalclusters"), name)
+}
@@ -22,25 +22,35 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
+	"sync"
+	"time"
 
 	authenticationv1 "k8s.io/api/authentication/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/admission"
 	kuser "k8s.io/apiserver/pkg/authentication/user"
 	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/client-go/tools/cache"
 
+	"github.com/kcp-dev/logicalcluster/v3"
+	"github.com/kcp-dev/sdk/apis/core"
 	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
 	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
 	kcpinformers "github.com/kcp-dev/sdk/client/informers/externalversions"
 	corev1alpha1listers "github.com/kcp-dev/sdk/client/listers/core/v1alpha1"
+	tenancyv1alpha1listers "github.com/kcp-dev/sdk/client/listers/tenancy/v1alpha1"
 
 	kcpinitializers "github.com/kcp-dev/kcp/pkg/admission/initializers"
 	"github.com/kcp-dev/kcp/pkg/authorization"
+	"github.com/kcp-dev/kcp/pkg/indexers"
 )
 
 // Validate and admit Workspace creation and updates.
@@ -52,9 +62,14 @@ const (
 func Register(plugins *admission.Plugins) {
 	plugins.Register(PluginName,
 		func(_ io.Reader) (admission.Interface, error) {
-			return &workspace{
+			p := &workspace{
 				Handler: admission.NewHandler(admission.Create, admission.Update),
-			}, nil
+				now:     time.Now,
+			}
+			p.getWorkspaceType = func(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error) {
+				return indexers.ByPathAndNameWithFallback[*tenancyv1alpha1.WorkspaceType](tenancyv1alpha1.Resource("workspacetypes"), p.typeIndexer, p.globalTypeIndexer, path, name)
+			}
+			return p, nil
 		})
 }
 
@@ -62,6 +77,17 @@ type workspace struct {
 	*admission.Handler
 
 	logicalClusterLister corev1alpha1listers.LogicalClusterClusterLister
+	workspaceLister      tenancyv1alpha1listers.WorkspaceClusterLister
+	getWorkspaceType     func(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error)
+
+	typeIndexer       cache.Indexer
+	globalTypeIndexer cache.Indexer
+
+	// pendingChildren are counted towards maxChildren on top of the listed
+	// children, see validateLimits.
+	pendingChildren pendingChildren
+
+	now func() time.Time
 }
 
 // Ensure that the required admission interfaces are implemented.
@@ -72,7 +98,9 @@ var _ = kcpinitializers.WantsKcpInformers(&workspace{})
 
 // Admit ensures that
 // - the owner user is recorded in annotations on create
-// - the required groups are copied over from the LogicalCluster.
+// - the required groups are copied over from the LogicalCluster
+// - the depth still allowed below the new workspace is recorded in annotations on create
+// - the lease is extended as requested by the extend-lease annotation.
 func (o *workspace) Admit(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
 	clusterName, err := genericapirequest.ClusterNameFrom(ctx)
 	if err != nil {
@@ -92,6 +120,21 @@ func (o *workspace) Admit(ctx context.Context, a admission.Attributes, _ admissi
 		return fmt.Errorf("failed to convert unstructured to Workspace: %w", err)
 	}
 
+	// extend the lease, but never shorten it, and never make a workspace expire that did not before
+	if value, found := ws.Annotations[tenancyv1alpha1.WorkspaceExtendLeaseAnnotationKey]; found {
+		extension, err := time.ParseDuration(value)
+		if err != nil || extension < 0 {
+			return admission.NewForbidden(a, fmt.Errorf("annotation %s must be a non-negative duration, got %q", tenancyv1alpha1.WorkspaceExtendLeaseAnnotationKey, value))
+		}
+		delete(ws.Annotations, tenancyv1alpha1.WorkspaceExtendLeaseAnnotationKey)
+		if ws.Spec.ExpiresAt != nil || a.GetOperation() == admission.Create {
+			expiresAt := metav1.NewTime(o.now().Add(extension).Truncate(time.Second))
+			if ws.Spec.ExpiresAt == nil || ws.Spec.ExpiresAt.Before(&expiresAt) {
+				ws.Spec.ExpiresAt = &expiresAt
+			}
+		}
+	}
+
 	if a.GetOperation() == admission.Create {
 		isSystemPrivileged := sets.New[string](a.GetUserInfo().GetGroups()...).Has(kuser.SystemPrivilegedGroup)
 
@@ -122,6 +165,27 @@ func (o *workspace) Admit(ctx context.Context, a admission.Attributes, _ admissi
 				delete(ws.Annotations, authorization.RequiredGroupsAnnotationKey)
 			}
 		}
+
+		// record the depth still allowed below the new child-workspace
+		if _, found := ws.Annotations[tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey]; !found || !isSystemPrivileged {
+			logicalCluster, err := o.logicalClusterLister.Cluster(clusterName).Get(corev1alpha1.LogicalClusterName)
+			if err != nil && !apierrors.IsNotFound(err) {
+				return admission.NewForbidden(a, err)
+			}
+			delete(ws.Annotations, tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey)
+			if err == nil {
+				depth, limited, err := o.remainingDepth(logicalCluster)
+				if err != nil {
+					return admission.NewForbidden(a, err)
+				}
+				if limited && depth > 0 {
+					if ws.Annotations == nil {
+						ws.Annotations = map[string]string{}
+					}
+					ws.Annotations[tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey] = strconv.FormatInt(depth-1, 10)
+				}
+			}
+		}
 	}
 
 	return updateUnstructured(u, ws)
@@ -150,7 +214,8 @@ func CreatedByFromUserInfo(user kuser.Info) *corev1alpha1.OwnerUserInfo {
 // - the cluster is not removed
 // - the user is recorded in annotations on create
 // - the required groups match with the LogicalCluster
-// - only system privileged users can set both spec.Type and spec.Mount.
+// - only system privileged users can set both spec.Type and spec.Mount
+// - the maxChildren and maxDepth of the WorkspaceTypes above are not exceeded on create.
 func (o *workspace) Validate(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) (err error) {
 	clusterName, err := genericapirequest.ClusterNameFrom(ctx)
 	if err != nil {
@@ -275,6 +340,10 @@ func (o *workspace) Validate(ctx context.Context, a admission.Attributes, _ admi
 			}
 		}
 
+		if err := o.validateLimits(a, clusterName, ws, isSystemPrivileged); err != nil {
+			return err
+		}
+
 		if ws.Spec.Mount != nil {
 			if ws.Spec.Mount.Reference.Kind == "" {
 				return admission.NewForbidden(a, errors.New("spec.mount.kind must be set"))
@@ -295,19 +364,132 @@ func (o *workspace) Validate(ctx context.Context, a admission.Attributes, _ admi
 	return nil
 }
 
+// validateLimits ensures that a new workspace does not exceed the maxDepth of
+// the WorkspaceTypes of the workspaces above, nor the maxChildren of the type
+// of its parent. The depth still allowed below the parent is recorded in an
+// annotation on its LogicalCluster by the scheduler, so that the ancestors'
+// types, which might live on other shards, need not be resolved. The children
+// of a workspace all live in its logical cluster, and are counted locally: the
+// listed ones plus those admitted but not yet seen by the informer, such that
+// parallel creates cannot exceed maxChildren.
+func (o *workspace) validateLimits(a admission.Attributes, clusterName logicalcluster.Name, ws *tenancyv1alpha1.Workspace, isSystemPrivileged bool) error {
+	logicalCluster, err := o.logicalClusterLister.Cluster(clusterName).Get(corev1alpha1.LogicalClusterName)
+	if apierrors.IsNotFound(err) {
+		// The parent is still being bootstrapped by a system privileged user.
+		return nil
+	} else if err != nil {
+		return admission.NewForbidden(a, err)
+	}
+	path := logicalcluster.NewPath(logicalCluster.Annotations[core.LogicalClusterPathAnnotationKey])
+	if path.Empty() {
+		path = clusterName.Path()
+	}
+
+	depth, limited, err := o.remainingDepth(logicalCluster)
+	if err != nil {
+		return admission.NewForbidden(a, err)
+	}
+	if limited && depth < 1 {
+		return admission.NewForbidden(a, fmt.Errorf("workspace %s has reached the maximum depth of nested workspaces", path))
+	}
+	if !isSystemPrivileged {
+		expected, found := "", false
+		if limited {
+			expected, found = strconv.FormatInt(depth-1, 10), true
+		}
+		if got, ok := ws.Annotations[tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey]; ok != found || got != expected {
+			return admission.NewForbidden(a, fmt.Errorf("expected annotation %s=%s", tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey, expected))
+		}
+	}
+
+	wt, err := o.workspaceTypeOf(logicalCluster)
+	if err != nil {
+		return admission.NewForbidden(a, err)
+	}
+	if wt != nil && wt.Spec.MaxChildren != nil {
+		children, err := o.workspaceLister.Cluster(clusterName).List(labels.Everything())
+		if err != nil {
+			return admission.NewForbidden(a, err)
+		}
+		if !o.pendingChildren.admit(clusterName, ws.Name, children, int64(*wt.Spec.MaxChildren), o.now(), !a.IsDryRun()) {
+			return admission.NewForbidden(a, fmt.Errorf("workspace %s has reached the maximum of %d child workspaces of its type %s|%s",
+				path, *wt.Spec.MaxChildren, logicalcluster.From(wt), wt.Name))
+		}
+	}
+
+	return nil
+}
+
+// remainingDepth returns the number of workspace levels allowed below the
+// given logical cluster by the maxDepth of its own WorkspaceType and by the
+// annotation recorded from its ancestors, and whether it is limited at all.
+func (o *workspace) remainingDepth(logicalCluster *corev1alpha1.LogicalCluster) (int64, bool, error) {
+	var depth int64
+	limited := false
+	if value, found := logicalCluster.Annotations[tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey]; found {
+		d, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return 0, false, fmt.Errorf("invalid annotation %s on LogicalCluster: %w", tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey, err)
+		}
+		depth, limited = d, true
+	}
+
+	wt, err := o.workspaceTypeOf(logicalCluster)
+	if err != nil {
+		return 0, false, err
+	}
+	if wt != nil && wt.Spec.MaxDepth != nil && (!limited || int64(*wt.Spec.MaxDepth) < depth) {
+		depth, limited = int64(*wt.Spec.MaxDepth), true
+	}
+
+	return depth, limited, nil
+}
+
+// workspaceTypeOf returns the WorkspaceType of the given logical cluster, or nil
+// if it has none or the type does not exist (anymore).
+func (o *workspace) workspaceTypeOf(logicalCluster *corev1alpha1.LogicalCluster) (*tenancyv1alpha1.WorkspaceType, error) {
+	value, found := logicalCluster.Annotations[tenancyv1alpha1.LogicalClusterTypeAnnotationKey]
+	if !found {
+		return nil, nil
+	}
+	path, name := logicalcluster.NewPath(value).Split()
+	wt, err := o.getWorkspaceType(path, name)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return wt, err
+}
+
 func (o *workspace) ValidateInitialization() error {
 	if o.logicalClusterLister == nil {
 		return fmt.Errorf(PluginName + " plugin needs an LogicalCluster lister")
 	}
+	if o.workspaceLister == nil {
+		return fmt.Errorf(PluginName + " plugin needs a Workspace lister")
+	}
 	return nil
 }
 
 func (o *workspace) SetKcpInformers(local, global kcpinformers.SharedInformerFactory) {
 	logicalClustersReady := local.Core().V1alpha1().LogicalClusters().Informer().HasSynced
+	workspacesReady := local.Tenancy().V1alpha1().Workspaces().Informer().HasSynced
+	typeInformer := local.Tenancy().V1alpha1().WorkspaceTypes()
+	globalTypeInformer := global.Tenancy().V1alpha1().WorkspaceTypes()
 	o.SetReadyFunc(func() bool {
-		return logicalClustersReady()
+		return logicalClustersReady() && workspacesReady() &&
+			typeInformer.Informer().HasSynced() && globalTypeInformer.Informer().HasSynced()
 	})
 	o.logicalClusterLister = local.Core().V1alpha1().LogicalClusters().Lister()
+	o.workspaceLister = local.Tenancy().V1alpha1().Workspaces().Lister()
+
+	o.typeIndexer = typeInformer.Informer().GetIndexer()
+	o.globalTypeIndexer = globalTypeInformer.Informer().GetIndexer()
+	indexers.AddIfNotPresentOrDie(o.typeIndexer, cache.Indexers{
+		indexers.ByLogicalClusterPathAndName: indexers.IndexByLogicalClusterPathAndName,
+	})
+	indexers.AddIfNotPresentOrDie(o.globalTypeIndexer, cache.Indexers{
+		indexers.ByLogicalClusterPathAndName: indexers.IndexByLogicalClusterPathAndName,
+	})
 }
 
 // updateUnstructured updates the given unstructured object to match the given workspace.
@@ -339,3 +521,56 @@ func WorkspaceOwnerAnnotationValue(user kuser.Info) (string, error) {
 
 	return string(rawInfo), nil
 }
+
+// pendingChildTTL is how long an admitted child workspace is counted while the
+// informer has not seen it yet. It bounds how long a create failing after
+// admission holds on to its place below maxChildren.
+const pendingChildTTL = time.Minute
+
+// pendingChildren records per logical cluster the child workspaces admitted
+// on this shard that the workspace informer has not seen yet.
+type pendingChildren struct {
+	lock     sync.Mutex
+	children map[logicalcluster.Name]map[string]time.Time
+}
+
+// admit returns whether another child workspace called name fits below max
+// next to the listed and the pending children of the logical cluster, and
+// records it as pending if reserve is set.
+func (p *pendingChildren) admit(clusterName logicalcluster.Name, name string, listed []*tenancyv1alpha1.Workspace, max int64, now time.Time, reserve bool) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	names := sets.New[string]()
+	for _, ws := range listed {
+		names.Insert(ws.Name)
+	}
+	pending := p.children[clusterName]
+	for child, admitted := range pending {
+		if names.Has(child) || now.Sub(admitted) > pendingChildTTL {
+			delete(pending, child)
+		}
+	}
+	if len(pending) == 0 {
+		delete(p.children, clusterName)
+	}
+
+	if names.Has(name) {
+		// the create fails as the workspace exists already
+		return true
+	}
+	if _, ok := pending[name]; !ok && int64(names.Len()+len(pending)) >= max {
+		return false
+	}
+
+	if reserve {
+		if p.children == nil {
+			p.children = map[logicalcluster.Name]map[string]time.Time{}
+		}
+		if p.children[clusterName] == nil {
+			p.children[clusterName] = map[string]time.Time{}
+		}
+		p.children[clusterName][name] = now
+	}
+	return true
+}
@@ -18,8 +18,10 @@ package workspace
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/require"
@@ -32,11 +34,13 @@ import (
 	"k8s.io/apiserver/pkg/admission"
 	kuser "k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/utils/ptr"
 
 	"github.com/kcp-dev/logicalcluster/v3"
 	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
 	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
 	corev1alpha1listers "github.com/kcp-dev/sdk/client/listers/core/v1alpha1"
+	tenancyv1alpha1listers "github.com/kcp-dev/sdk/client/listers/tenancy/v1alpha1"
 
 	"github.com/kcp-dev/kcp/pkg/admission/helpers"
 	"github.com/kcp-dev/kcp/pkg/authorization"
@@ -817,3 +821,346 @@ func (l fakeLogicalClusterLister) GetWithContext(ctx context.Context, name strin
 	}
 	return nil, apierrors.NewNotFound(tenancyv1alpha1.Resource("workspace"), name)
 }
+
+func (b builder) WithMaxChildren(n int32) builder {
+	b.Spec.MaxChildren = &n
+	return b
+}
+
+func (b builder) WithMaxDepth(n int32) builder {
+	b.Spec.MaxDepth = &n
+	return b
+}
+
+func (b thisBuilder) WithType(qualifiedName string) thisBuilder {
+	b.LogicalCluster.Annotations[tenancyv1alpha1.LogicalClusterTypeAnnotationKey] = qualifiedName
+	return b
+}
+
+func (b thisBuilder) WithMaxDepth(depth string) thisBuilder {
+	b.LogicalCluster.Annotations[tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey] = depth
+	return b
+}
+
+func TestLimits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		types           []*tenancyv1alpha1.WorkspaceType
+		logicalCluster  *corev1alpha1.LogicalCluster
+		children        int
+		annotations     map[string]string
+		skipAdmit       bool
+		wantMaxDepth    string
+		wantErrorSubstr string
+	}{
+		{
+			name:           "no limits",
+			logicalCluster: newLogicalCluster(logicalcluster.NewPath("root:org")).LogicalCluster,
+			children:       10,
+		},
+		{
+			name:           "below maxChildren",
+			types:          []*tenancyv1alpha1.WorkspaceType{newType("root:limited").WithMaxChildren(2).WorkspaceType},
+			logicalCluster: newLogicalCluster(logicalcluster.NewPath("root:org")).WithType("root:limited").LogicalCluster,
+			children:       1,
+		},
+		{
+			name:            "maxChildren reached",
+			types:           []*tenancyv1alpha1.WorkspaceType{newType("root:limited").WithMaxChildren(2).WorkspaceType},
+			logicalCluster:  newLogicalCluster(logicalcluster.NewPath("root:org")).WithType("root:limited").LogicalCluster,
+			children:        2,
+			wantErrorSubstr: "maximum of 2 child workspaces",
+		},
+		{
+			name:           "maxDepth of the parent type",
+			types:          []*tenancyv1alpha1.WorkspaceType{newType("root:limited").WithMaxDepth(1).WorkspaceType},
+			logicalCluster: newLogicalCluster(logicalcluster.NewPath("root:org")).WithType("root:limited").LogicalCluster,
+			wantMaxDepth:   "0",
+		},
+		{
+			name:            "maxDepth 0 of the parent type",
+			types:           []*tenancyv1alpha1.WorkspaceType{newType("root:limited").WithMaxDepth(0).WorkspaceType},
+			logicalCluster:  newLogicalCluster(logicalcluster.NewPath("root:org")).WithType("root:limited").LogicalCluster,
+			wantErrorSubstr: "maximum depth",
+		},
+		{
+			name:           "depth inherited from the ancestors",
+			logicalCluster: newLogicalCluster(logicalcluster.NewPath("root:org")).WithMaxDepth("2").LogicalCluster,
+			wantMaxDepth:   "1",
+		},
+		{
+			name:            "depth exhausted by the ancestors",
+			logicalCluster:  newLogicalCluster(logicalcluster.NewPath("root:org")).WithMaxDepth("0").LogicalCluster,
+			wantErrorSubstr: "maximum depth",
+		},
+		{
+			name:           "lower maxDepth of the parent type wins",
+			types:          []*tenancyv1alpha1.WorkspaceType{newType("root:limited").WithMaxDepth(1).WorkspaceType},
+			logicalCluster: newLogicalCluster(logicalcluster.NewPath("root:org")).WithType("root:limited").WithMaxDepth("3").LogicalCluster,
+			wantMaxDepth:   "0",
+		},
+		{
+			name:           "unknown type",
+			logicalCluster: newLogicalCluster(logicalcluster.NewPath("root:org")).WithType("root:missing").LogicalCluster,
+		},
+		{
+			name:            "forged depth annotation",
+			logicalCluster:  newLogicalCluster(logicalcluster.NewPath("root:org")).WithMaxDepth("1").LogicalCluster,
+			annotations:     map[string]string{tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey: "5"},
+			skipAdmit:       true,
+			wantErrorSubstr: "expected annotation internal.tenancy.kcp.io/max-depth=0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var children []*tenancyv1alpha1.Workspace
+			for i := range tt.children {
+				children = append(children, &tenancyv1alpha1.Workspace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        fmt.Sprintf("child-%d", i),
+						Annotations: map[string]string{logicalcluster.AnnotationKey: "root:org"},
+					},
+				})
+			}
+			o := &workspace{
+				Handler:              admission.NewHandler(admission.Create, admission.Update),
+				logicalClusterLister: fakeLogicalClusterClusterLister{tt.logicalCluster},
+				workspaceLister:      fakeWorkspaceClusterLister(children),
+				now:                  time.Now,
+				getWorkspaceType: func(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error) {
+					for _, wt := range tt.types {
+						if logicalcluster.From(wt).Path() == path && wt.Name == name {
+							return wt, nil
+						}
+					}
+					return nil, apierrors.NewNotFound(tenancyv1alpha1.Resource("workspacetypes"), name)
+				},
+			}
+
+			user := &kuser.DefaultInfo{Name: "user"}
+			owner, err := WorkspaceOwnerAnnotationValue(user)
+			require.NoError(t, err)
+			annotations := map[string]string{tenancyv1alpha1.ExperimentalWorkspaceOwnerAnnotationKey: owner}
+			for k, v := range tt.annotations {
+				annotations[k] = v
+			}
+			a := createAttrWithUser(&tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Annotations: annotations},
+			}, user)
+
+			ctx := request.WithCluster(context.Background(), request.Cluster{Name: "root:org"})
+			if !tt.skipAdmit {
+				require.NoError(t, o.Admit(ctx, a, nil))
+			}
+			err = o.Validate(ctx, a, nil)
+			if tt.wantErrorSubstr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErrorSubstr)
+				return
+			}
+			require.NoError(t, err)
+
+			got, ok := a.GetObject().(*unstructured.Unstructured)
+			require.True(t, ok, "expected unstructured, got %T", a.GetObject())
+			depth, found := got.GetAnnotations()[tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey]
+			require.Equal(t, tt.wantMaxDepth != "", found)
+			require.Equal(t, tt.wantMaxDepth, depth)
+		})
+	}
+}
+
+func TestMaxChildrenParallelCreates(t *testing.T) {
+	t.Parallel()
+
+	limited := newType("root:limited").WithMaxChildren(2).WorkspaceType
+	existing := &tenancyv1alpha1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{Name: "existing", Annotations: map[string]string{logicalcluster.AnnotationKey: "root:org"}},
+	}
+	now := time.Now()
+	o := &workspace{
+		Handler:              admission.NewHandler(admission.Create, admission.Update),
+		logicalClusterLister: fakeLogicalClusterClusterLister{newLogicalCluster(logicalcluster.NewPath("root:org")).WithType("root:limited").LogicalCluster},
+		// the informer does not see any of the workspaces admitted below
+		workspaceLister: fakeWorkspaceClusterLister{existing},
+		now:             func() time.Time { return now },
+		getWorkspaceType: func(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error) {
+			if logicalcluster.From(limited).Path() == path && limited.Name == name {
+				return limited, nil
+			}
+			return nil, apierrors.NewNotFound(tenancyv1alpha1.Resource("workspacetypes"), name)
+		},
+	}
+
+	user := &kuser.DefaultInfo{Name: "user"}
+	owner, err := WorkspaceOwnerAnnotationValue(user)
+	require.NoError(t, err)
+	ctx := request.WithCluster(context.Background(), request.Cluster{Name: "root:org"})
+	create := func(name string, dryRun bool) error {
+		a := admission.NewAttributesRecord(
+			helpers.ToUnstructuredOrDie(&tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: map[string]string{tenancyv1alpha1.ExperimentalWorkspaceOwnerAnnotationKey: owner}},
+			}),
+			nil,
+			tenancyv1alpha1.Kind("Workspace").WithVersion("v1alpha1"),
+			"",
+			name,
+			tenancyv1alpha1.Resource("workspaces").WithVersion("v1alpha1"),
+			"",
+			admission.Create,
+			&metav1.CreateOptions{},
+			dryRun,
+			user,
+		)
+		return o.Validate(ctx, a, nil)
+	}
+
+	require.NoError(t, create("a", true), "a dry-run fits below maxChildren")
+	require.NoError(t, create("a", false), "the dry-run must not have taken the place")
+	require.NoError(t, create("a", false), "retrying a create must not count twice")
+	err = create("b", false)
+	require.Error(t, err, "the pending child must count towards maxChildren")
+	require.Contains(t, err.Error(), "maximum of 2 child workspaces")
+	require.NoError(t, create("existing", false), "creates of existing workspaces fail elsewhere")
+
+	now = now.Add(pendingChildTTL + time.Second)
+	require.NoError(t, create("b", false), "pending children of failed creates must expire")
+}
+
+type fakeWorkspaceClusterLister []*tenancyv1alpha1.Workspace
+
+func (l fakeWorkspaceClusterLister) List(selector labels.Selector) (ret []*tenancyv1alpha1.Workspace, err error) {
+	return l, nil
+}
+
+func (l fakeWorkspaceClusterLister) Cluster(cluster logicalcluster.Name) tenancyv1alpha1listers.WorkspaceLister {
+	var perCluster []*tenancyv1alpha1.Workspace
+	for _, ws := range l {
+		if logicalcluster.From(ws) == cluster {
+			perCluster = append(perCluster, ws)
+		}
+	}
+	return fakeWorkspaceLister(perCluster)
+}
+
+type fakeWorkspaceLister []*tenancyv1alpha1.Workspace
+
+func (l fakeWorkspaceLister) List(selector labels.Selector) (ret []*tenancyv1alpha1.Workspace, err error) {
+	return l, nil
+}
+
+func (l fakeWorkspaceLister) Get(name string) (*tenancyv1alpha1.Workspace, error) {
+	for _, ws := range l {
+		if ws.Name == name {
+			return ws, nil
+		}
+	}
+	return nil, apierrors.NewNotFound(tenancyv1alpha1.Resource("workspaces"), name)
+}
+
+func TestExtendLease(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.January, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name            string
+		update          bool
+		expiresAt       *time.Time
+		extendLease     string
+		wantExpiresAt   *time.Time
+		wantErrorSubstr string
+	}{
+		{
+			name:          "sets expiry on create",
+			extendLease:   "24h",
+			wantExpiresAt: ptr.To(now.Add(24 * time.Hour)),
+		},
+		{
+			name:          "extends expiry on create",
+			expiresAt:     ptr.To(now.Add(time.Hour)),
+			extendLease:   "24h",
+			wantExpiresAt: ptr.To(now.Add(24 * time.Hour)),
+		},
+		{
+			name:          "extends expiry on update",
+			update:        true,
+			expiresAt:     ptr.To(now.Add(-time.Minute)),
+			extendLease:   "1h30m",
+			wantExpiresAt: ptr.To(now.Add(90 * time.Minute)),
+		},
+		{
+			name:          "does not shorten expiry",
+			update:        true,
+			expiresAt:     ptr.To(now.Add(48 * time.Hour)),
+			extendLease:   "24h",
+			wantExpiresAt: ptr.To(now.Add(48 * time.Hour)),
+		},
+		{
+			name:        "does not make a workspace expire on update",
+			update:      true,
+			extendLease: "24h",
+		},
+		{
+			name:            "invalid duration",
+			extendLease:     "tomorrow",
+			wantErrorSubstr: "must be a non-negative duration",
+		},
+		{
+			name:            "negative duration",
+			update:          true,
+			expiresAt:       ptr.To(now.Add(time.Hour)),
+			extendLease:     "-1h",
+			wantErrorSubstr: "must be a non-negative duration",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			o := &workspace{
+				Handler:              admission.NewHandler(admission.Create, admission.Update),
+				logicalClusterLister: fakeLogicalClusterClusterLister{newLogicalCluster(logicalcluster.NewPath("root:org")).LogicalCluster},
+				now:                  func() time.Time { return now },
+			}
+
+			ws := &tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+					Annotations: map[string]string{
+						tenancyv1alpha1.WorkspaceExtendLeaseAnnotationKey: tt.extendLease,
+					},
+				},
+			}
+			if tt.expiresAt != nil {
+				ws.Spec.ExpiresAt = &metav1.Time{Time: *tt.expiresAt}
+			}
+			user := &kuser.DefaultInfo{Name: "admin", Groups: []string{kuser.SystemPrivilegedGroup}}
+			a := createAttrWithUser(ws, user)
+			if tt.update {
+				a = updateAttrWithUser(ws, ws.DeepCopy(), user)
+			}
+
+			ctx := request.WithCluster(context.Background(), request.Cluster{Name: "root:org"})
+			err := o.Admit(ctx, a, nil)
+			if tt.wantErrorSubstr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErrorSubstr)
+				return
+			}
+			require.NoError(t, err)
+
+			got := &tenancyv1alpha1.Workspace{}
+			require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(a.GetObject().(*unstructured.Unstructured).Object, got))
+			require.NotContains(t, got.Annotations, tenancyv1alpha1.WorkspaceExtendLeaseAnnotationKey)
+			if tt.wantExpiresAt == nil {
+				require.Nil(t, got.Spec.ExpiresAt)
+			} else {
+				require.NotNil(t, got.Spec.ExpiresAt)
+				require.True(t, tt.wantExpiresAt.Equal(got.Spec.ExpiresAt.Time), "expected expiry %s, got %s", tt.wantExpiresAt, got.Spec.ExpiresAt.Time)
+			}
+		})
+	}
+}
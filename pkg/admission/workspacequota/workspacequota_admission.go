@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspacequota
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/admission"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+	kcpinformers "github.com/kcp-dev/sdk/client/informers/externalversions"
+	corev1alpha1listers "github.com/kcp-dev/sdk/client/listers/core/v1alpha1"
+
+	"github.com/kcp-dev/kcp/pkg/admission/initializers"
+	"github.com/kcp-dev/kcp/pkg/reconciler/tenancy/workspacequota"
+)
+
+// PluginName is the name of this admission plugin.
+const PluginName = "tenancy.kcp.io/WorkspaceQuota"
+
+// Register registers this admission plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName,
+		func(_ io.Reader) (admission.Interface, error) {
+			return &workspaceQuota{
+				Handler: admission.NewHandler(admission.Create),
+			}, nil
+		},
+	)
+}
+
+// workspaceQuota is a validating admission plugin enforcing the WorkspaceQuotas
+// above the workspace of a request. The usage is the aggregate in the status of
+// the WorkspaceQuotas, which is summed up over all shards periodically. Hence
+// the enforcement is eventually consistent: creations racing with the next
+// report can exceed a quota by the number of objects created in the meantime.
+type workspaceQuota struct {
+	*admission.Handler
+
+	logicalClusterLister corev1alpha1listers.LogicalClusterClusterLister
+	getWorkspaceQuotasAt func(path logicalcluster.Path) ([]*tenancyv1alpha1.WorkspaceQuota, error)
+}
+
+var _ admission.ValidationInterface = &workspaceQuota{}
+var _ = initializers.WantsKcpInformers(&workspaceQuota{})
+
+// ValidateInitialization validates all the expected fields are set.
+func (o *workspaceQuota) ValidateInitialization() error {
+	if o.logicalClusterLister == nil {
+		return fmt.Errorf("missing logicalClusterLister")
+	}
+	if o.getWorkspaceQuotasAt == nil {
+		return fmt.Errorf("missing getWorkspaceQuotasAt")
+	}
+	return nil
+}
+
+// Validate rejects the creation of a workspace if it would exceed the
+// workspaces limit of a WorkspaceQuota in the workspace itself or above, and
+// the creation of any other object if it would exceed the count/ limit of a
+// WorkspaceQuota above its workspace. Pods are also checked against the
+// compute resource limits, e.g. requests.cpu.
+func (o *workspaceQuota) Validate(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	cluster, err := genericapirequest.ValidClusterFrom(ctx)
+	if err != nil {
+		return err
+	}
+
+	logicalCluster, err := o.logicalClusterLister.Cluster(cluster.Name).Get(corev1alpha1.LogicalClusterName)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			// Fail open: enforcing quota is less important than availability.
+			klog.FromContext(ctx).Error(err, "failed to get LogicalCluster, skipping workspace quota", "cluster", cluster.Name)
+		}
+		return nil
+	}
+
+	if !corev1alpha1.IsLogicalClusterLimitEnforced(logicalCluster.Status.Phase) {
+		return nil
+	}
+
+	path := workspacequota.PathOf(logicalCluster)
+	resource := a.GetResource().GroupResource()
+	name := workspacequota.CountResourceName(resource)
+	if resource == tenancyv1alpha1.Resource("workspaces") {
+		// The new workspace is below the workspace of the request.
+		path = path.Join(a.GetName())
+		name = tenancyv1alpha1.ResourceWorkspaces
+	}
+
+	quotas, err := workspacequota.QuotasFor(path, o.getWorkspaceQuotasAt)
+	if err != nil {
+		klog.FromContext(ctx).Error(err, "failed to get WorkspaceQuotas, skipping workspace quota", "cluster", cluster.Name)
+		return nil
+	}
+	for _, quota := range quotas {
+		hard, ok := quota.Spec.Hard[name]
+		if !ok {
+			continue
+		}
+		used := quota.Status.Used[name]
+		if used.Value()+1 > hard.Value() {
+			return admission.NewForbidden(a, fmt.Errorf(
+				"exceeded WorkspaceQuota %s|%s: %s limited to %s, used %s",
+				workspacequota.PathOf(quota), quota.Name, name, hard.String(), used.String()))
+		}
+	}
+
+	if resource == workspacequota.PodsResource.GroupResource() && a.GetObject() != nil {
+		return o.validatePod(a, quotas)
+	}
+
+	return nil
+}
+
+// validatePod rejects the creation of a pod if its requests or limits would
+// exceed a compute resource limit of a WorkspaceQuota, or if it does not
+// specify the requests or limits a WorkspaceQuota requires, like Kubernetes
+// resource quota does.
+func (o *workspaceQuota) validatePod(a admission.Attributes, quotas []*tenancyv1alpha1.WorkspaceQuota) error {
+	pod, err := workspacequota.ToPod(a.GetObject())
+	if err != nil {
+		return admission.NewForbidden(a, err)
+	}
+	for _, quota := range quotas {
+		computeResources := workspacequota.ComputeResources(quota.Spec.Hard)
+		if len(computeResources) == 0 {
+			continue
+		}
+		if err := workspacequota.PodConstraints(pod, computeResources); err != nil {
+			return admission.NewForbidden(a, fmt.Errorf("failed quota WorkspaceQuota %s|%s: %w", workspacequota.PathOf(quota), quota.Name, err))
+		}
+		requested, err := workspacequota.PodUsage(pod, computeResources)
+		if err != nil {
+			return admission.NewForbidden(a, err)
+		}
+		for _, name := range computeResources {
+			request, ok := requested[name]
+			if !ok {
+				continue
+			}
+			hard := quota.Spec.Hard[name]
+			used := quota.Status.Used[name]
+			total := used.DeepCopy()
+			total.Add(request)
+			if total.Cmp(hard) > 0 {
+				return admission.NewForbidden(a, fmt.Errorf(
+					"exceeded WorkspaceQuota %s|%s: %s limited to %s, used %s, requested %s",
+					workspacequota.PathOf(quota), quota.Name, name, hard.String(), used.String(), request.String()))
+			}
+		}
+	}
+	return nil
+}
+
+func (o *workspaceQuota) SetKcpInformers(local, global kcpinformers.SharedInformerFactory) {
+	logicalClusterInformer := local.Core().V1alpha1().LogicalClusters()
+	quotaInformer := local.Tenancy().V1alpha1().WorkspaceQuotas()
+	globalQuotaInformer := global.Tenancy().V1alpha1().WorkspaceQuotas()
+
+	o.logicalClusterLister = logicalClusterInformer.Lister()
+	o.getWorkspaceQuotasAt = workspacequota.QuotasAtFunc(quotaInformer.Informer().GetIndexer(), globalQuotaInformer.Informer().GetIndexer())
+
+	o.SetReadyFunc(func() bool {
+		return logicalClusterInformer.Informer().HasSynced() &&
+			quotaInformer.Informer().HasSynced() &&
+			globalQuotaInformer.Informer().HasSynced()
+	})
+}
@@ -0,0 +1,309 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspacequota
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	"github.com/kcp-dev/sdk/apis/core"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+	corev1alpha1listers "github.com/kcp-dev/sdk/client/listers/core/v1alpha1"
+)
+
+const testCluster = logicalcluster.Name("abc123")
+
+var (
+	configMaps = corev1.SchemeGroupVersion.WithResource("configmaps")
+	pods       = corev1.SchemeGroupVersion.WithResource("pods")
+	workspaces = tenancyv1alpha1.SchemeGroupVersion.WithResource("workspaces")
+)
+
+func newAttr(gvr schema.GroupVersionResource, name string, op admission.Operation, subresource string) admission.Attributes {
+	return admission.NewAttributesRecord(
+		nil,
+		nil,
+		gvr.GroupVersion().WithKind("Object"),
+		"",
+		name,
+		gvr,
+		subresource,
+		op,
+		nil,
+		false,
+		&user.DefaultInfo{Name: "user"},
+	)
+}
+
+func newPodAttr(t *testing.T, requests, limits corev1.ResourceList) admission.Attributes {
+	t.Helper()
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{
+			Name:      "app",
+			Resources: corev1.ResourceRequirements{Requests: requests, Limits: limits},
+		}}},
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	require.NoError(t, err)
+	return admission.NewAttributesRecord(
+		&unstructured.Unstructured{Object: obj},
+		nil,
+		corev1.SchemeGroupVersion.WithKind("Pod"),
+		"default",
+		"pod",
+		pods,
+		"",
+		admission.Create,
+		nil,
+		false,
+		&user.DefaultInfo{Name: "user"},
+	)
+}
+
+func newQuota(path string, hard, used corev1.ResourceList) *tenancyv1alpha1.WorkspaceQuota {
+	return &tenancyv1alpha1.WorkspaceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "quota",
+			Annotations: map[string]string{
+				logicalcluster.AnnotationKey:         path,
+				core.LogicalClusterPathAnnotationKey: path,
+			},
+		},
+		Spec:   tenancyv1alpha1.WorkspaceQuotaSpec{Hard: hard},
+		Status: tenancyv1alpha1.WorkspaceQuotaStatus{Used: used},
+	}
+}
+
+// newPlugin returns a plugin for requests in the workspace root:org:ws.
+func newPlugin(phase corev1alpha1.LogicalClusterPhaseType, quotas ...*tenancyv1alpha1.WorkspaceQuota) *workspaceQuota {
+	return &workspaceQuota{
+		Handler: admission.NewHandler(admission.Create),
+		logicalClusterLister: fakeLogicalClusterClusterLister{{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: corev1alpha1.LogicalClusterName,
+				Annotations: map[string]string{
+					logicalcluster.AnnotationKey:         string(testCluster),
+					core.LogicalClusterPathAnnotationKey: "root:org:ws",
+				},
+			},
+			Status: corev1alpha1.LogicalClusterStatus{Phase: phase},
+		}},
+		getWorkspaceQuotasAt: func(path logicalcluster.Path) ([]*tenancyv1alpha1.WorkspaceQuota, error) {
+			var ret []*tenancyv1alpha1.WorkspaceQuota
+			for _, q := range quotas {
+				if q.Annotations[core.LogicalClusterPathAnnotationKey] == path.String() {
+					ret = append(ret, q)
+				}
+			}
+			return ret, nil
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		phase     corev1alpha1.LogicalClusterPhaseType
+		quotas    []*tenancyv1alpha1.WorkspaceQuota
+		attr      admission.Attributes
+		wantError bool
+	}{
+		"workspace within quota of the parent": {
+			quotas: []*tenancyv1alpha1.WorkspaceQuota{
+				newQuota("root:org:ws", corev1.ResourceList{"workspaces": resource.MustParse("3")}, corev1.ResourceList{"workspaces": resource.MustParse("2")}),
+			},
+			attr: newAttr(workspaces, "child", admission.Create, ""),
+		},
+		"workspace exceeding quota of the parent": {
+			quotas: []*tenancyv1alpha1.WorkspaceQuota{
+				newQuota("root:org:ws", corev1.ResourceList{"workspaces": resource.MustParse("2")}, corev1.ResourceList{"workspaces": resource.MustParse("2")}),
+			},
+			attr:      newAttr(workspaces, "child", admission.Create, ""),
+			wantError: true,
+		},
+		"workspace exceeding quota of an ancestor": {
+			quotas: []*tenancyv1alpha1.WorkspaceQuota{
+				newQuota("root", corev1.ResourceList{"workspaces": resource.MustParse("10")}, corev1.ResourceList{"workspaces": resource.MustParse("10")}),
+			},
+			attr:      newAttr(workspaces, "child", admission.Create, ""),
+			wantError: true,
+		},
+		"workspace without reported usage": {
+			quotas: []*tenancyv1alpha1.WorkspaceQuota{
+				newQuota("root:org", corev1.ResourceList{"workspaces": resource.MustParse("1")}, nil),
+			},
+			attr: newAttr(workspaces, "child", admission.Create, ""),
+		},
+		"object exceeding quota of an ancestor": {
+			quotas: []*tenancyv1alpha1.WorkspaceQuota{
+				newQuota("root:org", corev1.ResourceList{"count/configmaps": resource.MustParse("5")}, corev1.ResourceList{"count/configmaps": resource.MustParse("5")}),
+			},
+			attr:      newAttr(configMaps, "cm", admission.Create, ""),
+			wantError: true,
+		},
+		"object not covered by the quota of its own workspace": {
+			quotas: []*tenancyv1alpha1.WorkspaceQuota{
+				newQuota("root:org:ws", corev1.ResourceList{"count/configmaps": resource.MustParse("5")}, corev1.ResourceList{"count/configmaps": resource.MustParse("5")}),
+			},
+			attr: newAttr(configMaps, "cm", admission.Create, ""),
+		},
+		"object of another resource": {
+			quotas: []*tenancyv1alpha1.WorkspaceQuota{
+				newQuota("root:org", corev1.ResourceList{"count/secrets": resource.MustParse("5")}, corev1.ResourceList{"count/secrets": resource.MustParse("5")}),
+			},
+			attr: newAttr(configMaps, "cm", admission.Create, ""),
+		},
+		"pod within the compute quota of an ancestor": {
+			quotas: []*tenancyv1alpha1.WorkspaceQuota{
+				newQuota("root:org", corev1.ResourceList{"requests.cpu": resource.MustParse("2"), "pods": resource.MustParse("10")}, corev1.ResourceList{"requests.cpu": resource.MustParse("1500m"), "pods": resource.MustParse("3")}),
+			},
+			attr: newPodAttr(t, corev1.ResourceList{"cpu": resource.MustParse("500m")}, nil),
+		},
+		"pod exceeding the requests.cpu quota of an ancestor": {
+			quotas: []*tenancyv1alpha1.WorkspaceQuota{
+				newQuota("root:org", corev1.ResourceList{"requests.cpu": resource.MustParse("2")}, corev1.ResourceList{"requests.cpu": resource.MustParse("1500m")}),
+			},
+			attr:      newPodAttr(t, corev1.ResourceList{"cpu": resource.MustParse("600m")}, nil),
+			wantError: true,
+		},
+		"pod exceeding the limits.memory quota of an ancestor": {
+			quotas: []*tenancyv1alpha1.WorkspaceQuota{
+				newQuota("root", corev1.ResourceList{"limits.memory": resource.MustParse("1Gi")}, corev1.ResourceList{"limits.memory": resource.MustParse("768Mi")}),
+			},
+			attr:      newPodAttr(t, nil, corev1.ResourceList{"memory": resource.MustParse("512Mi")}),
+			wantError: true,
+		},
+		"pod exceeding the pods quota of an ancestor": {
+			quotas: []*tenancyv1alpha1.WorkspaceQuota{
+				newQuota("root:org", corev1.ResourceList{"pods": resource.MustParse("3")}, corev1.ResourceList{"pods": resource.MustParse("3")}),
+			},
+			attr:      newPodAttr(t, nil, nil),
+			wantError: true,
+		},
+		"pod without the cpu request a quota of an ancestor requires": {
+			quotas: []*tenancyv1alpha1.WorkspaceQuota{
+				newQuota("root:org", corev1.ResourceList{"requests.cpu": resource.MustParse("2")}, corev1.ResourceList{"requests.cpu": resource.MustParse("0")}),
+			},
+			attr:      newPodAttr(t, corev1.ResourceList{"memory": resource.MustParse("64Mi")}, nil),
+			wantError: true,
+		},
+		"pod not covered by the compute quota of its own workspace": {
+			quotas: []*tenancyv1alpha1.WorkspaceQuota{
+				newQuota("root:org:ws", corev1.ResourceList{"requests.cpu": resource.MustParse("1")}, corev1.ResourceList{"requests.cpu": resource.MustParse("1")}),
+			},
+			attr: newPodAttr(t, corev1.ResourceList{"cpu": resource.MustParse("1")}, nil),
+		},
+		"subresource": {
+			quotas: []*tenancyv1alpha1.WorkspaceQuota{
+				newQuota("root:org", corev1.ResourceList{"count/configmaps": resource.MustParse("5")}, corev1.ResourceList{"count/configmaps": resource.MustParse("5")}),
+			},
+			attr: newAttr(configMaps, "cm", admission.Create, "status"),
+		},
+		"object exceeding quota of an ancestor in a hibernated LogicalCluster": {
+			phase: corev1alpha1.LogicalClusterPhaseInactive,
+			quotas: []*tenancyv1alpha1.WorkspaceQuota{
+				newQuota("root:org", corev1.ResourceList{"count/configmaps": resource.MustParse("5")}, corev1.ResourceList{"count/configmaps": resource.MustParse("5")}),
+			},
+			attr:      newAttr(configMaps, "cm", admission.Create, ""),
+			wantError: true,
+		},
+		"non-ready LogicalCluster": {
+			phase: corev1alpha1.LogicalClusterPhaseInitializing,
+			quotas: []*tenancyv1alpha1.WorkspaceQuota{
+				newQuota("root:org", corev1.ResourceList{"count/configmaps": resource.MustParse("5")}, corev1.ResourceList{"count/configmaps": resource.MustParse("5")}),
+			},
+			attr: newAttr(configMaps, "cm", admission.Create, ""),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			phase := tc.phase
+			if phase == "" {
+				phase = corev1alpha1.LogicalClusterPhaseReady
+			}
+			p := newPlugin(phase, tc.quotas...)
+			ctx := genericapirequest.WithCluster(context.Background(), genericapirequest.Cluster{Name: testCluster})
+			err := p.Validate(ctx, tc.attr, nil)
+			if tc.wantError {
+				require.Error(t, err)
+				require.True(t, apierrors.IsForbidden(err), "expected forbidden, got %v", err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateWithoutLogicalCluster(t *testing.T) {
+	t.Parallel()
+
+	p := newPlugin(corev1alpha1.LogicalClusterPhaseReady)
+	ctx := genericapirequest.WithCluster(context.Background(), genericapirequest.Cluster{Name: "other"})
+	require.NoError(t, p.Validate(ctx, newAttr(configMaps, "cm", admission.Create, ""), nil))
+}
+
+type fakeLogicalClusterClusterLister []*corev1alpha1.LogicalCluster
+
+func (l fakeLogicalClusterClusterLister) List(_ labels.Selector) ([]*corev1alpha1.LogicalCluster, error) {
+	return l, nil
+}
+
+func (l fakeLogicalClusterClusterLister) Cluster(cluster logicalcluster.Name) corev1alpha1listers.LogicalClusterLister {
+	var perCluster []*corev1alpha1.LogicalCluster
+	for _, lc := range l {
+		if logicalcluster.From(lc) == cluster {
+			perCluster = append(perCluster, lc)
+		}
+	}
+	return fakeLogicalClusterLister(perCluster)
+}
+
+type fakeLogicalClusterLister []*corev1alpha1.LogicalCluster
+
+func (l fakeLogicalClusterLister) List(_ labels.Selector) ([]*corev1alpha1.LogicalCluster, error) {
+	return l, nil
+}
+
+func (l fakeLogicalClusterLister) Get(name string) (*corev1alpha1.LogicalCluster, error) {
+	for _, lc := range l {
+		if lc.Name == name {
+			return lc, nil
+		}
+	}
+	return nil, apierrors.NewNotFound(corev1alpha1.Resource("logicalclusters"), name)
+}
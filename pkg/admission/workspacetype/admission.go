@@ -48,6 +48,8 @@ import (
 //   - .spec.defaultChildWorkspaceType.path, .spec.limitAllowedChildren.types[*].path,
 //     and .spec.limitAllowedParents.types[*].path must be set when their parent
 //     fields are present.
+//   - .spec.maxChildren, .spec.maxDepth, .spec.hibernateAfter, .spec.defaultTTL and
+//     .spec.deletionRetention must not be negative.
 //   - the user has the "bind" verb on every APIExport listed in
 //     spec.defaultAPIBindings (newly added entries on update). This prevents
 //     a privilege escalation where unprivileged users would otherwise cause
@@ -135,6 +137,26 @@ func (o *workspacetype) Validate(ctx context.Context, a admission.Attributes, _
 		}
 	}
 
+	if wt.Spec.MaxChildren != nil && *wt.Spec.MaxChildren < 0 {
+		return admission.NewForbidden(a, fmt.Errorf(".spec.maxChildren must not be negative"))
+	}
+
+	if wt.Spec.MaxDepth != nil && *wt.Spec.MaxDepth < 0 {
+		return admission.NewForbidden(a, fmt.Errorf(".spec.maxDepth must not be negative"))
+	}
+
+	if wt.Spec.HibernateAfter != nil && wt.Spec.HibernateAfter.Duration < 0 {
+		return admission.NewForbidden(a, fmt.Errorf(".spec.hibernateAfter must not be negative"))
+	}
+
+	if wt.Spec.DefaultTTL != nil && wt.Spec.DefaultTTL.Duration < 0 {
+		return admission.NewForbidden(a, fmt.Errorf(".spec.defaultTTL must not be negative"))
+	}
+
+	if wt.Spec.DeletionRetention != nil && wt.Spec.DeletionRetention.Duration < 0 {
+		return admission.NewForbidden(a, fmt.Errorf(".spec.deletionRetention must not be negative"))
+	}
+
 	return o.checkDefaultAPIBindingsPermissions(ctx, a, clusterName, wt)
 }
 
@@ -20,6 +20,7 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -29,6 +30,7 @@ import (
 	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
 	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/utils/ptr"
 
 	kcpkubernetesclientset "github.com/kcp-dev/client-go/kubernetes"
 	"github.com/kcp-dev/logicalcluster/v3"
@@ -261,3 +263,53 @@ func TestValidate_DefaultAPIBindings(t *testing.T) {
 		})
 	}
 }
+
+func TestValidate_Limits(t *testing.T) {
+	t.Parallel()
+	tenantCluster := logicalcluster.Name("root-test")
+
+	tests := []struct {
+		name           string
+		maxChildren    *int32
+		maxDepth       *int32
+		hibernateAfter *metav1.Duration
+		defaultTTL     *metav1.Duration
+		retention      *metav1.Duration
+		wantContains   string
+	}{
+		{name: "no limits"},
+		{name: "zero limits", maxChildren: ptr.To[int32](0), maxDepth: ptr.To[int32](0)},
+		{name: "hibernateAfter", hibernateAfter: &metav1.Duration{Duration: time.Hour}},
+		{name: "negative hibernateAfter", hibernateAfter: &metav1.Duration{Duration: -time.Hour}, wantContains: ".spec.hibernateAfter must not be negative"},
+		{name: "defaultTTL", defaultTTL: &metav1.Duration{Duration: 72 * time.Hour}},
+		{name: "negative defaultTTL", defaultTTL: &metav1.Duration{Duration: -time.Hour}, wantContains: ".spec.defaultTTL must not be negative"},
+		{name: "deletionRetention", retention: &metav1.Duration{Duration: 7 * 24 * time.Hour}},
+		{name: "negative deletionRetention", retention: &metav1.Duration{Duration: -time.Hour}, wantContains: ".spec.deletionRetention must not be negative"},
+		{name: "negative maxChildren", maxChildren: ptr.To[int32](-1), wantContains: ".spec.maxChildren must not be negative"},
+		{name: "negative maxDepth", maxDepth: ptr.To[int32](-1), wantContains: ".spec.maxDepth must not be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p, _ := newPlugin(authorizer.DecisionAllow, nil)
+			wt := newWorkspaceType(tenantCluster, "limited")
+			wt.Spec.MaxChildren = tt.maxChildren
+			wt.Spec.MaxDepth = tt.maxDepth
+			wt.Spec.HibernateAfter = tt.hibernateAfter
+			wt.Spec.DefaultTTL = tt.defaultTTL
+			wt.Spec.DeletionRetention = tt.retention
+			ctx := request.WithCluster(context.Background(), request.Cluster{Name: tenantCluster})
+			err := p.Validate(ctx, makeAttr(t, admission.Create, wt, nil, nil), nil)
+			if tt.wantContains == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantContains) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantContains, err)
+			}
+		})
+	}
+}
@@ -22,8 +22,10 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -57,6 +59,7 @@ func Register(plugins *admission.Plugins) {
 			plugin := &workspacetypeExists{
 				Handler:          admission.NewHandler(admission.Create, admission.Update),
 				createAuthorizer: delegated.NewDelegatedAuthorizer,
+				now:              time.Now,
 			}
 			plugin.getType = func(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error) {
 				return indexers.ByPathAndNameWithFallback[*tenancyv1alpha1.WorkspaceType](tenancyv1alpha1.Resource("workspacetypes"), plugin.typeIndexer, plugin.globalTypeIndexer, path, name)
@@ -85,6 +88,8 @@ type workspacetypeExists struct {
 
 	deepSARClient    kcpkubernetesclientset.ClusterInterface
 	createAuthorizer delegated.DelegatedAuthorizerFactory
+
+	now func() time.Time
 }
 
 // Ensure that the required admission interfaces are implemented.
@@ -177,6 +182,7 @@ func (o *workspacetypeExists) Admit(ctx context.Context, a admission.Attributes,
 	}
 
 	addAdditionalWorkspaceLabels(wt, ws)
+	defaultExpiresAt(wt, ws, o.now)
 
 	return updateUnstructured(u, ws)
 }
@@ -411,6 +417,20 @@ func addAdditionalWorkspaceLabels(
 	}
 }
 
+// defaultExpiresAt sets the expiry of the workspace from the default TTL of
+// the workspace type if it is not already set.
+func defaultExpiresAt(
+	wt *tenancyv1alpha1.WorkspaceType,
+	ws *tenancyv1alpha1.Workspace,
+	now func() time.Time,
+) {
+	if ws.Spec.ExpiresAt != nil || wt.Spec.DefaultTTL == nil {
+		return
+	}
+	expiresAt := metav1.NewTime(now().Add(wt.Spec.DefaultTTL.Duration).Truncate(time.Second))
+	ws.Spec.ExpiresAt = &expiresAt
+}
+
 // TODO: Move this out of admission to some shared location.
 type TransitiveTypeResolver interface {
 	Resolve(t *tenancyv1alpha1.WorkspaceType) ([]*tenancyv1alpha1.WorkspaceType, error)
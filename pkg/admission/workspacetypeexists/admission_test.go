@@ -21,6 +21,7 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/require"
@@ -66,6 +67,7 @@ func createAttr(obj *tenancyv1alpha1.Workspace) admission.Attributes {
 
 func TestAdmit(t *testing.T) {
 	t.Parallel()
+	now := time.Date(2026, time.January, 1, 12, 0, 0, 0, time.UTC)
 	tests := []struct {
 		name            string
 		types           []*tenancyv1alpha1.WorkspaceType
@@ -194,6 +196,30 @@ func TestAdmit(t *testing.T) {
 			a:           createAttr(newWorkspace("root:org:ws:test").withType("universal").Workspace),
 			expectedObj: newWorkspace("root:org:ws:test").withType("root:universal").Workspace,
 		},
+		{
+			name:        "defaults expiry from the default TTL of the type",
+			clusterName: logicalcluster.Name("root:org:ws"),
+			logicalClusters: []*corev1alpha1.LogicalCluster{
+				newLogicalCluster("root:org:ws").withType("root:org", "parent").LogicalCluster,
+			},
+			types: []*tenancyv1alpha1.WorkspaceType{
+				newType("root:org:foo").withDefaultTTL(72 * time.Hour).WorkspaceType,
+			},
+			a:           createAttr(newWorkspace("root:org:ws:test").withType("root:org:foo").Workspace),
+			expectedObj: newWorkspace("root:org:ws:test").withType("root:org:foo").withExpiresAt(now.Add(72 * time.Hour)).Workspace,
+		},
+		{
+			name:        "keeps explicit expiry despite default TTL of the type",
+			clusterName: logicalcluster.Name("root:org:ws"),
+			logicalClusters: []*corev1alpha1.LogicalCluster{
+				newLogicalCluster("root:org:ws").withType("root:org", "parent").LogicalCluster,
+			},
+			types: []*tenancyv1alpha1.WorkspaceType{
+				newType("root:org:foo").withDefaultTTL(72 * time.Hour).WorkspaceType,
+			},
+			a:           createAttr(newWorkspace("root:org:ws:test").withType("root:org:foo").withExpiresAt(now.Add(time.Hour)).Workspace),
+			expectedObj: newWorkspace("root:org:ws:test").withType("root:org:foo").withExpiresAt(now.Add(time.Hour)).Workspace,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -205,6 +231,7 @@ func TestAdmit(t *testing.T) {
 				getType:                getType(tt.types),
 				logicalClusterLister:   fakeLogicalClusterClusterLister(tt.logicalClusters),
 				transitiveTypeResolver: NewTransitiveTypeResolver(typeLister.GetByPath),
+				now:                    func() time.Time { return now },
 			}
 			ctx := request.WithCluster(context.Background(), request.Cluster{Name: tt.clusterName})
 			if err := o.Admit(ctx, tt.a, nil); (err != nil) != tt.wantErr {
@@ -884,6 +911,11 @@ func (b builder) withAdditionalLabel(labels map[string]string) builder {
 	return b
 }
 
+func (b builder) withDefaultTTL(ttl time.Duration) builder {
+	b.WorkspaceType.Spec.DefaultTTL = &metav1.Duration{Duration: ttl}
+	return b
+}
+
 type wsBuilder struct {
 	*tenancyv1alpha1.Workspace
 }
@@ -914,6 +946,11 @@ func (b wsBuilder) withLabels(labels map[string]string) wsBuilder {
 	return b
 }
 
+func (b wsBuilder) withExpiresAt(expiresAt time.Time) wsBuilder {
+	b.Spec.ExpiresAt = &metav1.Time{Time: expiresAt}
+	return b
+}
+
 type thisWsBuilder struct {
 	*corev1alpha1.LogicalCluster
 }
@@ -160,10 +160,14 @@ func clusterRoles() []rbacv1.ClusterRole {
 			// logical cluster migration
 			ObjectMeta: metav1.ObjectMeta{Name: SystemExternalLogicalClusterAdmin},
 			Rules: []rbacv1.PolicyRule{
-				// pull data from origin to destination shard during migration
-				rbacv1helpers.NewRule("create").Groups(migration.GroupName).Resources("logicalclusterdumps").RuleOrDie(),
+				// pull data from origin to destination shard during migration,
+				// and back up and restore single logical clusters
+				rbacv1helpers.NewRule("create").Groups(migration.GroupName).Resources("logicalclusterdumps", "logicalclusterrestores").RuleOrDie(),
 				// allows shards to update LogicalClusterMigrations wherever it is placed
 				rbacv1helpers.NewRule("get", "update", "patch").Groups(migration.GroupName).Resources("logicalclustermigrations", "logicalclustermigrations/status").RuleOrDie(),
+				// allows the source shard of a ShardDrain to create the migrations and report progress
+				rbacv1helpers.NewRule("create").Groups(migration.GroupName).Resources("logicalclustermigrations").RuleOrDie(),
+				rbacv1helpers.NewRule("get", "update", "patch").Groups(migration.GroupName).Resources("sharddrains", "sharddrains/status").RuleOrDie(),
 				// Allow restoring bounds CRDs.
 				rbacv1helpers.NewRule("list").Groups(apis.GroupName).Resources("apibindings").RuleOrDie(),
 				rbacv1helpers.NewRule("get").Groups(apis.GroupName).Resources("apiexports").RuleOrDie(),
@@ -133,7 +133,7 @@ func (a *workspaceContentAuthorizer) Authorize(ctx context.Context, attr authori
 		corev1alpha1.LogicalClusterPhaseTerminating,
 		corev1alpha1.LogicalClusterPhaseDeleting:
 		// allowed
-	default: // Scheduling, Unavailable, Unknown, or any future phases are not allowed to access workspace content
+	default: // Scheduling, Unavailable, Deleted, Unknown, or any future phases are not allowed to access workspace content
 		return authorizer.DecisionNoOpinion, fmt.Sprintf("not permitted due to phase %q", logicalCluster.Status.Phase), nil
 	}
 
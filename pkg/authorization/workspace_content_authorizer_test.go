@@ -177,6 +177,14 @@ func TestWorkspaceContentAuthorizer(t *testing.T) {
 			wantDecision:       authorizer.DecisionNoOpinion,
 			wantReason:         "not permitted due to phase \"Scheduling\"",
 		},
+		{
+			testName: "service account of same workspace is not allowed access to deleted workspace",
+
+			requestedWorkspace: "root:deleted",
+			requestingUser:     newServiceAccountWithCluster("somebody", "root:deleted", "system:authenticated"),
+			wantDecision:       authorizer.DecisionNoOpinion,
+			wantReason:         "not permitted due to phase \"Deleted\"",
+		},
 		{
 			testName: "service account of same workspace is allowed on initializing workspace",
 
@@ -380,6 +388,10 @@ func TestWorkspaceContentAuthorizer(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{Name: corev1alpha1.LogicalClusterName, Annotations: map[string]string{logicalcluster.AnnotationKey: "root:scheduling"}},
 				Status:     corev1alpha1.LogicalClusterStatus{Phase: corev1alpha1.LogicalClusterPhaseScheduling},
 			}))
+			require.NoError(t, localIndexer.Add(&corev1alpha1.LogicalCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: corev1alpha1.LogicalClusterName, Annotations: map[string]string{logicalcluster.AnnotationKey: "root:deleted"}},
+				Status:     corev1alpha1.LogicalClusterStatus{Phase: corev1alpha1.LogicalClusterPhaseDeleted},
+			}))
 			require.NoError(t, localIndexer.Add(&corev1alpha1.LogicalCluster{
 				ObjectMeta: metav1.ObjectMeta{Name: corev1alpha1.LogicalClusterName, Annotations: map[string]string{logicalcluster.AnnotationKey: "root:initializing"}},
 				Status:     corev1alpha1.LogicalClusterStatus{Phase: corev1alpha1.LogicalClusterPhaseInitializing},
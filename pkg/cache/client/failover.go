@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+)
+
+// WithFailoverRoundTripper wraps an existing config's with FailoverRoundTripper
+// for the cache server replica at cfg.Host and the given additional replicas.
+// The replica URLs must only differ from cfg.Host in scheme and host.
+//
+// It must be applied before any other round tripper rewriting requests.
+//
+// Note: it is the caller responsibility to make a copy of the rest config.
+func WithFailoverRoundTripper(cfg *rest.Config, replicaURLs []string) (*rest.Config, error) {
+	if len(replicaURLs) == 0 {
+		return cfg, nil
+	}
+
+	primary, err := parseReplicaURL(cfg.Host)
+	if err != nil {
+		return nil, err
+	}
+	replicas := []*url.URL{primary}
+	for _, u := range replicaURLs {
+		replica, err := parseReplicaURL(u)
+		if err != nil {
+			return nil, err
+		}
+		replicas = append(replicas, replica)
+	}
+
+	cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return NewFailoverRoundTripper(rt, replicas)
+	})
+	return cfg, nil
+}
+
+func parseReplicaURL(s string) (*url.URL, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cache server URL %q: %w", s, err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid cache server URL %q: scheme and host are required", s)
+	}
+	return &url.URL{Scheme: u.Scheme, Host: u.Host}, nil
+}
+
+// FailoverRoundTripper is a http.RoundTripper sending requests to one of
+// multiple cache server replicas sharing the same storage. It sticks to a
+// replica until it fails, i.e. the connection cannot be established or it
+// responds with 503 Service Unavailable, and then tries the other replicas in
+// order.
+//
+// Requests with a body are only retried if the body can be replayed.
+type FailoverRoundTripper struct {
+	delegate http.RoundTripper
+	replicas []*url.URL
+	current  atomic.Int32
+}
+
+// NewFailoverRoundTripper creates a new FailoverRoundTripper for the given
+// replicas, given by scheme and host.
+func NewFailoverRoundTripper(delegate http.RoundTripper, replicas []*url.URL) *FailoverRoundTripper {
+	return &FailoverRoundTripper{
+		delegate: delegate,
+		replicas: replicas,
+	}
+}
+
+func (c *FailoverRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := int(c.current.Load())
+	replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+
+	for attempt := 0; ; attempt++ {
+		i := (start + attempt) % len(c.replicas)
+
+		r := req.Clone(req.Context())
+		r.URL.Scheme = c.replicas[i].Scheme
+		r.URL.Host = c.replicas[i].Host
+		r.Host = ""
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r.Body = body
+		}
+
+		resp, err := c.delegate.RoundTrip(r)
+		failed := shouldFailover(resp, err)
+		if !failed || !replayable || attempt == len(c.replicas)-1 || req.Context().Err() != nil {
+			if !failed && attempt > 0 {
+				klog.FromContext(req.Context()).V(2).Info("failed over to cache server replica", "replica", c.replicas[i].Host)
+				c.current.Store(int32(i))
+			}
+			return resp, err
+		}
+
+		if resp != nil {
+			// drain and close the body to reuse the connection
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+	}
+}
+
+func (c *FailoverRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return c.delegate
+}
+
+// shouldFailover returns whether the request has not been processed by the
+// replica, such that it can be retried safely on another one.
+func shouldFailover(resp *http.Response, err error) bool {
+	if err != nil {
+		var opErr *net.OpError
+		return utilnet.IsConnectionRefused(err) || (errors.As(err, &opErr) && opErr.Op == "dial")
+	}
+	return resp.StatusCode == http.StatusServiceUnavailable
+}
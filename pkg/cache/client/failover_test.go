@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailoverRoundTripper(t *testing.T) {
+	t.Parallel()
+
+	newReplica := func(name string, status int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			body, _ := io.ReadAll(req.Body)
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(name + ":" + string(body)))
+		}))
+	}
+	down := newReplica("down", http.StatusOK)
+	down.Close()
+	unavailable := newReplica("unavailable", http.StatusServiceUnavailable)
+	t.Cleanup(unavailable.Close)
+	healthy := newReplica("healthy", http.StatusOK)
+	t.Cleanup(healthy.Close)
+	broken := newReplica("broken", http.StatusInternalServerError)
+	t.Cleanup(broken.Close)
+
+	tests := map[string]struct {
+		replicas   []*httptest.Server
+		body       []byte
+		noGetBody  bool
+		wantStatus int
+		wantBody   string
+		wantErr    bool
+	}{
+		"first replica healthy": {
+			replicas:   []*httptest.Server{healthy, unavailable},
+			wantStatus: http.StatusOK,
+			wantBody:   "healthy:",
+		},
+		"connection refused": {
+			replicas:   []*httptest.Server{down, healthy},
+			wantStatus: http.StatusOK,
+			wantBody:   "healthy:",
+		},
+		"service unavailable": {
+			replicas:   []*httptest.Server{unavailable, healthy},
+			body:       []byte("payload"),
+			wantStatus: http.StatusOK,
+			wantBody:   "healthy:payload",
+		},
+		"other errors are not retried": {
+			replicas:   []*httptest.Server{broken, healthy},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   "broken:",
+		},
+		"body which cannot be replayed": {
+			replicas:   []*httptest.Server{unavailable, healthy},
+			body:       []byte("payload"),
+			noGetBody:  true,
+			wantStatus: http.StatusServiceUnavailable,
+			wantBody:   "unavailable:payload",
+		},
+		"last replica response is returned": {
+			replicas:   []*httptest.Server{down, unavailable},
+			wantStatus: http.StatusServiceUnavailable,
+			wantBody:   "unavailable:",
+		},
+		"all replicas down": {
+			replicas: []*httptest.Server{down, down},
+			wantErr:  true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var replicas []*url.URL
+			for _, s := range tc.replicas {
+				u, err := parseReplicaURL(s.URL)
+				require.NoError(t, err)
+				replicas = append(replicas, u)
+			}
+			rt := NewFailoverRoundTripper(http.DefaultTransport, replicas)
+
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, tc.replicas[0].URL+"/services/cache/shards/*/clusters/*/apis", bytes.NewReader(tc.body))
+			require.NoError(t, err)
+			if tc.noGetBody {
+				req.GetBody = nil
+			}
+
+			resp, err := rt.RoundTrip(req)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantStatus, resp.StatusCode)
+			require.Equal(t, tc.wantBody, string(body))
+		})
+	}
+}
+
+func TestFailoverRoundTripperSticksToReplica(t *testing.T) {
+	t.Parallel()
+
+	var requests []string
+	newReplica := func(name string, status int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			requests = append(requests, name)
+			w.WriteHeader(status)
+		}))
+	}
+	unavailable := newReplica("unavailable", http.StatusServiceUnavailable)
+	t.Cleanup(unavailable.Close)
+	healthy := newReplica("healthy", http.StatusOK)
+	t.Cleanup(healthy.Close)
+
+	var replicas []*url.URL
+	for _, s := range []*httptest.Server{unavailable, healthy} {
+		u, err := parseReplicaURL(s.URL)
+		require.NoError(t, err)
+		replicas = append(replicas, u)
+	}
+	rt := NewFailoverRoundTripper(http.DefaultTransport, replicas)
+
+	for range 2 {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, unavailable.URL+"/services/cache", http.NoBody)
+		require.NoError(t, err)
+		resp, err := rt.RoundTrip(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	require.Equal(t, []string{"unavailable", "healthy", "healthy"}, requests)
+}
+
+func TestParseReplicaURL(t *testing.T) {
+	t.Parallel()
+
+	_, err := parseReplicaURL("cache-1:6443")
+	require.Error(t, err)
+
+	u, err := parseReplicaURL("https://cache-1.example.com:6443/")
+	require.NoError(t, err)
+	require.Equal(t, "https://cache-1.example.com:6443", u.String())
+}
@@ -30,6 +30,10 @@ import (
 
 type Cache struct {
 	KubeconfigFile string
+
+	// FailoverURLs are the URLs of further cache server replicas sharing the
+	// storage with the one of the kubeconfig.
+	FailoverURLs []string
 }
 
 func NewCache() *Cache {
@@ -42,9 +46,14 @@ func (o *Cache) AddFlags(flags *pflag.FlagSet) {
 
 	flags.StringVar(&o.KubeconfigFile, "cache-kubeconfig", o.KubeconfigFile,
 		"The kubeconfig file of the cache server instance that hosts workspaces.")
+	flags.StringSliceVar(&o.FailoverURLs, "cache-failover-urls", o.FailoverURLs,
+		"URLs of further cache server replicas sharing the storage with the one of --cache-kubeconfig, e.g. https://cache-2:6443. Requests fail over to them in order when the cache server is unreachable or unavailable. They are accessed with the credentials of --cache-kubeconfig.")
 }
 
 func (o *Cache) Validate() []error {
+	if len(o.FailoverURLs) > 0 && len(o.KubeconfigFile) == 0 {
+		return []error{fmt.Errorf("--cache-failover-urls requires --cache-kubeconfig")}
+	}
 	return nil
 }
 
@@ -58,7 +67,12 @@ func (o *Cache) RestConfig(fallback *rest.Config) (*rest.Config, error) {
 		}
 	}
 
-	rt := cacheclient.WithCacheServiceRoundTripper(cacheClientConfig)
+	// failover must come first, as it only rewrites the host of the requests
+	rt, err := cacheclient.WithFailoverRoundTripper(cacheClientConfig, o.FailoverURLs)
+	if err != nil {
+		return nil, err
+	}
+	rt = cacheclient.WithCacheServiceRoundTripper(rt)
 	rt = cacheclient.WithShardNameFromContextRoundTripper(rt)
 	rt = cacheclient.WithDefaultShardRoundTripper(rt, shard.Wildcard)
 
@@ -47,11 +47,14 @@ func Bootstrap(ctx context.Context, apiExtensionsClusterClient kcpapiextensionsc
 		{"apis.kcp.io", "apiexportendpointslices"},
 		{"core.kcp.io", "logicalclusters"},
 		{"migration.kcp.io", "logicalclustermigrations"},
+		{"migration.kcp.io", "sharddrains"},
 		{"core.kcp.io", "shards"},
 		{"cache.kcp.io", "cachedobjects"},
 		{"cache.kcp.io", "clustercachedresources"},
 		{"cache.kcp.io", "clustercachedresourceendpointslices"},
 		{"tenancy.kcp.io", "workspacetypes"},
+		{"tenancy.kcp.io", "workspacequotas"},
+		{"tenancy.kcp.io", "workspacequotausages"},
 		{"rbac.authorization.k8s.io", "roles"},
 		{"rbac.authorization.k8s.io", "clusterroles"},
 		{"rbac.authorization.k8s.io", "rolebindings"},
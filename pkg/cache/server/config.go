@@ -39,6 +39,7 @@ import (
 
 	kcpapiextensionsclientset "github.com/kcp-dev/client-go/apiextensions/client"
 	kcpapiextensionsinformers "github.com/kcp-dev/client-go/apiextensions/informers"
+	kcpdynamic "github.com/kcp-dev/client-go/dynamic"
 	"github.com/kcp-dev/embeddedetcd"
 	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
 	kcpinformers "github.com/kcp-dev/sdk/client/informers/externalversions"
@@ -47,6 +48,7 @@ import (
 	"github.com/kcp-dev/kcp/pkg/cache/client/shard"
 	cacheserveroptions "github.com/kcp-dev/kcp/pkg/cache/server/options"
 	"github.com/kcp-dev/kcp/pkg/reconciler/cache/clustercachedresources"
+	"github.com/kcp-dev/kcp/pkg/reconciler/cache/shardgc"
 	"github.com/kcp-dev/kcp/pkg/server/filters"
 )
 
@@ -72,6 +74,10 @@ type ExtraConfig struct {
 	ApiExtensionsClusterClient         kcpapiextensionsclientset.ClusterInterface
 	ApiExtensionsSharedInformerFactory kcpapiextensionsinformers.SharedInformerFactory
 	KcpSharedInformerFactory           kcpinformers.SharedInformerFactory
+	DynamicClusterClient               kcpdynamic.ClusterInterface
+
+	// ShardUsage holds the objects and bytes stored per shard.
+	ShardUsage *shardgc.Usage
 }
 
 type CompletedConfig struct {
@@ -144,7 +150,9 @@ func NewConfig(opts *cacheserveroptions.CompletedOptions, optionalLocalShardRest
 		return nil, err
 	}
 
+	c.ShardUsage = shardgc.NewUsage()
 	serverConfig.Config.BuildHandlerChainFunc = func(apiHandler http.Handler, genericConfig *genericapiserver.Config) (secure http.Handler) {
+		apiHandler = WithShardLimits(apiHandler, c.ShardUsage, opts.MaxObjectsPerShard, opts.MaxBytesPerShard)
 		apiHandler = filters.WithResourceIdentity(apiHandler)
 		apiHandler = genericapiserver.DefaultBuildHandlerChainFromAuthzToCompletion(apiHandler, genericConfig)
 		apiHandler = genericapiserver.DefaultBuildHandlerChainFromImpersonationToAuthz(apiHandler, genericConfig)
@@ -218,6 +226,11 @@ func NewConfig(opts *cacheserveroptions.CompletedOptions, optionalLocalShardRest
 		return nil, err
 	}
 
+	c.DynamicClusterClient, err = kcpdynamic.NewForConfig(rt)
+	if err != nil {
+		return nil, err
+	}
+
 	c.ApiExtensionsSharedInformerFactory = kcpapiextensionsinformers.NewSharedInformerFactoryWithOptions(
 		c.ApiExtensionsClusterClient,
 		resyncPeriod,
@@ -34,6 +34,7 @@ import (
 	"k8s.io/apiserver/pkg/endpoints/request"
 
 	"github.com/kcp-dev/kcp/pkg/authorization/shardpaths"
+	"github.com/kcp-dev/kcp/pkg/reconciler/cache/shardgc"
 )
 
 var (
@@ -174,6 +175,57 @@ func WithCacheShardLevelPaths(handler http.Handler) http.Handler {
 	})
 }
 
+// WithShardLimits rejects requests creating or updating objects of a shard
+// which stores more objects or bytes in the cache server than allowed, such
+// that a single shard cannot fill the cache server. A limit of 0 means
+// unlimited.
+//
+// The usage of a shard is measured periodically. Creates admitted in between
+// are accounted by their request size. Shards named "system:..." are not
+// limited.
+//
+// Must run AFTER WithShardScope and WithRequestInfo.
+func WithShardLimits(handler http.Handler, usage *shardgc.Usage, maxObjects, maxBytes int64) http.Handler {
+	if maxObjects <= 0 && maxBytes <= 0 {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		shardName := request.ShardFrom(req.Context())
+		info, ok := request.RequestInfoFrom(req.Context())
+		if !ok || !info.IsResourceRequest || shardName.Empty() || shardName.Wildcard() || shardgc.IsProtected(shardName.String()) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+
+		var err error
+		u := usage.Get(shardName.String())
+		switch info.Verb {
+		case "create":
+			switch {
+			case maxObjects > 0 && u.Objects >= maxObjects:
+				err = fmt.Errorf("shard %q exceeded its limit of %d objects in the cache server", shardName, maxObjects)
+			case maxBytes > 0 && u.Bytes+max(req.ContentLength, 0) > maxBytes:
+				err = fmt.Errorf("shard %q exceeded its limit of %d bytes in the cache server", shardName, maxBytes)
+			default:
+				usage.Add(shardName.String(), 1, max(req.ContentLength, 0))
+			}
+		case "update", "patch":
+			if maxBytes > 0 && u.Bytes >= maxBytes {
+				err = fmt.Errorf("shard %q exceeded its limit of %d bytes in the cache server", shardName, maxBytes)
+			}
+		}
+		if err != nil {
+			responsewriters.ErrorNegotiated(
+				apierrors.NewForbidden(schema.GroupResource{Group: info.APIGroup, Resource: info.Resource}, info.Name, err),
+				errorCodecs, schema.GroupVersion{},
+				w, req)
+			return
+		}
+
+		handler.ServeHTTP(w, req)
+	})
+}
+
 // WithSyntheticDelay injects a synthetic delay to calls, to exacerbate timing issues and expose inconsistent client behavior.
 func WithSyntheticDelay(handler http.Handler, delay time.Duration) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
@@ -25,6 +25,8 @@ import (
 	"k8s.io/apiserver/pkg/endpoints/request"
 
 	"github.com/kcp-dev/logicalcluster/v3"
+
+	"github.com/kcp-dev/kcp/pkg/reconciler/cache/shardgc"
 )
 
 func TestWithShardScopePassesThroughMetrics(t *testing.T) {
@@ -130,3 +132,79 @@ func TestWithCacheShardLevelPaths(t *testing.T) {
 		})
 	}
 }
+
+func TestWithShardLimits(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		shard         request.Shard
+		verb          string
+		contentLength int64
+		usage         shardgc.ShardUsage
+
+		wantStatus int
+	}{
+		"create within the limits": {
+			shard:         "amber",
+			verb:          "create",
+			contentLength: 100,
+			usage:         shardgc.ShardUsage{Objects: 9, Bytes: 900},
+			wantStatus:    http.StatusOK,
+		},
+		"create exceeding the object limit": {
+			shard:      "amber",
+			verb:       "create",
+			usage:      shardgc.ShardUsage{Objects: 10, Bytes: 100},
+			wantStatus: http.StatusForbidden,
+		},
+		"create exceeding the byte limit": {
+			shard:         "amber",
+			verb:          "create",
+			contentLength: 101,
+			usage:         shardgc.ShardUsage{Objects: 1, Bytes: 900},
+			wantStatus:    http.StatusForbidden,
+		},
+		"update exceeding the byte limit": {
+			shard:      "amber",
+			verb:       "update",
+			usage:      shardgc.ShardUsage{Objects: 1, Bytes: 1000},
+			wantStatus: http.StatusForbidden,
+		},
+		"delete is always allowed": {
+			shard:      "amber",
+			verb:       "delete",
+			usage:      shardgc.ShardUsage{Objects: 100, Bytes: 10000},
+			wantStatus: http.StatusOK,
+		},
+		"system shards are not limited": {
+			shard:      "system:cache:server",
+			verb:       "create",
+			usage:      shardgc.ShardUsage{Objects: 100, Bytes: 10000},
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			usage := shardgc.NewUsage()
+			usage.Add(tc.shard.String(), tc.usage.Objects, tc.usage.Bytes)
+			h := WithShardLimits(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}), usage, 10, 1000)
+
+			ctx := request.WithShard(context.Background(), tc.shard)
+			ctx = request.WithRequestInfo(ctx, &request.RequestInfo{IsResourceRequest: true, Verb: tc.verb, APIGroup: "apis.kcp.io", Resource: "apiexports"})
+			req := httptest.NewRequestWithContext(ctx, http.MethodPost, "https://cache.example/clusters/root/apis/apis.kcp.io/v1alpha1/apiexports", http.NoBody)
+			req.ContentLength = tc.contentLength
+			rec := httptest.NewRecorder()
+
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tc.wantStatus {
+				t.Errorf("status: got %d, want %d", rec.Code, tc.wantStatus)
+			}
+		})
+	}
+}
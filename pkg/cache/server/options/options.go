@@ -17,6 +17,7 @@ limitations under the License.
 package options
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/spf13/pflag"
@@ -40,6 +41,11 @@ type Options struct {
 	EmbeddedEtcd     etcdoptions.Options
 	Logs             *logs.Options
 	SyntheticDelay   time.Duration
+
+	ShardGCGracePeriod time.Duration
+	ShardUsageInterval time.Duration
+	MaxObjectsPerShard int64
+	MaxBytesPerShard   int64
 }
 
 type completedOptions struct {
@@ -52,6 +58,11 @@ type completedOptions struct {
 	EmbeddedEtcd     etcdoptions.CompletedOptions
 	Logs             *logs.Options
 	SyntheticDelay   time.Duration
+
+	ShardGCGracePeriod time.Duration
+	ShardUsageInterval time.Duration
+	MaxObjectsPerShard int64
+	MaxBytesPerShard   int64
 }
 
 type CompletedOptions struct {
@@ -67,6 +78,15 @@ func (o *CompletedOptions) Validate() []error {
 	errors = append(errors, o.Authorization.Validate()...)
 	errors = append(errors, o.APIEnablement.Validate()...)
 	errors = append(errors, o.EmbeddedEtcd.Validate()...)
+	if o.ShardGCGracePeriod < 0 {
+		errors = append(errors, fmt.Errorf("--shard-gc-grace-period must not be negative"))
+	}
+	if o.ShardUsageInterval <= 0 {
+		errors = append(errors, fmt.Errorf("--shard-usage-interval must be positive"))
+	}
+	if o.MaxObjectsPerShard < 0 || o.MaxBytesPerShard < 0 {
+		errors = append(errors, fmt.Errorf("--max-objects-per-shard and --max-bytes-per-shard must not be negative"))
+	}
 	return errors
 }
 
@@ -81,6 +101,9 @@ func NewOptions(rootDir string) *Options {
 		APIEnablement:    genericoptions.NewAPIEnablementOptions(),
 		EmbeddedEtcd:     *etcdoptions.NewOptions(rootDir),
 		Logs:             logs.NewOptions(),
+
+		ShardGCGracePeriod: time.Hour,
+		ShardUsageInterval: time.Minute,
 	}
 
 	o.SecureServing.ServerCert.CertDirectory = rootDir
@@ -112,6 +135,11 @@ func (o *Options) Complete() (*CompletedOptions, error) {
 		APIEnablement:    o.APIEnablement,
 		EmbeddedEtcd:     o.EmbeddedEtcd.Complete(o.Etcd),
 		Logs:             o.Logs,
+
+		ShardGCGracePeriod: o.ShardGCGracePeriod,
+		ShardUsageInterval: o.ShardUsageInterval,
+		MaxObjectsPerShard: o.MaxObjectsPerShard,
+		MaxBytesPerShard:   o.MaxBytesPerShard,
 	}}, nil
 }
 
@@ -123,4 +151,8 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	o.Authorization.AddFlags(fs)
 	logsapiv1.AddFlags(o.Logs, fs)
 	fs.DurationVar(&o.SyntheticDelay, "synthetic-delay", 0, "The duration of time the cache server will inject a delay for to all inbound requests. Useful for testing.")
+	fs.DurationVar(&o.ShardGCGracePeriod, "shard-gc-grace-period", o.ShardGCGracePeriod, "The time after which the objects of a shard whose Shard object has been removed are deleted from the cache server. 0 disables the deletion.")
+	fs.DurationVar(&o.ShardUsageInterval, "shard-usage-interval", o.ShardUsageInterval, "The interval in which the objects and bytes stored per shard are measured, and the objects of removed shards are deleted.")
+	fs.Int64Var(&o.MaxObjectsPerShard, "max-objects-per-shard", o.MaxObjectsPerShard, "The maximum number of objects a single shard can store in the cache server. 0 means unlimited.")
+	fs.Int64Var(&o.MaxBytesPerShard, "max-bytes-per-shard", o.MaxBytesPerShard, "The maximum size in bytes of the objects a single shard can store in the cache server. 0 means unlimited.")
 }
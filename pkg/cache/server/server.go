@@ -26,6 +26,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/kcp-dev/kcp/pkg/cache/server/bootstrap"
+	"github.com/kcp-dev/kcp/pkg/reconciler/cache/shardgc"
 )
 
 type Server struct {
@@ -96,6 +97,31 @@ func (s *Server) PrepareRun(ctx context.Context) (preparedServer, error) {
 	}); err != nil {
 		return preparedServer{}, err
 	}
+
+	if err := s.apiextensions.GenericAPIServer.AddPostStartHook("cache-server-shard-gc", func(hookContext genericapiserver.PostStartHookContext) error {
+		logger := logger.WithValues("postStartHook", "cache-server-shard-gc")
+		crdInformer := s.ApiExtensionsSharedInformerFactory.Apiextensions().V1().CustomResourceDefinitions()
+		if err := wait.PollUntilContextCancel(hookContext, time.Millisecond*100, true, func(ctx context.Context) (bool, error) {
+			return crdInformer.Informer().HasSynced(), nil
+		}); err != nil {
+			logger.Error(err, "failed to wait for the CRD informer")
+			return nil // don't klog.Fatal. This only happens when context is cancelled.
+		}
+
+		controller := shardgc.NewController(
+			s.DynamicClusterClient,
+			crdInformer,
+			bootstrap.SystemCRDLogicalCluster,
+			s.ShardUsage,
+			s.Options.ShardGCGracePeriod,
+			s.Options.ShardUsageInterval,
+		)
+		go controller.Start(klog.NewContext(hookContext, logger))
+		return nil
+	}); err != nil {
+		return preparedServer{}, err
+	}
+
 	return preparedServer{s, s.apiextensions.GenericAPIServer.Handler}, nil
 }
 
@@ -63,6 +63,13 @@ func (m *Manager[K]) Delete(key K, reason error) {
 	m.rc.delete(key.String(), reason)
 }
 
+// Release removes the entry for the given key if its context has been
+// cancelled, so that following calls get a fresh context. A live context is
+// left untouched.
+func (m *Manager[K]) Release(key K) {
+	m.rc.release(key.String())
+}
+
 // Shutdown cancels the root context, which propagates to all contexts.
 func (m *Manager[K]) Shutdown() {
 	m.rc.cancelAll(errShutdown)
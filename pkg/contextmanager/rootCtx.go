@@ -105,6 +105,15 @@ func (rc *rootCtx) delete(key string, reason error) {
 	stored.(*entry).cancel(reason)
 }
 
+func (rc *rootCtx) release(key string) {
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+
+	if stored, loaded := rc.entries.Load(key); loaded && stored.(*entry).ctx.Err() != nil {
+		rc.entries.Delete(key)
+	}
+}
+
 func (rc *rootCtx) cancelAll(reason error) {
 	rc.rootCancel(reason)
 }
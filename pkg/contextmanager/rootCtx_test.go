@@ -121,6 +121,30 @@ func TestRootCtx_Delete(t *testing.T) {
 	rc.delete("missing", errors.New("nope"))
 }
 
+func TestRootCtx_Release(t *testing.T) {
+	t.Parallel()
+	rc := newRootCtx(context.Background())
+
+	live, _ := rc.context("live")
+	rc.release("live")
+	if err := live.Err(); err != nil {
+		t.Fatalf("release should not cancel a live context, got Err=%v", err)
+	}
+	if ctx, _ := rc.context("live"); ctx != live {
+		t.Fatalf("release should keep a live context")
+	}
+
+	rc.cancel("cancelled", errors.New("inactive"))
+	rc.release("cancelled")
+	ctx, _ := rc.context("cancelled")
+	if err := ctx.Err(); err != nil {
+		t.Fatalf("expected fresh live context after release, got Err=%v", err)
+	}
+
+	// release on a missing key is a no-op.
+	rc.release("missing")
+}
+
 func TestRootCtx_CancelAll(t *testing.T) {
 	t.Parallel()
 	rc := newRootCtx(context.Background())
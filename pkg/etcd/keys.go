@@ -25,6 +25,10 @@ import (
 // ScanPageSize is the page size used in etcd range scans.
 const ScanPageSize int64 = 1000
 
+// ScanValuesPageSize is the page size used in etcd range scans which fetch
+// values, bounding the memory used per page.
+const ScanValuesPageSize int64 = 100
+
 // DumpMaxBytes is the default cap on the total size of entry values
 // returned in a single LogicalClusterDump page, used when the request
 // doesn't specify spec.maxBytes.
@@ -49,6 +53,15 @@ func (p KeyParts) ClusterPrefix(storagePrefix string) string {
 	return storagePrefix + p.Group + "/" + p.Resource + "/" + p.Segment + "/" + string(p.Cluster)
 }
 
+// Key reconstructs the full etcd key, e.g. after changing Cluster to move
+// the key to another logical cluster.
+func (p KeyParts) Key(storagePrefix string) string {
+	if p.Rest == "" {
+		return p.ClusterPrefix(storagePrefix)
+	}
+	return p.ClusterPrefix(storagePrefix) + "/" + p.Rest
+}
+
 // SplitKey parses an etcd key into its structural components.
 // true is only returned when the key was parsed correctly into KeyParts, not if KeyParts matches the lc.
 func SplitKey(prefix, key string, lc logicalcluster.Name) (KeyParts, bool) {
@@ -331,3 +331,31 @@ func TestKeyPartsClusterPrefix(t *testing.T) {
 		})
 	}
 }
+
+func TestKeyPartsKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		key  string
+		lc   logicalcluster.Name
+	}{
+		{name: "built-in namespaced", key: "/registry/apps/deployments/root:ws/default/my-deploy", lc: "root:ws"},
+		{name: "built-in cluster-scoped", key: "/registry/rbac.authorization.k8s.io/clusterroles/root:ws/admin", lc: "root:ws"},
+		{name: "CRD", key: "/registry/mygroup.io/widgets/customresources/root:ws/default/my-widget", lc: "root:ws"},
+		{name: "identity-based", key: "/registry/mygroup.io/widgets/abc123def/root:ws/my-widget", lc: "root:ws"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			parts, ok := SplitKey("/registry/", tt.key, tt.lc)
+			require.True(t, ok)
+			assert.Equal(t, tt.key, parts.Key("/registry/"), "splitting and joining a key must round-trip")
+
+			parts.Cluster = "restored"
+			assert.Equal(t, strings.Replace(tt.key, "/"+tt.lc.String()+"/", "/restored/", 1), parts.Key("/registry/"))
+		})
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"bytes"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ProtobufPrefix is the magic prefix of protobuf encoded values in etcd.
+var ProtobufPrefix = []byte("k8s\x00")
+
+// IsProtobuf returns whether the etcd value is protobuf encoded.
+func IsProtobuf(value []byte) bool {
+	return bytes.HasPrefix(value, ProtobufPrefix)
+}
+
+// RewriteProtobufMeta rewrites the metadata of a protobuf encoded value, i.e.
+// a runtime.Unknown whose raw object has the ObjectMeta as field 1. All other
+// fields are kept as they are. It returns false if rewrite does.
+func RewriteProtobufMeta(value []byte, rewrite func(meta *metav1.ObjectMeta) bool) ([]byte, bool, error) {
+	var unknown runtime.Unknown
+	if err := unknown.Unmarshal(value[len(ProtobufPrefix):]); err != nil {
+		return nil, false, err
+	}
+
+	var raw []byte
+	for b := unknown.Raw; len(b) > 0; {
+		num, typ, n := protowire.ConsumeField(b)
+		if n < 0 {
+			return nil, false, protowire.ParseError(n)
+		}
+		field := b[:n]
+		b = b[n:]
+
+		if num != 1 || typ != protowire.BytesType {
+			raw = append(raw, field...)
+			continue
+		}
+
+		_, _, tagLen := protowire.ConsumeTag(field)
+		data, dataLen := protowire.ConsumeBytes(field[tagLen:])
+		if dataLen < 0 {
+			return nil, false, protowire.ParseError(dataLen)
+		}
+		var meta metav1.ObjectMeta
+		if err := meta.Unmarshal(data); err != nil {
+			return nil, false, err
+		}
+		if !rewrite(&meta) {
+			return nil, false, nil
+		}
+		data, err := meta.Marshal()
+		if err != nil {
+			return nil, false, err
+		}
+		raw = protowire.AppendTag(raw, 1, protowire.BytesType)
+		raw = protowire.AppendBytes(raw, data)
+	}
+	unknown.Raw = raw
+
+	data, err := unknown.Marshal()
+	if err != nil {
+		return nil, false, err
+	}
+	return append(append([]byte{}, ProtobufPrefix...), data...), true, nil
+}
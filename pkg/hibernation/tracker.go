@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hibernation
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apiserver/pkg/authentication/user"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+
+	"github.com/kcp-dev/kcp/pkg/authorization/bootstrap"
+)
+
+// Tracker records the requests of users per logical cluster in this process.
+// It is fed by the request handler chain and read by the hibernation
+// controller to find idle logical clusters.
+type Tracker struct {
+	now     func() time.Time
+	started time.Time
+
+	mu       sync.Mutex
+	clusters map[logicalcluster.Name]*activity
+}
+
+type activity struct {
+	inFlight int
+	last     time.Time
+}
+
+// NewTracker creates an empty Tracker. Logical clusters without any request
+// are idle since the Tracker was created.
+func NewTracker() *Tracker {
+	return newTracker(time.Now)
+}
+
+func newTracker(now func() time.Time) *Tracker {
+	return &Tracker{
+		now:      now,
+		started:  now(),
+		clusters: map[logicalcluster.Name]*activity{},
+	}
+}
+
+// Begin records the start of a request to the given logical cluster. The
+// returned function must be called when the request has finished. Long
+// running requests like watches keep the logical cluster busy until they end.
+func (t *Tracker) Begin(cluster logicalcluster.Name) func() {
+	t.mu.Lock()
+	a, ok := t.clusters[cluster]
+	if !ok {
+		a = &activity{}
+		t.clusters[cluster] = a
+	}
+	a.inFlight++
+	a.last = t.now()
+	t.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			a.inFlight--
+			a.last = t.now()
+		})
+	}
+}
+
+// IdleSince returns the time since which the given logical cluster has not
+// seen any request, and false if a request is in flight.
+func (t *Tracker) IdleSince(cluster logicalcluster.Name) (time.Time, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	a, ok := t.clusters[cluster]
+	if !ok {
+		return t.started, true
+	}
+	if a.inFlight > 0 {
+		return time.Time{}, false
+	}
+	return a.last, true
+}
+
+// Forget drops the recorded activity of the given logical cluster, e.g. when
+// it is deleted. Requests in flight are still finished correctly.
+func (t *Tracker) Forget(cluster logicalcluster.Name) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.clusters, cluster)
+}
+
+// IsActivity returns whether requests by the given user count as activity
+// of a logical cluster. Requests of kcp system components, which act with
+// privileged identities, and unauthenticated requests do not, so that
+// controllers resyncing all logical clusters do not keep them awake.
+func IsActivity(u user.Info) bool {
+	for _, group := range u.GetGroups() {
+		switch group {
+		case user.SystemPrivilegedGroup,
+			bootstrap.SystemLogicalClusterAdmin,
+			bootstrap.SystemExternalLogicalClusterAdmin,
+			user.AllUnauthenticated:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hibernation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apiserver/pkg/authentication/user"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+
+	"github.com/kcp-dev/kcp/pkg/authorization/bootstrap"
+)
+
+func TestTracker(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tracker := newTracker(func() time.Time { return now })
+	started := now
+	cluster := logicalcluster.Name("foo")
+
+	since, idle := tracker.IdleSince(cluster)
+	require.True(t, idle, "unknown clusters are idle")
+	require.Equal(t, started, since, "unknown clusters are idle since the tracker was created")
+
+	now = now.Add(time.Minute)
+	done := tracker.Begin(cluster)
+	_, idle = tracker.IdleSince(cluster)
+	require.False(t, idle, "clusters with requests in flight are not idle")
+
+	now = now.Add(time.Hour)
+	done()
+	done()
+	since, idle = tracker.IdleSince(cluster)
+	require.True(t, idle)
+	require.Equal(t, now, since, "clusters are idle since their last request ended")
+
+	tracker.Forget(cluster)
+	since, idle = tracker.IdleSince(cluster)
+	require.True(t, idle)
+	require.Equal(t, started, since)
+}
+
+func TestIsActivity(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		groups []string
+		want   bool
+	}{
+		{name: "authenticated user", groups: []string{user.AllAuthenticated}, want: true},
+		{name: "service account", groups: []string{user.AllAuthenticated, "system:serviceaccounts"}, want: true},
+		{name: "privileged system user", groups: []string{user.SystemPrivilegedGroup}},
+		{name: "logical cluster admin", groups: []string{bootstrap.SystemLogicalClusterAdmin}},
+		{name: "external logical cluster admin", groups: []string{bootstrap.SystemExternalLogicalClusterAdmin}},
+		{name: "unauthenticated", groups: []string{user.AllUnauthenticated}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.want, IsActivity(&user.DefaultInfo{Name: "someone", Groups: tc.groups}))
+		})
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hibernation
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/sync/singleflight"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
+)
+
+// Waker wakes up hibernated logical clusters by removing their hibernated
+// annotation. Concurrent calls for the same logical cluster are coalesced.
+type Waker struct {
+	group singleflight.Group
+	patch func(ctx context.Context, cluster logicalcluster.Name, patch []byte) error
+}
+
+// NewWaker creates a Waker patching LogicalClusters with the given client,
+// which must be allowed to update LogicalClusters in every logical cluster.
+func NewWaker(kcpClusterClient kcpclientset.ClusterInterface) *Waker {
+	return &Waker{
+		patch: func(ctx context.Context, cluster logicalcluster.Name, patch []byte) error {
+			_, err := kcpClusterClient.Cluster(cluster.Path()).CoreV1alpha1().LogicalClusters().Patch(ctx, corev1alpha1.LogicalClusterName, types.MergePatchType, patch, metav1.PatchOptions{})
+			return err
+		},
+	}
+}
+
+// Wake removes the hibernated annotation from the LogicalCluster of the given
+// logical cluster.
+func (w *Waker) Wake(ctx context.Context, cluster logicalcluster.Name) error {
+	_, err, _ := w.group.Do(cluster.String(), func() (any, error) {
+		return nil, w.patch(context.WithoutCancel(ctx), cluster, wakePatch)
+	})
+	return err
+}
+
+var wakePatch = []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:null}}}`, corev1alpha1.LogicalClusterHibernatedAnnotationKey))
@@ -139,8 +139,13 @@ func (c *State) UpsertWorkspace(shard string, ws *tenancyv1alpha1.Workspace) {
 		}
 
 		c.shardClusterWorkspaceNameCluster[shard][clusterName][ws.Name] = logicalcluster.Name(ws.Spec.Cluster)
-		c.shardClusterWorkspaceName[shard][logicalcluster.Name(ws.Spec.Cluster)] = ws.Name
-		c.shardClusterParentCluster[shard][logicalcluster.Name(ws.Spec.Cluster)] = clusterName
+		// A workspace being moved shares its logical cluster with the one
+		// taking over. The latter owns the reverse edges.
+		_, moving := ws.Annotations[tenancyv1alpha1.WorkspaceMoveToAnnotationKey]
+		if _, found := c.shardClusterWorkspaceName[shard][logicalcluster.Name(ws.Spec.Cluster)]; !moving || !found {
+			c.shardClusterWorkspaceName[shard][logicalcluster.Name(ws.Spec.Cluster)] = ws.Name
+			c.shardClusterParentCluster[shard][logicalcluster.Name(ws.Spec.Cluster)] = clusterName
+		}
 	}
 
 	if ws.Spec.Mount != nil {
@@ -182,16 +187,19 @@ func (c *State) DeleteWorkspace(shard string, ws *tenancyv1alpha1.Workspace) {
 			delete(c.shardClusterWorkspaceNameCluster, shard)
 		}
 
+		// The reverse edges might belong to another workspace that took over
+		// the logical cluster, i.e. after a move.
 		cluster := logicalcluster.Name(ws.Spec.Cluster)
+		if c.shardClusterWorkspaceName[shard][cluster] == ws.Name && c.shardClusterParentCluster[shard][cluster] == clusterName {
+			delete(c.shardClusterWorkspaceName[shard], cluster)
+			if len(c.shardClusterWorkspaceName[shard]) == 0 {
+				delete(c.shardClusterWorkspaceName, shard)
+			}
 
-		delete(c.shardClusterWorkspaceName[shard], cluster)
-		if len(c.shardClusterWorkspaceName[shard]) == 0 {
-			delete(c.shardClusterWorkspaceName, shard)
-		}
-
-		delete(c.shardClusterParentCluster[shard], cluster)
-		if len(c.shardClusterParentCluster[shard]) == 0 {
-			delete(c.shardClusterParentCluster, shard)
+			delete(c.shardClusterParentCluster[shard], cluster)
+			if len(c.shardClusterParentCluster[shard]) == 0 {
+				delete(c.shardClusterParentCluster, shard)
+			}
 		}
 	}
 
@@ -555,6 +555,40 @@ func TestDeleteWorkspace(t *testing.T) {
 	validateLookupOutput(t, logicalcluster.NewPath("root:org"), r.Shard, r.Cluster, r.URL, found, "root", "43", "", true)
 }
 
+func TestMoveWorkspace(t *testing.T) {
+	t.Parallel()
+
+	for _, sourceFirst := range []bool{true, false} {
+		target := New(nil)
+		target.UpsertShard("root", "https://root.io")
+		target.UpsertLogicalCluster("root", newLogicalCluster("root"))
+		target.UpsertLogicalCluster("root", newLogicalCluster("34"))
+
+		source := newWorkspace("org", "root", "34")
+		source.Annotations[tenancyv1alpha1.WorkspaceMoveToAnnotationKey] = "root:renamed"
+		if sourceFirst {
+			target.UpsertWorkspace("root", source)
+			target.UpsertWorkspace("root", newWorkspace("renamed", "root", "34"))
+		} else {
+			target.UpsertWorkspace("root", newWorkspace("renamed", "root", "34"))
+			target.UpsertWorkspace("root", source)
+		}
+		target.DeleteWorkspace("root", source)
+
+		r, found := target.Lookup(logicalcluster.NewPath("root:org"))
+		validateLookupOutput(t, logicalcluster.NewPath("root:org"), r.Shard, r.Cluster, r.URL, found, "", "", "", false)
+		r, found = target.Lookup(logicalcluster.NewPath("root:renamed"))
+		validateLookupOutput(t, logicalcluster.NewPath("root:renamed"), r.Shard, r.Cluster, r.URL, found, "root", "34", "", true)
+
+		// the reverse edges of the moved workspace are kept, so that the
+		// forward edge is scrubbed with the logical cluster.
+		target.DeleteLogicalCluster("root", newLogicalCluster("34"))
+		if _, found := target.shardClusterWorkspaceNameCluster["root"]["root"]["renamed"]; found {
+			t.Errorf("forward edge of the moved workspace must be scrubbed (sourceFirst=%v)", sourceFirst)
+		}
+	}
+}
+
 func TestUpsertLogicalCluster(t *testing.T) {
 	t.Parallel()
 	target := New(nil)
@@ -86,6 +86,9 @@ func TestBuiltInInformableTypes(t *testing.T) {
 		{Group: "authorization.k8s.io", Version: "v1", Kind: "SubjectAccessReview"}:      {},
 		{Group: "apiextensions.k8s.io", Version: "v1", Kind: "ConversionReview"}:         {},
 		{Group: "core.kcp.io", Version: "v1alpha1", Kind: "Shard"}:                       {},
+		{Group: "core.kcp.io", Version: "v1alpha1", Kind: "FrontProxyRoute"}:             {},
+		{Group: "core.kcp.io", Version: "v1alpha1", Kind: "FrontProxyRateLimit"}:         {},
+		{Group: "core.kcp.io", Version: "v1alpha1", Kind: "LogicalClusterLimits"}:        {},
 	}
 
 	gvsToIgnore := map[schema.GroupVersion]struct{}{
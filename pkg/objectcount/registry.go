@@ -22,9 +22,14 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
 	"github.com/kcp-dev/logicalcluster/v3"
 	"github.com/kcp-dev/sdk/apis/core"
 	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
 )
 
 // Registry tracks the total number of objects per logical cluster on this
@@ -33,13 +38,34 @@ import (
 // between scans. The effective count is base + delta. Every completed scan
 // replaces the base and resets all deltas, so any drift (e.g. from writes that
 // failed after admission) self-corrects within one scan interval.
+//
+// The same is done per resource for the logical clusters with per-resource
+// limits, see ResourceLimitsFor, and for the bytes the objects occupy in
+// storage while any storage limit is in effect, see StorageLimitFor.
 type Registry struct {
-	defaultLimit int64
-	active       atomic.Bool
+	defaultLimit  int64
+	active        atomic.Bool
+	storageActive atomic.Bool
+	tracked       atomic.Bool
+	scanned       atomic.Bool
 
 	mu    sync.RWMutex
 	base  map[logicalcluster.Name]int64
 	delta map[logicalcluster.Name]*atomic.Int64
+
+	resourceScanned sets.Set[logicalcluster.Name]
+	resourceBase    map[clusterResource]int64
+	resourceDelta   map[clusterResource]*atomic.Int64
+
+	bytesScanned bool
+	bytesBase    map[logicalcluster.Name]int64
+	bytesDelta   map[logicalcluster.Name]*atomic.Int64
+}
+
+// clusterResource identifies a resource in a logical cluster.
+type clusterResource struct {
+	cluster  logicalcluster.Name
+	resource schema.GroupResource
 }
 
 // NewRegistry creates a Registry with the given shard-wide default limit.
@@ -49,6 +75,13 @@ func NewRegistry(defaultLimit int64) *Registry {
 		defaultLimit: defaultLimit,
 		base:         map[logicalcluster.Name]int64{},
 		delta:        map[logicalcluster.Name]*atomic.Int64{},
+
+		resourceScanned: sets.New[logicalcluster.Name](),
+		resourceBase:    map[clusterResource]int64{},
+		resourceDelta:   map[clusterResource]*atomic.Int64{},
+
+		bytesBase:  map[logicalcluster.Name]int64{},
+		bytesDelta: map[logicalcluster.Name]*atomic.Int64{},
 	}
 }
 
@@ -70,6 +103,30 @@ func (r *Registry) SetEnforcementActive(active bool) {
 	r.active.Store(active)
 }
 
+// StorageEnforcementActive reports whether any storage limit is potentially
+// in effect on this shard. Like EnforcementActive, it is maintained by the
+// scanner.
+func (r *Registry) StorageEnforcementActive() bool {
+	return r.storageActive.Load()
+}
+
+// SetStorageEnforcementActive is called by the scanner to enable or disable
+// storage limit enforcement.
+func (r *Registry) SetStorageEnforcementActive(active bool) {
+	r.storageActive.Store(active)
+}
+
+// TrackUsage makes the scanner count objects even if no limit is in effect on
+// this shard, such that Total can be reported as shard usage.
+func (r *Registry) TrackUsage() {
+	r.tracked.Store(true)
+}
+
+// UsageTracked reports whether TrackUsage has been called.
+func (r *Registry) UsageTracked() bool {
+	return r.tracked.Load()
+}
+
 // Count returns the effective object count for the given logical cluster.
 func (r *Registry) Count(cluster logicalcluster.Name) int64 {
 	r.mu.RLock()
@@ -81,6 +138,21 @@ func (r *Registry) Count(cluster logicalcluster.Name) int64 {
 	return count
 }
 
+// Total returns the effective object count of all logical clusters on this
+// shard. The second return value is false until the first scan completed.
+func (r *Registry) Total() (int64, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var total int64
+	for _, count := range r.base {
+		total += count
+	}
+	for _, d := range r.delta {
+		total += d.Load()
+	}
+	return total, r.scanned.Load()
+}
+
 // Inc records an admitted object creation in the given logical cluster.
 func (r *Registry) Inc(cluster logicalcluster.Name) {
 	r.deltaFor(cluster).Add(1)
@@ -116,6 +188,124 @@ func (r *Registry) ReplaceBase(counts map[logicalcluster.Name]int64) {
 	defer r.mu.Unlock()
 	r.base = counts
 	r.delta = map[logicalcluster.Name]*atomic.Int64{}
+	r.scanned.Store(true)
+}
+
+// ResourceCount returns the effective object count of the given resource in
+// the given logical cluster. The second return value is false if the objects
+// of the logical cluster were not counted per resource by the last scan, i.e.
+// it had no per-resource limits at the time.
+func (r *Registry) ResourceCount(cluster logicalcluster.Name, resource schema.GroupResource) (int64, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	key := clusterResource{cluster: cluster, resource: resource}
+	count := r.resourceBase[key]
+	if d, ok := r.resourceDelta[key]; ok {
+		count += d.Load()
+	}
+	return count, r.resourceScanned.Has(cluster)
+}
+
+// IncResource records an admitted creation of an object of the given
+// resource in the given logical cluster.
+func (r *Registry) IncResource(cluster logicalcluster.Name, resource schema.GroupResource) {
+	r.resourceDeltaFor(clusterResource{cluster: cluster, resource: resource}).Add(1)
+}
+
+// DecResource records the deletion of an object of the given resource in the
+// given logical cluster.
+func (r *Registry) DecResource(cluster logicalcluster.Name, resource schema.GroupResource) {
+	r.resourceDeltaFor(clusterResource{cluster: cluster, resource: resource}).Add(-1)
+}
+
+func (r *Registry) resourceDeltaFor(key clusterResource) *atomic.Int64 {
+	r.mu.RLock()
+	d, ok := r.resourceDelta[key]
+	r.mu.RUnlock()
+	if ok {
+		return d
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if d, ok := r.resourceDelta[key]; ok {
+		return d
+	}
+	d = &atomic.Int64{}
+	r.resourceDelta[key] = d
+	return d
+}
+
+// ReplaceResourceBase replaces the authoritative per-resource base counts
+// with the result of a completed scan and resets all per-resource deltas.
+// counts holds an entry for every logical cluster counted per resource.
+func (r *Registry) ReplaceResourceBase(counts map[logicalcluster.Name]map[schema.GroupResource]int64) {
+	base := map[clusterResource]int64{}
+	scanned := sets.New[logicalcluster.Name]()
+	for cluster, resources := range counts {
+		scanned.Insert(cluster)
+		for resource, count := range resources {
+			base[clusterResource{cluster: cluster, resource: resource}] = count
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.resourceScanned = scanned
+	r.resourceBase = base
+	r.resourceDelta = map[clusterResource]*atomic.Int64{}
+}
+
+// Bytes returns the effective number of bytes the objects of the given
+// logical cluster occupy in storage. The second return value is false if the
+// last scan did not sum the object sizes, i.e. no storage limit was in effect
+// at the time.
+func (r *Registry) Bytes(cluster logicalcluster.Name) (int64, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	bytes := r.bytesBase[cluster]
+	if d, ok := r.bytesDelta[cluster]; ok {
+		bytes += d.Load()
+	}
+	return bytes, r.bytesScanned
+}
+
+// AddBytes records an admitted change of n bytes, which is negative for
+// deletions and shrinking updates, in the given logical cluster.
+func (r *Registry) AddBytes(cluster logicalcluster.Name, n int64) {
+	r.bytesDeltaFor(cluster).Add(n)
+}
+
+func (r *Registry) bytesDeltaFor(cluster logicalcluster.Name) *atomic.Int64 {
+	r.mu.RLock()
+	d, ok := r.bytesDelta[cluster]
+	r.mu.RUnlock()
+	if ok {
+		return d
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if d, ok := r.bytesDelta[cluster]; ok {
+		return d
+	}
+	d = &atomic.Int64{}
+	r.bytesDelta[cluster] = d
+	return d
+}
+
+// ReplaceBytesBase replaces the authoritative byte counts with the result of
+// a completed scan and resets all byte deltas. A nil bytes map records a scan
+// which did not sum the object sizes.
+func (r *Registry) ReplaceBytesBase(bytes map[logicalcluster.Name]int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.bytesScanned = bytes != nil
+	if bytes == nil {
+		bytes = map[logicalcluster.Name]int64{}
+	}
+	r.bytesBase = bytes
+	r.bytesDelta = map[logicalcluster.Name]*atomic.Int64{}
 }
 
 // LimitFor resolves the effective limit for a logical cluster from its
@@ -138,3 +328,55 @@ func (r *Registry) LimitFor(cluster logicalcluster.Name, annotations map[string]
 	}
 	return r.defaultLimit
 }
+
+// ResourceLimitsFor resolves the per-resource limits of a logical cluster from
+// the annotations of its LogicalCluster and from its LogicalClusterLimits,
+// whose entries take precedence. limits may be nil. Annotation entries which
+// cannot be parsed or are negative are ignored. There is no shard-wide default
+// for per-resource limits.
+func ResourceLimitsFor(annotations map[string]string, limits *corev1alpha1.LogicalClusterLimits) map[schema.GroupResource]int64 {
+	ret := map[schema.GroupResource]int64{}
+	for entry := range strings.SplitSeq(annotations[corev1alpha1.LogicalClusterMaxObjectsPerResourceAnnotationKey], ",") {
+		resource, value, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok {
+			continue
+		}
+		limit, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil || limit < 0 {
+			continue
+		}
+		gr := schema.ParseGroupResource(strings.TrimSpace(resource))
+		if gr.Resource == "" {
+			continue
+		}
+		ret[gr] = limit
+	}
+	if limits != nil {
+		for _, l := range limits.Spec.ObjectCounts {
+			ret[schema.ParseGroupResource(l.Resource)] = l.Max
+		}
+	}
+	return ret
+}
+
+// StorageLimitFor resolves the storage limit in bytes of a logical cluster
+// from the annotations of its LogicalCluster, falling back to the
+// maxStorageBytes of its WorkspaceType. A return value <= 0 means no limit is
+// enforced. An unparseable annotation value falls back to the WorkspaceType,
+// and a WorkspaceType which cannot be found means no limit.
+func StorageLimitFor(annotations map[string]string, getWorkspaceType func(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error)) int64 {
+	if v, ok := annotations[corev1alpha1.LogicalClusterMaxStorageBytesAnnotationKey]; ok {
+		if limit, err := resource.ParseQuantity(v); err == nil {
+			return limit.Value()
+		}
+	}
+	wtPath, wtName := logicalcluster.NewPath(annotations[tenancyv1alpha1.LogicalClusterTypeAnnotationKey]).Split()
+	if wtPath.Empty() || wtName == "" {
+		return 0
+	}
+	wt, err := getWorkspaceType(wtPath, wtName)
+	if err != nil || wt.Spec.MaxStorageBytes == nil {
+		return 0
+	}
+	return wt.Spec.MaxStorageBytes.Value()
+}
@@ -22,9 +22,14 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
 	"github.com/kcp-dev/logicalcluster/v3"
 	"github.com/kcp-dev/sdk/apis/core"
 	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
 )
 
 func TestRegistryCountIncDec(t *testing.T) {
@@ -67,6 +72,24 @@ func TestRegistryReplaceBaseResetsDeltas(t *testing.T) {
 	require.Equal(t, int64(6), r.Count(ws), "delta must apply on top of the new base")
 }
 
+func TestRegistryTotal(t *testing.T) {
+	t.Parallel()
+
+	r := NewRegistry(0)
+	r.Inc(logicalcluster.Name("root:ws"))
+	_, scanned := r.Total()
+	require.False(t, scanned, "total must not be known before the first scan")
+
+	r.ReplaceBase(map[logicalcluster.Name]int64{"root:ws": 5, "root:other": 3})
+	r.Inc(logicalcluster.Name("root:ws"))
+	r.Dec(logicalcluster.Name("root:other"))
+	r.Inc(logicalcluster.Name("root:new"))
+
+	total, scanned := r.Total()
+	require.True(t, scanned)
+	require.Equal(t, int64(9), total)
+}
+
 func TestRegistryLimitFor(t *testing.T) {
 	t.Parallel()
 
@@ -153,6 +176,189 @@ func TestRegistryLimitFor(t *testing.T) {
 	}
 }
 
+func TestRegistryResourceCount(t *testing.T) {
+	t.Parallel()
+
+	r := NewRegistry(0)
+	ws := logicalcluster.Name("root:ws")
+	secrets := schema.GroupResource{Resource: "secrets"}
+	widgets := schema.GroupResource{Group: "example.io", Resource: "widgets"}
+
+	r.IncResource(ws, secrets)
+	count, scanned := r.ResourceCount(ws, secrets)
+	require.Equal(t, int64(1), count)
+	require.False(t, scanned, "resource counts must not be known before the cluster was scanned per resource")
+
+	r.ReplaceResourceBase(map[logicalcluster.Name]map[schema.GroupResource]int64{ws: {secrets: 5}})
+	count, scanned = r.ResourceCount(ws, secrets)
+	require.Equal(t, int64(5), count, "base must replace delta")
+	require.True(t, scanned)
+
+	r.IncResource(ws, secrets)
+	r.IncResource(ws, widgets)
+	r.DecResource(ws, secrets)
+	r.DecResource(ws, secrets)
+	count, _ = r.ResourceCount(ws, secrets)
+	require.Equal(t, int64(4), count)
+	count, scanned = r.ResourceCount(ws, widgets)
+	require.Equal(t, int64(1), count, "resources without objects at scan time must count from zero")
+	require.True(t, scanned)
+
+	_, scanned = r.ResourceCount(logicalcluster.Name("root:other"), secrets)
+	require.False(t, scanned)
+	require.Equal(t, int64(0), r.Count(ws), "resource counts must not affect the total")
+}
+
+func TestResourceLimitsFor(t *testing.T) {
+	t.Parallel()
+
+	limits := func(objectCounts ...corev1alpha1.ResourceObjectCountLimit) *corev1alpha1.LogicalClusterLimits {
+		return &corev1alpha1.LogicalClusterLimits{
+			Spec: corev1alpha1.LogicalClusterLimitsSpec{ObjectCounts: objectCounts},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		limits      *corev1alpha1.LogicalClusterLimits
+		want        map[schema.GroupResource]int64
+	}{
+		{
+			name: "no limits",
+			want: map[schema.GroupResource]int64{},
+		},
+		{
+			name:        "annotation",
+			annotations: map[string]string{corev1alpha1.LogicalClusterMaxObjectsPerResourceAnnotationKey: "secrets=500, deployments.apps=10,widgets.example.io=0"},
+			want: map[schema.GroupResource]int64{
+				{Resource: "secrets"}:                      500,
+				{Group: "apps", Resource: "deployments"}:   10,
+				{Group: "example.io", Resource: "widgets"}: 0,
+			},
+		},
+		{
+			name:        "invalid annotation entries are ignored",
+			annotations: map[string]string{corev1alpha1.LogicalClusterMaxObjectsPerResourceAnnotationKey: "secrets,configmaps=many,=5,services=-1,pods=3"},
+			want:        map[schema.GroupResource]int64{{Resource: "pods"}: 3},
+		},
+		{
+			name:   "LogicalClusterLimits",
+			limits: limits(corev1alpha1.ResourceObjectCountLimit{Resource: "secrets", Max: 100}),
+			want:   map[schema.GroupResource]int64{{Resource: "secrets"}: 100},
+		},
+		{
+			name:        "LogicalClusterLimits take precedence",
+			annotations: map[string]string{corev1alpha1.LogicalClusterMaxObjectsPerResourceAnnotationKey: "secrets=500,configmaps=1000"},
+			limits:      limits(corev1alpha1.ResourceObjectCountLimit{Resource: "secrets", Max: 100}),
+			want: map[schema.GroupResource]int64{
+				{Resource: "secrets"}:    100,
+				{Resource: "configmaps"}: 1000,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, ResourceLimitsFor(tt.annotations, tt.limits))
+		})
+	}
+}
+
+func TestRegistryBytes(t *testing.T) {
+	t.Parallel()
+
+	r := NewRegistry(0)
+	ws := logicalcluster.Name("root:ws")
+
+	r.AddBytes(ws, 100)
+	bytes, scanned := r.Bytes(ws)
+	require.Equal(t, int64(100), bytes)
+	require.False(t, scanned, "bytes must not be known before the object sizes were scanned")
+
+	r.ReplaceBytesBase(map[logicalcluster.Name]int64{ws: 1000})
+	bytes, scanned = r.Bytes(ws)
+	require.Equal(t, int64(1000), bytes, "base must replace delta")
+	require.True(t, scanned)
+
+	r.AddBytes(ws, 50)
+	r.AddBytes(ws, -200)
+	bytes, _ = r.Bytes(ws)
+	require.Equal(t, int64(850), bytes)
+
+	r.ReplaceBytesBase(nil)
+	bytes, scanned = r.Bytes(ws)
+	require.Equal(t, int64(0), bytes)
+	require.False(t, scanned, "a scan without object sizes must reset the bytes")
+	require.Equal(t, int64(0), r.Count(ws), "bytes must not affect the object count")
+}
+
+func TestStorageLimitFor(t *testing.T) {
+	t.Parallel()
+
+	getWorkspaceType := func(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error) {
+		switch path.Join(name).String() {
+		case "root:limited":
+			limit := resource.MustParse("1Mi")
+			return &tenancyv1alpha1.WorkspaceType{Spec: tenancyv1alpha1.WorkspaceTypeSpec{MaxStorageBytes: &limit}}, nil
+		case "root:universal":
+			return &tenancyv1alpha1.WorkspaceType{}, nil
+		}
+		return nil, apierrors.NewNotFound(tenancyv1alpha1.Resource("workspacetypes"), name)
+	}
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        int64
+	}{
+		{
+			name: "no limit",
+		},
+		{
+			name:        "annotation",
+			annotations: map[string]string{corev1alpha1.LogicalClusterMaxStorageBytesAnnotationKey: "1Gi"},
+			want:        1 << 30,
+		},
+		{
+			name:        "WorkspaceType",
+			annotations: map[string]string{tenancyv1alpha1.LogicalClusterTypeAnnotationKey: "root:limited"},
+			want:        1 << 20,
+		},
+		{
+			name:        "WorkspaceType without limit",
+			annotations: map[string]string{tenancyv1alpha1.LogicalClusterTypeAnnotationKey: "root:universal"},
+		},
+		{
+			name:        "unknown WorkspaceType",
+			annotations: map[string]string{tenancyv1alpha1.LogicalClusterTypeAnnotationKey: "root:unknown"},
+		},
+		{
+			name: "annotation takes precedence",
+			annotations: map[string]string{
+				tenancyv1alpha1.LogicalClusterTypeAnnotationKey:         "root:limited",
+				corev1alpha1.LogicalClusterMaxStorageBytesAnnotationKey: "0",
+			},
+		},
+		{
+			name: "invalid annotation falls back to the WorkspaceType",
+			annotations: map[string]string{
+				tenancyv1alpha1.LogicalClusterTypeAnnotationKey:         "root:limited",
+				corev1alpha1.LogicalClusterMaxStorageBytesAnnotationKey: "lots",
+			},
+			want: 1 << 20,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, StorageLimitFor(tt.annotations, getWorkspaceType))
+		})
+	}
+}
+
 func TestRegistryConcurrentInc(t *testing.T) {
 	t.Parallel()
 
@@ -25,11 +25,14 @@ import (
 	clientv3 "go.etcd.io/etcd/client/v3"
 
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 
 	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
 	corev1alpha1listers "github.com/kcp-dev/sdk/client/listers/core/v1alpha1"
 
 	kcpetcd "github.com/kcp-dev/kcp/pkg/etcd"
@@ -37,14 +40,20 @@ import (
 )
 
 // Scanner periodically counts all objects per logical cluster on this shard
-// by scanning etcd keys and feeds the results into a Registry.
+// by scanning etcd keys and feeds the results into a Registry. Objects of
+// logical clusters with per-resource limits are also counted per resource.
+// While any storage limit is in effect, the values are scanned as well to sum
+// up the bytes per logical cluster.
 type Scanner struct {
-	kv       clientv3.KV
-	prefix   string
-	interval time.Duration
-	registry *Registry
-	lcLister corev1alpha1listers.LogicalClusterClusterLister
-	shard    string
+	kv               clientv3.KV
+	prefix           string
+	interval         time.Duration
+	registry         *Registry
+	lcLister         corev1alpha1listers.LogicalClusterClusterLister
+	limitsLister     corev1alpha1listers.LogicalClusterLimitsClusterLister
+	getWorkspaceType func(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error)
+	countResources   func(lc *corev1alpha1.LogicalCluster) bool
+	shard            string
 
 	// published tracks the logical clusters currently exposed as metrics so
 	// their label sets can be deleted when they drop below the threshold.
@@ -52,26 +61,35 @@ type Scanner struct {
 }
 
 // NewScanner creates a Scanner. prefix is the etcd storage prefix of this
-// shard.
+// shard. getWorkspaceType resolves the WorkspaceTypes of the logical clusters
+// for their storage limit. countResources returns whether the objects of a
+// logical cluster without per-resource limits should still be counted per
+// resource, e.g. for a WorkspaceQuota above it; it may be nil.
 func NewScanner(
 	kv clientv3.KV,
 	prefix string,
 	interval time.Duration,
 	registry *Registry,
 	lcLister corev1alpha1listers.LogicalClusterClusterLister,
+	limitsLister corev1alpha1listers.LogicalClusterLimitsClusterLister,
+	getWorkspaceType func(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error),
+	countResources func(lc *corev1alpha1.LogicalCluster) bool,
 	shardName string,
 ) *Scanner {
 	if !strings.HasSuffix(prefix, "/") {
 		prefix += "/"
 	}
 	return &Scanner{
-		kv:        kv,
-		prefix:    prefix,
-		interval:  interval,
-		registry:  registry,
-		lcLister:  lcLister,
-		shard:     shardName,
-		published: sets.New[logicalcluster.Name](),
+		kv:               kv,
+		prefix:           prefix,
+		interval:         interval,
+		registry:         registry,
+		lcLister:         lcLister,
+		limitsLister:     limitsLister,
+		getWorkspaceType: getWorkspaceType,
+		countResources:   countResources,
+		shard:            shardName,
+		published:        sets.New[logicalcluster.Name](),
 	}
 }
 
@@ -90,12 +108,25 @@ func (s *Scanner) tick(ctx context.Context) {
 		logger.Error(err, "failed to list logical clusters, skipping object count scan")
 		return
 	}
+	allLimits, err := s.limitsLister.List(labels.Everything())
+	if err != nil {
+		logger.Error(err, "failed to list logical cluster limits, skipping object count scan")
+		return
+	}
+	limitsByCluster := make(map[logicalcluster.Name]*corev1alpha1.LogicalClusterLimits, len(allLimits))
+	for _, l := range allLimits {
+		if l.Name == corev1alpha1.LogicalClusterLimitsName {
+			limitsByCluster[logicalcluster.From(l)] = l
+		}
+	}
 
 	// Limits per logical cluster and the set of known cluster names, used both
 	// for enforcement gating and for disambiguating etcd keys.
 	limits := make(map[logicalcluster.Name]int64, len(lcs))
 	clusterNames := sets.New[string]()
+	resourceLimited := sets.New[logicalcluster.Name]()
 	anyLimited := false
+	storageLimited := false
 	for _, lc := range lcs {
 		name := logicalcluster.From(lc)
 		clusterNames.Insert(string(name))
@@ -104,11 +135,22 @@ func (s *Scanner) tick(ctx context.Context) {
 		if limit > 0 {
 			anyLimited = true
 		}
+		if len(ResourceLimitsFor(lc.Annotations, limitsByCluster[name])) > 0 {
+			resourceLimited.Insert(name)
+			anyLimited = true
+		} else if s.countResources != nil && s.countResources(lc) {
+			// Counted for reporting only, nothing to enforce on this shard.
+			resourceLimited.Insert(name)
+		}
+		if StorageLimitFor(lc.Annotations, s.getWorkspaceType) > 0 {
+			storageLimited = true
+		}
 	}
 
 	active := s.registry.DefaultLimit() > 0 || anyLimited
 	s.registry.SetEnforcementActive(active)
-	if !active {
+	s.registry.SetStorageEnforcementActive(storageLimited)
+	if !active && !storageLimited && resourceLimited.Len() == 0 && !s.registry.UsageTracked() {
 		// Feature unused on this shard: skip the etcd scan and retract all
 		// published metrics.
 		for cluster := range s.published {
@@ -118,39 +160,52 @@ func (s *Scanner) tick(ctx context.Context) {
 		return
 	}
 
-	counts, err := s.scanOnce(ctx, clusterNames)
+	counts, resourceCounts, bytes, err := s.scanOnce(ctx, clusterNames, resourceLimited, storageLimited)
 	if err != nil {
 		logger.Error(err, "failed to scan etcd for object counts, keeping previous counts")
 		return
 	}
 
+	s.registry.ReplaceBytesBase(bytes)
+	s.registry.ReplaceResourceBase(resourceCounts)
 	s.registry.ReplaceBase(counts)
 	s.publishMetrics(counts, limits)
 }
 
-// scanOnce performs one keys-only paginated pass over the whole storage
-// prefix and buckets object counts per logical cluster.
-func (s *Scanner) scanOnce(ctx context.Context, clusterNames sets.Set[string]) (map[logicalcluster.Name]int64, error) {
+// scanOnce performs one paginated pass over the whole storage prefix and
+// buckets object counts per logical cluster, and per resource for the logical
+// clusters in resourceLimited. The pass is keys-only unless sumBytes is set,
+// in which case the value sizes are summed per logical cluster. The returned
+// bytes are nil otherwise.
+func (s *Scanner) scanOnce(ctx context.Context, clusterNames sets.Set[string], resourceLimited sets.Set[logicalcluster.Name], sumBytes bool) (map[logicalcluster.Name]int64, map[logicalcluster.Name]map[schema.GroupResource]int64, map[logicalcluster.Name]int64, error) {
 	isCluster := func(segment string) bool {
 		return strings.HasPrefix(segment, "system:") || clusterNames.Has(segment)
 	}
 
 	counts := map[logicalcluster.Name]int64{}
+	resourceCounts := make(map[logicalcluster.Name]map[schema.GroupResource]int64, len(resourceLimited))
+	for cluster := range resourceLimited {
+		resourceCounts[cluster] = map[schema.GroupResource]int64{}
+	}
+	var bytes map[logicalcluster.Name]int64
+	opts := []clientv3.OpOption{clientv3.WithRange(clientv3.GetPrefixRangeEnd(s.prefix))}
+	if sumBytes {
+		bytes = map[logicalcluster.Name]int64{}
+		opts = append(opts, clientv3.WithLimit(kcpetcd.ScanValuesPageSize))
+	} else {
+		opts = append(opts, clientv3.WithKeysOnly(), clientv3.WithLimit(kcpetcd.ScanPageSize))
+	}
 
 	key := s.prefix
 	for {
-		resp, err := s.kv.Get(ctx, key,
-			clientv3.WithRange(clientv3.GetPrefixRangeEnd(s.prefix)),
-			clientv3.WithKeysOnly(),
-			clientv3.WithLimit(kcpetcd.ScanPageSize),
-		)
+		resp, err := s.kv.Get(ctx, key, opts...)
 		if err != nil {
-			return nil, fmt.Errorf("failed to list etcd keys: %w", err)
+			return nil, nil, nil, fmt.Errorf("failed to list etcd keys: %w", err)
 		}
 
 		for _, kv := range resp.Kvs {
 			if err := ctx.Err(); err != nil {
-				return nil, err
+				return nil, nil, nil, err
 			}
 
 			key := string(kv.Key)
@@ -166,15 +221,32 @@ func (s *Scanner) scanOnce(ctx context.Context, clusterNames sets.Set[string]) (
 				continue
 			}
 			counts[cluster]++
+			if bytes != nil {
+				bytes[cluster] += int64(len(kv.Value))
+			}
+			if perResource, ok := resourceCounts[cluster]; ok {
+				perResource[resourceOf(s.prefix, key)]++
+			}
 		}
 
 		if !resp.More {
-			return counts, nil
+			return counts, resourceCounts, bytes, nil
 		}
 		key = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
 	}
 }
 
+// resourceOf returns the resource of a storage key below prefix, whose first
+// segments are always <group>/<resource>. The core group is stored as "core".
+func resourceOf(prefix, key string) schema.GroupResource {
+	group, rest, _ := strings.Cut(strings.TrimPrefix(key, prefix), "/")
+	resource, _, _ := strings.Cut(rest, "/")
+	if group == "core" {
+		group = ""
+	}
+	return schema.GroupResource{Group: group, Resource: resource}
+}
+
 // publishMetrics publishes count/limit gauges for logical clusters at or
 // above 90% of their limit and retracts gauges for all others.
 func (s *Scanner) publishMetrics(counts map[logicalcluster.Name]int64, limits map[logicalcluster.Name]int64) {
@@ -28,12 +28,16 @@ import (
 	"go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/kcp-dev/logicalcluster/v3"
 	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
 	corev1alpha1listers "github.com/kcp-dev/sdk/client/listers/core/v1alpha1"
 
 	kcpetcd "github.com/kcp-dev/kcp/pkg/etcd"
@@ -62,14 +66,23 @@ func TestScannerScanOnce(t *testing.T) {
 
 	s := newTestScanner(kv, NewRegistry(0))
 
-	counts, err := s.scanOnce(context.Background(), sets.New("root:ws", "root:other"))
+	counts, resourceCounts, bytes, err := s.scanOnce(context.Background(), sets.New("root:ws", "root:other"), sets.New[logicalcluster.Name]("root:ws"), false)
 	require.NoError(t, err)
+	require.Nil(t, bytes, "keys-only scans must not sum object sizes")
 
 	require.Equal(t, map[logicalcluster.Name]int64{
 		"root:ws":      5,
 		"root:other":   1,
 		"system:admin": 1,
 	}, counts)
+	require.Equal(t, map[logicalcluster.Name]map[schema.GroupResource]int64{
+		"root:ws": {
+			{Resource: "configmaps"}: 2,
+			{Group: "rbac.authorization.k8s.io", Resource: "clusterroles"}: 1,
+			{Group: "mygroup.io", Resource: "widgets"}:                     1,
+			{Group: "mygroup.io", Resource: "things"}:                      1,
+		},
+	}, resourceCounts, "only logical clusters with per-resource limits are counted per resource")
 }
 
 func TestScannerScanOncePaginates(t *testing.T) {
@@ -85,9 +98,34 @@ func TestScannerScanOncePaginates(t *testing.T) {
 
 	s := newTestScanner(kv, NewRegistry(0))
 
-	counts, err := s.scanOnce(context.Background(), sets.New("root:ws"))
+	counts, _, _, err := s.scanOnce(context.Background(), sets.New("root:ws"), nil, false)
+	require.NoError(t, err)
+	require.Equal(t, map[logicalcluster.Name]int64{"root:ws": int64(total)}, counts)
+
+	counts, _, bytes, err := s.scanOnce(context.Background(), sets.New("root:ws"), nil, true)
 	require.NoError(t, err)
 	require.Equal(t, map[logicalcluster.Name]int64{"root:ws": int64(total)}, counts)
+	require.Equal(t, map[logicalcluster.Name]int64{"root:ws": int64(total)}, bytes)
+}
+
+func TestScannerScanOnceSumsBytes(t *testing.T) {
+	t.Parallel()
+
+	kv := newFakeKV(map[string]string{
+		"/registry/core/configmaps/root:ws/default/cm1":                   "12345",
+		"/registry/mygroup.io/widgets/customresources/root:ws/default/w1": "1234567890",
+		// events must not be counted
+		"/registry/core/events/root:ws/default/ev1": "1234567890",
+		// other clusters
+		"/registry/core/secrets/root:other/default/s": "123",
+	})
+
+	s := newTestScanner(kv, NewRegistry(0))
+
+	counts, _, bytes, err := s.scanOnce(context.Background(), sets.New("root:ws", "root:other"), nil, true)
+	require.NoError(t, err)
+	require.Equal(t, map[logicalcluster.Name]int64{"root:ws": 2, "root:other": 1}, counts)
+	require.Equal(t, map[logicalcluster.Name]int64{"root:ws": 15, "root:other": 3}, bytes)
 }
 
 func TestScannerTickGatesOnConfiguration(t *testing.T) {
@@ -98,11 +136,17 @@ func TestScannerTickGatesOnConfiguration(t *testing.T) {
 	})
 
 	tests := []struct {
-		name         string
-		defaultLimit int64
-		annotations  map[string]string
-		wantActive   bool
-		wantCount    int64
+		name              string
+		defaultLimit      int64
+		annotations       map[string]string
+		limits            *corev1alpha1.LogicalClusterLimits
+		trackUsage        bool
+		countResources    bool
+		wantActive        bool
+		wantStorageActive bool
+		wantCount         int64
+		wantResourceCount int64
+		wantBytes         int64
 	}{
 		{
 			name:         "disabled without default limit and annotations",
@@ -130,6 +174,61 @@ func TestScannerTickGatesOnConfiguration(t *testing.T) {
 			wantActive:   false,
 			wantCount:    0,
 		},
+		{
+			name:              "enabled via per-resource annotation",
+			defaultLimit:      0,
+			annotations:       map[string]string{corev1alpha1.LogicalClusterMaxObjectsPerResourceAnnotationKey: "configmaps=10"},
+			wantActive:        true,
+			wantCount:         1,
+			wantResourceCount: 1,
+		},
+		{
+			name:         "enabled via LogicalClusterLimits",
+			defaultLimit: 0,
+			limits: &corev1alpha1.LogicalClusterLimits{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        corev1alpha1.LogicalClusterLimitsName,
+					Annotations: map[string]string{logicalcluster.AnnotationKey: "root:ws"},
+				},
+				Spec: corev1alpha1.LogicalClusterLimitsSpec{
+					ObjectCounts: []corev1alpha1.ResourceObjectCountLimit{{Resource: "secrets", Max: 10}},
+				},
+			},
+			wantActive:        true,
+			wantCount:         1,
+			wantResourceCount: 1,
+		},
+		{
+			name:              "enabled via storage annotation",
+			defaultLimit:      0,
+			annotations:       map[string]string{corev1alpha1.LogicalClusterMaxStorageBytesAnnotationKey: "1Mi"},
+			wantStorageActive: true,
+			wantCount:         1,
+			wantBytes:         1,
+		},
+		{
+			name:              "enabled via WorkspaceType",
+			defaultLimit:      0,
+			annotations:       map[string]string{tenancyv1alpha1.LogicalClusterTypeAnnotationKey: "root:limited"},
+			wantStorageActive: true,
+			wantCount:         1,
+			wantBytes:         1,
+		},
+		{
+			name:              "counted per resource without enforcing",
+			defaultLimit:      0,
+			countResources:    true,
+			wantActive:        false,
+			wantCount:         1,
+			wantResourceCount: 1,
+		},
+		{
+			name:         "usage tracking scans without enforcing",
+			defaultLimit: 0,
+			trackUsage:   true,
+			wantActive:   false,
+			wantCount:    1,
+		},
 	}
 
 	for _, tt := range tests {
@@ -137,21 +236,47 @@ func TestScannerTickGatesOnConfiguration(t *testing.T) {
 			t.Parallel()
 
 			registry := NewRegistry(tt.defaultLimit)
+			if tt.trackUsage {
+				registry.TrackUsage()
+			}
 			s := newTestScanner(kv, registry)
 			s.lcLister = fakeLogicalClusterClusterLister{
 				newLogicalCluster("root:ws", tt.annotations),
 			}
+			if tt.limits != nil {
+				s.limitsLister = fakeLogicalClusterLimitsClusterLister{tt.limits}
+			}
+			if tt.countResources {
+				s.countResources = func(*corev1alpha1.LogicalCluster) bool { return true }
+			}
 
 			s.tick(context.Background())
 
 			require.Equal(t, tt.wantActive, registry.EnforcementActive())
+			require.Equal(t, tt.wantStorageActive, registry.StorageEnforcementActive())
 			require.Equal(t, tt.wantCount, registry.Count(logicalcluster.Name("root:ws")))
+			count, scanned := registry.ResourceCount(logicalcluster.Name("root:ws"), schema.GroupResource{Resource: "configmaps"})
+			require.Equal(t, tt.wantResourceCount, count)
+			require.Equal(t, tt.wantResourceCount > 0, scanned)
+			bytes, scanned := registry.Bytes(logicalcluster.Name("root:ws"))
+			require.Equal(t, tt.wantBytes, bytes)
+			require.Equal(t, tt.wantStorageActive, scanned)
 		})
 	}
 }
 
 func newTestScanner(kv clientv3.KV, registry *Registry) *Scanner {
-	return NewScanner(kv, "/registry", time.Minute, registry, fakeLogicalClusterClusterLister{}, "root")
+	return NewScanner(kv, "/registry", time.Minute, registry, fakeLogicalClusterClusterLister{}, fakeLogicalClusterLimitsClusterLister{}, getWorkspaceType, nil, "root")
+}
+
+// getWorkspaceType knows a single WorkspaceType root:limited with a storage
+// limit.
+func getWorkspaceType(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error) {
+	if path.Join(name).String() != "root:limited" {
+		return nil, apierrors.NewNotFound(tenancyv1alpha1.Resource("workspacetypes"), name)
+	}
+	limit := resource.MustParse("1Mi")
+	return &tenancyv1alpha1.WorkspaceType{Spec: tenancyv1alpha1.WorkspaceTypeSpec{MaxStorageBytes: &limit}}, nil
 }
 
 func newLogicalCluster(cluster logicalcluster.Name, annotations map[string]string) *corev1alpha1.LogicalCluster {
@@ -175,6 +300,16 @@ func (l fakeLogicalClusterClusterLister) Cluster(cluster logicalcluster.Name) co
 	panic("not implemented")
 }
 
+type fakeLogicalClusterLimitsClusterLister []*corev1alpha1.LogicalClusterLimits
+
+func (l fakeLogicalClusterLimitsClusterLister) List(_ labels.Selector) ([]*corev1alpha1.LogicalClusterLimits, error) {
+	return l, nil
+}
+
+func (l fakeLogicalClusterLimitsClusterLister) Cluster(cluster logicalcluster.Name) corev1alpha1listers.LogicalClusterLimitsLister {
+	panic("not implemented")
+}
+
 // fakeKV implements clientv3.KV for range reads with pagination, mirroring the
 // harness used by the logicalclustermigration data cleanup tests.
 type fakeKV struct {
@@ -115,3 +115,14 @@ func NewHandler(ctx context.Context, mappings []types.PathMapping) (http.Handler
 
 	return &handlers, nil
 }
+
+// newRouteBackend returns a function creating the handlers proxying requests
+// to the backends of FrontProxyRoutes.
+func newRouteBackend(transport http.RoundTripper) func(u *url.URL) http.Handler {
+	return func(u *url.URL) http.Handler {
+		proxy := httputil.NewSingleHostReverseProxy(u)
+		proxy.Transport = transport
+		proxy.ErrorHandler = metrics.NewProxyErrorHandler()
+		return WithProxyAuthHeaders(proxy, "X-Remote-User", "X-Remote-Group", "X-Remote-Extra-")
+	}
+}
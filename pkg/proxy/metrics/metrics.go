@@ -23,6 +23,7 @@ import (
 	"io"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
@@ -43,6 +44,13 @@ const (
 	TLSErrorTypeOther            = "other"
 )
 
+// Rate limit result constants.
+const (
+	RateLimitResultAdmitted = "admitted"
+	RateLimitResultQueued   = "queued"
+	RateLimitResultRejected = "rejected"
+)
+
 // WithLatencyTracking tracks the number of seconds it took the wrapped handler
 // to complete.
 func WithLatencyTracking(delegate http.Handler) http.Handler {
@@ -88,6 +96,24 @@ var (
 		},
 		[]string{"shard"},
 	)
+
+	rateLimitRequests = compbasemetrics.NewCounterVec(
+		&compbasemetrics.CounterOpts{
+			Name:           "kcp_front_proxy_rate_limit_requests_total",
+			Help:           "Total requests subject to a FrontProxyRateLimit by limit, key type and result.",
+			StabilityLevel: compbasemetrics.ALPHA,
+		},
+		[]string{"limit", "key", "result"},
+	)
+
+	rateLimitQueueWait = compbasemetrics.NewHistogram(
+		&compbasemetrics.HistogramOpts{
+			Name:           "kcp_front_proxy_rate_limit_queue_wait_seconds",
+			Help:           "Time in seconds requests were queued by FrontProxyRateLimits before being forwarded.",
+			Buckets:        []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+			StabilityLevel: compbasemetrics.ALPHA,
+		},
+	)
 )
 
 var registerMetrics sync.Once
@@ -99,6 +125,8 @@ func Register() {
 		legacyregistry.MustRegister(tlsConnectionErrors)
 		legacyregistry.MustRegister(backendRequestBodyBytes)
 		legacyregistry.MustRegister(backendResponseBodyBytes)
+		legacyregistry.MustRegister(rateLimitRequests)
+		legacyregistry.MustRegister(rateLimitQueueWait)
 	})
 }
 
@@ -235,3 +263,13 @@ func WithBodyTracking(delegate http.Handler) http.Handler {
 		}
 	})
 }
+
+// RecordRateLimitResult counts a request subject to the given FrontProxyRateLimit.
+func RecordRateLimitResult(limit, key, result string) {
+	rateLimitRequests.WithLabelValues(limit, key, result).Inc()
+}
+
+// ObserveRateLimitQueueWait records the time a request was queued by rate limits.
+func ObserveRateLimitQueueWait(wait time.Duration) {
+	rateLimitQueueWait.Observe(wait.Seconds())
+}
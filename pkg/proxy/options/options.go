@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 
 	apiserveroptions "k8s.io/apiserver/pkg/server/options"
 	cliflag "k8s.io/component-base/cli/flag"
@@ -29,6 +30,9 @@ type Options struct {
 	SecureServing         apiserveroptions.SecureServingOptionsWithLoopback
 	Authentication        Authentication
 	MappingFile           string
+	RouteBackendServerCA  string
+	RouteProxyClientCert  string
+	RouteProxyClientKey   string
 	RootDirectory         string
 	RootKubeconfig        string
 	ShardsKubeconfig      string
@@ -57,6 +61,9 @@ func (o *Options) AddFlags(fss *cliflag.NamedFlagSets) {
 
 	fs := fss.FlagSet("proxy")
 	fs.StringVar(&o.MappingFile, "mapping-file", o.MappingFile, "Config file mapping paths to backends")
+	fs.StringVar(&o.RouteBackendServerCA, "route-backend-server-ca", o.RouteBackendServerCA, "CA file to verify the backends of FrontProxyRoutes. Together with --route-proxy-client-cert and --route-proxy-client-key, enables routing by the FrontProxyRoutes in the root workspace.")
+	fs.StringVar(&o.RouteProxyClientCert, "route-proxy-client-cert", o.RouteProxyClientCert, "Client certificate file presented to the backends of FrontProxyRoutes.")
+	fs.StringVar(&o.RouteProxyClientKey, "route-proxy-client-key", o.RouteProxyClientKey, "Client key file presented to the backends of FrontProxyRoutes.")
 	fs.StringVar(&o.RootDirectory, "root-directory", o.RootDirectory, "Root directory.")
 	fs.StringVar(&o.RootKubeconfig, "root-kubeconfig", o.RootKubeconfig, "The path to the kubeconfig of the root shard.")
 	fs.StringVar(&o.ShardsKubeconfig, "shards-kubeconfig", o.ShardsKubeconfig, "The path to the kubeconfig used for communication with all shards. The server name if provided is replaced with a shard's hostname.")
@@ -89,6 +96,9 @@ func (o *Options) Validate() []error {
 	if o.MappingFile == "" {
 		errs = append(errs, fmt.Errorf("--mapping-file is required"))
 	}
+	if routeFlags := []string{o.RouteBackendServerCA, o.RouteProxyClientCert, o.RouteProxyClientKey}; slices.Contains(routeFlags, "") && slices.ContainsFunc(routeFlags, func(s string) bool { return s != "" }) {
+		errs = append(errs, fmt.Errorf("--route-backend-server-ca, --route-proxy-client-cert and --route-proxy-client-key must be given together"))
+	}
 	if len(o.ShardsKubeconfig) == 0 {
 		errs = append(errs, fmt.Errorf("--shards-kubeconfig is required"))
 	}
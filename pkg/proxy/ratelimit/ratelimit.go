@@ -0,0 +1,283 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"slices"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilcache "k8s.io/apimachinery/pkg/util/cache"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	corev1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/core/v1alpha1"
+
+	"github.com/kcp-dev/kcp/pkg/proxy/lookup"
+	"github.com/kcp-dev/kcp/pkg/proxy/metrics"
+)
+
+// maxBucketsPerLimit bounds the memory used by a single limit. Once exceeded,
+// the least recently used buckets are dropped, i.e. their keys start over
+// with a full bucket.
+const maxBucketsPerLimit = 10000
+
+// Limiter enforces the FrontProxyRateLimits in the root workspace. The limits
+// are rebuilt whenever a FrontProxyRateLimit changes. The buckets of unchanged
+// limits are kept.
+type Limiter struct {
+	listLimits func() ([]*corev1alpha1.FrontProxyRateLimit, error)
+
+	lock   sync.Mutex
+	limits atomic.Pointer[[]*limit]
+}
+
+// NewLimiter returns a Limiter for the FrontProxyRateLimits of the given informer.
+func NewLimiter(ctx context.Context, rateLimitInformer corev1alpha1informers.FrontProxyRateLimitInformer) *Limiter {
+	l := &Limiter{
+		listLimits: func() ([]*corev1alpha1.FrontProxyRateLimit, error) {
+			return rateLimitInformer.Lister().List(labels.Everything())
+		},
+	}
+	l.limits.Store(&[]*limit{})
+
+	logger := klog.FromContext(ctx).WithValues("component", "front-proxy-rate-limits")
+	_, _ = rateLimitInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { l.sync(logger) },
+		UpdateFunc: func(_, obj interface{}) { l.sync(logger) },
+		DeleteFunc: func(obj interface{}) { l.sync(logger) },
+	})
+
+	return l
+}
+
+// sync rebuilds the limits from all FrontProxyRateLimits.
+func (l *Limiter) sync(logger klog.Logger) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	rateLimits, err := l.listLimits()
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	limits := compile(rateLimits, *l.limits.Load())
+	l.limits.Store(&limits)
+	logger.V(2).Info("updated rate limits", "count", len(limits))
+}
+
+// WithRateLimiting passes requests on to delegate once they are within all
+// rate limits they match. Requests exceeding a limit wait for their turn as
+// long as the limit allows, and are rejected with 429 otherwise.
+//
+// It must run after authentication and after the logical cluster of the
+// request has been resolved.
+func WithRateLimiting(delegate http.Handler, limiter *Limiter) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+		now := time.Now()
+
+		wait, ok := limiter.admit(req, now)
+		if !ok {
+			retryAfter := int(math.Ceil(wait.Seconds()))
+			klog.FromContext(ctx).V(4).Info("rejecting rate limited request", "path", req.URL.Path, "retryAfter", retryAfter)
+			responsewriters.ErrorNegotiated(
+				apierrors.NewTooManyRequests("the front-proxy rate limit has been exceeded, please try again later", max(retryAfter, 1)),
+				kubernetesscheme.Codecs, schema.GroupVersion{}, w, req,
+			)
+			return
+		}
+
+		if wait > 0 {
+			metrics.ObserveRateLimitQueueWait(wait)
+			timer := time.NewTimer(wait)
+			defer timer.Stop()
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				// The client is gone, the reserved tokens are lost.
+				return
+			}
+		}
+
+		delegate.ServeHTTP(w, req)
+	})
+}
+
+// admit reserves a token in the bucket of every limit req matches. It returns
+// how long the request has to wait until all tokens are available. If a limit
+// does not allow to wait that long, no tokens are reserved, and it returns
+// false and the wait required by that limit.
+func (l *Limiter) admit(req *http.Request, now time.Time) (time.Duration, bool) {
+	type reservation struct {
+		limit *limit
+		*rate.Reservation
+	}
+
+	var reservations []reservation
+	var wait time.Duration
+	for _, lim := range *l.limits.Load() {
+		key, ok := lim.keyFor(req)
+		if !ok {
+			continue
+		}
+		r := lim.bucket(key).ReserveN(now, 1)
+		delay := r.DelayFrom(now)
+		if !r.OK() || delay > lim.maxQueueWait {
+			r.CancelAt(now)
+			for _, other := range reservations {
+				other.CancelAt(now)
+			}
+			metrics.RecordRateLimitResult(lim.name, string(lim.key), metrics.RateLimitResultRejected)
+			return delay, false
+		}
+		reservations = append(reservations, reservation{limit: lim, Reservation: r})
+		wait = max(wait, delay)
+	}
+
+	for _, r := range reservations {
+		result := metrics.RateLimitResultAdmitted
+		if r.DelayFrom(now) > 0 {
+			result = metrics.RateLimitResultQueued
+		}
+		metrics.RecordRateLimitResult(r.limit.name, string(r.limit.key), result)
+	}
+	return wait, true
+}
+
+type limit struct {
+	name       string
+	generation int64
+
+	key            corev1alpha1.FrontProxyRateLimitKey
+	qps            rate.Limit
+	burst          int
+	maxQueueWait   time.Duration
+	workspaceTypes sets.Set[string]
+	exemptGroups   sets.Set[string]
+
+	// idleTimeout is the time after which an unused bucket is full again,
+	// including the tokens reserved by queued requests, i.e. it can be
+	// dropped without loosening the limit.
+	idleTimeout time.Duration
+
+	lock    sync.Mutex
+	buckets *utilcache.LRUExpireCache
+}
+
+// compile turns FrontProxyRateLimits into limits ordered by name. Limits found
+// unchanged in previous are reused, keeping their buckets.
+func compile(rateLimits []*corev1alpha1.FrontProxyRateLimit, previous []*limit) []*limit {
+	limits := make([]*limit, 0, len(rateLimits))
+	for _, rl := range rateLimits {
+		if i := slices.IndexFunc(previous, func(l *limit) bool { return l.name == rl.Name && l.generation == rl.Generation }); i >= 0 {
+			limits = append(limits, previous[i])
+			continue
+		}
+
+		qps := max(rl.Spec.QPS, 1)
+		burst := rl.Spec.Burst
+		if burst <= 0 {
+			burst = qps
+		}
+		lim := &limit{
+			name:       rl.Name,
+			generation: rl.Generation,
+			key:        rl.Spec.Key,
+			qps:        rate.Limit(qps),
+			burst:      int(burst),
+			buckets:    utilcache.NewLRUExpireCache(maxBucketsPerLimit),
+		}
+		if rl.Spec.MaxQueueWait != nil {
+			lim.maxQueueWait = rl.Spec.MaxQueueWait.Duration
+		}
+		lim.idleTimeout = time.Duration(math.Ceil(float64(burst)/float64(qps)))*time.Second + lim.maxQueueWait
+		if len(rl.Spec.WorkspaceTypes) > 0 {
+			lim.workspaceTypes = sets.New(rl.Spec.WorkspaceTypes...)
+		}
+		if len(rl.Spec.ExemptGroups) > 0 {
+			lim.exemptGroups = sets.New(rl.Spec.ExemptGroups...)
+		}
+		limits = append(limits, lim)
+	}
+
+	sort.Slice(limits, func(i, j int) bool {
+		return limits[i].name < limits[j].name
+	})
+	return limits
+}
+
+// keyFor returns the key req is counted by, or false if the limit does not
+// apply to req.
+func (lim *limit) keyFor(req *http.Request) (string, bool) {
+	ctx := req.Context()
+
+	u, authenticated := request.UserFrom(ctx)
+	if authenticated && lim.exemptGroups != nil && slices.ContainsFunc(u.GetGroups(), lim.exemptGroups.Has) {
+		return "", false
+	}
+
+	workspaceType := lookup.WorkspaceTypeFrom(ctx)
+	if lim.workspaceTypes != nil && !lim.workspaceTypes.Has(workspaceType.String()) {
+		return "", false
+	}
+
+	switch lim.key {
+	case corev1alpha1.FrontProxyRateLimitKeyUser:
+		if !authenticated {
+			return user.Anonymous, true
+		}
+		return u.GetName(), true
+	case corev1alpha1.FrontProxyRateLimitKeyLogicalCluster:
+		clusterName := lookup.ClusterNameFrom(ctx)
+		return clusterName.String(), clusterName != ""
+	case corev1alpha1.FrontProxyRateLimitKeyWorkspaceType:
+		return workspaceType.String(), !workspaceType.Empty()
+	default:
+		return "", false
+	}
+}
+
+// bucket returns the token bucket of key, creating it if needed.
+func (lim *limit) bucket(key string) *rate.Limiter {
+	lim.lock.Lock()
+	defer lim.lock.Unlock()
+
+	b, ok := lim.buckets.Get(key)
+	if !ok {
+		b = rate.NewLimiter(lim.qps, lim.burst)
+	}
+	lim.buckets.Add(key, b, lim.idleTimeout)
+	return b.(*rate.Limiter)
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+
+	"github.com/kcp-dev/kcp/pkg/proxy/lookup"
+)
+
+func TestKeyFor(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		spec          corev1alpha1.FrontProxyRateLimitSpec
+		user          *user.DefaultInfo
+		cluster       logicalcluster.Name
+		workspaceType logicalcluster.Path
+
+		wantKey string
+		wantOK  bool
+	}{
+		"user": {
+			spec:    corev1alpha1.FrontProxyRateLimitSpec{Key: corev1alpha1.FrontProxyRateLimitKeyUser},
+			user:    &user.DefaultInfo{Name: "alice"},
+			wantKey: "alice",
+			wantOK:  true,
+		},
+		"unauthenticated user": {
+			spec:    corev1alpha1.FrontProxyRateLimitSpec{Key: corev1alpha1.FrontProxyRateLimitKeyUser},
+			wantKey: user.Anonymous,
+			wantOK:  true,
+		},
+		"exempt group": {
+			spec: corev1alpha1.FrontProxyRateLimitSpec{Key: corev1alpha1.FrontProxyRateLimitKeyUser, ExemptGroups: []string{"system:masters"}},
+			user: &user.DefaultInfo{Name: "admin", Groups: []string{"system:authenticated", "system:masters"}},
+		},
+		"logical cluster": {
+			spec:    corev1alpha1.FrontProxyRateLimitSpec{Key: corev1alpha1.FrontProxyRateLimitKeyLogicalCluster},
+			cluster: "2x7k9",
+			wantKey: "2x7k9",
+			wantOK:  true,
+		},
+		"logical cluster not resolved": {
+			spec: corev1alpha1.FrontProxyRateLimitSpec{Key: corev1alpha1.FrontProxyRateLimitKeyLogicalCluster},
+		},
+		"workspace type": {
+			spec:          corev1alpha1.FrontProxyRateLimitSpec{Key: corev1alpha1.FrontProxyRateLimitKeyWorkspaceType},
+			cluster:       "2x7k9",
+			workspaceType: logicalcluster.NewPath("root:universal"),
+			wantKey:       "root:universal",
+			wantOK:        true,
+		},
+		"mounted workspace without type": {
+			spec:    corev1alpha1.FrontProxyRateLimitSpec{Key: corev1alpha1.FrontProxyRateLimitKeyWorkspaceType},
+			cluster: "2x7k9",
+		},
+		"selected workspace type": {
+			spec:          corev1alpha1.FrontProxyRateLimitSpec{Key: corev1alpha1.FrontProxyRateLimitKeyLogicalCluster, WorkspaceTypes: []string{"root:team"}},
+			cluster:       "2x7k9",
+			workspaceType: logicalcluster.NewPath("root:team"),
+			wantKey:       "2x7k9",
+			wantOK:        true,
+		},
+		"other workspace type": {
+			spec:          corev1alpha1.FrontProxyRateLimitSpec{Key: corev1alpha1.FrontProxyRateLimitKeyLogicalCluster, WorkspaceTypes: []string{"root:team"}},
+			cluster:       "2x7k9",
+			workspaceType: logicalcluster.NewPath("root:universal"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			limits := compile([]*corev1alpha1.FrontProxyRateLimit{rateLimit("limit", tc.spec)}, nil)
+			require.Len(t, limits, 1)
+
+			key, ok := limits[0].keyFor(newRequest(tc.user, tc.cluster, tc.workspaceType))
+			require.Equal(t, tc.wantOK, ok)
+			require.Equal(t, tc.wantKey, key)
+		})
+	}
+}
+
+func TestAdmit(t *testing.T) {
+	t.Parallel()
+
+	limiter := &Limiter{}
+	limits := compile([]*corev1alpha1.FrontProxyRateLimit{
+		rateLimit("per-user", corev1alpha1.FrontProxyRateLimitSpec{
+			Key:          corev1alpha1.FrontProxyRateLimitKeyUser,
+			QPS:          1,
+			Burst:        2,
+			MaxQueueWait: &metav1.Duration{Duration: time.Second},
+		}),
+		rateLimit("per-cluster", corev1alpha1.FrontProxyRateLimitSpec{
+			Key: corev1alpha1.FrontProxyRateLimitKeyLogicalCluster,
+			QPS: 1,
+		}),
+	}, nil)
+	limiter.limits.Store(&limits)
+
+	now := time.Now()
+	alice := &user.DefaultInfo{Name: "alice"}
+	bob := &user.DefaultInfo{Name: "bob"}
+
+	wait, ok := limiter.admit(newRequest(alice, "", logicalcluster.None), now)
+	require.True(t, ok)
+	require.Zero(t, wait)
+
+	wait, ok = limiter.admit(newRequest(alice, "", logicalcluster.None), now)
+	require.True(t, ok, "second request is within the burst")
+	require.Zero(t, wait)
+
+	wait, ok = limiter.admit(newRequest(alice, "", logicalcluster.None), now)
+	require.True(t, ok, "third request is queued")
+	require.Equal(t, time.Second, wait)
+
+	wait, ok = limiter.admit(newRequest(alice, "", logicalcluster.None), now)
+	require.False(t, ok, "fourth request would wait longer than allowed")
+	require.Equal(t, 2*time.Second, wait)
+
+	wait, ok = limiter.admit(newRequest(bob, "2x7k9", logicalcluster.None), now)
+	require.True(t, ok, "other users have their own bucket")
+	require.Zero(t, wait)
+
+	_, ok = limiter.admit(newRequest(bob, "2x7k9", logicalcluster.None), now)
+	require.False(t, ok, "the cluster limit does not allow queuing")
+
+	wait, ok = limiter.admit(newRequest(bob, "", logicalcluster.None), now)
+	require.True(t, ok, "the rejected request must not have consumed a token of the user limit")
+	require.Zero(t, wait)
+
+	wait, ok = limiter.admit(newRequest(alice, "", logicalcluster.None), now.Add(3*time.Second))
+	require.True(t, ok, "the bucket refills over time")
+	require.Zero(t, wait)
+}
+
+func TestCompileKeepsUnchangedLimits(t *testing.T) {
+	t.Parallel()
+
+	spec := corev1alpha1.FrontProxyRateLimitSpec{Key: corev1alpha1.FrontProxyRateLimitKeyUser, QPS: 10}
+	first := compile([]*corev1alpha1.FrontProxyRateLimit{rateLimit("a", spec), rateLimit("b", spec)}, nil)
+
+	changed := rateLimit("b", spec)
+	changed.Generation = 2
+	second := compile([]*corev1alpha1.FrontProxyRateLimit{changed, rateLimit("a", spec)}, first)
+
+	require.Len(t, second, 2)
+	require.Same(t, first[0], second[0])
+	require.NotSame(t, first[1], second[1])
+	require.Equal(t, 10, second[1].burst, "burst defaults to qps")
+}
+
+func TestWithRateLimiting(t *testing.T) {
+	t.Parallel()
+
+	limiter := &Limiter{}
+	limits := compile([]*corev1alpha1.FrontProxyRateLimit{
+		rateLimit("per-cluster", corev1alpha1.FrontProxyRateLimitSpec{
+			Key: corev1alpha1.FrontProxyRateLimitKeyLogicalCluster,
+			QPS: 1,
+		}),
+		rateLimit("per-user", corev1alpha1.FrontProxyRateLimitSpec{
+			Key:          corev1alpha1.FrontProxyRateLimitKeyUser,
+			QPS:          20,
+			Burst:        1,
+			MaxQueueWait: &metav1.Duration{Duration: time.Second},
+		}),
+	}, nil)
+	limiter.limits.Store(&limits)
+
+	var served int
+	handler := WithRateLimiting(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		served++
+		w.WriteHeader(http.StatusOK)
+	}), limiter)
+
+	alice := &user.DefaultInfo{Name: "alice"}
+	start := time.Now()
+	for range 2 {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newRequest(alice, "", logicalcluster.None))
+		require.Equal(t, http.StatusOK, rec.Code)
+	}
+	require.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond, "the second request must have been queued")
+	require.Equal(t, 2, served)
+
+	req := newRequest(alice, "", logicalcluster.None)
+	ctx, cancel := context.WithCancel(req.Context())
+	cancel()
+	handler.ServeHTTP(httptest.NewRecorder(), req.WithContext(ctx))
+	require.Equal(t, 2, served, "requests of gone clients must not be served after queuing")
+
+	bob := &user.DefaultInfo{Name: "bob"}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newRequest(bob, "2x7k9", logicalcluster.None))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, newRequest(bob, "2x7k9", logicalcluster.None))
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "1", rec.Header().Get("Retry-After"))
+	require.Equal(t, 3, served)
+}
+
+func newRequest(u *user.DefaultInfo, cluster logicalcluster.Name, workspaceType logicalcluster.Path) *http.Request {
+	ctx := context.Background()
+	if u != nil {
+		ctx = request.WithUser(ctx, u)
+	}
+	if cluster != "" {
+		ctx = lookup.WithClusterName(ctx, cluster)
+		ctx = lookup.WithWorkspaceType(ctx, workspaceType)
+	}
+	return httptest.NewRequestWithContext(ctx, http.MethodGet, "/clusters/root/api", nil)
+}
+
+func rateLimit(name string, spec corev1alpha1.FrontProxyRateLimitSpec) *corev1alpha1.FrontProxyRateLimit {
+	return &corev1alpha1.FrontProxyRateLimit{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Generation: 1},
+		Spec:       spec,
+	}
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routes
+
+import (
+	"context"
+	mathrand "math/rand"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	corev1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/core/v1alpha1"
+)
+
+// Router routes requests according to the FrontProxyRoutes in the root
+// workspace. The routing table is rebuilt whenever a route changes.
+type Router struct {
+	listRoutes func() ([]*corev1alpha1.FrontProxyRoute, error)
+	newBackend func(u *url.URL) http.Handler
+	intn       func(n int) int
+
+	lock   sync.Mutex
+	routes atomic.Pointer[[]route]
+}
+
+// NewRouter returns a Router for the FrontProxyRoutes of the given informer.
+// newBackend returns the handler proxying requests to a backend URL.
+func NewRouter(ctx context.Context, routeInformer corev1alpha1informers.FrontProxyRouteInformer, newBackend func(u *url.URL) http.Handler) *Router {
+	r := &Router{
+		listRoutes: func() ([]*corev1alpha1.FrontProxyRoute, error) {
+			return routeInformer.Lister().List(labels.Everything())
+		},
+		newBackend: newBackend,
+		intn:       mathrand.Intn,
+	}
+	r.routes.Store(&[]route{})
+
+	logger := klog.FromContext(ctx).WithValues("component", "front-proxy-routes")
+	_, _ = routeInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { r.sync(logger) },
+		UpdateFunc: func(_, obj interface{}) { r.sync(logger) },
+		DeleteFunc: func(obj interface{}) { r.sync(logger) },
+	})
+
+	return r
+}
+
+// sync rebuilds the routing table from all FrontProxyRoutes.
+func (r *Router) sync(logger klog.Logger) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	frontProxyRoutes, err := r.listRoutes()
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	routes := compile(logger, frontProxyRoutes, r.newBackend)
+	r.routes.Store(&routes)
+	logger.V(2).Info("updated routes", "count", len(routes))
+}
+
+// Route returns the backend handler for req, or nil if no route matches.
+func (r *Router) Route(req *http.Request) http.Handler {
+	for _, rt := range *r.routes.Load() {
+		if rt.matches(req) {
+			return rt.pick(r.intn)
+		}
+	}
+	return nil
+}
+
+// WithRoutes serves the requests matching a FrontProxyRoute by one of its
+// backends, and passes all others on to delegate.
+func WithRoutes(delegate http.Handler, router *Router) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if backend := router.Route(req); backend != nil {
+			backend.ServeHTTP(w, req)
+			return
+		}
+		delegate.ServeHTTP(w, req)
+	})
+}
+
+type route struct {
+	name       string
+	priority   int32
+	pathPrefix string
+	headers    []corev1alpha1.FrontProxyRouteHeaderMatch
+	groups     sets.Set[string]
+
+	backends    []backend
+	totalWeight int
+}
+
+type backend struct {
+	weight  int
+	handler http.Handler
+}
+
+// compile turns FrontProxyRoutes into routes ordered by precedence. Routes
+// without any backend in rotation are skipped, as are backends with an
+// invalid URL.
+func compile(logger klog.Logger, frontProxyRoutes []*corev1alpha1.FrontProxyRoute, newBackend func(u *url.URL) http.Handler) []route {
+	routes := make([]route, 0, len(frontProxyRoutes))
+	for _, fpr := range frontProxyRoutes {
+		rt := route{
+			name:       fpr.Name,
+			priority:   fpr.Spec.Priority,
+			pathPrefix: fpr.Spec.Match.PathPrefix,
+			headers:    fpr.Spec.Match.Headers,
+		}
+		if len(fpr.Spec.Match.Groups) > 0 {
+			rt.groups = sets.New(fpr.Spec.Match.Groups...)
+		}
+		for _, b := range fpr.Spec.Backends {
+			weight := 1
+			if b.Weight != nil {
+				weight = int(*b.Weight)
+			}
+			if weight <= 0 {
+				continue
+			}
+			u, err := url.Parse(b.URL)
+			if err != nil || u.Scheme == "" || u.Host == "" {
+				logger.Info("skipping backend with invalid URL", "route", fpr.Name, "url", b.URL, "err", err)
+				continue
+			}
+			rt.backends = append(rt.backends, backend{weight: weight, handler: newBackend(u)})
+			rt.totalWeight += weight
+		}
+		if rt.totalWeight == 0 {
+			logger.V(2).Info("skipping route without backends in rotation", "route", fpr.Name)
+			continue
+		}
+		routes = append(routes, rt)
+	}
+
+	sort.SliceStable(routes, func(i, j int) bool {
+		if routes[i].priority != routes[j].priority {
+			return routes[i].priority > routes[j].priority
+		}
+		if len(routes[i].pathPrefix) != len(routes[j].pathPrefix) {
+			return len(routes[i].pathPrefix) > len(routes[j].pathPrefix)
+		}
+		return routes[i].name < routes[j].name
+	})
+	return routes
+}
+
+// matches returns true if req matches the path prefix, the headers and the
+// groups of the route.
+func (rt *route) matches(req *http.Request) bool {
+	path := req.URL.Path
+	if !strings.HasPrefix(path, rt.pathPrefix) && !(strings.HasSuffix(rt.pathPrefix, "/") && path == strings.TrimSuffix(rt.pathPrefix, "/")) {
+		return false
+	}
+
+	for _, h := range rt.headers {
+		values := req.Header.Values(h.Name)
+		if len(values) == 0 {
+			return false
+		}
+		if h.Value != "" && !slices.Contains(values, h.Value) {
+			return false
+		}
+	}
+
+	if rt.groups != nil {
+		user, ok := request.UserFrom(req.Context())
+		if !ok || !slices.ContainsFunc(user.GetGroups(), rt.groups.Has) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// pick returns the handler of one of the backends, chosen at random in
+// proportion to their weight.
+func (rt *route) pick(intn func(n int) int) http.Handler {
+	n := intn(rt.totalWeight)
+	for _, b := range rt.backends {
+		if n < b.weight {
+			return b.handler
+		}
+		n -= b.weight
+	}
+	return rt.backends[len(rt.backends)-1].handler
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routes
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+)
+
+func TestRoute(t *testing.T) {
+	t.Parallel()
+
+	routes := []*corev1alpha1.FrontProxyRoute{
+		frontProxyRoute("services", 0, corev1alpha1.FrontProxyRouteMatch{PathPrefix: "/services/"}, "https://services"),
+		frontProxyRoute("widgets", 0, corev1alpha1.FrontProxyRouteMatch{PathPrefix: "/services/widgets/"}, "https://widgets"),
+		frontProxyRoute("widgets-canary", 10, corev1alpha1.FrontProxyRouteMatch{
+			PathPrefix: "/services/widgets/",
+			Headers:    []corev1alpha1.FrontProxyRouteHeaderMatch{{Name: "X-Canary", Value: "true"}},
+		}, "https://widgets-canary"),
+		frontProxyRoute("widgets-beta", 5, corev1alpha1.FrontProxyRouteMatch{
+			PathPrefix: "/services/widgets/",
+			Groups:     []string{"beta-testers"},
+		}, "https://widgets-beta"),
+		frontProxyRoute("debug", 0, corev1alpha1.FrontProxyRouteMatch{
+			PathPrefix: "/debug/",
+			Headers:    []corev1alpha1.FrontProxyRouteHeaderMatch{{Name: "X-Debug"}},
+		}, "https://debug"),
+		frontProxyRoute("broken", 100, corev1alpha1.FrontProxyRouteMatch{PathPrefix: "/"}, "not a url"),
+	}
+
+	tests := map[string]struct {
+		path    string
+		headers map[string]string
+		groups  []string
+		want    string
+	}{
+		"no route matches": {
+			path: "/clusters/root/api",
+		},
+		"path prefix": {
+			path: "/services/other/clusters/root",
+			want: "https://services",
+		},
+		"path without the trailing slash of the prefix": {
+			path: "/services",
+			want: "https://services",
+		},
+		"longest path prefix wins": {
+			path: "/services/widgets/clusters/root",
+			want: "https://widgets",
+		},
+		"header match": {
+			path:    "/services/widgets/clusters/root",
+			headers: map[string]string{"x-canary": "true"},
+			want:    "https://widgets-canary",
+		},
+		"header value mismatch": {
+			path:    "/services/widgets/clusters/root",
+			headers: map[string]string{"X-Canary": "false"},
+			want:    "https://widgets",
+		},
+		"header presence": {
+			path:    "/debug/pprof",
+			headers: map[string]string{"X-Debug": "1"},
+			want:    "https://debug",
+		},
+		"header missing": {
+			path: "/debug/pprof",
+		},
+		"group match": {
+			path:   "/services/widgets/clusters/root",
+			groups: []string{"system:authenticated", "beta-testers"},
+			want:   "https://widgets-beta",
+		},
+		"higher priority wins over group match": {
+			path:    "/services/widgets/clusters/root",
+			headers: map[string]string{"X-Canary": "true"},
+			groups:  []string{"beta-testers"},
+			want:    "https://widgets-canary",
+		},
+	}
+
+	router := &Router{
+		newBackend: func(u *url.URL) http.Handler { return backendName(u.String()) },
+		intn:       func(n int) int { return 0 },
+	}
+	compiled := compile(klog.Background(), routes, router.newBackend)
+	router.routes.Store(&compiled)
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			for k, v := range tc.headers {
+				req.Header.Set(k, v)
+			}
+			if tc.groups != nil {
+				req = req.WithContext(request.WithUser(req.Context(), &user.DefaultInfo{Name: "user", Groups: tc.groups}))
+			}
+
+			handler := router.Route(req)
+			if tc.want == "" {
+				require.Nil(t, handler)
+				return
+			}
+			require.Equal(t, backendName(tc.want), handler)
+		})
+	}
+}
+
+func TestPickWeighted(t *testing.T) {
+	t.Parallel()
+
+	fpr := frontProxyRoute("weighted", 0, corev1alpha1.FrontProxyRouteMatch{PathPrefix: "/"}, "https://a", "https://b", "https://c")
+	fpr.Spec.Backends[0].Weight = ptr.To[int32](1)
+	fpr.Spec.Backends[1].Weight = ptr.To[int32](0)
+	fpr.Spec.Backends[2].Weight = ptr.To[int32](3)
+
+	routes := compile(klog.Background(), []*corev1alpha1.FrontProxyRoute{fpr}, func(u *url.URL) http.Handler { return backendName(u.String()) })
+	require.Len(t, routes, 1)
+	require.Equal(t, 4, routes[0].totalWeight)
+
+	picked := map[http.Handler]int{}
+	for n := range routes[0].totalWeight {
+		picked[routes[0].pick(func(int) int { return n })]++
+	}
+	require.Equal(t, map[http.Handler]int{backendName("https://a"): 1, backendName("https://c"): 3}, picked)
+}
+
+func TestCompileSkipsRoutesWithoutBackends(t *testing.T) {
+	t.Parallel()
+
+	fpr := frontProxyRoute("drained", 0, corev1alpha1.FrontProxyRouteMatch{PathPrefix: "/"}, "https://a")
+	fpr.Spec.Backends[0].Weight = ptr.To[int32](0)
+
+	routes := compile(klog.Background(), []*corev1alpha1.FrontProxyRoute{fpr}, func(u *url.URL) http.Handler { return backendName(u.String()) })
+	require.Empty(t, routes)
+}
+
+func TestWithRoutes(t *testing.T) {
+	t.Parallel()
+
+	router := &Router{intn: func(n int) int { return 0 }}
+	compiled := compile(klog.Background(), []*corev1alpha1.FrontProxyRoute{
+		frontProxyRoute("services", 0, corev1alpha1.FrontProxyRouteMatch{PathPrefix: "/services/"}, "https://services"),
+	}, func(u *url.URL) http.Handler { return backendName(u.String()) })
+	router.routes.Store(&compiled)
+
+	handler := WithRoutes(backendName("static"), router)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/services/widgets", nil))
+	require.Equal(t, "https://services", rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/clusters/root", nil))
+	require.Equal(t, "static", rec.Body.String())
+}
+
+// backendName is a backend handler responding with its name.
+type backendName string
+
+func (b backendName) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	_, _ = w.Write([]byte(b))
+}
+
+func frontProxyRoute(name string, priority int32, match corev1alpha1.FrontProxyRouteMatch, backendURLs ...string) *corev1alpha1.FrontProxyRoute {
+	fpr := &corev1alpha1.FrontProxyRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: corev1alpha1.FrontProxyRouteSpec{
+			Match:    match,
+			Priority: priority,
+		},
+	}
+	for _, u := range backendURLs {
+		fpr.Spec.Backends = append(fpr.Spec.Backends, corev1alpha1.FrontProxyRouteBackend{URL: u})
+	}
+	return fpr
+}
@@ -51,6 +51,8 @@ import (
 	"github.com/kcp-dev/kcp/pkg/proxy/index"
 	"github.com/kcp-dev/kcp/pkg/proxy/lookup"
 	"github.com/kcp-dev/kcp/pkg/proxy/metrics"
+	"github.com/kcp-dev/kcp/pkg/proxy/ratelimit"
+	"github.com/kcp-dev/kcp/pkg/proxy/routes"
 	kcpfilters "github.com/kcp-dev/kcp/pkg/server/filters"
 	"github.com/kcp-dev/kcp/pkg/server/requestinfo"
 
@@ -110,6 +112,23 @@ func NewServer(ctx context.Context, c CompletedConfig) (*Server, error) {
 		return s, err
 	}
 
+	// FrontProxyRoutes take precedence over the static mappings. They are
+	// matched after authentication, such that routes can select user groups.
+	if c.Options.RouteProxyClientCert != "" {
+		transport, err := newTransport(c.Options.RouteProxyClientCert, c.Options.RouteProxyClientKey, c.Options.RouteBackendServerCA)
+		if err != nil {
+			return s, fmt.Errorf("failed to create transport for FrontProxyRoutes: %w", err)
+		}
+		router := routes.NewRouter(ctx, s.KcpSharedInformerFactory.Core().V1alpha1().FrontProxyRoutes(), newRouteBackend(transport))
+		handler = routes.WithRoutes(handler, router)
+	}
+
+	// FrontProxyRateLimits are enforced after authentication and after the
+	// logical cluster has been resolved, such that requests can be limited
+	// per user, logical cluster and workspace type.
+	limiter := ratelimit.NewLimiter(ctx, s.KcpSharedInformerFactory.Core().V1alpha1().FrontProxyRateLimits())
+	handler = ratelimit.WithRateLimiting(handler, limiter)
+
 	// The optional auth handler will call the underlying authenticator only if
 	// auth methods are configured directly on the front-proxy *or* if there is
 	// a custom workspace authenticator, i.e. the AdditionalAuthEnabled field
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apibinding
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha1 "github.com/kcp-dev/sdk/apis/apis/v1alpha1"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+)
+
+// pinResources replaces the schemas of the given resources by those pinned in the
+// APIBinding. It returns the resources to bind, and per pinned group resource the
+// schema that the APIExport offers instead.
+func pinResources(
+	getAPIResourceSchema func(clusterName logicalcluster.Name, name string) (*apisv1alpha1.APIResourceSchema, error),
+	apiExport *apisv1alpha2.APIExport,
+	apiBinding *apisv1alpha2.APIBinding,
+	resources []apisv1alpha2.ResourceSchema,
+) ([]apisv1alpha2.ResourceSchema, map[schema.GroupResource]*apisv1alpha1.APIResourceSchema, error) {
+	if len(apiBinding.Spec.PinnedSchemas) == 0 {
+		return resources, nil, nil
+	}
+
+	exportClusterName := logicalcluster.From(apiExport)
+	pinned := make([]apisv1alpha2.ResourceSchema, 0, len(resources))
+	available := map[schema.GroupResource]*apisv1alpha1.APIResourceSchema{}
+	for _, res := range resources {
+		i := slices.IndexFunc(apiBinding.Spec.PinnedSchemas, func(pin apisv1alpha2.PinnedSchema) bool {
+			return pin.Group == res.Group && pin.Resource == res.Name
+		})
+		if i < 0 {
+			pinned = append(pinned, res)
+			continue
+		}
+
+		sch, err := resolvePinnedSchema(getAPIResourceSchema, apiExport, apiBinding, res, apiBinding.Spec.PinnedSchemas[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		if sch.Name != res.Schema {
+			if offered, err := getAPIResourceSchema(exportClusterName, res.Schema); err == nil {
+				available[schema.GroupResource{Group: res.Group, Resource: res.Name}] = offered
+			}
+			res.Schema = sch.Name
+		}
+		pinned = append(pinned, res)
+	}
+
+	return pinned, available, nil
+}
+
+// resolvePinnedSchema returns the APIResourceSchema the given pin refers to. Pins are
+// resolved against the schemas offered by the APIExport, the previous schemas of a
+// staged rollout and the one currently bound, so that a binding cannot pick any other
+// schema of the workspace of the APIExport.
+func resolvePinnedSchema(
+	getAPIResourceSchema func(clusterName logicalcluster.Name, name string) (*apisv1alpha1.APIResourceSchema, error),
+	apiExport *apisv1alpha2.APIExport,
+	apiBinding *apisv1alpha2.APIBinding,
+	res apisv1alpha2.ResourceSchema,
+	pin apisv1alpha2.PinnedSchema,
+) (*apisv1alpha1.APIResourceSchema, error) {
+	exportClusterName := logicalcluster.From(apiExport)
+
+	candidates := []string{res.Schema}
+	if apiExport.Spec.Rollout != nil {
+		for _, prev := range apiExport.Spec.Rollout.PreviousResources {
+			if prev.Group == res.Group && prev.Name == res.Name {
+				candidates = append(candidates, prev.Schema)
+			}
+		}
+	}
+	for _, br := range apiBinding.Status.BoundResources {
+		if br.Group == res.Group && br.Resource == res.Name {
+			candidates = append(candidates, br.Schema.Name)
+		}
+	}
+
+	name := pin.Schema
+	if name == "" {
+		for _, candidate := range candidates {
+			sch, err := getAPIResourceSchema(exportClusterName, candidate)
+			if err == nil && string(sch.UID) == pin.SchemaUID {
+				name = candidate
+				break
+			}
+		}
+		if name == "" {
+			return nil, fmt.Errorf("no APIResourceSchema with UID %q found for pinned resource %s", pin.SchemaUID, pinnedResourceString(pin))
+		}
+	} else if !slices.Contains(candidates, name) {
+		return nil, fmt.Errorf("APIResourceSchema %s|%s pinned for resource %s is neither offered by the APIExport nor bound", exportClusterName, name, pinnedResourceString(pin))
+	}
+
+	sch, err := getAPIResourceSchema(exportClusterName, name)
+	if err != nil {
+		return nil, fmt.Errorf("APIResourceSchema %s|%s pinned for resource %s: %w", exportClusterName, name, pinnedResourceString(pin), err)
+	}
+	if pin.SchemaUID != "" && string(sch.UID) != pin.SchemaUID {
+		return nil, fmt.Errorf("APIResourceSchema %s|%s pinned for resource %s has UID %q, not %q", exportClusterName, name, pinnedResourceString(pin), sch.UID, pin.SchemaUID)
+	}
+	if sch.Spec.Group != res.Group || sch.Spec.Names.Plural != res.Name {
+		return nil, fmt.Errorf("APIResourceSchema %s|%s pinned for resource %s defines %s.%s instead", exportClusterName, name, pinnedResourceString(pin), sch.Spec.Names.Plural, sch.Spec.Group)
+	}
+
+	return sch, nil
+}
+
+func pinnedResourceString(pin apisv1alpha2.PinnedSchema) string {
+	return schema.GroupResource{Group: pin.Group, Resource: pin.Resource}.String()
+}
+
+// availableSchemasString lists the schemas available for pinned resources, sorted by
+// group resource.
+func availableSchemasString(available map[schema.GroupResource]*apisv1alpha1.APIResourceSchema) string {
+	items := make([]string, 0, len(available))
+	for gr, sch := range available {
+		items = append(items, fmt.Sprintf("%s (%s)", gr, sch.Name))
+	}
+	sort.Strings(items)
+	return strings.Join(items, ", ")
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apibinding
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha1 "github.com/kcp-dev/sdk/apis/apis/v1alpha1"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+)
+
+func TestPinResources(t *testing.T) {
+	t.Parallel()
+
+	newSchema := func(name, uid, plural string) *apisv1alpha1.APIResourceSchema {
+		return &apisv1alpha1.APIResourceSchema{
+			ObjectMeta: metav1.ObjectMeta{Name: name, UID: types.UID(uid)},
+			Spec: apisv1alpha1.APIResourceSchemaSpec{
+				Group: "kcp.io",
+				Names: apiextensionsv1.CustomResourceDefinitionNames{Plural: plural},
+			},
+		}
+	}
+	schemas := map[string]*apisv1alpha1.APIResourceSchema{
+		"today.widgets.kcp.io":    newSchema("today.widgets.kcp.io", "uid-today", "widgets"),
+		"tomorrow.widgets.kcp.io": newSchema("tomorrow.widgets.kcp.io", "uid-tomorrow", "widgets"),
+		"today.gadgets.kcp.io":    newSchema("today.gadgets.kcp.io", "uid-gadgets", "gadgets"),
+	}
+	getAPIResourceSchema := func(clusterName logicalcluster.Name, name string) (*apisv1alpha1.APIResourceSchema, error) {
+		if sch, ok := schemas[name]; ok {
+			return sch, nil
+		}
+		return nil, fmt.Errorf("APIResourceSchema %s|%s not found", clusterName, name)
+	}
+
+	widgets := schema.GroupResource{Group: "kcp.io", Resource: "widgets"}
+	offered := []apisv1alpha2.ResourceSchema{
+		{Group: "kcp.io", Name: "widgets", Schema: "tomorrow.widgets.kcp.io"},
+	}
+	pinnedToday := []apisv1alpha2.ResourceSchema{
+		{Group: "kcp.io", Name: "widgets", Schema: "today.widgets.kcp.io"},
+	}
+
+	tests := map[string]struct {
+		pins           []apisv1alpha2.PinnedSchema
+		boundResources []apisv1alpha2.BoundAPIResource
+		previous       []apisv1alpha2.ResourceSchema
+
+		wantResources []apisv1alpha2.ResourceSchema
+		wantAvailable []string
+		wantErr       bool
+	}{
+		"no pins": {
+			wantResources: offered,
+		},
+		"pinned to the offered schema": {
+			pins:          []apisv1alpha2.PinnedSchema{{Group: "kcp.io", Resource: "widgets", Schema: "tomorrow.widgets.kcp.io"}},
+			wantResources: offered,
+		},
+		"pinned by name to the bound schema": {
+			pins: []apisv1alpha2.PinnedSchema{{Group: "kcp.io", Resource: "widgets", Schema: "today.widgets.kcp.io"}},
+			boundResources: []apisv1alpha2.BoundAPIResource{
+				{Group: "kcp.io", Resource: "widgets", Schema: apisv1alpha2.BoundAPIResourceSchema{Name: "today.widgets.kcp.io", UID: "uid-today"}},
+			},
+			wantResources: pinnedToday,
+			wantAvailable: []string{"tomorrow.widgets.kcp.io"},
+		},
+		"pinned by name to a previous schema of a rollout": {
+			pins:          []apisv1alpha2.PinnedSchema{{Group: "kcp.io", Resource: "widgets", Schema: "today.widgets.kcp.io"}},
+			previous:      pinnedToday,
+			wantResources: pinnedToday,
+			wantAvailable: []string{"tomorrow.widgets.kcp.io"},
+		},
+		"pinned by name to a schema neither offered nor bound": {
+			pins:    []apisv1alpha2.PinnedSchema{{Group: "kcp.io", Resource: "widgets", Schema: "today.widgets.kcp.io"}},
+			wantErr: true,
+		},
+		"pinned by UID to the bound schema": {
+			pins: []apisv1alpha2.PinnedSchema{{Group: "kcp.io", Resource: "widgets", SchemaUID: "uid-today"}},
+			boundResources: []apisv1alpha2.BoundAPIResource{
+				{Group: "kcp.io", Resource: "widgets", Schema: apisv1alpha2.BoundAPIResourceSchema{Name: "today.widgets.kcp.io", UID: "uid-today"}},
+			},
+			wantResources: pinnedToday,
+			wantAvailable: []string{"tomorrow.widgets.kcp.io"},
+		},
+		"pinned by unknown UID": {
+			pins:    []apisv1alpha2.PinnedSchema{{Group: "kcp.io", Resource: "widgets", SchemaUID: "uid-yesterday"}},
+			wantErr: true,
+		},
+		"pinned by name with mismatching UID": {
+			pins: []apisv1alpha2.PinnedSchema{{Group: "kcp.io", Resource: "widgets", Schema: "today.widgets.kcp.io", SchemaUID: "uid-tomorrow"}},
+			boundResources: []apisv1alpha2.BoundAPIResource{
+				{Group: "kcp.io", Resource: "widgets", Schema: apisv1alpha2.BoundAPIResourceSchema{Name: "today.widgets.kcp.io", UID: "uid-today"}},
+			},
+			wantErr: true,
+		},
+		"pinned to a schema of another resource": {
+			pins:    []apisv1alpha2.PinnedSchema{{Group: "kcp.io", Resource: "widgets", Schema: "today.gadgets.kcp.io"}},
+			wantErr: true,
+		},
+		"pinned to a missing schema": {
+			pins:    []apisv1alpha2.PinnedSchema{{Group: "kcp.io", Resource: "widgets", Schema: "yesterday.widgets.kcp.io"}},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			export := &apisv1alpha2.APIExport{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "export",
+					Annotations: map[string]string{logicalcluster.AnnotationKey: "provider"},
+				},
+				Spec: apisv1alpha2.APIExportSpec{Resources: offered},
+			}
+			if tc.previous != nil {
+				export.Spec.Rollout = &apisv1alpha2.APIExportRollout{PreviousResources: tc.previous}
+			}
+			binding := &apisv1alpha2.APIBinding{
+				Spec:   apisv1alpha2.APIBindingSpec{PinnedSchemas: tc.pins},
+				Status: apisv1alpha2.APIBindingStatus{BoundResources: tc.boundResources},
+			}
+
+			resources, available, err := pinResources(getAPIResourceSchema, export, binding, offered)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantResources, resources)
+
+			var availableNames []string
+			if sch, ok := available[widgets]; ok {
+				availableNames = append(availableNames, sch.Name)
+			}
+			require.Equal(t, tc.wantAvailable, availableNames)
+		})
+	}
+}
@@ -165,6 +165,11 @@ func (r *bindingReconciler) reconcile(ctx context.Context, apiBinding *apisv1alp
 		apiExportPath = logicalcluster.From(apiBinding).Path()
 	}
 	apiExport, err := r.controller.getAPIExportByPath(apiExportPath, workspaceRef.Name)
+	if apierrors.IsNotFound(err) && apiBinding.Status.APIExportClusterName != "" {
+		// The workspace of the APIExport might have been moved. Keep following
+		// it by the logical cluster name, which does not change.
+		apiExport, err = r.controller.getAPIExportByPath(logicalcluster.NewPath(apiBinding.Status.APIExportClusterName), workspaceRef.Name)
+	}
 	if apierrors.IsNotFound(err) {
 		conditions.MarkFalse(
 			apiBinding,
@@ -213,10 +218,50 @@ func (r *bindingReconciler) reconcile(ctx context.Context, apiBinding *apisv1alp
 	// The full path is unreliable for this purpose.
 	apiBinding.Status.APIExportClusterName = logicalcluster.From(apiExport).String()
 
+	// Pick the resources according to the rollout of the APIExport.
+	resources, schemaRevision, err := RolloutResources(apiExport, apiBinding)
+	if err != nil {
+		conditions.MarkFalse(
+			apiBinding,
+			apisv1alpha2.APIExportValid,
+			apisv1alpha2.InternalErrorReason,
+			conditionsv1alpha1.ConditionSeverityError,
+			"Invalid APIExport. Please contact the APIExport owner to resolve: %v", err,
+		)
+		return reconcileStatusContinue, nil
+	}
+
+	// Keep pinned resources on their schemas.
+	resources, availableSchemas, err := pinResources(r.getAPIResourceSchema, apiExport, apiBinding, resources)
+	if err != nil {
+		conditions.MarkFalse(
+			apiBinding,
+			apisv1alpha2.BindingUpToDate,
+			apisv1alpha2.PinnedSchemaInvalidReason,
+			conditionsv1alpha1.ConditionSeverityError,
+			"Unable to bind APIs: %v",
+			err,
+		)
+
+		// Only change InitialBindingCompleted if it's false.
+		if conditions.IsFalse(apiBinding, apisv1alpha2.InitialBindingCompleted) {
+			conditions.MarkFalse(
+				apiBinding,
+				apisv1alpha2.InitialBindingCompleted,
+				apisv1alpha2.PinnedSchemaInvalidReason,
+				conditionsv1alpha1.ConditionSeverityError,
+				"Unable to bind APIs: %v",
+				err,
+			)
+		}
+		return reconcileStatusContinue, nil
+	}
+	schemaRevision = apisv1alpha2.SchemaRevision(resources)
+
 	// Collect the schemas.
 	schemas := make(map[string]*apisv1alpha1.APIResourceSchema)
 	grs := sets.New[schema.GroupResource]()
-	for _, resourceSchema := range apiExport.Spec.Resources {
+	for _, resourceSchema := range resources {
 		sch, err := r.getAPIResourceSchema(logicalcluster.From(apiExport), resourceSchema.Schema)
 		if err != nil {
 			logger.Error(err, "error binding")
@@ -332,7 +377,7 @@ func (r *bindingReconciler) reconcile(ctx context.Context, apiBinding *apisv1alp
 		return reconcileStatusContinue, err
 	}
 	var needToWaitForRequeueWhenEstablished []string
-	for _, resourceSchema := range apiExport.Spec.Resources {
+	for _, resourceSchema := range resources {
 		sch := schemas[resourceSchema.Schema]
 		logger := logging.WithObject(logger, sch)
 
@@ -537,6 +582,13 @@ func (r *bindingReconciler) reconcile(ctx context.Context, apiBinding *apisv1alp
 			},
 			StorageVersions: sortedStorageVersions,
 		}
+		if offered, ok := availableSchemas[schema.GroupResource{Group: resourceSchema.Group, Resource: resourceSchema.Name}]; ok {
+			newBoundResource.AvailableSchema = &apisv1alpha2.BoundAPIResourceSchema{
+				Name:         offered.Name,
+				UID:          string(offered.UID),
+				IdentityHash: apiExport.Status.IdentityHash,
+			}
+		}
 
 		found := false
 		for i, r := range apiBinding.Status.BoundResources {
@@ -597,8 +649,20 @@ func (r *bindingReconciler) reconcile(ctx context.Context, apiBinding *apisv1alp
 		}
 	} else {
 		conditions.MarkTrue(apiBinding, apisv1alpha2.InitialBindingCompleted)
-		conditions.MarkTrue(apiBinding, apisv1alpha2.BindingUpToDate)
+		if len(availableSchemas) > 0 {
+			conditions.MarkFalse(
+				apiBinding,
+				apisv1alpha2.BindingUpToDate,
+				apisv1alpha2.UpgradeAvailableReason,
+				conditionsv1alpha1.ConditionSeverityInfo,
+				"Newer schemas are available for pinned APIs: %s",
+				availableSchemasString(availableSchemas),
+			)
+		} else {
+			conditions.MarkTrue(apiBinding, apisv1alpha2.BindingUpToDate)
+		}
 		apiBinding.Status.Phase = apisv1alpha2.APIBindingPhaseBound
+		apiBinding.Status.SchemaRevision = schemaRevision
 	}
 
 	return reconcileStatusContinue, nil
@@ -202,6 +202,7 @@ func TestReconcileBinding(t *testing.T) {
 		// input objects
 		apiBinding                *apisv1alpha2.APIBinding
 		getAPIExportError         error
+		apiExportMoved            bool
 		getAPIResourceSchemaError error
 		existingAPIBindings       []*apisv1alpha2.APIBinding
 		logicalCluster            *corev1alpha1.LogicalCluster
@@ -248,6 +249,25 @@ func TestReconcileBinding(t *testing.T) {
 				notFound: true,
 			},
 		},
+		"APIExport moved, never bound": {
+			apiBinding:     binding.Build(),
+			apiExportMoved: true,
+			wantAPIExportValid: wantAPIExportValid{
+				notFound: true,
+			},
+		},
+		"APIExport moved, found by the cluster name in status": {
+			logicalCluster: withResourceBindings(newLogicalCluster(), ResourceBindingsAnnotation{}),
+			apiBinding: func() *apisv1alpha2.APIBinding {
+				b := binding.Build()
+				b.Status.APIExportClusterName = "org-some-workspace"
+				return b
+			}(),
+			apiExportMoved:                  true,
+			wantCreateCRD:                   true,
+			checkUpdateLogicalClusterCalled: true,
+			wantUpdateLogicalClusterCalled:  true,
+		},
 		"APIExport get error - random error": {
 			apiBinding:        binding.Build(),
 			getAPIExportError: errors.New("foo"),
@@ -681,6 +701,12 @@ func TestReconcileBinding(t *testing.T) {
 					return tc.existingAPIBindings, nil
 				},
 				getAPIExportByPath: func(path logicalcluster.Path, name string) (*apisv1alpha2.APIExport, error) {
+					if tc.apiExportMoved {
+						if path.String() != "org-some-workspace" {
+							return nil, apierrors.NewNotFound(apisv1alpha2.Resource("apiexports"), name)
+						}
+						return apiExports[name], nil
+					}
 					require.Equal(t, "org:some-workspace", path.String())
 					return apiExports[name], tc.getAPIExportError
 				},
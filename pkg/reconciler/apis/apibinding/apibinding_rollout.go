@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apibinding
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+)
+
+// RolloutResources returns the resources of the APIExport the APIBinding is to be
+// bound to, and their schema revision. Without a rollout, these are the resources
+// of the APIExport. During a rollout, only the APIBindings selected by it are bound to
+// them, all others stay on the previous resources.
+func RolloutResources(apiExport *apisv1alpha2.APIExport, apiBinding *apisv1alpha2.APIBinding) ([]apisv1alpha2.ResourceSchema, string, error) {
+	revision := apisv1alpha2.SchemaRevision(apiExport.Spec.Resources)
+
+	rollout := apiExport.Spec.Rollout
+	if rollout == nil {
+		return apiExport.Spec.Resources, revision, nil
+	}
+
+	if rollout.Halted {
+		if apiBinding.Status.SchemaRevision == revision {
+			return apiExport.Spec.Resources, revision, nil
+		}
+	} else {
+		selected, err := selectedForRollout(rollout, apiBinding)
+		if err != nil {
+			return nil, "", err
+		}
+		if selected {
+			return apiExport.Spec.Resources, revision, nil
+		}
+	}
+
+	return rollout.PreviousResources, apisv1alpha2.SchemaRevision(rollout.PreviousResources), nil
+}
+
+// selectedForRollout returns whether the APIBinding is selected by the rollout, either
+// by its labels or by the rollout percentage.
+func selectedForRollout(rollout *apisv1alpha2.APIExportRollout, apiBinding *apisv1alpha2.APIBinding) (bool, error) {
+	if rollout.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(rollout.Selector)
+		if err != nil {
+			return false, fmt.Errorf("invalid rollout selector: %w", err)
+		}
+		if !selector.Empty() && selector.Matches(labels.Set(apiBinding.Labels)) {
+			return true, nil
+		}
+	}
+
+	return rolloutBucket(apiBinding) < rollout.Percentage, nil
+}
+
+// rolloutBucket maps the APIBinding to a stable bucket in [0, 100).
+func rolloutBucket(apiBinding *apisv1alpha2.APIBinding) int32 {
+	hash := fnv.New32a()
+	hash.Write([]byte(logicalcluster.From(apiBinding).String() + "|" + apiBinding.Name))
+	return int32(hash.Sum32() % 100)
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apibinding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+)
+
+func TestRolloutResources(t *testing.T) {
+	t.Parallel()
+
+	current := []apisv1alpha2.ResourceSchema{
+		{Group: "kcp.io", Name: "widgets", Schema: "tomorrow.widgets.kcp.io"},
+	}
+	previous := []apisv1alpha2.ResourceSchema{
+		{Group: "kcp.io", Name: "widgets", Schema: "today.widgets.kcp.io"},
+	}
+	currentRevision := apisv1alpha2.SchemaRevision(current)
+	previousRevision := apisv1alpha2.SchemaRevision(previous)
+
+	tests := map[string]struct {
+		rollout  *apisv1alpha2.APIExportRollout
+		labels   map[string]string
+		revision string
+
+		wantResources []apisv1alpha2.ResourceSchema
+		wantRevision  string
+		wantErr       bool
+	}{
+		"no rollout": {
+			wantResources: current,
+			wantRevision:  currentRevision,
+		},
+		"rollout, not selected": {
+			rollout:       &apisv1alpha2.APIExportRollout{PreviousResources: previous},
+			wantResources: previous,
+			wantRevision:  previousRevision,
+		},
+		"rollout, selected by percentage": {
+			rollout:       &apisv1alpha2.APIExportRollout{PreviousResources: previous, Percentage: 100},
+			wantResources: current,
+			wantRevision:  currentRevision,
+		},
+		"rollout, selected by labels": {
+			rollout: &apisv1alpha2.APIExportRollout{
+				PreviousResources: previous,
+				Selector:          &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}},
+			},
+			labels:        map[string]string{"canary": "true"},
+			wantResources: current,
+			wantRevision:  currentRevision,
+		},
+		"rollout, empty selector selects nothing": {
+			rollout:       &apisv1alpha2.APIExportRollout{PreviousResources: previous, Selector: &metav1.LabelSelector{}},
+			labels:        map[string]string{"canary": "true"},
+			wantResources: previous,
+			wantRevision:  previousRevision,
+		},
+		"rollout, invalid selector": {
+			rollout: &apisv1alpha2.APIExportRollout{
+				PreviousResources: previous,
+				Selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "canary", Operator: "Invalid"},
+				}},
+			},
+			wantErr: true,
+		},
+		"halted rollout keeps rolled out binding": {
+			rollout:       &apisv1alpha2.APIExportRollout{PreviousResources: previous, Halted: true},
+			revision:      currentRevision,
+			wantResources: current,
+			wantRevision:  currentRevision,
+		},
+		"halted rollout keeps selected binding on previous resources": {
+			rollout:       &apisv1alpha2.APIExportRollout{PreviousResources: previous, Percentage: 100, Halted: true},
+			revision:      previousRevision,
+			wantResources: previous,
+			wantRevision:  previousRevision,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			apiExport := &apisv1alpha2.APIExport{
+				Spec: apisv1alpha2.APIExportSpec{
+					Resources: current,
+					Rollout:   tc.rollout,
+				},
+			}
+			apiBinding := &apisv1alpha2.APIBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "binding",
+					Labels:      tc.labels,
+					Annotations: map[string]string{logicalcluster.AnnotationKey: "org-ws"},
+				},
+				Status: apisv1alpha2.APIBindingStatus{
+					SchemaRevision: tc.revision,
+				},
+			}
+
+			resources, revision, err := RolloutResources(apiExport, apiBinding)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantResources, resources)
+			require.Equal(t, tc.wantRevision, revision)
+		})
+	}
+}
+
+func TestRolloutBucket(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		apiBinding := &apisv1alpha2.APIBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Annotations: map[string]string{logicalcluster.AnnotationKey: "org-ws"},
+			},
+		}
+		bucket := rolloutBucket(apiBinding)
+		require.GreaterOrEqual(t, bucket, int32(0))
+		require.Less(t, bucket, int32(100))
+		require.Equal(t, bucket, rolloutBucket(apiBinding.DeepCopy()), "bucket must be stable")
+	}
+}
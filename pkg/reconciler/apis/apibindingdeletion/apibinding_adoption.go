@@ -17,10 +17,14 @@ limitations under the License.
 package apibindingdeletion
 
 import (
+	"slices"
+
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/kcp-dev/logicalcluster/v3"
 	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+
+	"github.com/kcp-dev/kcp/pkg/reconciler/apis/apibinding"
 )
 
 // adoptedResources returns, per bound group/resource of the deleting
@@ -31,7 +35,8 @@ import (
 //
 // A binding is a successor for a bound resource only if its referenced
 // APIExport serves the same group/resource through the same APIResourceSchema
-// (by UID) with the same identity hash. That guarantees identical storage
+// (by UID) with the same identity hash, taking schemas pinned by the candidate
+// into account. That guarantees identical storage
 // (same etcd prefix) and identical served API (same bound CRD), so the
 // handover moves no data.
 //
@@ -83,10 +88,27 @@ func (c *Controller) successorFor(clusterName logicalcluster.Name, candidates []
 		if export.Status.IdentityHash != br.Schema.IdentityHash {
 			continue
 		}
-		for _, res := range export.Spec.Resources {
+		resources, _, err := apibinding.RolloutResources(export, b)
+		if err != nil {
+			continue
+		}
+		for _, res := range resources {
 			if res.Group != br.Group || res.Name != br.Resource {
 				continue
 			}
+			// A candidate pinned to a schema serves that one instead of the offered one.
+			if i := slices.IndexFunc(b.Spec.PinnedSchemas, func(pin apisv1alpha2.PinnedSchema) bool {
+				return pin.Group == res.Group && pin.Resource == res.Name
+			}); i >= 0 {
+				pin := b.Spec.PinnedSchemas[i]
+				if pin.Schema == "" {
+					if pin.SchemaUID == br.Schema.UID {
+						return b.Name, true
+					}
+					continue
+				}
+				res.Schema = pin.Schema
+			}
 			sch, err := c.getAPIResourceSchema(logicalcluster.From(export), res.Schema)
 			if err != nil {
 				continue
@@ -149,6 +149,36 @@ func TestAdoptedResources(t *testing.T) {
 				cowboySchemaName: {ObjectMeta: metav1.ObjectMeta{Name: cowboySchemaName, UID: types.UID("other-uid")}},
 			},
 		},
+		{
+			name: "successor pinned to the same schema while export offers another: adopted",
+			bindings: func() []*apisv1alpha2.APIBinding {
+				b := candidateBinding("cowboys", "cowboys")
+				b.Spec.PinnedSchemas = []apisv1alpha2.PinnedSchema{{Group: "wildwest.dev", Resource: "cowboys", Schema: cowboySchemaName}}
+				return []*apisv1alpha2.APIBinding{b}
+			}(),
+			exports: map[string]*apisv1alpha2.APIExport{
+				"cowboys": cowboysExport("cowboys", identity, "v2.cowboys.wildwest.dev"),
+			},
+			schemas: map[string]*apisv1alpha1.APIResourceSchema{
+				cowboySchemaName:          {ObjectMeta: metav1.ObjectMeta{Name: cowboySchemaName, UID: types.UID(cowboySchemaUID)}},
+				"v2.cowboys.wildwest.dev": {ObjectMeta: metav1.ObjectMeta{Name: "v2.cowboys.wildwest.dev", UID: types.UID("other-uid")}},
+			},
+			expectSuccessor: "cowboys",
+		},
+		{
+			name: "successor pinned by UID to another schema: not adopted",
+			bindings: func() []*apisv1alpha2.APIBinding {
+				b := candidateBinding("cowboys", "cowboys")
+				b.Spec.PinnedSchemas = []apisv1alpha2.PinnedSchema{{Group: "wildwest.dev", Resource: "cowboys", SchemaUID: "other-uid"}}
+				return []*apisv1alpha2.APIBinding{b}
+			}(),
+			exports: map[string]*apisv1alpha2.APIExport{
+				"cowboys": cowboysExport("cowboys", identity, cowboySchemaName),
+			},
+			schemas: map[string]*apisv1alpha1.APIResourceSchema{
+				cowboySchemaName: {ObjectMeta: metav1.ObjectMeta{Name: cowboySchemaName, UID: types.UID(cowboySchemaUID)}},
+			},
+		},
 		{
 			name: "successor being deleted itself: ignored",
 			bindings: func() []*apisv1alpha2.APIBinding {
@@ -56,6 +56,7 @@ func TestReconcile(t *testing.T) {
 		listShardsError                      error
 		apiExportEndpointSliceNotFound       bool
 		skipEndpointSliceAnnotation          bool
+		rollout                              bool
 
 		apiBindings []interface{}
 
@@ -69,6 +70,7 @@ func TestReconcile(t *testing.T) {
 		wantIdentityValid                bool
 		wantCreateAPIExportEndpointSlice bool
 		wantVirtualWorkspaceURLs         bool
+		wantPreviousSchemaRevision       bool
 	}{
 		"create secret when ref is nil and secret doesn't exist": {
 			secretExists: false,
@@ -158,6 +160,15 @@ func TestReconcile(t *testing.T) {
 			wantIdentityValid:        true,
 			wantVirtualWorkspaceURLs: true,
 		},
+		"schema revisions recorded during rollout": {
+			secretRefSet: true,
+			secretExists: true,
+			rollout:      true,
+
+			wantStatusHashSet:          true,
+			wantIdentityValid:          true,
+			wantPreviousSchemaRevision: true,
+		},
 		"error listing shards with feature gate enabled": {
 			secretRefSet: true,
 			secretExists: true,
@@ -284,6 +295,17 @@ func TestReconcile(t *testing.T) {
 				conditions.MarkFalse(apiExport, apisv1alpha2.APIExportIdentityValid, apisv1alpha2.IdentityVerificationFailedReason, conditionsv1alpha1.ConditionSeverityError, "")
 			}
 
+			apiExport.Spec.Resources = []apisv1alpha2.ResourceSchema{
+				{Group: "example.io", Name: "widgets", Schema: "v2.widgets.example.io", Storage: apisv1alpha2.ResourceSchemaStorage{CRD: &apisv1alpha2.ResourceSchemaStorageCRD{}}},
+			}
+			if tc.rollout {
+				apiExport.Spec.Rollout = &apisv1alpha2.APIExportRollout{
+					PreviousResources: []apisv1alpha2.ResourceSchema{
+						{Group: "example.io", Name: "widgets", Schema: "v1.widgets.example.io", Storage: apisv1alpha2.ResourceSchemaStorage{CRD: &apisv1alpha2.ResourceSchemaStorageCRD{}}},
+					},
+				}
+			}
+
 			if tc.skipEndpointSliceAnnotation {
 				apiExport.Annotations[apisv1alpha2.APIExportEndpointSliceSkipAnnotation] = "true"
 			}
@@ -307,6 +329,14 @@ func TestReconcile(t *testing.T) {
 				}
 			}
 
+			require.Equal(t, apisv1alpha2.SchemaRevision(apiExport.Spec.Resources), apiExport.Status.SchemaRevision)
+			if tc.wantPreviousSchemaRevision {
+				require.Equal(t, apisv1alpha2.SchemaRevision(apiExport.Spec.Rollout.PreviousResources), apiExport.Status.PreviousSchemaRevision)
+				require.NotEqual(t, apiExport.Status.SchemaRevision, apiExport.Status.PreviousSchemaRevision)
+			} else {
+				require.Empty(t, apiExport.Status.PreviousSchemaRevision)
+			}
+
 			if tc.wantStatusHashSet {
 				hashBytes := sha256.Sum256([]byte("abc"))
 				hash := fmt.Sprintf("%x", hashBytes)
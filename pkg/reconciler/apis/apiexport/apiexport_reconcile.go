@@ -51,6 +51,13 @@ func (c *controller) reconcile(ctx context.Context, apiExport *apisv1alpha2.APIE
 	clusterName := logicalcluster.From(apiExport)
 	clusterPath := apiExport.Annotations[core.LogicalClusterPathAnnotationKey]
 
+	// Record the schema revisions that APIBindings report in their status.
+	apiExport.Status.SchemaRevision = apisv1alpha2.SchemaRevision(apiExport.Spec.Resources)
+	apiExport.Status.PreviousSchemaRevision = ""
+	if apiExport.Spec.Rollout != nil {
+		apiExport.Status.PreviousSchemaRevision = apisv1alpha2.SchemaRevision(apiExport.Spec.Rollout.PreviousResources)
+	}
+
 	if identity.SecretRef == nil {
 		c.ensureSecretNamespaceExists(ctx, clusterName)
 
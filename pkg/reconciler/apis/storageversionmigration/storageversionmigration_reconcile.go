@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storageversionmigration
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"k8s.io/apiextensions-apiserver/pkg/apihelpers"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+	conditionsv1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+)
+
+// reconcile migrates the objects of one bound resource with outdated storage versions per call, and
+// prunes its storage versions afterwards. The resulting status update enqueues the APIBinding again
+// for the next bound resource, so that the StorageVersionsMigrated condition reports the progress.
+func (c *controller) reconcile(ctx context.Context, apiBinding *apisv1alpha2.APIBinding) error {
+	logger := klog.FromContext(ctx)
+	clusterName := logicalcluster.From(apiBinding)
+
+	var pending []int
+	storageVersions := map[int]string{}
+	for i, br := range apiBinding.Status.BoundResources {
+		version, ok, err := c.storageVersionToMigrateTo(br)
+		if err != nil {
+			return err
+		}
+		if ok {
+			pending = append(pending, i)
+			storageVersions[i] = version
+		}
+	}
+
+	if len(pending) == 0 {
+		// Only report the condition for bindings that needed a migration at some point.
+		if conditions.Has(apiBinding, apisv1alpha2.StorageVersionsMigrated) {
+			conditions.MarkTrue(apiBinding, apisv1alpha2.StorageVersionsMigrated)
+		}
+		return nil
+	}
+
+	br := &apiBinding.Status.BoundResources[pending[0]]
+	gvr := schema.GroupVersionResource{Group: br.Group, Version: storageVersions[pending[0]], Resource: br.Resource}
+	count, err := c.rewriteObjects(ctx, clusterName, gvr)
+	if err != nil {
+		conditions.MarkFalse(
+			apiBinding,
+			apisv1alpha2.StorageVersionsMigrated,
+			apisv1alpha2.StorageVersionMigrationFailedReason,
+			conditionsv1alpha1.ConditionSeverityWarning,
+			"Failed to migrate %s to storage version %s: %v",
+			gvr.GroupResource(), gvr.Version, err,
+		)
+		return err
+	}
+
+	logger.V(2).Info("migrated bound resource to storage version", "resource", gvr.GroupResource(), "storageVersion", gvr.Version, "previousStorageVersions", br.StorageVersions, "objects", count)
+	br.StorageVersions = []string{gvr.Version}
+
+	pending = pending[1:]
+	if len(pending) == 0 {
+		conditions.MarkTrue(apiBinding, apisv1alpha2.StorageVersionsMigrated)
+		return nil
+	}
+
+	remaining := make([]string, 0, len(pending))
+	for _, i := range pending {
+		remaining = append(remaining, schema.GroupResource{Group: apiBinding.Status.BoundResources[i].Group, Resource: apiBinding.Status.BoundResources[i].Resource}.String())
+	}
+	conditions.MarkFalse(
+		apiBinding,
+		apisv1alpha2.StorageVersionsMigrated,
+		apisv1alpha2.StorageVersionMigrationInProgressReason,
+		conditionsv1alpha1.ConditionSeverityInfo,
+		"Migrated %d objects of %s to storage version %s, remaining resources: %s",
+		count, gvr.GroupResource(), gvr.Version, strings.Join(remaining, ", "),
+	)
+
+	return nil
+}
+
+// storageVersionToMigrateTo returns the storage version of the bound CRD of the given bound resource,
+// and whether the bound resource recorded other storage versions that objects have to be migrated from.
+func (c *controller) storageVersionToMigrateTo(br apisv1alpha2.BoundAPIResource) (string, bool, error) {
+	// Bound resources without storage versions don't use CRD storage.
+	if len(br.StorageVersions) == 0 {
+		return "", false, nil
+	}
+
+	crd, err := c.getBoundCRD(br.Schema.UID)
+	if apierrors.IsNotFound(err) {
+		return "", false, nil // the APIBinding controller is still creating it
+	} else if err != nil {
+		return "", false, err
+	}
+	if !apihelpers.IsCRDConditionTrue(crd, apiextensionsv1.Established) {
+		return "", false, nil
+	}
+
+	version, err := apihelpers.GetCRDStorageVersion(crd)
+	if err != nil {
+		return "", false, nil //nolint:nilerr // invalid bound CRDs are reported by the APIBinding controller
+	}
+
+	// The APIBinding controller merges the stored versions of the bound CRD into the bound
+	// resource. Pruning is only stable once both agree on the storage version.
+	for _, v := range crd.Status.StoredVersions {
+		if v != version {
+			return "", false, nil
+		}
+	}
+	if !slices.Contains(br.StorageVersions, version) {
+		return "", false, nil
+	}
+
+	return version, len(br.StorageVersions) > 1, nil
+}
+
+// rewriteObjects updates every object of the given resource in the logical cluster without changes,
+// which makes the apiserver persist it in the current storage version. It returns the number of
+// objects rewritten.
+func (c *controller) rewriteObjects(ctx context.Context, clusterName logicalcluster.Name, gvr schema.GroupVersionResource) (int, error) {
+	count := 0
+	continueToken := ""
+	for {
+		list, err := c.listObjects(ctx, clusterName, gvr, continueToken)
+		if err != nil {
+			return count, err
+		}
+		for i := range list.Items {
+			// Objects deleted or updated in the meantime have been written in the current storage version anyway.
+			if err := c.updateObject(ctx, clusterName, gvr, &list.Items[i]); err != nil && !apierrors.IsNotFound(err) && !apierrors.IsConflict(err) {
+				return count, err
+			}
+			count++
+		}
+		continueToken = list.GetContinue()
+		if continueToken == "" {
+			return count, nil
+		}
+	}
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storageversionmigration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+	conditionsv1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+)
+
+func boundCRD(name, storageVersion string, storedVersions ...string) *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{{Name: storageVersion, Served: true, Storage: true}},
+		},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{
+			StoredVersions: storedVersions,
+			Conditions: []apiextensionsv1.CustomResourceDefinitionCondition{
+				{Type: apiextensionsv1.Established, Status: apiextensionsv1.ConditionTrue},
+			},
+		},
+	}
+}
+
+func binding(resources ...apisv1alpha2.BoundAPIResource) *apisv1alpha2.APIBinding {
+	return &apisv1alpha2.APIBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "example",
+			Annotations: map[string]string{logicalcluster.AnnotationKey: "consumer"},
+		},
+		Status: apisv1alpha2.APIBindingStatus{BoundResources: resources},
+	}
+}
+
+func boundResource(resource, uid string, storageVersions ...string) apisv1alpha2.BoundAPIResource {
+	return apisv1alpha2.BoundAPIResource{
+		Group:           "example.io",
+		Resource:        resource,
+		Schema:          apisv1alpha2.BoundAPIResourceSchema{Name: resource + ".example.io", UID: uid, IdentityHash: "hash"},
+		StorageVersions: storageVersions,
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	tests := map[string]struct {
+		binding   *apisv1alpha2.APIBinding
+		crds      map[string]*apiextensionsv1.CustomResourceDefinition
+		objects   int
+		updateErr error
+
+		wantErr             bool
+		wantStorageVersions map[string][]string
+		wantCondition       *conditionsv1alpha1.Condition
+		wantRewritten       []schema.GroupVersionResource
+	}{
+		"single storage version: nothing to do": {
+			binding: binding(boundResource("widgets", "uid-1", "v1")),
+			crds:    map[string]*apiextensionsv1.CustomResourceDefinition{"uid-1": boundCRD("uid-1", "v1", "v1")},
+			objects: 3,
+
+			wantStorageVersions: map[string][]string{"widgets": {"v1"}},
+		},
+		"non-CRD storage: nothing to do": {
+			binding: binding(boundResource("widgets", "uid-1")),
+			objects: 3,
+
+			wantStorageVersions: map[string][]string{"widgets": nil},
+		},
+		"bound CRD not found: nothing to do": {
+			binding: binding(boundResource("widgets", "uid-1", "v1", "v2")),
+			objects: 3,
+
+			wantStorageVersions: map[string][]string{"widgets": {"v1", "v2"}},
+		},
+		"bound CRD with other stored versions: waits": {
+			binding: binding(boundResource("widgets", "uid-1", "v1", "v2")),
+			crds:    map[string]*apiextensionsv1.CustomResourceDefinition{"uid-1": boundCRD("uid-1", "v2", "v1", "v2")},
+			objects: 3,
+
+			wantStorageVersions: map[string][]string{"widgets": {"v1", "v2"}},
+		},
+		"outdated storage version: rewritten and pruned": {
+			binding: binding(boundResource("widgets", "uid-1", "v1", "v2")),
+			crds:    map[string]*apiextensionsv1.CustomResourceDefinition{"uid-1": boundCRD("uid-1", "v2", "v2")},
+			objects: 3,
+
+			wantStorageVersions: map[string][]string{"widgets": {"v2"}},
+			wantCondition:       &conditionsv1alpha1.Condition{Type: apisv1alpha2.StorageVersionsMigrated, Status: "True"},
+			wantRewritten:       []schema.GroupVersionResource{{Group: "example.io", Version: "v2", Resource: "widgets"}},
+		},
+		"two outdated resources: first one migrated, progress reported": {
+			binding: binding(
+				boundResource("widgets", "uid-1", "v1", "v2"),
+				boundResource("gadgets", "uid-2", "v1beta1", "v1"),
+			),
+			crds: map[string]*apiextensionsv1.CustomResourceDefinition{
+				"uid-1": boundCRD("uid-1", "v2", "v2"),
+				"uid-2": boundCRD("uid-2", "v1", "v1"),
+			},
+			objects: 2,
+
+			wantStorageVersions: map[string][]string{"widgets": {"v2"}, "gadgets": {"v1beta1", "v1"}},
+			wantCondition: &conditionsv1alpha1.Condition{
+				Type:     apisv1alpha2.StorageVersionsMigrated,
+				Status:   "False",
+				Severity: conditionsv1alpha1.ConditionSeverityInfo,
+				Reason:   apisv1alpha2.StorageVersionMigrationInProgressReason,
+				Message:  "Migrated 2 objects of widgets.example.io to storage version v2, remaining resources: gadgets.example.io",
+			},
+			wantRewritten: []schema.GroupVersionResource{{Group: "example.io", Version: "v2", Resource: "widgets"}},
+		},
+		"conflicts and deleted objects are ignored": {
+			binding:   binding(boundResource("widgets", "uid-1", "v1", "v2")),
+			crds:      map[string]*apiextensionsv1.CustomResourceDefinition{"uid-1": boundCRD("uid-1", "v2", "v2")},
+			objects:   3,
+			updateErr: apierrors.NewConflict(schema.GroupResource{Group: "example.io", Resource: "widgets"}, "obj", errors.New("conflict")),
+
+			wantStorageVersions: map[string][]string{"widgets": {"v2"}},
+			wantCondition:       &conditionsv1alpha1.Condition{Type: apisv1alpha2.StorageVersionsMigrated, Status: "True"},
+			wantRewritten:       []schema.GroupVersionResource{{Group: "example.io", Version: "v2", Resource: "widgets"}},
+		},
+		"update failing: storage versions kept, failure reported": {
+			binding:   binding(boundResource("widgets", "uid-1", "v1", "v2")),
+			crds:      map[string]*apiextensionsv1.CustomResourceDefinition{"uid-1": boundCRD("uid-1", "v2", "v2")},
+			objects:   3,
+			updateErr: errors.New("boom"),
+
+			wantErr:             true,
+			wantStorageVersions: map[string][]string{"widgets": {"v1", "v2"}},
+			wantCondition: &conditionsv1alpha1.Condition{
+				Type:     apisv1alpha2.StorageVersionsMigrated,
+				Status:   "False",
+				Severity: conditionsv1alpha1.ConditionSeverityWarning,
+				Reason:   apisv1alpha2.StorageVersionMigrationFailedReason,
+				Message:  "Failed to migrate widgets.example.io to storage version v2: boom",
+			},
+			wantRewritten: []schema.GroupVersionResource{{Group: "example.io", Version: "v2", Resource: "widgets"}},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var rewritten []schema.GroupVersionResource
+			c := &controller{
+				getBoundCRD: func(name string) (*apiextensionsv1.CustomResourceDefinition, error) {
+					if crd, ok := tc.crds[name]; ok {
+						return crd, nil
+					}
+					return nil, apierrors.NewNotFound(apiextensionsv1.Resource("customresourcedefinitions"), name)
+				},
+				listObjects: func(ctx context.Context, clusterName logicalcluster.Name, gvr schema.GroupVersionResource, continueToken string) (*unstructured.UnstructuredList, error) {
+					require.Equal(t, logicalcluster.Name("consumer"), clusterName)
+					// Serve one object per page to exercise pagination.
+					list := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{{}}}
+					page := len(continueToken)
+					if page+1 < tc.objects {
+						list.SetContinue(continueToken + "x")
+					}
+					return list, nil
+				},
+				updateObject: func(ctx context.Context, clusterName logicalcluster.Name, gvr schema.GroupVersionResource, obj *unstructured.Unstructured) error {
+					if len(rewritten) == 0 || rewritten[len(rewritten)-1] != gvr {
+						rewritten = append(rewritten, gvr)
+					}
+					return tc.updateErr
+				},
+			}
+
+			err := c.reconcile(context.Background(), tc.binding)
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			for _, br := range tc.binding.Status.BoundResources {
+				require.Equal(t, tc.wantStorageVersions[br.Resource], br.StorageVersions, "storage versions of %s", br.Resource)
+			}
+			if tc.wantCondition == nil {
+				require.Nil(t, conditions.Get(tc.binding, apisv1alpha2.StorageVersionsMigrated))
+			} else {
+				cond := conditions.Get(tc.binding, apisv1alpha2.StorageVersionsMigrated)
+				require.NotNil(t, cond)
+				cond.LastTransitionTime = metav1.Time{}
+				require.Equal(t, *tc.wantCondition, *cond)
+			}
+			require.Equal(t, tc.wantRewritten, rewritten)
+		})
+	}
+}
@@ -273,11 +273,21 @@ func InstallIndexers(
 			Local:  localKcpInformers.Migration().V1alpha1().LogicalClusterMigrations().Informer(),
 			Global: globalKcpInformers.Migration().V1alpha1().LogicalClusterMigrations().Informer(),
 		},
+		migration.SchemeGroupVersion.WithResource("sharddrains"): {
+			Kind:   "ShardDrain",
+			Local:  localKcpInformers.Migration().V1alpha1().ShardDrains().Informer(),
+			Global: globalKcpInformers.Migration().V1alpha1().ShardDrains().Informer(),
+		},
 		tenancyv1alpha1.SchemeGroupVersion.WithResource("workspacetypes"): {
 			Kind:   "WorkspaceType",
 			Local:  localKcpInformers.Tenancy().V1alpha1().WorkspaceTypes().Informer(),
 			Global: globalKcpInformers.Tenancy().V1alpha1().WorkspaceTypes().Informer(),
 		},
+		tenancyv1alpha1.SchemeGroupVersion.WithResource("workspacequotas"): {
+			Kind:   "WorkspaceQuota",
+			Local:  localKcpInformers.Tenancy().V1alpha1().WorkspaceQuotas().Informer(),
+			Global: globalKcpInformers.Tenancy().V1alpha1().WorkspaceQuotas().Informer(),
+		},
 		rbacv1.SchemeGroupVersion.WithResource("clusterroles"): {
 			Kind: "ClusterRole",
 			Filter: func(u *unstructured.Unstructured) bool {
@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shardgc
+
+import (
+	"context"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	kcpapiextensionsv1informers "github.com/kcp-dev/client-go/apiextensions/informers/apiextensions/v1"
+	kcpdynamic "github.com/kcp-dev/client-go/dynamic"
+	"github.com/kcp-dev/logicalcluster/v3"
+
+	cacheclient "github.com/kcp-dev/kcp/pkg/cache/client"
+	"github.com/kcp-dev/kcp/pkg/cache/client/shard"
+	"github.com/kcp-dev/kcp/pkg/logging"
+)
+
+const (
+	ControllerName = "kcp-cache-shard-gc"
+)
+
+// NewController returns a controller running in the cache server. It
+// periodically measures the objects and bytes every shard stores in the cache
+// server, and deletes the objects of shards whose Shard object has been gone
+// for longer than gracePeriod. A gracePeriod of 0 disables the deletion.
+//
+// The resources considered are the ones of the system CRDs in the given
+// logical cluster of the cache server.
+func NewController(
+	dynamicCacheClient kcpdynamic.ClusterInterface,
+	crdInformer kcpapiextensionsv1informers.CustomResourceDefinitionClusterInformer,
+	systemCRDCluster logicalcluster.Name,
+	usage *Usage,
+	gracePeriod time.Duration,
+	interval time.Duration,
+) *Controller {
+	return &Controller{
+		listResources: func() ([]schema.GroupVersionResource, error) {
+			crds, err := crdInformer.Lister().Cluster(systemCRDCluster).List(labels.Everything())
+			if err != nil {
+				return nil, err
+			}
+			gvrs := make([]schema.GroupVersionResource, 0, len(crds))
+			for _, crd := range crds {
+				if gvr, ok := storageVersionResource(crd); ok {
+					gvrs = append(gvrs, gvr)
+				}
+			}
+			return gvrs, nil
+		},
+		listObjects: func(ctx context.Context, gvr schema.GroupVersionResource) ([]unstructured.Unstructured, error) {
+			list, err := dynamicCacheClient.Cluster(logicalcluster.Wildcard).Resource(gvr).List(cacheclient.WithShardInContext(ctx, shard.Wildcard), metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return list.Items, nil
+		},
+		deleteObject: func(ctx context.Context, shardName string, gvr schema.GroupVersionResource, obj *unstructured.Unstructured) error {
+			return dynamicCacheClient.Cluster(logicalcluster.From(obj).Path()).Resource(gvr).Namespace(obj.GetNamespace()).
+				Delete(cacheclient.WithShardInContext(ctx, shard.New(shardName)), obj.GetName(), metav1.DeleteOptions{})
+		},
+		now: time.Now,
+
+		usage:        usage,
+		gracePeriod:  gracePeriod,
+		interval:     interval,
+		missingSince: map[string]time.Time{},
+	}
+}
+
+// Controller garbage-collects the objects of removed shards from the cache
+// server and measures the usage of the remaining ones.
+type Controller struct {
+	listResources func() ([]schema.GroupVersionResource, error)
+	listObjects   func(ctx context.Context, gvr schema.GroupVersionResource) ([]unstructured.Unstructured, error)
+	deleteObject  func(ctx context.Context, shardName string, gvr schema.GroupVersionResource, obj *unstructured.Unstructured) error
+	now           func() time.Time
+
+	usage       *Usage
+	gracePeriod time.Duration
+	interval    time.Duration
+
+	// missingSince holds when shards storing objects were first seen without
+	// a Shard object. It is kept in memory only, i.e. a restart of the cache
+	// server restarts the grace period.
+	missingSince map[string]time.Time
+}
+
+// Start runs the controller until the context is done.
+func (c *Controller) Start(ctx context.Context) {
+	defer utilruntime.HandleCrash()
+
+	logger := logging.WithReconciler(klog.FromContext(ctx), ControllerName)
+	ctx = klog.NewContext(ctx, logger)
+	logger.Info("Starting controller")
+	defer logger.Info("Shutting down controller")
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := c.reconcile(ctx); err != nil {
+			utilruntime.HandleError(err)
+		}
+	}, c.interval)
+}
+
+// storageVersionResource returns the resource of the storage version of crd.
+func storageVersionResource(crd *apiextensionsv1.CustomResourceDefinition) (schema.GroupVersionResource, bool) {
+	for _, v := range crd.Spec.Versions {
+		if v.Storage {
+			return schema.GroupVersionResource{Group: crd.Spec.Group, Version: v.Name, Resource: crd.Spec.Names.Plural}, true
+		}
+	}
+	return schema.GroupVersionResource{}, false
+}
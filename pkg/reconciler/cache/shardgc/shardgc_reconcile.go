@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shardgc
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+
+	"github.com/kcp-dev/kcp/pkg/cache/client/shard"
+)
+
+var shardsResource = corev1alpha1.SchemeGroupVersion.WithResource("shards")
+
+// reconcile measures the usage of all shards storing objects in the cache
+// server and deletes the objects of shards which have been removed for
+// longer than the grace period.
+func (c *Controller) reconcile(ctx context.Context) error {
+	logger := klog.FromContext(ctx)
+	now := c.now()
+
+	gvrs, err := c.listResources()
+	if err != nil {
+		return err
+	}
+
+	// The Shard objects are replicated into the cache server by the root shard.
+	shards, err := c.listObjects(ctx, shardsResource)
+	if err != nil {
+		return err
+	}
+	existing := sets.New[string]()
+	for _, s := range shards {
+		existing.Insert(s.GetName())
+	}
+	// Without any Shard object, e.g. before the root shard replicated them,
+	// every shard would look removed.
+	collect := c.gracePeriod > 0 && existing.Len() > 0
+
+	expired := func(shardName string) bool {
+		since, found := c.missingSince[shardName]
+		return collect && found && now.Sub(since) >= c.gracePeriod
+	}
+
+	usage := map[string]ShardUsage{}
+	seen := sets.New[string]()
+	var errs []error
+	for _, gvr := range gvrs {
+		objs, err := c.listObjects(ctx, gvr)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for i := range objs {
+			obj := &objs[i]
+			shardName := obj.GetAnnotations()[shard.AnnotationKey]
+			if shardName == "" {
+				continue
+			}
+			seen.Insert(shardName)
+			if !IsProtected(shardName) && !existing.Has(shardName) && expired(shardName) {
+				logger.V(2).Info("deleting object of removed shard", "shard", shardName, "resource", gvr.String(), "object", klog.KObj(obj))
+				if err := c.deleteObject(ctx, shardName, gvr, obj); err != nil && !apierrors.IsNotFound(err) {
+					errs = append(errs, err)
+				}
+				continue
+			}
+			bs, err := json.Marshal(obj.Object)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			u := usage[shardName]
+			u.Objects++
+			u.Bytes += int64(len(bs))
+			usage[shardName] = u
+		}
+	}
+
+	for shardName := range seen {
+		if IsProtected(shardName) || existing.Has(shardName) {
+			continue
+		}
+		if _, found := c.missingSince[shardName]; !found {
+			logger.Info("found objects of a shard without Shard object", "shard", shardName, "gracePeriod", c.gracePeriod)
+			c.missingSince[shardName] = now
+		}
+	}
+	for shardName := range c.missingSince {
+		if !seen.Has(shardName) || existing.Has(shardName) {
+			delete(c.missingSince, shardName)
+		}
+	}
+
+	// Only publish complete measurements.
+	if len(errs) == 0 {
+		c.usage.set(usage)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// IsProtected returns whether the objects of the given shard are never
+// garbage-collected and never limited. These are the objects the cache server
+// stores for itself.
+func IsProtected(shardName string) bool {
+	return strings.HasPrefix(shardName, "system:")
+}
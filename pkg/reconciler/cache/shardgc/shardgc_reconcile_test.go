@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shardgc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kcp-dev/kcp/pkg/cache/client/shard"
+)
+
+var apiExportsResource = schema.GroupVersionResource{Group: "apis.kcp.io", Version: "v1alpha1", Resource: "apiexports"}
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		shards      []string
+		apiExports  map[string]int
+		gracePeriod time.Duration
+		passes      []time.Duration
+
+		wantDeleted map[string]int
+		wantUsage   map[string]int64
+	}{
+		"existing shards are kept": {
+			shards:      []string{"root", "alpha"},
+			apiExports:  map[string]int{"root": 2, "alpha": 1},
+			gracePeriod: time.Hour,
+			passes:      []time.Duration{0, 2 * time.Hour},
+			wantUsage:   map[string]int64{"root": 4, "alpha": 1},
+		},
+		"removed shard is kept during the grace period": {
+			shards:      []string{"root"},
+			apiExports:  map[string]int{"root": 1, "beta": 2},
+			gracePeriod: time.Hour,
+			passes:      []time.Duration{0, 30 * time.Minute},
+			wantUsage:   map[string]int64{"root": 2, "beta": 2},
+		},
+		"removed shard is collected after the grace period": {
+			shards:      []string{"root"},
+			apiExports:  map[string]int{"root": 1, "beta": 2},
+			gracePeriod: time.Hour,
+			passes:      []time.Duration{0, time.Hour},
+			wantDeleted: map[string]int{"beta": 2},
+			wantUsage:   map[string]int64{"root": 2},
+		},
+		"grace period starts when the objects are first seen": {
+			shards:      []string{"root"},
+			apiExports:  map[string]int{"root": 1, "beta": 2},
+			gracePeriod: time.Hour,
+			passes:      []time.Duration{2 * time.Hour},
+			wantUsage:   map[string]int64{"root": 2, "beta": 2},
+		},
+		"system shards are never collected": {
+			shards:      []string{"root"},
+			apiExports:  map[string]int{"root": 1, "system:cache:server": 1},
+			gracePeriod: time.Hour,
+			passes:      []time.Duration{0, 2 * time.Hour},
+			wantUsage:   map[string]int64{"root": 2, "system:cache:server": 1},
+		},
+		"nothing is collected without any shard": {
+			apiExports:  map[string]int{"beta": 1},
+			gracePeriod: time.Hour,
+			passes:      []time.Duration{0, 2 * time.Hour},
+			wantUsage:   map[string]int64{"beta": 1},
+		},
+		"a grace period of 0 disables collection": {
+			shards:     []string{"root"},
+			apiExports: map[string]int{"root": 1, "beta": 1},
+			passes:     []time.Duration{0, 2 * time.Hour},
+			wantUsage:  map[string]int64{"root": 2, "beta": 1},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			objects := map[schema.GroupVersionResource][]unstructured.Unstructured{}
+			for _, name := range tc.shards {
+				objects[shardsResource] = append(objects[shardsResource], object("root", name))
+			}
+			for shardName, n := range tc.apiExports {
+				for range n {
+					objects[apiExportsResource] = append(objects[apiExportsResource], object(shardName, "export"))
+				}
+			}
+
+			deleted := map[string]int{}
+			var now time.Time
+			usage := NewUsage()
+			c := &Controller{
+				listResources: func() ([]schema.GroupVersionResource, error) {
+					return []schema.GroupVersionResource{shardsResource, apiExportsResource}, nil
+				},
+				listObjects: func(ctx context.Context, gvr schema.GroupVersionResource) ([]unstructured.Unstructured, error) {
+					var objs []unstructured.Unstructured
+					for _, obj := range objects[gvr] {
+						if deleted[obj.GetAnnotations()[shard.AnnotationKey]] == 0 {
+							objs = append(objs, *obj.DeepCopy())
+						}
+					}
+					return objs, nil
+				},
+				deleteObject: func(ctx context.Context, shardName string, gvr schema.GroupVersionResource, obj *unstructured.Unstructured) error {
+					require.Equal(t, apiExportsResource, gvr)
+					require.Equal(t, shardName, obj.GetAnnotations()[shard.AnnotationKey])
+					deleted[shardName]++
+					return nil
+				},
+				now:          func() time.Time { return now },
+				usage:        usage,
+				gracePeriod:  tc.gracePeriod,
+				missingSince: map[string]time.Time{},
+			}
+
+			for _, pass := range tc.passes {
+				now = start.Add(pass)
+				require.NoError(t, c.reconcile(context.Background()))
+			}
+
+			if tc.wantDeleted == nil {
+				tc.wantDeleted = map[string]int{}
+			}
+			require.Equal(t, tc.wantDeleted, deleted)
+
+			objectCounts := map[string]int64{}
+			for shardName, u := range usage.shards {
+				objectCounts[shardName] = u.Objects
+				require.Positive(t, u.Bytes)
+			}
+			require.Equal(t, tc.wantUsage, objectCounts)
+		})
+	}
+}
+
+func object(shardName, name string) unstructured.Unstructured {
+	obj := unstructured.Unstructured{}
+	obj.SetName(name)
+	obj.SetAnnotations(map[string]string{
+		shard.AnnotationKey: shardName,
+		"kcp.io/cluster":    "root",
+	})
+	return obj
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shardgc
+
+import (
+	"sync"
+)
+
+// ShardUsage is what a shard stores in the cache server.
+type ShardUsage struct {
+	// Objects is the number of objects.
+	Objects int64
+	// Bytes is the size of the objects, serialized as JSON.
+	Bytes int64
+}
+
+// Usage holds the usage of all shards, as last measured by the controller
+// and adjusted by the writes admitted since.
+type Usage struct {
+	lock   sync.RWMutex
+	shards map[string]ShardUsage
+}
+
+// NewUsage returns an empty Usage.
+func NewUsage() *Usage {
+	return &Usage{shards: map[string]ShardUsage{}}
+}
+
+// Get returns the usage of the given shard.
+func (u *Usage) Get(shardName string) ShardUsage {
+	u.lock.RLock()
+	defer u.lock.RUnlock()
+
+	return u.shards[shardName]
+}
+
+// Add accounts a write of the given shard until the next measurement.
+func (u *Usage) Add(shardName string, objects, bytes int64) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	s := u.shards[shardName]
+	s.Objects += objects
+	s.Bytes += bytes
+	u.shards[shardName] = s
+}
+
+func (u *Usage) set(shards map[string]ShardUsage) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	u.shards = shards
+}
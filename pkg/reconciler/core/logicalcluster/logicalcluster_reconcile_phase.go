@@ -57,6 +57,19 @@ func (r *phaseReconciler) reconcile(ctx context.Context, workspace *corev1alpha1
 		return reconcileStatusContinue, nil
 	}
 
+	if corev1alpha1.IsLogicalClusterDeleted(workspace.Annotations) {
+		// The workspace has been deleted, but the logical cluster is retained to be
+		// undeleted. Access is not permitted meanwhile, so cancel its connections.
+		if workspace.Status.Phase != corev1alpha1.LogicalClusterPhaseDeleted {
+			workspace.Status.Phase = corev1alpha1.LogicalClusterPhaseDeleted
+			lcPath := logicalcluster.From(workspace).Path()
+			reason := fmt.Errorf("logical cluster %s deleted", lcPath)
+			r.clusterContextManager.Cancel(lcPath, reason)
+			r.clusterContextManager.Cancel(logicalcluster.Wildcard, reason)
+		}
+		return reconcileStatusContinue, nil
+	}
+
 	switch workspace.Status.Phase {
 	case "", corev1alpha1.LogicalClusterPhaseScheduling:
 		// A LogicalCluster object's placement is the shard it lives on, so its
@@ -83,15 +96,39 @@ func (r *phaseReconciler) reconcile(ctx context.Context, workspace *corev1alpha1
 			reason := fmt.Errorf("logical cluster %s inactive", lcPath)
 			r.clusterContextManager.Cancel(lcPath, reason)
 			r.clusterContextManager.Cancel(logicalcluster.Wildcard, reason)
+		} else if corev1alpha1.IsLogicalClusterHibernated(workspace.Annotations) {
+			// A hibernated logical cluster keeps serving requests and
+			// is woken up by them, so its connections stay intact.
+			workspace.Status.Phase = corev1alpha1.LogicalClusterPhaseInactive
 		}
+	case corev1alpha1.LogicalClusterPhaseDeleted:
+		// Undeleted. Continue where the logical cluster left off.
+		if len(workspace.Status.Initializers) > 0 {
+			workspace.Status.Phase = corev1alpha1.LogicalClusterPhaseInitializing
+		} else {
+			workspace.Status.Phase = corev1alpha1.LogicalClusterPhaseReady
+		}
+		lcPath := logicalcluster.From(workspace).Path()
+		r.clusterContextManager.Release(lcPath)
+		r.clusterContextManager.Release(logicalcluster.Wildcard)
 	case corev1alpha1.LogicalClusterPhaseInactive:
-		if !corev1alpha1.IsLogicalClusterInactive(workspace.Annotations) {
+		if corev1alpha1.IsLogicalClusterInactive(workspace.Annotations) && corev1alpha1.IsLogicalClusterHibernated(workspace.Annotations) {
+			// Marked inactive while hibernated, the connections have not
+			// been cancelled on the transition to Inactive.
+			lcPath := logicalcluster.From(workspace).Path()
+			reason := fmt.Errorf("logical cluster %s inactive", lcPath)
+			r.clusterContextManager.Cancel(lcPath, reason)
+			r.clusterContextManager.Cancel(logicalcluster.Wildcard, reason)
+		}
+		if !corev1alpha1.IsLogicalClusterInactive(workspace.Annotations) && !corev1alpha1.IsLogicalClusterHibernated(workspace.Annotations) {
 			workspace.Status.Phase = corev1alpha1.LogicalClusterPhaseReady
-			// Drop the cancelled entries so the next request creates fresh live contexts.
+			// Drop the cancelled entries so the next request creates fresh
+			// live contexts. Entries that have not been cancelled, e.g. when
+			// the logical cluster was only hibernated, are kept as they
+			// belong to requests being served.
 			lcPath := logicalcluster.From(workspace).Path()
-			reason := fmt.Errorf("logical cluster %s reactivated", lcPath)
-			r.clusterContextManager.Delete(lcPath, reason)
-			r.clusterContextManager.Delete(logicalcluster.Wildcard, reason)
+			r.clusterContextManager.Release(lcPath)
+			r.clusterContextManager.Release(logicalcluster.Wildcard)
 		}
 	}
 
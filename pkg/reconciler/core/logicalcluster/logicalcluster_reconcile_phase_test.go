@@ -19,8 +19,15 @@ package logicalcluster
 import (
 	"context"
 	"testing"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/kcp-dev/logicalcluster/v3"
 	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+
+	"github.com/kcp-dev/kcp/pkg/contextmanager"
 )
 
 func TestPhaseReconcile(t *testing.T) {
@@ -84,12 +91,57 @@ func TestPhaseReconcile(t *testing.T) {
 			},
 			expectedPhase: corev1alpha1.LogicalClusterPhaseReady,
 		},
+		{
+			name: "ready with inactive annotation becomes inactive",
+			logicalCluster: &corev1alpha1.LogicalCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{corev1alpha1.LogicalClusterInactiveAnnotationKey: "true"},
+				},
+				Status: corev1alpha1.LogicalClusterStatus{
+					Phase: corev1alpha1.LogicalClusterPhaseReady,
+				},
+			},
+			expectedPhase: corev1alpha1.LogicalClusterPhaseInactive,
+		},
+		{
+			name: "ready with hibernated annotation becomes inactive",
+			logicalCluster: &corev1alpha1.LogicalCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{corev1alpha1.LogicalClusterHibernatedAnnotationKey: "2026-01-01T00:00:00Z"},
+				},
+				Status: corev1alpha1.LogicalClusterStatus{
+					Phase: corev1alpha1.LogicalClusterPhaseReady,
+				},
+			},
+			expectedPhase: corev1alpha1.LogicalClusterPhaseInactive,
+		},
+		{
+			name: "inactive with hibernated annotation stays inactive",
+			logicalCluster: &corev1alpha1.LogicalCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{corev1alpha1.LogicalClusterHibernatedAnnotationKey: "2026-01-01T00:00:00Z"},
+				},
+				Status: corev1alpha1.LogicalClusterStatus{
+					Phase: corev1alpha1.LogicalClusterPhaseInactive,
+				},
+			},
+			expectedPhase: corev1alpha1.LogicalClusterPhaseInactive,
+		},
+		{
+			name: "inactive without annotations becomes ready",
+			logicalCluster: &corev1alpha1.LogicalCluster{
+				Status: corev1alpha1.LogicalClusterStatus{
+					Phase: corev1alpha1.LogicalClusterPhaseInactive,
+				},
+			},
+			expectedPhase: corev1alpha1.LogicalClusterPhaseReady,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			r := &phaseReconciler{}
+			r := &phaseReconciler{clusterContextManager: contextmanager.New[logicalcluster.Path](context.Background())}
 			if _, err := r.reconcile(context.Background(), tt.logicalCluster); err != nil {
 				t.Fatalf("unexpected reconcile error: %v", err)
 			}
@@ -99,3 +151,105 @@ func TestPhaseReconcile(t *testing.T) {
 		})
 	}
 }
+
+func TestPhaseReconcileHibernation(t *testing.T) {
+	t.Parallel()
+
+	mgr := contextmanager.New[logicalcluster.Path](context.Background())
+	r := &phaseReconciler{clusterContextManager: mgr}
+	lc := &corev1alpha1.LogicalCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				logicalcluster.AnnotationKey:                       "foo",
+				corev1alpha1.LogicalClusterHibernatedAnnotationKey: "2026-01-01T00:00:00Z",
+			},
+		},
+		Status: corev1alpha1.LogicalClusterStatus{
+			Phase: corev1alpha1.LogicalClusterPhaseReady,
+		},
+	}
+	ctx, cancel := mgr.Context(context.Background(), logicalcluster.NewPath("foo"))
+	defer cancel()
+	wildcardCtx, wildcardCancel := mgr.Context(context.Background(), logicalcluster.Wildcard)
+	defer wildcardCancel()
+
+	if _, err := r.reconcile(context.Background(), lc); err != nil {
+		t.Fatalf("unexpected reconcile error: %v", err)
+	}
+	if lc.Status.Phase != corev1alpha1.LogicalClusterPhaseInactive {
+		t.Fatalf("phase: got %q, want %q", lc.Status.Phase, corev1alpha1.LogicalClusterPhaseInactive)
+	}
+	if ctx.Err() != nil || wildcardCtx.Err() != nil {
+		t.Fatalf("hibernation must not cancel contexts")
+	}
+
+	delete(lc.Annotations, corev1alpha1.LogicalClusterHibernatedAnnotationKey)
+	if _, err := r.reconcile(context.Background(), lc); err != nil {
+		t.Fatalf("unexpected reconcile error: %v", err)
+	}
+	if lc.Status.Phase != corev1alpha1.LogicalClusterPhaseReady {
+		t.Fatalf("phase: got %q, want %q", lc.Status.Phase, corev1alpha1.LogicalClusterPhaseReady)
+	}
+	if ctx.Err() != nil || wildcardCtx.Err() != nil {
+		t.Fatalf("waking up must not cancel contexts")
+	}
+}
+
+func TestPhaseReconcileSoftDeletion(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name         string
+		initializers []corev1alpha1.LogicalClusterInitializer
+		wantPhase    corev1alpha1.LogicalClusterPhaseType
+	}{
+		{name: "ready", wantPhase: corev1alpha1.LogicalClusterPhaseReady},
+		{name: "initializing", initializers: []corev1alpha1.LogicalClusterInitializer{"root:org:foo"}, wantPhase: corev1alpha1.LogicalClusterPhaseInitializing},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mgr := contextmanager.New[logicalcluster.Path](context.Background())
+			r := &phaseReconciler{clusterContextManager: mgr}
+			lc := &corev1alpha1.LogicalCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						logicalcluster.AnnotationKey:                    "foo",
+						corev1alpha1.LogicalClusterDeletedAnnotationKey: "2026-01-08T00:00:00Z",
+					},
+				},
+				Status: corev1alpha1.LogicalClusterStatus{
+					Phase:        tc.wantPhase,
+					Initializers: tc.initializers,
+				},
+			}
+			ctx, cancel := mgr.Context(context.Background(), logicalcluster.NewPath("foo"))
+			defer cancel()
+
+			if _, err := r.reconcile(context.Background(), lc); err != nil {
+				t.Fatalf("unexpected reconcile error: %v", err)
+			}
+			if lc.Status.Phase != corev1alpha1.LogicalClusterPhaseDeleted {
+				t.Fatalf("phase: got %q, want %q", lc.Status.Phase, corev1alpha1.LogicalClusterPhaseDeleted)
+			}
+			select {
+			case <-ctx.Done():
+			case <-time.After(wait.ForeverTestTimeout):
+				t.Fatalf("deletion must cancel contexts")
+			}
+
+			delete(lc.Annotations, corev1alpha1.LogicalClusterDeletedAnnotationKey)
+			if _, err := r.reconcile(context.Background(), lc); err != nil {
+				t.Fatalf("unexpected reconcile error: %v", err)
+			}
+			if lc.Status.Phase != tc.wantPhase {
+				t.Fatalf("phase: got %q, want %q", lc.Status.Phase, tc.wantPhase)
+			}
+			ctx, cancel = mgr.Context(context.Background(), logicalcluster.NewPath("foo"))
+			defer cancel()
+			if ctx.Err() != nil {
+				t.Fatalf("undeletion must release the cancelled contexts")
+			}
+		})
+	}
+}
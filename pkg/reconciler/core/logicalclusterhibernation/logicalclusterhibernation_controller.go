@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logicalclusterhibernation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
+	corev1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/core/v1alpha1"
+	tenancyv1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/tenancy/v1alpha1"
+
+	"github.com/kcp-dev/kcp/pkg/hibernation"
+	"github.com/kcp-dev/kcp/pkg/indexers"
+	"github.com/kcp-dev/kcp/pkg/logging"
+)
+
+const (
+	ControllerName = "kcp-logicalcluster-hibernation"
+
+	// interval is the period in which idle logical clusters are looked for.
+	interval = time.Minute
+)
+
+// NewController returns a controller which hibernates the logical clusters
+// on this shard that have not seen requests of users for the hibernateAfter
+// duration of their WorkspaceType. The activity is taken from the tracker,
+// which is fed by the request handler chain of this process.
+func NewController(
+	kcpClusterClient kcpclientset.ClusterInterface,
+	logicalClusterInformer corev1alpha1informers.LogicalClusterClusterInformer,
+	workspaceTypeInformer, globalWorkspaceTypeInformer tenancyv1alpha1informers.WorkspaceTypeClusterInformer,
+	tracker *hibernation.Tracker,
+) (*Controller, error) {
+	indexers.AddIfNotPresentOrDie(workspaceTypeInformer.Informer().GetIndexer(), cache.Indexers{
+		indexers.ByLogicalClusterPathAndName: indexers.IndexByLogicalClusterPathAndName,
+	})
+	indexers.AddIfNotPresentOrDie(globalWorkspaceTypeInformer.Informer().GetIndexer(), cache.Indexers{
+		indexers.ByLogicalClusterPathAndName: indexers.IndexByLogicalClusterPathAndName,
+	})
+
+	c := &Controller{
+		listLogicalClusters: func() ([]*corev1alpha1.LogicalCluster, error) {
+			return logicalClusterInformer.Lister().List(labels.Everything())
+		},
+		getWorkspaceType: func(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error) {
+			return indexers.ByPathAndNameWithFallback[*tenancyv1alpha1.WorkspaceType](tenancyv1alpha1.Resource("workspacetypes"), workspaceTypeInformer.Informer().GetIndexer(), globalWorkspaceTypeInformer.Informer().GetIndexer(), path, name)
+		},
+		idleSince: tracker.IdleSince,
+		hibernate: func(ctx context.Context, cluster logicalcluster.Name, patch []byte) error {
+			_, err := kcpClusterClient.Cluster(cluster.Path()).CoreV1alpha1().LogicalClusters().Patch(ctx, corev1alpha1.LogicalClusterName, types.MergePatchType, patch, metav1.PatchOptions{})
+			return err
+		},
+		now: time.Now,
+	}
+
+	_, err := logicalClusterInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if lc, ok := obj.(*corev1alpha1.LogicalCluster); ok {
+				tracker.Forget(logicalcluster.From(lc))
+			}
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Controller periodically hibernates idle logical clusters.
+type Controller struct {
+	listLogicalClusters func() ([]*corev1alpha1.LogicalCluster, error)
+	getWorkspaceType    func(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error)
+	idleSince           func(cluster logicalcluster.Name) (time.Time, bool)
+	hibernate           func(ctx context.Context, cluster logicalcluster.Name, patch []byte) error
+	now                 func() time.Time
+}
+
+// Start runs the controller until ctx is done.
+func (c *Controller) Start(ctx context.Context) {
+	logger := logging.WithReconciler(klog.FromContext(ctx), ControllerName)
+	ctx = klog.NewContext(ctx, logger)
+	logger.Info("Starting controller")
+	defer logger.Info("Shutting down controller")
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := c.hibernateIdle(ctx); err != nil {
+			logger.Error(err, "failed to hibernate idle logical clusters")
+		}
+	}, interval)
+}
+
+func (c *Controller) hibernateIdle(ctx context.Context) error {
+	logger := klog.FromContext(ctx)
+
+	logicalClusters, err := c.listLogicalClusters()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, lc := range logicalClusters {
+		if lc.Status.Phase != corev1alpha1.LogicalClusterPhaseReady ||
+			corev1alpha1.IsLogicalClusterInactive(lc.Annotations) ||
+			corev1alpha1.IsLogicalClusterHibernated(lc.Annotations) {
+			continue
+		}
+		name := logicalcluster.From(lc)
+		if strings.HasPrefix(name.String(), "system:") {
+			continue
+		}
+
+		after, err := c.hibernateAfter(lc)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if after <= 0 {
+			continue
+		}
+		since, idle := c.idleSince(name)
+		if !idle || c.now().Sub(since) < after {
+			continue
+		}
+
+		logger.V(2).Info("hibernating idle logical cluster", "logicalcluster", name, "idleSince", since)
+		patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, corev1alpha1.LogicalClusterHibernatedAnnotationKey, c.now().UTC().Format(time.RFC3339))
+		if err := c.hibernate(ctx, name, []byte(patch)); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// hibernateAfter returns the idle time after which the given logical cluster
+// is hibernated, or 0 if it is never hibernated.
+func (c *Controller) hibernateAfter(lc *corev1alpha1.LogicalCluster) (time.Duration, error) {
+	value, found := lc.Annotations[tenancyv1alpha1.LogicalClusterTypeAnnotationKey]
+	if !found {
+		return 0, nil
+	}
+	path, name := logicalcluster.NewPath(value).Split()
+	wt, err := c.getWorkspaceType(path, name)
+	if apierrors.IsNotFound(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	if wt.Spec.HibernateAfter == nil {
+		return 0, nil
+	}
+	return wt.Spec.HibernateAfter.Duration, nil
+}
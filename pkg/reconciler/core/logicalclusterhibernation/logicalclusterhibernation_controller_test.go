@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logicalclusterhibernation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+)
+
+func TestHibernateIdle(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	newLogicalCluster := func(name string, phase corev1alpha1.LogicalClusterPhaseType, annotations map[string]string) *corev1alpha1.LogicalCluster {
+		lc := &corev1alpha1.LogicalCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: corev1alpha1.LogicalClusterName,
+				Annotations: map[string]string{
+					logicalcluster.AnnotationKey:                    name,
+					tenancyv1alpha1.LogicalClusterTypeAnnotationKey: "root:dev",
+				},
+			},
+			Status: corev1alpha1.LogicalClusterStatus{Phase: phase},
+		}
+		for k, v := range annotations {
+			lc.Annotations[k] = v
+		}
+		return lc
+	}
+
+	tests := map[string]struct {
+		logicalCluster *corev1alpha1.LogicalCluster
+		hibernateAfter *metav1.Duration
+		idleSince      time.Time
+		busy           bool
+
+		wantHibernated bool
+	}{
+		"idle longer than hibernateAfter is hibernated": {
+			logicalCluster: newLogicalCluster("foo", corev1alpha1.LogicalClusterPhaseReady, nil),
+			hibernateAfter: &metav1.Duration{Duration: time.Hour},
+			idleSince:      now.Add(-2 * time.Hour),
+			wantHibernated: true,
+		},
+		"idle shorter than hibernateAfter is not hibernated": {
+			logicalCluster: newLogicalCluster("foo", corev1alpha1.LogicalClusterPhaseReady, nil),
+			hibernateAfter: &metav1.Duration{Duration: time.Hour},
+			idleSince:      now.Add(-time.Minute),
+		},
+		"request in flight is not hibernated": {
+			logicalCluster: newLogicalCluster("foo", corev1alpha1.LogicalClusterPhaseReady, nil),
+			hibernateAfter: &metav1.Duration{Duration: time.Hour},
+			busy:           true,
+		},
+		"type without hibernateAfter is not hibernated": {
+			logicalCluster: newLogicalCluster("foo", corev1alpha1.LogicalClusterPhaseReady, nil),
+			idleSince:      now.Add(-48 * time.Hour),
+		},
+		"initializing is not hibernated": {
+			logicalCluster: newLogicalCluster("foo", corev1alpha1.LogicalClusterPhaseInitializing, nil),
+			hibernateAfter: &metav1.Duration{Duration: time.Hour},
+			idleSince:      now.Add(-2 * time.Hour),
+		},
+		"inactive is not hibernated": {
+			logicalCluster: newLogicalCluster("foo", corev1alpha1.LogicalClusterPhaseReady, map[string]string{corev1alpha1.LogicalClusterInactiveAnnotationKey: "true"}),
+			hibernateAfter: &metav1.Duration{Duration: time.Hour},
+			idleSince:      now.Add(-2 * time.Hour),
+		},
+		"system logical cluster is not hibernated": {
+			logicalCluster: newLogicalCluster("system:admin", corev1alpha1.LogicalClusterPhaseReady, nil),
+			hibernateAfter: &metav1.Duration{Duration: time.Hour},
+			idleSince:      now.Add(-2 * time.Hour),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var patched []byte
+			c := &Controller{
+				listLogicalClusters: func() ([]*corev1alpha1.LogicalCluster, error) {
+					return []*corev1alpha1.LogicalCluster{tc.logicalCluster}, nil
+				},
+				getWorkspaceType: func(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error) {
+					if path.String() != "root" || name != "dev" {
+						return nil, apierrors.NewNotFound(tenancyv1alpha1.Resource("workspacetypes"), name)
+					}
+					return &tenancyv1alpha1.WorkspaceType{Spec: tenancyv1alpha1.WorkspaceTypeSpec{HibernateAfter: tc.hibernateAfter}}, nil
+				},
+				idleSince: func(cluster logicalcluster.Name) (time.Time, bool) {
+					return tc.idleSince, !tc.busy
+				},
+				hibernate: func(ctx context.Context, cluster logicalcluster.Name, patch []byte) error {
+					require.Equal(t, logicalcluster.From(tc.logicalCluster), cluster)
+					patched = patch
+					return nil
+				},
+				now: func() time.Time { return now },
+			}
+
+			require.NoError(t, c.hibernateIdle(context.Background()))
+			if tc.wantHibernated {
+				require.JSONEq(t, `{"metadata":{"annotations":{"core.kcp.io/hibernated":"2026-01-01T12:00:00Z"}}}`, string(patched))
+			} else {
+				require.Nil(t, patched)
+			}
+		})
+	}
+}
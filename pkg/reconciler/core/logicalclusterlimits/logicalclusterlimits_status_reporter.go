@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logicalclusterlimits
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
+	corev1alpha1client "github.com/kcp-dev/sdk/client/clientset/versioned/typed/core/v1alpha1"
+	corev1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/core/v1alpha1"
+
+	"github.com/kcp-dev/kcp/pkg/logging"
+	"github.com/kcp-dev/kcp/pkg/objectcount"
+	"github.com/kcp-dev/kcp/pkg/reconciler/committer"
+)
+
+const (
+	StatusReporterName = "kcp-logicalclusterlimits-status-reporter"
+)
+
+type LogicalClusterLimits = corev1alpha1.LogicalClusterLimits
+type LogicalClusterLimitsSpec = corev1alpha1.LogicalClusterLimitsSpec
+type LogicalClusterLimitsStatus = corev1alpha1.LogicalClusterLimitsStatus
+type Patcher = corev1alpha1client.LogicalClusterLimitsInterface
+type Resource = committer.Resource[*LogicalClusterLimitsSpec, *LogicalClusterLimitsStatus]
+type CommitFunc = func(ctx context.Context, original, updated *Resource) error
+
+// NewStatusReporter returns a reporter which periodically writes the number of
+// objects of every limited resource into the status of the
+// LogicalClusterLimits objects on this shard. The counts are taken from the
+// object count registry, i.e. they are as recent as the last scan plus the
+// creations and deletions admitted since.
+func NewStatusReporter(
+	interval time.Duration,
+	kcpClusterClient kcpclientset.ClusterInterface,
+	logicalClusterInformer corev1alpha1informers.LogicalClusterClusterInformer,
+	logicalClusterLimitsInformer corev1alpha1informers.LogicalClusterLimitsClusterInformer,
+	registry *objectcount.Registry,
+) *StatusReporter {
+	return &StatusReporter{
+		interval: interval,
+
+		getLogicalCluster: func(cluster logicalcluster.Name) (*corev1alpha1.LogicalCluster, error) {
+			return logicalClusterInformer.Lister().Cluster(cluster).Get(corev1alpha1.LogicalClusterName)
+		},
+		listLogicalClusterLimits: func() ([]*corev1alpha1.LogicalClusterLimits, error) {
+			return logicalClusterLimitsInformer.Lister().List(labels.Everything())
+		},
+		countObjects: registry.ResourceCount,
+
+		commit: committer.NewCommitter[*LogicalClusterLimits, Patcher, *LogicalClusterLimitsSpec, *LogicalClusterLimitsStatus](kcpClusterClient.CoreV1alpha1().LogicalClusterLimits()),
+	}
+}
+
+// StatusReporter periodically reports the object counts of the limited
+// resources of every logical cluster with a LogicalClusterLimits object.
+type StatusReporter struct {
+	interval time.Duration
+
+	getLogicalCluster        func(cluster logicalcluster.Name) (*corev1alpha1.LogicalCluster, error)
+	listLogicalClusterLimits func() ([]*corev1alpha1.LogicalClusterLimits, error)
+	countObjects             func(cluster logicalcluster.Name, resource schema.GroupResource) (int64, bool)
+
+	commit CommitFunc
+}
+
+// Start runs the reporter until ctx is done.
+func (r *StatusReporter) Start(ctx context.Context) {
+	logger := logging.WithReconciler(klog.FromContext(ctx), StatusReporterName)
+	ctx = klog.NewContext(ctx, logger)
+
+	logger.Info("Starting reporter")
+	defer logger.Info("Shutting down reporter")
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := r.report(ctx); err != nil {
+			logger.Error(err, "failed to report logical cluster limits")
+		}
+	}, r.interval)
+}
+
+func (r *StatusReporter) report(ctx context.Context) error {
+	all, err := r.listLogicalClusterLimits()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, limits := range all {
+		if limits.Name != corev1alpha1.LogicalClusterLimitsName {
+			continue
+		}
+		if err := r.reportOne(ctx, limits); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+func (r *StatusReporter) reportOne(ctx context.Context, limits *corev1alpha1.LogicalClusterLimits) error {
+	logger := logging.WithObject(klog.FromContext(ctx), limits)
+	cluster := logicalcluster.From(limits)
+
+	logicalCluster, err := r.getLogicalCluster(cluster)
+	if err != nil {
+		// The logical cluster is still being created or already being deleted.
+		logger.V(4).Info("LogicalCluster not found, skipping", "err", err)
+		return nil
+	}
+
+	updated := limits.DeepCopy()
+	updated.Status.ObjectCounts = nil
+	for resource, limit := range objectcount.ResourceLimitsFor(logicalCluster.Annotations, limits) {
+		count, ok := r.countObjects(cluster, resource)
+		if !ok {
+			// Not counted per resource yet, keep the last reported count.
+			for _, c := range limits.Status.ObjectCounts {
+				if c.Resource == resource.String() {
+					count = c.Count
+				}
+			}
+		}
+		updated.Status.ObjectCounts = append(updated.Status.ObjectCounts, corev1alpha1.ResourceObjectCount{
+			Resource: resource.String(),
+			Max:      limit,
+			Count:    count,
+		})
+	}
+	sort.Slice(updated.Status.ObjectCounts, func(i, j int) bool {
+		return updated.Status.ObjectCounts[i].Resource < updated.Status.ObjectCounts[j].Resource
+	})
+
+	oldResource := &Resource{ObjectMeta: limits.ObjectMeta, Spec: &limits.Spec, Status: &limits.Status}
+	newResource := &Resource{ObjectMeta: updated.ObjectMeta, Spec: &updated.Spec, Status: &updated.Status}
+	return r.commit(ctx, oldResource, newResource)
+}
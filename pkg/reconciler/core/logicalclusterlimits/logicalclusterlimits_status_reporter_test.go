@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logicalclusterlimits
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+)
+
+func TestStatusReporterReport(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		annotations           map[string]string
+		spec                  corev1alpha1.LogicalClusterLimitsSpec
+		status                corev1alpha1.LogicalClusterLimitsStatus
+		scanned               bool
+		withoutLogicalCluster bool
+		wantStatus            *corev1alpha1.LogicalClusterLimitsStatus
+	}{
+		"reports limits of the spec and the annotation": {
+			annotations: map[string]string{corev1alpha1.LogicalClusterMaxObjectsPerResourceAnnotationKey: "configmaps=100,secrets=5"},
+			spec: corev1alpha1.LogicalClusterLimitsSpec{
+				ObjectCounts: []corev1alpha1.ResourceObjectCountLimit{{Resource: "secrets", Max: 10}, {Resource: "widgets.example.io", Max: 3}},
+			},
+			scanned: true,
+			wantStatus: &corev1alpha1.LogicalClusterLimitsStatus{
+				ObjectCounts: []corev1alpha1.ResourceObjectCount{
+					{Resource: "configmaps", Max: 100, Count: 7},
+					{Resource: "secrets", Max: 10, Count: 2},
+					{Resource: "widgets.example.io", Max: 3, Count: 0},
+				},
+			},
+		},
+		"drops resources which are not limited anymore": {
+			spec: corev1alpha1.LogicalClusterLimitsSpec{
+				ObjectCounts: []corev1alpha1.ResourceObjectCountLimit{{Resource: "secrets", Max: 10}},
+			},
+			status: corev1alpha1.LogicalClusterLimitsStatus{
+				ObjectCounts: []corev1alpha1.ResourceObjectCount{
+					{Resource: "configmaps", Max: 100, Count: 7},
+					{Resource: "secrets", Max: 10, Count: 1},
+				},
+			},
+			scanned: true,
+			wantStatus: &corev1alpha1.LogicalClusterLimitsStatus{
+				ObjectCounts: []corev1alpha1.ResourceObjectCount{
+					{Resource: "secrets", Max: 10, Count: 2},
+				},
+			},
+		},
+		"keeps the last count until the logical cluster is counted per resource": {
+			spec: corev1alpha1.LogicalClusterLimitsSpec{
+				ObjectCounts: []corev1alpha1.ResourceObjectCountLimit{{Resource: "secrets", Max: 20}},
+			},
+			status: corev1alpha1.LogicalClusterLimitsStatus{
+				ObjectCounts: []corev1alpha1.ResourceObjectCount{{Resource: "secrets", Max: 10, Count: 9}},
+			},
+			wantStatus: &corev1alpha1.LogicalClusterLimitsStatus{
+				ObjectCounts: []corev1alpha1.ResourceObjectCount{{Resource: "secrets", Max: 20, Count: 9}},
+			},
+		},
+		"skipped without LogicalCluster": {
+			spec: corev1alpha1.LogicalClusterLimitsSpec{
+				ObjectCounts: []corev1alpha1.ResourceObjectCountLimit{{Resource: "secrets", Max: 10}},
+			},
+			scanned:               true,
+			withoutLogicalCluster: true,
+		},
+		"no change": {
+			spec: corev1alpha1.LogicalClusterLimitsSpec{
+				ObjectCounts: []corev1alpha1.ResourceObjectCountLimit{{Resource: "secrets", Max: 10}},
+			},
+			status: corev1alpha1.LogicalClusterLimitsStatus{
+				ObjectCounts: []corev1alpha1.ResourceObjectCount{{Resource: "secrets", Max: 10, Count: 2}},
+			},
+			scanned: true,
+		},
+	}
+
+	counts := map[schema.GroupResource]int64{
+		{Resource: "configmaps"}: 7,
+		{Resource: "secrets"}:    2,
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var committed *corev1alpha1.LogicalClusterLimitsStatus
+			r := &StatusReporter{
+				getLogicalCluster: func(cluster logicalcluster.Name) (*corev1alpha1.LogicalCluster, error) {
+					require.Equal(t, logicalcluster.Name("root:ws"), cluster)
+					if tc.withoutLogicalCluster {
+						return nil, apierrors.NewNotFound(corev1alpha1.Resource("logicalclusters"), corev1alpha1.LogicalClusterName)
+					}
+					return &corev1alpha1.LogicalCluster{
+						ObjectMeta: metav1.ObjectMeta{Name: corev1alpha1.LogicalClusterName, Annotations: tc.annotations},
+					}, nil
+				},
+				listLogicalClusterLimits: func() ([]*corev1alpha1.LogicalClusterLimits, error) {
+					return []*corev1alpha1.LogicalClusterLimits{{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        corev1alpha1.LogicalClusterLimitsName,
+							Annotations: map[string]string{logicalcluster.AnnotationKey: "root:ws"},
+						},
+						Spec:   tc.spec,
+						Status: *tc.status.DeepCopy(),
+					}}, nil
+				},
+				countObjects: func(_ logicalcluster.Name, resource schema.GroupResource) (int64, bool) {
+					if !tc.scanned {
+						return 0, false
+					}
+					return counts[resource], true
+				},
+				commit: func(_ context.Context, old, new *Resource) error {
+					if !equality.Semantic.DeepEqual(old.Status, new.Status) {
+						committed = new.Status
+					}
+					return nil
+				},
+			}
+
+			require.NoError(t, r.report(context.Background()))
+			if tc.wantStatus == nil {
+				require.Nil(t, committed)
+				return
+			}
+			require.Equal(t, tc.wantStatus, committed)
+		})
+	}
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logicalclusterpath
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	kcpcache "github.com/kcp-dev/apimachinery/v2/pkg/cache"
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+	cachev1alpha1 "github.com/kcp-dev/sdk/apis/cache/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/core"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
+	apisv1alpha2informers "github.com/kcp-dev/sdk/client/informers/externalversions/apis/v1alpha2"
+	cachev1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/cache/v1alpha1"
+	corev1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/core/v1alpha1"
+	tenancyv1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/tenancy/v1alpha1"
+
+	"github.com/kcp-dev/kcp/pkg/logging"
+)
+
+const (
+	ControllerName = "kcp-logicalcluster-path"
+)
+
+// pathAnnotatedResource lists and patches the objects of a resource which
+// carry the kcp.io/path annotation of their logical cluster.
+type pathAnnotatedResource struct {
+	list  func(clusterName logicalcluster.Name) ([]metav1.Object, error)
+	patch func(ctx context.Context, cluster logicalcluster.Path, name string, patch []byte) error
+}
+
+// NewController returns a controller which updates the kcp.io/path annotation
+// of the objects in the logical clusters on this shard when the path of the
+// logical cluster changes, i.e. when its workspace or one of its ancestors is
+// moved. These are the resources whose annotation is maintained by the
+// kcp.io/PathAnnotation admission plugin, and which are looked up by path.
+func NewController(
+	kcpClusterClient kcpclientset.ClusterInterface,
+	logicalClusterInformer corev1alpha1informers.LogicalClusterClusterInformer,
+	apiExportInformer apisv1alpha2informers.APIExportClusterInformer,
+	apiBindingInformer apisv1alpha2informers.APIBindingClusterInformer,
+	workspaceTypeInformer tenancyv1alpha1informers.WorkspaceTypeClusterInformer,
+	workspaceQuotaInformer tenancyv1alpha1informers.WorkspaceQuotaClusterInformer,
+	clusterCachedResourceInformer cachev1alpha1informers.ClusterCachedResourceClusterInformer,
+) *Controller {
+	c := &Controller{
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name: ControllerName,
+			},
+		),
+		getLogicalCluster: func(clusterName logicalcluster.Name) (*corev1alpha1.LogicalCluster, error) {
+			return logicalClusterInformer.Lister().Cluster(clusterName).Get(corev1alpha1.LogicalClusterName)
+		},
+		resources: map[schema.GroupResource]pathAnnotatedResource{
+			apisv1alpha2.Resource("apiexports"): {
+				list: func(clusterName logicalcluster.Name) ([]metav1.Object, error) {
+					return objects(apiExportInformer.Lister().Cluster(clusterName).List(labels.Everything()))
+				},
+				patch: func(ctx context.Context, cluster logicalcluster.Path, name string, patch []byte) error {
+					_, err := kcpClusterClient.Cluster(cluster).ApisV1alpha2().APIExports().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+					return err
+				},
+			},
+			apisv1alpha2.Resource("apibindings"): {
+				list: func(clusterName logicalcluster.Name) ([]metav1.Object, error) {
+					return objects(apiBindingInformer.Lister().Cluster(clusterName).List(labels.Everything()))
+				},
+				patch: func(ctx context.Context, cluster logicalcluster.Path, name string, patch []byte) error {
+					_, err := kcpClusterClient.Cluster(cluster).ApisV1alpha2().APIBindings().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+					return err
+				},
+			},
+			tenancyv1alpha1.Resource("workspacetypes"): {
+				list: func(clusterName logicalcluster.Name) ([]metav1.Object, error) {
+					return objects(workspaceTypeInformer.Lister().Cluster(clusterName).List(labels.Everything()))
+				},
+				patch: func(ctx context.Context, cluster logicalcluster.Path, name string, patch []byte) error {
+					_, err := kcpClusterClient.Cluster(cluster).TenancyV1alpha1().WorkspaceTypes().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+					return err
+				},
+			},
+			tenancyv1alpha1.Resource("workspacequotas"): {
+				list: func(clusterName logicalcluster.Name) ([]metav1.Object, error) {
+					return objects(workspaceQuotaInformer.Lister().Cluster(clusterName).List(labels.Everything()))
+				},
+				patch: func(ctx context.Context, cluster logicalcluster.Path, name string, patch []byte) error {
+					_, err := kcpClusterClient.Cluster(cluster).TenancyV1alpha1().WorkspaceQuotas().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+					return err
+				},
+			},
+			cachev1alpha1.Resource("clustercachedresources"): {
+				list: func(clusterName logicalcluster.Name) ([]metav1.Object, error) {
+					return objects(clusterCachedResourceInformer.Lister().Cluster(clusterName).List(labels.Everything()))
+				},
+				patch: func(ctx context.Context, cluster logicalcluster.Path, name string, patch []byte) error {
+					_, err := kcpClusterClient.Cluster(cluster).CacheV1alpha1().ClusterCachedResources().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+					return err
+				},
+			},
+		},
+	}
+
+	_, _ = logicalClusterInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) { c.enqueue(obj) },
+		UpdateFunc: func(oldObj, obj interface{}) {
+			oldLogicalCluster, ok := oldObj.(*corev1alpha1.LogicalCluster)
+			if !ok {
+				return
+			}
+			logicalCluster, ok := obj.(*corev1alpha1.LogicalCluster)
+			if !ok {
+				return
+			}
+			if oldLogicalCluster.Annotations[core.LogicalClusterPathAnnotationKey] != logicalCluster.Annotations[core.LogicalClusterPathAnnotationKey] {
+				c.enqueue(obj)
+			}
+		},
+	})
+
+	return c
+}
+
+// Controller updates path annotations after logical cluster moves.
+type Controller struct {
+	queue workqueue.TypedRateLimitingInterface[string]
+
+	getLogicalCluster func(clusterName logicalcluster.Name) (*corev1alpha1.LogicalCluster, error)
+	resources         map[schema.GroupResource]pathAnnotatedResource
+}
+
+func objects[T metav1.Object](items []T, err error) ([]metav1.Object, error) {
+	if err != nil {
+		return nil, err
+	}
+	objs := make([]metav1.Object, 0, len(items))
+	for _, item := range items {
+		objs = append(objs, item)
+	}
+	return objs, nil
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := kcpcache.MetaClusterNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	logger := logging.WithQueueKey(logging.WithReconciler(klog.Background(), ControllerName), key)
+	logger.V(4).Info("queueing LogicalCluster")
+	c.queue.Add(key)
+}
+
+func (c *Controller) Start(ctx context.Context, numThreads int) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	logger := logging.WithReconciler(klog.FromContext(ctx), ControllerName)
+	ctx = klog.NewContext(ctx, logger)
+	logger.Info("Starting controller")
+	defer logger.Info("Shutting down controller")
+
+	for range numThreads {
+		go wait.UntilWithContext(ctx, c.startWorker, time.Second)
+	}
+
+	<-ctx.Done()
+}
+
+func (c *Controller) startWorker(ctx context.Context) {
+	for c.processNextWorkItem(ctx) {
+	}
+}
+
+func (c *Controller) processNextWorkItem(ctx context.Context) bool {
+	// Wait until there is a new item in the working queue
+	k, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	key := k
+
+	logger := logging.WithQueueKey(klog.FromContext(ctx), key)
+	ctx = klog.NewContext(ctx, logger)
+	logger.V(4).Info("processing key")
+
+	// No matter what, tell the queue we're done with this key, to unblock
+	// other workers.
+	defer c.queue.Done(key)
+
+	if err := c.process(ctx, key); err != nil {
+		utilruntime.HandleError(fmt.Errorf("%q controller failed to sync %q, err: %w", ControllerName, key, err))
+		c.queue.AddRateLimited(key)
+		return true
+	}
+	c.queue.Forget(key)
+	return true
+}
+
+func (c *Controller) process(ctx context.Context, key string) error {
+	logger := klog.FromContext(ctx)
+	clusterName, _, _, err := kcpcache.SplitMetaClusterNamespaceKey(key)
+	if err != nil {
+		logger.Error(err, "invalid key")
+		return nil
+	}
+
+	logicalCluster, err := c.getLogicalCluster(clusterName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil // object deleted before we handled it
+		}
+		return err
+	}
+	path := logicalCluster.Annotations[core.LogicalClusterPathAnnotationKey]
+	if path == "" {
+		return nil
+	}
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, core.LogicalClusterPathAnnotationKey, path)
+
+	var errs []error
+	for resource, r := range c.resources {
+		objs, err := r.list(clusterName)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, obj := range objs {
+			if obj.GetAnnotations()[core.LogicalClusterPathAnnotationKey] == path {
+				continue
+			}
+			logger.V(2).Info("updating path annotation", "resource", resource, "name", obj.GetName(), "path", path)
+			if err := r.patch(ctx, clusterName.Path(), obj.GetName(), []byte(patch)); err != nil && !apierrors.IsNotFound(err) {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
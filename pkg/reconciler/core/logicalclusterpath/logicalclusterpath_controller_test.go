@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logicalclusterpath
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+	"github.com/kcp-dev/sdk/apis/core"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+)
+
+func TestProcess(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		name        string
+		notFound    bool
+		path        string
+		objectPaths map[string]string
+
+		wantPatched []string
+	}{
+		{
+			name:        "logical cluster not found",
+			notFound:    true,
+			objectPaths: map[string]string{"a": "root:old"},
+		},
+		{
+			name:        "logical cluster without path",
+			path:        "",
+			objectPaths: map[string]string{"a": "root:old"},
+		},
+		{
+			name:        "objects are up-to-date",
+			path:        "root:new",
+			objectPaths: map[string]string{"a": "root:new", "b": "root:new"},
+		},
+		{
+			name:        "logical cluster was moved",
+			path:        "root:new",
+			objectPaths: map[string]string{"a": "root:old", "b": "root:new", "c": ""},
+			wantPatched: []string{"a", "c"},
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var patched []string
+			resource := pathAnnotatedResource{
+				list: func(clusterName logicalcluster.Name) ([]metav1.Object, error) {
+					require.Equal(t, "test", clusterName.String())
+					var objs []metav1.Object
+					for name, path := range testCase.objectPaths {
+						obj := &apisv1alpha2.APIExport{ObjectMeta: metav1.ObjectMeta{Name: name}}
+						if path != "" {
+							obj.Annotations = map[string]string{core.LogicalClusterPathAnnotationKey: path}
+						}
+						objs = append(objs, obj)
+					}
+					return objs, nil
+				},
+				patch: func(ctx context.Context, cluster logicalcluster.Path, name string, patch []byte) error {
+					require.Equal(t, "test", cluster.String())
+					require.JSONEq(t, `{"metadata":{"annotations":{"kcp.io/path":"root:new"}}}`, string(patch))
+					patched = append(patched, name)
+					return nil
+				},
+			}
+			c := &Controller{
+				getLogicalCluster: func(clusterName logicalcluster.Name) (*corev1alpha1.LogicalCluster, error) {
+					if testCase.notFound {
+						return nil, apierrors.NewNotFound(corev1alpha1.Resource("logicalclusters"), corev1alpha1.LogicalClusterName)
+					}
+					lc := &corev1alpha1.LogicalCluster{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{}}}
+					if testCase.path != "" {
+						lc.Annotations[core.LogicalClusterPathAnnotationKey] = testCase.path
+					}
+					return lc, nil
+				},
+				resources: map[schema.GroupResource]pathAnnotatedResource{
+					tenancyv1alpha1.Resource("workspacetypes"): resource,
+				},
+			}
+
+			err := c.process(context.Background(), "test|cluster")
+			require.NoError(t, err)
+			require.ElementsMatch(t, testCase.wantPatched, patched)
+		})
+	}
+}
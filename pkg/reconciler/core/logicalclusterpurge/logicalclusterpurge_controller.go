@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logicalclusterpurge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
+	corev1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/core/v1alpha1"
+
+	"github.com/kcp-dev/kcp/pkg/logging"
+)
+
+const (
+	ControllerName = "kcp-logicalcluster-purge"
+
+	// interval is the period in which logical clusters past their retention are looked for.
+	interval = time.Minute
+)
+
+// NewController returns a controller which deletes the logical clusters on
+// this shard that have been retained after the deletion of their workspace,
+// once the time in their core.kcp.io/deleted annotation has passed. The
+// deletion then proceeds as usual, i.e. terminators run and the content is
+// removed by the logical cluster deletion controller.
+func NewController(
+	kcpClusterClient kcpclientset.ClusterInterface,
+	logicalClusterInformer corev1alpha1informers.LogicalClusterClusterInformer,
+) *Controller {
+	return &Controller{
+		listLogicalClusters: func() ([]*corev1alpha1.LogicalCluster, error) {
+			return logicalClusterInformer.Lister().List(labels.Everything())
+		},
+		deleteLogicalCluster: func(ctx context.Context, lc *corev1alpha1.LogicalCluster) error {
+			// the preconditions make sure that a concurrent undelete wins
+			return kcpClusterClient.Cluster(logicalcluster.From(lc).Path()).CoreV1alpha1().LogicalClusters().Delete(ctx, lc.Name, metav1.DeleteOptions{
+				Preconditions: &metav1.Preconditions{UID: &lc.UID, ResourceVersion: &lc.ResourceVersion},
+			})
+		},
+		now: time.Now,
+	}
+}
+
+// Controller periodically purges retained logical clusters.
+type Controller struct {
+	listLogicalClusters  func() ([]*corev1alpha1.LogicalCluster, error)
+	deleteLogicalCluster func(ctx context.Context, lc *corev1alpha1.LogicalCluster) error
+	now                  func() time.Time
+}
+
+// Start runs the controller until ctx is done.
+func (c *Controller) Start(ctx context.Context) {
+	logger := logging.WithReconciler(klog.FromContext(ctx), ControllerName)
+	ctx = klog.NewContext(ctx, logger)
+	logger.Info("Starting controller")
+	defer logger.Info("Shutting down controller")
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := c.purgeExpired(ctx); err != nil {
+			logger.Error(err, "failed to purge retained logical clusters")
+		}
+	}, interval)
+}
+
+func (c *Controller) purgeExpired(ctx context.Context) error {
+	logger := klog.FromContext(ctx)
+
+	logicalClusters, err := c.listLogicalClusters()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, lc := range logicalClusters {
+		value, found := lc.Annotations[corev1alpha1.LogicalClusterDeletedAnnotationKey]
+		if !found || !lc.DeletionTimestamp.IsZero() {
+			continue
+		}
+		until, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid %s annotation on logical cluster %s: %w", corev1alpha1.LogicalClusterDeletedAnnotationKey, logicalcluster.From(lc), err))
+			continue
+		}
+		if c.now().Before(until) {
+			continue
+		}
+
+		logger.V(2).Info("purging retained logical cluster", "logicalcluster", logicalcluster.From(lc), "until", until)
+		if err := c.deleteLogicalCluster(ctx, lc); err != nil && !apierrors.IsNotFound(err) && !apierrors.IsConflict(err) {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logicalclusterpurge
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+)
+
+func TestPurgeExpired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	newLogicalCluster := func(annotations map[string]string, deleting bool) *corev1alpha1.LogicalCluster {
+		lc := &corev1alpha1.LogicalCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        corev1alpha1.LogicalClusterName,
+				Annotations: map[string]string{logicalcluster.AnnotationKey: "foo"},
+			},
+		}
+		for k, v := range annotations {
+			lc.Annotations[k] = v
+		}
+		if deleting {
+			lc.DeletionTimestamp = &metav1.Time{Time: now}
+		}
+		return lc
+	}
+
+	tests := map[string]struct {
+		logicalCluster *corev1alpha1.LogicalCluster
+		deleteErr      error
+
+		wantDeleted bool
+		wantErr     bool
+	}{
+		"not deleted is kept": {
+			logicalCluster: newLogicalCluster(nil, false),
+		},
+		"retained until the future is kept": {
+			logicalCluster: newLogicalCluster(map[string]string{corev1alpha1.LogicalClusterDeletedAnnotationKey: "2026-01-01T13:00:00Z"}, false),
+		},
+		"retained until the past is purged": {
+			logicalCluster: newLogicalCluster(map[string]string{corev1alpha1.LogicalClusterDeletedAnnotationKey: "2026-01-01T11:00:00Z"}, false),
+			wantDeleted:    true,
+		},
+		"already deleting is skipped": {
+			logicalCluster: newLogicalCluster(map[string]string{corev1alpha1.LogicalClusterDeletedAnnotationKey: "2026-01-01T11:00:00Z"}, true),
+		},
+		"conflict with an undelete is ignored": {
+			logicalCluster: newLogicalCluster(map[string]string{corev1alpha1.LogicalClusterDeletedAnnotationKey: "2026-01-01T11:00:00Z"}, false),
+			deleteErr:      apierrors.NewConflict(corev1alpha1.Resource("logicalclusters"), corev1alpha1.LogicalClusterName, nil),
+			wantDeleted:    true,
+		},
+		"invalid annotation is an error": {
+			logicalCluster: newLogicalCluster(map[string]string{corev1alpha1.LogicalClusterDeletedAnnotationKey: "tomorrow"}, false),
+			wantErr:        true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var deleted bool
+			c := &Controller{
+				listLogicalClusters: func() ([]*corev1alpha1.LogicalCluster, error) {
+					return []*corev1alpha1.LogicalCluster{tc.logicalCluster}, nil
+				},
+				deleteLogicalCluster: func(ctx context.Context, lc *corev1alpha1.LogicalCluster) error {
+					deleted = true
+					return tc.deleteErr
+				},
+				now: func() time.Time { return now },
+			}
+
+			err := c.purgeExpired(context.Background())
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantDeleted, deleted)
+		})
+	}
+}
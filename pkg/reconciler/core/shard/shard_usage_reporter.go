@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shard
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	"github.com/kcp-dev/sdk/apis/core"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
+	corev1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/core/v1alpha1"
+	tenancyv1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/tenancy/v1alpha1"
+
+	"github.com/kcp-dev/kcp/pkg/logging"
+	"github.com/kcp-dev/kcp/pkg/objectcount"
+	"github.com/kcp-dev/kcp/pkg/reconciler/committer"
+)
+
+const (
+	UsageReporterName = "kcp-shard-usage-reporter"
+)
+
+// NewUsageReporter returns a reporter which periodically writes the capacity
+// and the usage of this shard into the status of its Shard object, for the
+// workspace scheduler to place new workspaces.
+//
+// The given capacity is merged into status.capacity, keys not given are left
+// untouched such that they can still be maintained by hand. status.usage is
+// owned by the reporter: it holds the number of logical clusters on this
+// shard, the number of logical clusters of every WorkspaceType with topology
+// spread constraints of scope Type and, while the object count registry is
+// counting, the total number of objects.
+func NewUsageReporter(
+	shardName string,
+	interval time.Duration,
+	capacity corev1.ResourceList,
+	rootKcpClient kcpclientset.ClusterInterface,
+	globalShardInformer corev1alpha1informers.ShardClusterInformer,
+	globalWorkspaceTypeInformer tenancyv1alpha1informers.WorkspaceTypeClusterInformer,
+	logicalClusterInformer corev1alpha1informers.LogicalClusterClusterInformer,
+	registry *objectcount.Registry,
+) *UsageReporter {
+	return &UsageReporter{
+		interval: interval,
+		capacity: capacity,
+
+		getShard: func() (*corev1alpha1.Shard, error) {
+			return globalShardInformer.Cluster(core.RootCluster).Lister().Get(shardName)
+		},
+		listLogicalClusters: func() ([]*corev1alpha1.LogicalCluster, error) {
+			return logicalClusterInformer.Lister().List(labels.Everything())
+		},
+		listWorkspaceTypes: func() ([]*tenancyv1alpha1.WorkspaceType, error) {
+			return globalWorkspaceTypeInformer.Lister().List(labels.Everything())
+		},
+		countObjects: func() (int64, bool) {
+			if !registry.EnforcementActive() && !registry.UsageTracked() {
+				return 0, false
+			}
+			return registry.Total()
+		},
+
+		commit: committer.NewCommitter[*Shard, Patcher, *ShardSpec, *ShardStatus](rootKcpClient.CoreV1alpha1().Shards()),
+	}
+}
+
+// UsageReporter periodically reports the capacity and the usage of this shard.
+type UsageReporter struct {
+	interval time.Duration
+	capacity corev1.ResourceList
+
+	getShard            func() (*corev1alpha1.Shard, error)
+	listLogicalClusters func() ([]*corev1alpha1.LogicalCluster, error)
+	listWorkspaceTypes  func() ([]*tenancyv1alpha1.WorkspaceType, error)
+	countObjects        func() (int64, bool)
+
+	commit CommitFunc
+}
+
+// Start runs the reporter until ctx is done.
+func (r *UsageReporter) Start(ctx context.Context) {
+	logger := logging.WithReconciler(klog.FromContext(ctx), UsageReporterName)
+	ctx = klog.NewContext(ctx, logger)
+
+	logger.Info("Starting reporter")
+	defer logger.Info("Shutting down reporter")
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := r.report(ctx); err != nil {
+			logger.Error(err, "failed to report shard usage")
+		}
+	}, r.interval)
+}
+
+func (r *UsageReporter) report(ctx context.Context) error {
+	logger := klog.FromContext(ctx)
+
+	shard, err := r.getShard()
+	if kerrors.IsNotFound(err) {
+		logger.V(4).Info("Shard not found yet, skipping usage report")
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	logicalClusters, err := r.listLogicalClusters()
+	if err != nil {
+		return err
+	}
+	spreadTypes, err := r.typesSpreadByType()
+	if err != nil {
+		return err
+	}
+
+	updated := shard.DeepCopy()
+	if len(r.capacity) > 0 && updated.Status.Capacity == nil {
+		updated.Status.Capacity = corev1.ResourceList{}
+	}
+	for name, quantity := range r.capacity {
+		updated.Status.Capacity[name] = quantity
+	}
+	updated.Status.Usage = corev1.ResourceList{
+		corev1alpha1.ShardCapacityLogicalClusters: *resource.NewQuantity(int64(len(logicalClusters)), resource.DecimalSI),
+	}
+	perType := map[string]int64{}
+	for _, lc := range logicalClusters {
+		if t := lc.Annotations[tenancyv1alpha1.LogicalClusterTypeAnnotationKey]; spreadTypes.Has(t) {
+			perType[t]++
+		}
+	}
+	for t, count := range perType {
+		updated.Status.Usage[corev1alpha1.ShardUsageLogicalClustersOfTypePrefix+corev1.ResourceName(t)] = *resource.NewQuantity(count, resource.DecimalSI)
+	}
+	if objects, ok := r.countObjects(); ok {
+		updated.Status.Usage[corev1alpha1.ShardCapacityObjects] = *resource.NewQuantity(objects, resource.DecimalSI)
+	}
+
+	oldResource := &Resource{ObjectMeta: shard.ObjectMeta, Spec: &shard.Spec, Status: &shard.Status}
+	newResource := &Resource{ObjectMeta: updated.ObjectMeta, Spec: &updated.Spec, Status: &updated.Status}
+	return r.commit(ctx, oldResource, newResource)
+}
+
+// typesSpreadByType returns the WorkspaceTypes with topology spread constraints
+// of scope Type, in the format of the tenancyv1alpha1.LogicalClusterTypeAnnotationKey
+// annotation.
+func (r *UsageReporter) typesSpreadByType() (sets.Set[string], error) {
+	wts, err := r.listWorkspaceTypes()
+	if err != nil {
+		return nil, err
+	}
+	types := sets.New[string]()
+	for _, wt := range wts {
+		for _, constraint := range wt.Spec.TopologySpreadConstraints {
+			if constraint.Scope != tenancyv1alpha1.WorkspaceTopologySpreadScopeType {
+				continue
+			}
+			path := logicalcluster.NewPath(wt.Annotations[core.LogicalClusterPathAnnotationKey])
+			if path.Empty() {
+				path = logicalcluster.From(wt).Path()
+			}
+			types.Insert(path.Join(wt.Name).String())
+		}
+	}
+	return types, nil
+}
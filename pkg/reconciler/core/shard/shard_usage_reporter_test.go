@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shard
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kcp-dev/sdk/apis/core"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+)
+
+func TestUsageReporterReport(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		capacity       corev1.ResourceList
+		status         corev1alpha1.ShardStatus
+		objects        int64
+		objectsKnown   bool
+		workspaceTypes []*tenancyv1alpha1.WorkspaceType
+		wantStatus     *corev1alpha1.ShardStatus
+	}{
+		"reports logical clusters and objects": {
+			objects:      1000,
+			objectsKnown: true,
+			wantStatus: &corev1alpha1.ShardStatus{
+				Usage: corev1.ResourceList{
+					corev1alpha1.ShardCapacityLogicalClusters: resource.MustParse("3"),
+					corev1alpha1.ShardCapacityObjects:         resource.MustParse("1000"),
+				},
+			},
+		},
+		"merges configured capacity and keeps other keys": {
+			capacity: corev1.ResourceList{corev1alpha1.ShardCapacityLogicalClusters: resource.MustParse("100")},
+			status: corev1alpha1.ShardStatus{
+				Capacity: corev1.ResourceList{
+					corev1alpha1.ShardCapacityLogicalClusters: resource.MustParse("10"),
+					corev1alpha1.ShardCapacityObjects:         resource.MustParse("5000"),
+				},
+			},
+			wantStatus: &corev1alpha1.ShardStatus{
+				Capacity: corev1.ResourceList{
+					corev1alpha1.ShardCapacityLogicalClusters: resource.MustParse("100"),
+					corev1alpha1.ShardCapacityObjects:         resource.MustParse("5000"),
+				},
+				Usage: corev1.ResourceList{
+					corev1alpha1.ShardCapacityLogicalClusters: resource.MustParse("3"),
+				},
+			},
+		},
+		"drops object usage while objects are not counted": {
+			status: corev1alpha1.ShardStatus{
+				Usage: corev1.ResourceList{
+					corev1alpha1.ShardCapacityLogicalClusters: resource.MustParse("2"),
+					corev1alpha1.ShardCapacityObjects:         resource.MustParse("1000"),
+				},
+			},
+			wantStatus: &corev1alpha1.ShardStatus{
+				Usage: corev1.ResourceList{
+					corev1alpha1.ShardCapacityLogicalClusters: resource.MustParse("3"),
+				},
+			},
+		},
+		"reports logical clusters of types spread by type": {
+			workspaceTypes: []*tenancyv1alpha1.WorkspaceType{
+				workspaceType("team", tenancyv1alpha1.WorkspaceTopologySpreadScopeType),
+				workspaceType("org", tenancyv1alpha1.WorkspaceTopologySpreadScopeSiblings),
+			},
+			wantStatus: &corev1alpha1.ShardStatus{
+				Usage: corev1.ResourceList{
+					corev1alpha1.ShardCapacityLogicalClusters:                        resource.MustParse("3"),
+					corev1alpha1.ShardUsageLogicalClustersOfTypePrefix + "root:team": resource.MustParse("2"),
+				},
+			},
+		},
+		"no change": {
+			status: corev1alpha1.ShardStatus{
+				Usage: corev1.ResourceList{
+					corev1alpha1.ShardCapacityLogicalClusters: resource.MustParse("3"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var committed *corev1alpha1.ShardStatus
+			r := &UsageReporter{
+				capacity: tc.capacity,
+				getShard: func() (*corev1alpha1.Shard, error) {
+					return &corev1alpha1.Shard{
+						ObjectMeta: metav1.ObjectMeta{Name: "shard-1"},
+						Status:     *tc.status.DeepCopy(),
+					}, nil
+				},
+				listLogicalClusters: func() ([]*corev1alpha1.LogicalCluster, error) {
+					return []*corev1alpha1.LogicalCluster{
+						logicalClusterOfType("root:team"),
+						logicalClusterOfType("root:team"),
+						logicalClusterOfType("root:org"),
+					}, nil
+				},
+				listWorkspaceTypes: func() ([]*tenancyv1alpha1.WorkspaceType, error) { return tc.workspaceTypes, nil },
+				countObjects:       func() (int64, bool) { return tc.objects, tc.objectsKnown },
+				commit: func(_ context.Context, old, new *Resource) error {
+					if !equality.Semantic.DeepEqual(old.Status, new.Status) {
+						committed = new.Status
+					}
+					return nil
+				},
+			}
+
+			require.NoError(t, r.report(context.Background()))
+			if tc.wantStatus == nil {
+				require.Nil(t, committed)
+				return
+			}
+			require.NotNil(t, committed)
+			require.True(t, equality.Semantic.DeepEqual(tc.wantStatus, committed), "got %#v", committed)
+		})
+	}
+}
+
+func logicalClusterOfType(t string) *corev1alpha1.LogicalCluster {
+	return &corev1alpha1.LogicalCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{tenancyv1alpha1.LogicalClusterTypeAnnotationKey: t},
+		},
+	}
+}
+
+func workspaceType(name string, scope tenancyv1alpha1.WorkspaceTopologySpreadScope) *tenancyv1alpha1.WorkspaceType {
+	return &tenancyv1alpha1.WorkspaceType{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{core.LogicalClusterPathAnnotationKey: "root"},
+		},
+		Spec: tenancyv1alpha1.WorkspaceTypeSpec{
+			TopologySpreadConstraints: []tenancyv1alpha1.WorkspaceTopologySpreadConstraint{
+				{SpreadBy: "topology.kcp.io/region", Scope: scope},
+			},
+		},
+	}
+}
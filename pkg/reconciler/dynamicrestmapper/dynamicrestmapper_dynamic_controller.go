@@ -441,7 +441,7 @@ func (c *DynamicTypesController) gatherGVKRsForAPIBinding(apiBinding *apisv1alph
 
 	var gvkrs []typeMeta
 
-	for _, resourceSchema := range apiExport.Spec.Resources {
+	for _, resourceSchema := range apiExport.BindableResources() {
 		sch, err := c.getAPIResourceSchema(logicalcluster.From(apiExport), resourceSchema.Schema)
 		if err != nil {
 			return nil, err
@@ -62,7 +62,12 @@ func (c *Controller) ensureBoundCRDs(ctx context.Context, lcName logicalcluster.
 			return fmt.Errorf("failed to get APIExport %s:%s for binding %s: %w", exportPath, exportName, binding.Name, err)
 		}
 
-		for _, resource := range apiExport.Spec.Resources {
+		resources, _, err := apibinding.RolloutResources(apiExport, &binding)
+		if err != nil {
+			return fmt.Errorf("failed to get resources of APIExport %s:%s for binding %s: %w", exportPath, exportName, binding.Name, err)
+		}
+
+		for _, resource := range resources {
 			schema, err := c.getAPIResourceSchema(logicalcluster.From(apiExport), resource.Schema)
 			if err != nil {
 				return fmt.Errorf("failed to get APIResourceSchema %s in %s: %w", resource.Schema, exportPath, err)
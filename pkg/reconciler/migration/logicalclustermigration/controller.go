@@ -65,6 +65,12 @@ const (
 	// indicate it is currently being migrated. The value is the cluster path
 	// of the LogicalClusterMigration object that triggered the migration.
 	MigratingAnnotationKey = "internal.kcp.io/migrating"
+
+	// MigrationFrozenAnnotationKey is set on the LogicalCluster object by the
+	// origin shard right before it is frozen for the final copy round of a
+	// PreCopy migration. Changing the object ensures it is part of the final
+	// delta, as the destination shard leaves it out of the earlier rounds.
+	MigrationFrozenAnnotationKey = "internal.kcp.io/migration-frozen"
 )
 
 func NewController(
@@ -159,7 +165,7 @@ type Controller struct {
 	// LogicalClusterDump. Extracted as a field (defaulting to
 	// copyPageFromOriginViaHTTP) so reconcileMigrating's pagination and
 	// requeue logic can be unit-tested without a live origin shard.
-	copyPageFromOrigin func(ctx context.Context, lcName logicalcluster.Name, originShardName, continueToken string) (int64, string, error)
+	copyPageFromOrigin func(ctx context.Context, req copyPageRequest) (copyPageResult, error)
 
 	// originClientsMu guards originClients.
 	originClientsMu sync.Mutex
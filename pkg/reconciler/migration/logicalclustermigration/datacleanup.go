@@ -30,10 +30,11 @@ import (
 	kcpetcd "github.com/kcp-dev/kcp/pkg/etcd"
 )
 
-// deleteOriginData removes all etcd data belonging to the given logical cluster.
-func (c *Controller) deleteOriginData(ctx context.Context, lcName logicalcluster.Name) error {
+// deleteLogicalClusterData removes all etcd data belonging to the given logical
+// cluster from the local etcd.
+func (c *Controller) deleteLogicalClusterData(ctx context.Context, lcName logicalcluster.Name) error {
 	logger := klog.FromContext(ctx)
-	logger.V(2).Info("cleaning up origin data via etcd", "logicalCluster", lcName)
+	logger.V(2).Info("cleaning up logical cluster data via etcd", "logicalCluster", lcName)
 
 	prefix := c.etcdStoragePrefix
 	if !strings.HasSuffix(prefix, "/") {
@@ -159,9 +159,10 @@ func collectScan(t *testing.T, kv *fakeKV, prefix string, target logicalcluster.
 }
 
 // fakeKV is a minimal in-memory fake of clientv3.KV for unit-testing range
-// scans. It supports only the operations scanEtcdKeys uses: Get with a
-// range end, optional limit, and optional keys-only. Other methods are not
-// implemented and panic if called.
+// scans. It supports only the operations scanEtcdKeys and deleteStaleKeys
+// use: Get with a range end, optional limit, and optional keys-only, and
+// Delete of a single key. Other methods are not implemented and panic if
+// called.
 type fakeKV struct {
 	kvs map[string]string
 }
@@ -197,8 +198,13 @@ func (f *fakeKV) Get(_ context.Context, key string, opts ...clientv3.OpOption) (
 func (f *fakeKV) Put(context.Context, string, string, ...clientv3.OpOption) (*clientv3.PutResponse, error) {
 	panic("not implemented")
 }
-func (f *fakeKV) Delete(context.Context, string, ...clientv3.OpOption) (*clientv3.DeleteResponse, error) {
-	panic("not implemented")
+func (f *fakeKV) Delete(_ context.Context, key string, _ ...clientv3.OpOption) (*clientv3.DeleteResponse, error) {
+	_, ok := f.kvs[key]
+	delete(f.kvs, key)
+	if !ok {
+		return &clientv3.DeleteResponse{}, nil
+	}
+	return &clientv3.DeleteResponse{Deleted: 1}, nil
 }
 func (f *fakeKV) Compact(context.Context, int64, ...clientv3.CompactOption) (*clientv3.CompactResponse, error) {
 	panic("not implemented")
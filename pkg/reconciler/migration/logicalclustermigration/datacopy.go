@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"strings"
 
+	clientv3 "go.etcd.io/etcd/client/v3"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/klog/v2"
@@ -30,38 +32,67 @@ import (
 	migrationv1alpha1 "github.com/kcp-dev/sdk/apis/migration/v1alpha1"
 	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
 
+	kcpetcd "github.com/kcp-dev/kcp/pkg/etcd"
 	"github.com/kcp-dev/kcp/pkg/virtual/migratingworkspaces"
 )
 
+// copyPageRequest describes a single LogicalClusterDump page to copy from
+// the origin shard.
+type copyPageRequest struct {
+	lcName          logicalcluster.Name
+	originShardName string
+	continueToken   string
+
+	// sinceRevision turns the page into a delta against an earlier copy,
+	// see LogicalClusterDumpSpec.SinceRevision. Local entries in the
+	// page's key range that no longer exist on the origin are deleted.
+	sinceRevision int64
+
+	// skipLogicalCluster leaves the LogicalCluster object out of the copy.
+	// The front-proxy routes the logical cluster to whichever shard last
+	// reported its LogicalCluster object, so it must only appear on the
+	// destination shard once the origin is frozen.
+	skipLogicalCluster bool
+}
+
+// copyPageResult is the outcome of copying a single LogicalClusterDump page.
+type copyPageResult struct {
+	// copied is the number of entries written to or deleted from the
+	// local etcd.
+	copied int64
+	// continueToken is the continue token for the next page. Empty means
+	// the copy is complete.
+	continueToken string
+	// revision is the origin shard's etcd revision the page was read at.
+	revision int64
+}
+
 // copyPageFromOriginViaHTTP requests a single page of a LogicalClusterDump
-// from the origin shard's migrating virtual workspace, starting after
-// continueToken, and writes the returned etcd entries directly to the local
-// shard's etcd.
-//
-// It returns the number of entries written and the continue token for the
-// next page. An empty next continue token means the copy is complete.
+// from the origin shard's migrating virtual workspace and writes the
+// returned etcd entries directly to the local shard's etcd.
 //
 // This is assigned to Controller.copyPageFromOrigin at construction time;
 // reconcileMigrating calls that field rather than this method directly so
 // tests can substitute a fake.
-func (c *Controller) copyPageFromOriginViaHTTP(ctx context.Context, lcName logicalcluster.Name, originShardName, continueToken string) (int64, string, error) {
+func (c *Controller) copyPageFromOriginViaHTTP(ctx context.Context, req copyPageRequest) (copyPageResult, error) {
 	logger := klog.FromContext(ctx)
 
-	shardClient, err := c.acquireOriginClient(lcName, originShardName)
+	shardClient, err := c.acquireOriginClient(req.lcName, req.originShardName)
 	if err != nil {
-		return 0, "", err
+		return copyPageResult{}, err
 	}
-	client := shardClient.Cluster(lcName.Path())
+	client := shardClient.Cluster(req.lcName.Path())
 
-	logger.V(2).Info("requesting logical cluster dump page from origin", "logicalCluster", lcName, "originShard", originShardName, "continue", continueToken)
+	logger.V(2).Info("requesting logical cluster dump page from origin", "logicalCluster", req.lcName, "originShard", req.originShardName, "continue", req.continueToken, "sinceRevision", req.sinceRevision)
 
 	dump, err := client.MigrationV1alpha1().LogicalClusterDumps().Create(ctx, &migrationv1alpha1.LogicalClusterDump{
 		Spec: migrationv1alpha1.LogicalClusterDumpSpec{
-			Continue: continueToken,
+			Continue:      req.continueToken,
+			SinceRevision: req.sinceRevision,
 		},
 	}, metav1.CreateOptions{})
 	if err != nil {
-		return 0, "", fmt.Errorf("failed to dump logical cluster from origin: %w", err)
+		return copyPageResult{}, fmt.Errorf("failed to dump logical cluster from origin: %w", err)
 	}
 
 	destPrefix := c.etcdStoragePrefix
@@ -69,19 +100,96 @@ func (c *Controller) copyPageFromOriginViaHTTP(ctx context.Context, lcName logic
 		destPrefix += "/"
 	}
 
-	logger.V(2).Info("writing dump page entries to local etcd", "logicalCluster", lcName, "entries", len(dump.Status.Entries))
+	logger.V(2).Info("writing dump page entries to local etcd", "logicalCluster", req.lcName, "entries", len(dump.Status.Entries))
 
+	var copied int64
+	keep := make(map[string]struct{}, len(dump.Status.Entries))
 	for _, entry := range dump.Status.Entries {
 		if err := ctx.Err(); err != nil {
-			return 0, "", err
+			return copyPageResult{}, err
 		}
 		key := destPrefix + strings.TrimPrefix(entry.Key, "/")
+		keep[key] = struct{}{}
+		if len(entry.Value) == 0 {
+			// unchanged since req.sinceRevision
+			continue
+		}
+		if req.skipLogicalCluster && isLogicalClusterKey(entry.Key, req.lcName) {
+			continue
+		}
 		if _, err := c.etcdClient.Put(ctx, key, string(entry.Value)); err != nil {
-			return 0, "", fmt.Errorf("failed to write etcd key %q: %w", key, err)
+			return copyPageResult{}, fmt.Errorf("failed to write etcd key %q: %w", key, err)
 		}
+		copied++
 	}
 
-	return int64(len(dump.Status.Entries)), dump.Status.Continue, nil
+	if req.sinceRevision > 0 {
+		deleted, err := deleteStaleKeys(ctx, c.etcdClient, destPrefix, req.lcName, req.continueToken, dump.Status.Continue, keep)
+		if err != nil {
+			return copyPageResult{}, err
+		}
+		if deleted > 0 {
+			logger.V(2).Info("deleted entries removed on origin since last copy round", "logicalCluster", req.lcName, "deleted", deleted)
+		}
+		copied += deleted
+	}
+
+	return copyPageResult{
+		copied:        copied,
+		continueToken: dump.Status.Continue,
+		revision:      dump.Status.Revision,
+	}, nil
+}
+
+// isLogicalClusterKey returns whether the storage key, with the storage
+// prefix stripped, is the LogicalCluster object of lcName.
+func isLogicalClusterKey(key string, lcName logicalcluster.Name) bool {
+	split, ok := kcpetcd.SplitKey("", key, lcName)
+	return ok && split.Cluster == lcName && split.Group == core.GroupName && split.Resource == "logicalclusters"
+}
+
+// deleteStaleKeys deletes the local etcd keys of lcName in the key range
+// [from, to) of a dump page that are not in keep. An empty from starts at
+// the beginning of the keyspace, an empty to runs to its end. from and to
+// are relative to prefix, keep holds absolute keys.
+//
+// A delta page lists every key of the logical cluster in its range, so any
+// local key missing from it was deleted on the origin shard since the
+// previous copy round.
+func deleteStaleKeys(ctx context.Context, kv clientv3.KV, prefix string, lcName logicalcluster.Name, from, to string, keep map[string]struct{}) (int64, error) {
+	key := prefix + strings.TrimPrefix(from, "/")
+	end := clientv3.GetPrefixRangeEnd(prefix)
+	if to != "" {
+		end = prefix + strings.TrimPrefix(to, "/")
+	}
+
+	var deleted int64
+	for {
+		resp, err := kv.Get(ctx, key,
+			clientv3.WithRange(end),
+			clientv3.WithKeysOnly(),
+			clientv3.WithLimit(kcpetcd.ScanPageSize),
+		)
+		if err != nil {
+			return deleted, fmt.Errorf("failed to list etcd keys: %w", err)
+		}
+
+		for _, entry := range resp.Kvs {
+			k := string(entry.Key)
+			if _, ok := keep[k]; ok || !kcpetcd.BelongsToCluster(prefix, k, lcName) {
+				continue
+			}
+			if _, err := kv.Delete(ctx, k); err != nil {
+				return deleted, fmt.Errorf("failed to delete etcd key %q: %w", k, err)
+			}
+			deleted++
+		}
+
+		if !resp.More {
+			return deleted, nil
+		}
+		key = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+	}
 }
 
 // acquireOriginClient returns the shared, cluster-aware client for
@@ -17,6 +17,7 @@ limitations under the License.
 package logicalclustermigration
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -166,3 +167,63 @@ func TestReleaseOriginClient_neverAcquiredIsANoop(t *testing.T) {
 		c.releaseOriginClient(logicalcluster.Name("lc-1"), "shard-that-does-not-exist")
 	})
 }
+
+func TestDeleteStaleKeys_deletesOnlyMissingKeysOfTheClusterInRange(t *testing.T) {
+	t.Parallel()
+
+	prefix := "/registry/"
+	target := logicalcluster.Name("root:ws")
+
+	kv := newFakeKV(map[string]string{
+		"/registry/apps/deployments/root:ws/default/a": "v",
+		"/registry/apps/deployments/root:ws/default/b": "v",
+		"/registry/apps/deployments/root:ws/default/c": "v",
+		"/registry/apps/deployments/root:ws/default/d": "v",
+		// other cluster in range, must be kept
+		"/registry/apps/deployments/root:other/default/x": "v",
+	})
+
+	keep := map[string]struct{}{
+		"/registry/apps/deployments/root:ws/default/b": {},
+	}
+
+	// The page covered [a, d): a and c were deleted on the origin, d
+	// belongs to the next page and must not be touched.
+	deleted, err := deleteStaleKeys(context.Background(), kv, prefix, target, "apps/deployments/root:ws/default/a", "apps/deployments/root:ws/default/d", keep)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), deleted)
+
+	require.Equal(t, map[string]string{
+		"/registry/apps/deployments/root:ws/default/b":    "v",
+		"/registry/apps/deployments/root:ws/default/d":    "v",
+		"/registry/apps/deployments/root:other/default/x": "v",
+	}, kv.kvs)
+}
+
+func TestDeleteStaleKeys_emptyBoundsCoverTheWholeKeyspace(t *testing.T) {
+	t.Parallel()
+
+	prefix := "/registry/"
+	target := logicalcluster.Name("root:ws")
+
+	kv := newFakeKV(map[string]string{
+		"/registry/apps/deployments/root:ws/default/a": "v",
+		"/registry/core/configmaps/root:ws/default/b":  "v",
+	})
+
+	deleted, err := deleteStaleKeys(context.Background(), kv, prefix, target, "", "", map[string]struct{}{})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), deleted)
+	require.Empty(t, kv.kvs)
+}
+
+func TestIsLogicalClusterKey(t *testing.T) {
+	t.Parallel()
+
+	target := logicalcluster.Name("2x8xoy5yhq6mlkh9")
+
+	require.True(t, isLogicalClusterKey("core.kcp.io/logicalclusters/customresources/2x8xoy5yhq6mlkh9/cluster", target))
+	require.True(t, isLogicalClusterKey("core.kcp.io/logicalclusters/2x8xoy5yhq6mlkh9/cluster", target))
+	require.False(t, isLogicalClusterKey("core.kcp.io/logicalclusters/customresources/other/cluster", target))
+	require.False(t, isLogicalClusterKey("core/configmaps/2x8xoy5yhq6mlkh9/default/cm", target))
+}
@@ -52,4 +52,43 @@ limitations under the License.
 // The front-proxy automatically bars access to the LC and routes
 // requests to the new shard by discovering the objects on the shards
 // directly.
+//
+// With the PreCopy strategy the origin shard keeps serving the LC while
+// the bulk of the data is copied, which shortens the time the LC is
+// unavailable:
+//
+// Origin shard (reconcilePreparing):
+//
+//   - Sets itself in the .status.originShard
+//   - Allows the LC to be dumped without freezing it
+//
+// Destination shard (reconcilePreCopying):
+//
+//   - Copies all objects except the LC itself, then repeatedly copies
+//     only the entries modified since the previous round and deletes
+//     those removed on the origin in the meantime
+//   - Moves on once a round copies no more than .spec.preCopy.freezeThreshold
+//     entries or .spec.preCopy.maxRounds is reached
+//
+// Origin shard (reconcileFreezing):
+//
+//   - Annotates the LC as frozen so it is part of the final delta
+//   - Freezes the LC like reconcilePreparing does for the Freeze strategy
+//
+// Destination shard (reconcileMigrating) then only copies the final
+// delta, after which the migration continues as above.
+//
+// Setting .spec.abort before the OriginCleanup phase rolls the migration
+// back through the Aborting phase:
+//
+// Destination shard (reconcileAbortingDestination):
+//
+//   - Deletes all data copied so far from etcd
+//
+// Origin shard (reconcileAbortingOrigin):
+//
+//   - Unfreezes the LC and resyncs all informers
+//   - Waits for the destination shard to be cleaned
+//   - Updates the LC to remove the annotations, which routes the
+//     front-proxy back to the origin shard
 package logicalclustermigration
@@ -25,7 +25,8 @@ import (
 
 // MigratingLogicalClusters tracks which logical clusters are in migration.
 type MigratingLogicalClusters struct {
-	clusters sync.Map // logicalcluster.Name → string (migration object path)
+	clusters   sync.Map // logicalcluster.Name → string (migration object path)
+	preCopying sync.Map // logicalcluster.Name → string (migration object path)
 }
 
 func NewMigratingLogicalClusters() *MigratingLogicalClusters {
@@ -43,9 +44,21 @@ func (m *MigratingLogicalClusters) Set(name logicalcluster.Name, migrationPath s
 	return nil
 }
 
-// Remove marks a logical cluster as no longer migrating.
+// SetPreCopying marks a logical cluster as the origin of a PreCopy
+// migration. Unlike Set this does not block access to the logical cluster,
+// it only allows its data to be dumped while it stays writable.
+func (m *MigratingLogicalClusters) SetPreCopying(name logicalcluster.Name, migrationPath string) error {
+	cur, loaded := m.preCopying.LoadOrStore(name, migrationPath)
+	if loaded && cur != migrationPath {
+		return fmt.Errorf("tried to register logical cluster %s as pre-copying that was already pre-copying from a different path: existing=%s new=%s", name, cur, migrationPath)
+	}
+	return nil
+}
+
+// Remove marks a logical cluster as no longer migrating or pre-copying.
 func (m *MigratingLogicalClusters) Remove(name logicalcluster.Name) {
 	m.clusters.Delete(name)
+	m.preCopying.Delete(name)
 }
 
 // Get returns the migration object path for a migrating logical cluster.
@@ -62,3 +75,13 @@ func (m *MigratingLogicalClusters) IsMigrating(name logicalcluster.Name) bool {
 	_, ok := m.clusters.Load(name)
 	return ok
 }
+
+// IsDumpable returns whether the data of the logical cluster may be dumped
+// for a migration, i.e. whether it is either migrating or pre-copying.
+func (m *MigratingLogicalClusters) IsDumpable(name logicalcluster.Name) bool {
+	if m.IsMigrating(name) {
+		return true
+	}
+	_, ok := m.preCopying.Load(name)
+	return ok
+}
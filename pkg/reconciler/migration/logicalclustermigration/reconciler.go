@@ -42,10 +42,17 @@ func (c *Controller) reconcile(ctx context.Context, migration *migrationv1alpha1
 		return false, nil
 	}
 
+	if migration.Spec.Abort && startAbort(migration) {
+		logger.V(2).Info("migration aborted, transitioning to Aborting", "logicalCluster", migration.Spec.LogicalCluster)
+		return true, nil
+	}
+
 	// Route through the process. The overview of the process id
 	// described in the doc.go, details are in the methods.
 	switch migration.Status.Phase {
-	case migrationv1alpha1.LogicalClusterMigrationPhaseCompleted, migrationv1alpha1.LogicalClusterMigrationPhaseFailed:
+	case migrationv1alpha1.LogicalClusterMigrationPhaseCompleted,
+		migrationv1alpha1.LogicalClusterMigrationPhaseFailed,
+		migrationv1alpha1.LogicalClusterMigrationPhaseRolledBack:
 		return false, nil
 
 	case "":
@@ -61,6 +68,21 @@ func (c *Controller) reconcile(ctx context.Context, migration *migrationv1alpha1
 		}
 		return false, nil
 
+	case migrationv1alpha1.LogicalClusterMigrationPhasePreCopying:
+		if isOrigin {
+			return c.reconcilePreCopyingOrigin(ctx, migration)
+		}
+		if isDestination {
+			return c.reconcilePreCopying(ctx, migration)
+		}
+		return false, nil
+
+	case migrationv1alpha1.LogicalClusterMigrationPhaseFreezing:
+		if isOrigin {
+			return c.reconcileFreezing(ctx, migration)
+		}
+		return false, nil
+
 	case migrationv1alpha1.LogicalClusterMigrationPhaseMigrating:
 		if isDestination {
 			return c.reconcileMigrating(ctx, migration)
@@ -79,6 +101,15 @@ func (c *Controller) reconcile(ctx context.Context, migration *migrationv1alpha1
 		}
 		return false, nil
 
+	case migrationv1alpha1.LogicalClusterMigrationPhaseAborting:
+		if isDestination && !conditions.IsTrue(migration, migrationv1alpha1.LCMigrationDestinationCleaned) {
+			return c.reconcileAbortingDestination(ctx, migration)
+		}
+		if isOrigin {
+			return c.reconcileAbortingOrigin(ctx, migration)
+		}
+		return false, nil
+
 	default:
 		logger.V(2).Info("unknown phase", "phase", migration.Status.Phase)
 		return false, nil
@@ -134,12 +165,42 @@ func (c *Controller) reconcilePreparing(ctx context.Context, migration *migratio
 		}
 	}
 
+	if migration.Spec.Strategy == migrationv1alpha1.LogicalClusterMigrationStrategyPreCopy {
+		// Keep the LC writable while the bulk of the data is copied,
+		// only allow the destination shard to dump it. It is frozen for
+		// the final copy round in reconcileFreezing.
+		if err := c.migratingLogicalClusters.SetPreCopying(lcName, migrationPath); err != nil {
+			return false, err
+		}
+
+		conditions.MarkTrue(migration, migrationv1alpha1.LCMigrationOriginReady)
+		migration.Status.Phase = migrationv1alpha1.LogicalClusterMigrationPhasePreCopying
+
+		logger.V(2).Info("origin prepared, transitioning to PreCopying", "logicalCluster", lcName)
+		return false, nil
+	}
+
+	if err := c.freezeOrigin(lcName, migrationPath); err != nil {
+		return false, err
+	}
+
+	// Phase is done, update LCMigration
+	conditions.MarkTrue(migration, migrationv1alpha1.LCMigrationOriginReady)
+	migration.Status.Phase = migrationv1alpha1.LogicalClusterMigrationPhaseMigrating
+
+	logger.V(2).Info("origin prepared, transitioning to Migrating", "logicalCluster", lcName)
+	return false, nil
+}
+
+// freezeOrigin blocks all access to the logical cluster on the origin shard
+// and drops it from the local informers.
+func (c *Controller) freezeOrigin(lcName logicalcluster.Name, migrationPath string) error {
 	// Register in MigratingLogicalClusters, this causes the handler to
 	// prevent deny access and the DDSIF to ignore objects related to
 	// the LC
 	if !c.migratingLogicalClusters.IsMigrating(lcName) {
 		if err := c.migratingLogicalClusters.Set(lcName, migrationPath); err != nil {
-			return false, err
+			return err
 		}
 	}
 
@@ -150,11 +211,146 @@ func (c *Controller) reconcilePreparing(ctx context.Context, migration *migratio
 	// Purge informer stores for this cluster
 	c.ddsif.PurgeCluster(lcName)
 
-	// Phase is done, update LCMigration
-	conditions.MarkTrue(migration, migrationv1alpha1.LCMigrationOriginReady)
+	return nil
+}
+
+// reconcilePreCopyingOrigin keeps the origin shard serving dumps of the
+// logical cluster while the destination shard pre-copies it. The
+// registration is in-memory only, so it is renewed here in case the origin
+// shard restarted since reconcilePreparing.
+func (c *Controller) reconcilePreCopyingOrigin(_ context.Context, migration *migrationv1alpha1.LogicalClusterMigration) (bool, error) {
+	lcName := logicalcluster.Name(migration.Spec.LogicalCluster)
+	migrationPath := logicalcluster.From(migration).Path().Join(migration.Name).String()
+	return false, c.migratingLogicalClusters.SetPreCopying(lcName, migrationPath)
+}
+
+func (c *Controller) reconcilePreCopying(ctx context.Context, migration *migrationv1alpha1.LogicalClusterMigration) (bool, error) {
+	logger := klog.FromContext(ctx)
+	lcName := logicalcluster.Name(migration.Spec.LogicalCluster)
+
+	// Register in MigratingLogicalClusters to prevent reconciles of the
+	// objects written to the local etcd.
+	migrationPath := logicalcluster.From(migration).Path().Join(migration.Name).String()
+	if !c.migratingLogicalClusters.IsMigrating(lcName) {
+		if err := c.migratingLogicalClusters.Set(lcName, migrationPath); err != nil {
+			return false, err
+		}
+	}
+	c.ddsif.PurgeCluster(lcName)
+
+	// Copy one page per reconcile, like reconcileMigrating. The first
+	// round copies everything, every later one only what changed on the
+	// origin since the previous round started.
+	result, err := c.copyPageFromOrigin(ctx, copyPageRequest{
+		lcName:             lcName,
+		originShardName:    migration.Status.OriginShard,
+		continueToken:      migration.Status.DumpContinue,
+		sinceRevision:      migration.Status.SyncedRevision,
+		skipLogicalCluster: true,
+	})
+	if err != nil {
+		conditions.MarkFalse(
+			migration,
+			migrationv1alpha1.LCMigrationPreCopied,
+			"CopyFailed",
+			conditionsv1alpha1.ConditionSeverityError,
+			"%v", err,
+		)
+		return true, err
+	}
+
+	requeue := applyPreCopyPageResult(migration, result)
+	if migration.Status.Phase == migrationv1alpha1.LogicalClusterMigrationPhaseFreezing {
+		logger.V(2).Info("data pre-copied, transitioning to Freezing", "logicalCluster", lcName, "rounds", migration.Status.CopyRounds, "lastRoundEntries", migration.Status.RoundEntriesCopied)
+	} else {
+		logger.V(2).Info("pre-copied data page", "logicalCluster", lcName, "round", migration.Status.CopyRounds+1, "entriesCopied", migration.Status.EntriesCopied)
+	}
+	return requeue, nil
+}
+
+const (
+	defaultPreCopyMaxRounds       = 5
+	defaultPreCopyFreezeThreshold = 100
+)
+
+// applyPreCopyPageResult records the result of copying one page during the
+// PreCopying phase onto migration.Status and reports whether
+// reconcilePreCopying should requeue for another page.
+//
+// Once a round completes, its starting revision becomes
+// status.syncedRevision. If the round was small enough, or the maximum
+// number of rounds is reached, the migration moves on to Freezing.
+// Otherwise another round is started.
+func applyPreCopyPageResult(migration *migrationv1alpha1.LogicalClusterMigration, result copyPageResult) (requeue bool) {
+	if migration.Status.DumpContinue == "" {
+		// first page of a new round
+		migration.Status.RoundRevision = result.revision
+		migration.Status.RoundEntriesCopied = 0
+	}
+	migration.Status.EntriesCopied += result.copied
+	migration.Status.RoundEntriesCopied += result.copied
+	migration.Status.DumpContinue = result.continueToken
+	conditions.Delete(migration, migrationv1alpha1.LCMigrationPreCopied)
+
+	if result.continueToken != "" {
+		return true
+	}
+
+	migration.Status.SyncedRevision = migration.Status.RoundRevision
+	migration.Status.RoundRevision = 0
+	migration.Status.CopyRounds++
+
+	maxRounds, freezeThreshold := int32(defaultPreCopyMaxRounds), int64(defaultPreCopyFreezeThreshold)
+	if preCopy := migration.Spec.PreCopy; preCopy != nil {
+		if preCopy.MaxRounds > 0 {
+			maxRounds = preCopy.MaxRounds
+		}
+		if preCopy.FreezeThreshold > 0 {
+			freezeThreshold = preCopy.FreezeThreshold
+		}
+	}
+
+	if migration.Status.RoundEntriesCopied > freezeThreshold && migration.Status.CopyRounds < maxRounds {
+		return true
+	}
+
+	conditions.MarkTrue(migration, migrationv1alpha1.LCMigrationPreCopied)
+	migration.Status.Phase = migrationv1alpha1.LogicalClusterMigrationPhaseFreezing
+	return false
+}
+
+func (c *Controller) reconcileFreezing(ctx context.Context, migration *migrationv1alpha1.LogicalClusterMigration) (bool, error) {
+	logger := klog.FromContext(ctx)
+	lcName := logicalcluster.Name(migration.Spec.LogicalCluster)
+	migrationPath := logicalcluster.From(migration).Path().Join(migration.Name).String()
+
+	if !c.migratingLogicalClusters.IsMigrating(lcName) {
+		// The destination shard left the LogicalCluster object out of
+		// the pre-copy. Changing it here, before the freeze, moves it
+		// past status.syncedRevision so the final round picks it up.
+		lc, err := c.logicalClusterLister.Cluster(lcName).Get(corev1alpha1.LogicalClusterName)
+		if err != nil {
+			return true, fmt.Errorf("failed to get LogicalCluster %s: %w", lcName, err)
+		}
+		if lc.Annotations[MigrationFrozenAnnotationKey] == "" {
+			lcCopy := lc.DeepCopy()
+			if lcCopy.Annotations == nil {
+				lcCopy.Annotations = make(map[string]string)
+			}
+			lcCopy.Annotations[MigrationFrozenAnnotationKey] = "true"
+			if _, err := c.kcpClusterClient.CoreV1alpha1().LogicalClusters().Cluster(lcName.Path()).Update(ctx, lcCopy, metav1.UpdateOptions{}); err != nil {
+				return true, fmt.Errorf("failed to set migration frozen annotation on LogicalCluster %s: %w", lcName, err)
+			}
+		}
+
+		if err := c.freezeOrigin(lcName, migrationPath); err != nil {
+			return false, err
+		}
+	}
+
 	migration.Status.Phase = migrationv1alpha1.LogicalClusterMigrationPhaseMigrating
 
-	logger.V(2).Info("origin prepared, transitioning to Migrating", "logicalCluster", lcName)
+	logger.V(2).Info("origin frozen, transitioning to Migrating", "logicalCluster", lcName)
 	return false, nil
 }
 
@@ -178,7 +374,14 @@ func (c *Controller) reconcileMigrating(ctx context.Context, migration *migratio
 	// status.entriesCopied are persisted after every call, so a shard
 	// restart resumes from the last completed page instead of starting
 	// the copy over.
-	copied, nextContinue, err := c.copyPageFromOrigin(ctx, lcName, migration.Status.OriginShard, migration.Status.DumpContinue)
+	// After a pre-copy status.syncedRevision is set and only the delta
+	// since the last pre-copy round is copied.
+	result, err := c.copyPageFromOrigin(ctx, copyPageRequest{
+		lcName:          lcName,
+		originShardName: migration.Status.OriginShard,
+		continueToken:   migration.Status.DumpContinue,
+		sinceRevision:   migration.Status.SyncedRevision,
+	})
 	if err != nil {
 		conditions.MarkFalse(
 			migration,
@@ -189,7 +392,7 @@ func (c *Controller) reconcileMigrating(ctx context.Context, migration *migratio
 		)
 		return true, err
 	}
-	requeue := applyDumpPageResult(migration, copied, nextContinue)
+	requeue := applyDumpPageResult(migration, result.copied, result.continueToken)
 	if requeue {
 		logger.V(2).Info("copied data page, more remaining", "logicalCluster", lcName, "entriesCopied", migration.Status.EntriesCopied)
 	} else {
@@ -236,7 +439,7 @@ func (c *Controller) reconcileOriginCleanup(ctx context.Context, migration *migr
 	logger := klog.FromContext(ctx)
 	lcName := logicalcluster.Name(migration.Spec.LogicalCluster)
 
-	if err := c.deleteOriginData(ctx, lcName); err != nil {
+	if err := c.deleteLogicalClusterData(ctx, lcName); err != nil {
 		conditions.MarkFalse(
 			migration,
 			migrationv1alpha1.LCMigrationOriginCleaned,
@@ -281,9 +484,10 @@ func (c *Controller) reconcileDestinationFinalize(ctx context.Context, migration
 	if err != nil {
 		return true, fmt.Errorf("failed to get LogicalCluster %s: %w", lcName, err)
 	}
-	if lc.Annotations[MigratingAnnotationKey] != "" {
+	if lc.Annotations[MigratingAnnotationKey] != "" || lc.Annotations[MigrationFrozenAnnotationKey] != "" {
 		lcCopy := lc.DeepCopy()
 		delete(lcCopy.Annotations, MigratingAnnotationKey)
+		delete(lcCopy.Annotations, MigrationFrozenAnnotationKey)
 		if _, err := c.kcpClusterClient.CoreV1alpha1().LogicalClusters().Cluster(lcName.Path()).Update(ctx, lcCopy, metav1.UpdateOptions{}); err != nil {
 			return true, fmt.Errorf("failed to remove migrating annotation from LogicalCluster %s: %w", lcName, err)
 		}
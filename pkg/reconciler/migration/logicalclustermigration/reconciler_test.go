@@ -117,3 +117,72 @@ func TestApplyDumpPageResult_resumesAfterSimulatedRestart(t *testing.T) {
 	require.Empty(t, restarted.Status.DumpContinue)
 	require.Equal(t, migrationv1alpha1.LogicalClusterMigrationPhaseOriginCleanup, restarted.Status.Phase)
 }
+
+func TestApplyPreCopyPageResult_recordsRoundRevisionFromFirstPage(t *testing.T) {
+	t.Parallel()
+
+	migration := &migrationv1alpha1.LogicalClusterMigration{}
+	migration.Status.Phase = migrationv1alpha1.LogicalClusterMigrationPhasePreCopying
+
+	require.True(t, applyPreCopyPageResult(migration, copyPageResult{copied: 10, continueToken: "token-1", revision: 100}))
+	require.True(t, applyPreCopyPageResult(migration, copyPageResult{copied: 10, continueToken: "token-2", revision: 120}))
+
+	require.Equal(t, int64(100), migration.Status.RoundRevision, "the round must start at the revision of its first page")
+	require.Equal(t, int64(20), migration.Status.RoundEntriesCopied)
+	require.Equal(t, "token-2", migration.Status.DumpContinue)
+	require.Zero(t, migration.Status.CopyRounds)
+	require.Zero(t, migration.Status.SyncedRevision)
+}
+
+func TestApplyPreCopyPageResult_startsAnotherRoundWhileDeltaIsLarge(t *testing.T) {
+	t.Parallel()
+
+	migration := &migrationv1alpha1.LogicalClusterMigration{}
+	migration.Status.Phase = migrationv1alpha1.LogicalClusterMigrationPhasePreCopying
+
+	require.True(t, applyPreCopyPageResult(migration, copyPageResult{copied: 5000, revision: 100}))
+
+	require.Equal(t, migrationv1alpha1.LogicalClusterMigrationPhasePreCopying, migration.Status.Phase)
+	require.Equal(t, int32(1), migration.Status.CopyRounds)
+	require.Equal(t, int64(100), migration.Status.SyncedRevision)
+	require.Zero(t, migration.Status.RoundRevision)
+
+	// The next round starts from scratch with its own revision.
+	require.True(t, applyPreCopyPageResult(migration, copyPageResult{copied: 300, revision: 200}))
+	require.Equal(t, int64(300), migration.Status.RoundEntriesCopied)
+	require.Equal(t, int64(200), migration.Status.SyncedRevision)
+	require.Equal(t, int64(5300), migration.Status.EntriesCopied)
+}
+
+func TestApplyPreCopyPageResult_freezesOnceDeltaIsBelowThreshold(t *testing.T) {
+	t.Parallel()
+
+	migration := &migrationv1alpha1.LogicalClusterMigration{}
+	migration.Spec.PreCopy = &migrationv1alpha1.LogicalClusterMigrationPreCopy{FreezeThreshold: 50}
+	migration.Status.Phase = migrationv1alpha1.LogicalClusterMigrationPhasePreCopying
+
+	require.True(t, applyPreCopyPageResult(migration, copyPageResult{copied: 1000, revision: 100}))
+	require.False(t, applyPreCopyPageResult(migration, copyPageResult{copied: 50, revision: 150}))
+
+	require.Equal(t, migrationv1alpha1.LogicalClusterMigrationPhaseFreezing, migration.Status.Phase)
+	require.Equal(t, int64(150), migration.Status.SyncedRevision)
+	require.Equal(t, int32(2), migration.Status.CopyRounds)
+
+	cond := conditions.Get(migration, migrationv1alpha1.LCMigrationPreCopied)
+	require.NotNil(t, cond)
+	require.Equal(t, "True", string(cond.Status))
+}
+
+func TestApplyPreCopyPageResult_freezesAfterMaxRounds(t *testing.T) {
+	t.Parallel()
+
+	migration := &migrationv1alpha1.LogicalClusterMigration{}
+	migration.Spec.PreCopy = &migrationv1alpha1.LogicalClusterMigrationPreCopy{MaxRounds: 2, FreezeThreshold: 10}
+	migration.Status.Phase = migrationv1alpha1.LogicalClusterMigrationPhasePreCopying
+
+	require.True(t, applyPreCopyPageResult(migration, copyPageResult{copied: 1000, revision: 100}))
+	require.False(t, applyPreCopyPageResult(migration, copyPageResult{copied: 1000, revision: 200}), "a busy logical cluster must still be frozen once maxRounds is reached")
+
+	require.Equal(t, migrationv1alpha1.LogicalClusterMigrationPhaseFreezing, migration.Status.Phase)
+	require.Equal(t, int64(200), migration.Status.SyncedRevision)
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logicalclustermigration
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	migrationv1alpha1 "github.com/kcp-dev/sdk/apis/migration/v1alpha1"
+	conditionsv1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+)
+
+// startAbort moves a migration with spec.abort set into the Aborting phase
+// and reports whether it did so.
+//
+// Only migrations that haven't reached OriginCleanup can be rolled back,
+// afterwards the origin shard is already deleting its copy of the data.
+// Those are marked with a RolledBack=False condition instead, so the abort
+// request isn't silently ignored.
+func startAbort(migration *migrationv1alpha1.LogicalClusterMigration) bool {
+	switch migration.Status.Phase {
+	case "",
+		migrationv1alpha1.LogicalClusterMigrationPhasePreparing,
+		migrationv1alpha1.LogicalClusterMigrationPhasePreCopying,
+		migrationv1alpha1.LogicalClusterMigrationPhaseFreezing,
+		migrationv1alpha1.LogicalClusterMigrationPhaseMigrating:
+		migration.Status.Phase = migrationv1alpha1.LogicalClusterMigrationPhaseAborting
+		return true
+
+	case migrationv1alpha1.LogicalClusterMigrationPhaseOriginCleanup,
+		migrationv1alpha1.LogicalClusterMigrationPhaseDestinationFinalize,
+		migrationv1alpha1.LogicalClusterMigrationPhaseCompleted:
+		conditions.MarkFalse(
+			migration,
+			migrationv1alpha1.LCMigrationRolledBack,
+			"OriginCleanupStarted",
+			conditionsv1alpha1.ConditionSeverityWarning,
+			"migration cannot be aborted after the origin shard started deleting its data",
+		)
+	}
+
+	return false
+}
+
+// reconcileAbortingDestination deletes everything copied to the destination
+// shard so far.
+//
+// This runs before the origin shard restores the logical cluster, as the
+// front-proxy routes to the destination shard as soon as it sees the
+// LogicalCluster object there.
+func (c *Controller) reconcileAbortingDestination(ctx context.Context, migration *migrationv1alpha1.LogicalClusterMigration) (bool, error) {
+	logger := klog.FromContext(ctx)
+	lcName := logicalcluster.Name(migration.Spec.LogicalCluster)
+
+	// Keep ignoring the objects of the LC while they are deleted from
+	// the local etcd.
+	migrationPath := logicalcluster.From(migration).Path().Join(migration.Name).String()
+	if !c.migratingLogicalClusters.IsMigrating(lcName) {
+		if err := c.migratingLogicalClusters.Set(lcName, migrationPath); err != nil {
+			return false, err
+		}
+	}
+	c.ddsif.PurgeCluster(lcName)
+
+	if err := c.deleteLogicalClusterData(ctx, lcName); err != nil {
+		conditions.MarkFalse(
+			migration,
+			migrationv1alpha1.LCMigrationDestinationCleaned,
+			"CleanupFailed",
+			conditionsv1alpha1.ConditionSeverityError,
+			"%v", err,
+		)
+		return true, err
+	}
+
+	c.releaseOriginClient(lcName, migration.Status.OriginShard)
+	c.migratingLogicalClusters.Remove(lcName)
+
+	conditions.MarkTrue(migration, migrationv1alpha1.LCMigrationDestinationCleaned)
+
+	logger.V(2).Info("destination cleaned, waiting for origin to roll back", "logicalCluster", lcName)
+	return false, nil
+}
+
+// reconcileAbortingOrigin makes the logical cluster available on the origin
+// shard again.
+//
+// Access is restored right away so an unhealthy destination shard doesn't
+// keep the logical cluster blocked. The annotations are only removed once
+// the destination shard is cleaned up; the resulting update makes the
+// front-proxy route to the origin shard again in case it already picked up
+// the copy on the destination shard.
+func (c *Controller) reconcileAbortingOrigin(ctx context.Context, migration *migrationv1alpha1.LogicalClusterMigration) (bool, error) {
+	logger := klog.FromContext(ctx)
+	lcName := logicalcluster.Name(migration.Spec.LogicalCluster)
+
+	if c.migratingLogicalClusters.IsDumpable(lcName) {
+		frozen := c.migratingLogicalClusters.IsMigrating(lcName)
+		c.migratingLogicalClusters.Remove(lcName)
+		if frozen {
+			// The informers have been ignoring the LC since it was
+			// frozen and need to pick its objects up again.
+			c.ddsif.ForceRelist()
+		}
+		logger.V(2).Info("origin unfrozen", "logicalCluster", lcName)
+	}
+
+	if !conditions.IsTrue(migration, migrationv1alpha1.LCMigrationDestinationCleaned) {
+		logger.V(4).Info("waiting for destination to be cleaned", "logicalCluster", lcName)
+		return false, nil
+	}
+
+	lc, err := c.kcpClusterClient.CoreV1alpha1().LogicalClusters().Cluster(lcName.Path()).Get(ctx, corev1alpha1.LogicalClusterName, metav1.GetOptions{})
+	if err != nil {
+		return true, fmt.Errorf("failed to get LogicalCluster %s: %w", lcName, err)
+	}
+
+	// The migrating annotation might belong to a different migration if
+	// this one was aborted before it could set its own.
+	migrationPath := logicalcluster.From(migration).Path().Join(migration.Name).String()
+	ownsAnnotation := lc.Annotations[MigratingAnnotationKey] == migrationPath
+	if ownsAnnotation || lc.Annotations[MigrationFrozenAnnotationKey] != "" {
+		lcCopy := lc.DeepCopy()
+		if ownsAnnotation {
+			delete(lcCopy.Annotations, MigratingAnnotationKey)
+		}
+		delete(lcCopy.Annotations, MigrationFrozenAnnotationKey)
+		if _, err := c.kcpClusterClient.CoreV1alpha1().LogicalClusters().Cluster(lcName.Path()).Update(ctx, lcCopy, metav1.UpdateOptions{}); err != nil {
+			return true, fmt.Errorf("failed to remove migrating annotation from LogicalCluster %s: %w", lcName, err)
+		}
+	}
+
+	conditions.MarkTrue(migration, migrationv1alpha1.LCMigrationRolledBack)
+	migration.Status.Phase = migrationv1alpha1.LogicalClusterMigrationPhaseRolledBack
+
+	logger.V(2).Info("migration rolled back", "logicalCluster", lcName)
+	return false, nil
+}
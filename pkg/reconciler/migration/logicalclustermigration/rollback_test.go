@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logicalclustermigration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	migrationv1alpha1 "github.com/kcp-dev/sdk/apis/migration/v1alpha1"
+	conditionsv1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+)
+
+func TestStartAbort_abortsBeforeOriginCleanup(t *testing.T) {
+	t.Parallel()
+
+	for _, phase := range []migrationv1alpha1.LogicalClusterMigrationPhaseType{
+		"",
+		migrationv1alpha1.LogicalClusterMigrationPhasePreparing,
+		migrationv1alpha1.LogicalClusterMigrationPhasePreCopying,
+		migrationv1alpha1.LogicalClusterMigrationPhaseFreezing,
+		migrationv1alpha1.LogicalClusterMigrationPhaseMigrating,
+	} {
+		t.Run(string(phase), func(t *testing.T) {
+			t.Parallel()
+
+			migration := &migrationv1alpha1.LogicalClusterMigration{}
+			migration.Status.Phase = phase
+
+			require.True(t, startAbort(migration))
+			require.Equal(t, migrationv1alpha1.LogicalClusterMigrationPhaseAborting, migration.Status.Phase)
+			require.Nil(t, conditions.Get(migration, migrationv1alpha1.LCMigrationRolledBack))
+		})
+	}
+}
+
+func TestStartAbort_marksRolledBackFalseOnceOriginCleanupStarted(t *testing.T) {
+	t.Parallel()
+
+	for _, phase := range []migrationv1alpha1.LogicalClusterMigrationPhaseType{
+		migrationv1alpha1.LogicalClusterMigrationPhaseOriginCleanup,
+		migrationv1alpha1.LogicalClusterMigrationPhaseDestinationFinalize,
+		migrationv1alpha1.LogicalClusterMigrationPhaseCompleted,
+	} {
+		t.Run(string(phase), func(t *testing.T) {
+			t.Parallel()
+
+			migration := &migrationv1alpha1.LogicalClusterMigration{}
+			migration.Status.Phase = phase
+
+			require.False(t, startAbort(migration))
+			require.Equal(t, phase, migration.Status.Phase)
+
+			cond := conditions.Get(migration, migrationv1alpha1.LCMigrationRolledBack)
+			require.NotNil(t, cond)
+			require.Equal(t, "False", string(cond.Status))
+			require.Equal(t, "OriginCleanupStarted", cond.Reason)
+			require.Equal(t, conditionsv1alpha1.ConditionSeverityWarning, cond.Severity)
+		})
+	}
+}
+
+func TestStartAbort_leavesTerminalAndAbortingPhasesAlone(t *testing.T) {
+	t.Parallel()
+
+	for _, phase := range []migrationv1alpha1.LogicalClusterMigrationPhaseType{
+		migrationv1alpha1.LogicalClusterMigrationPhaseFailed,
+		migrationv1alpha1.LogicalClusterMigrationPhaseAborting,
+		migrationv1alpha1.LogicalClusterMigrationPhaseRolledBack,
+	} {
+		t.Run(string(phase), func(t *testing.T) {
+			t.Parallel()
+
+			migration := &migrationv1alpha1.LogicalClusterMigration{}
+			migration.Status.Phase = phase
+
+			require.False(t, startAbort(migration))
+			require.Equal(t, phase, migration.Status.Phase)
+			require.Empty(t, migration.Status.Conditions)
+		})
+	}
+}
@@ -0,0 +1,333 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sharddrain
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	kcpcache "github.com/kcp-dev/apimachinery/v2/pkg/cache"
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	migrationv1alpha1 "github.com/kcp-dev/sdk/apis/migration/v1alpha1"
+	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
+	migrationv1alpha1client "github.com/kcp-dev/sdk/client/clientset/versioned/typed/migration/v1alpha1"
+	corev1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/core/v1alpha1"
+	migrationv1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/migration/v1alpha1"
+
+	"github.com/kcp-dev/kcp/pkg/logging"
+	"github.com/kcp-dev/kcp/pkg/reconciler/committer"
+	"github.com/kcp-dev/kcp/pkg/reconciler/events"
+)
+
+const (
+	ControllerName = "kcp-shard-drain"
+
+	// ShardDrainLabelKey is set on the LogicalClusterMigrations created for a
+	// ShardDrain. The value is the name of the ShardDrain, which lives in the
+	// same logical cluster as its migrations.
+	ShardDrainLabelKey = "migration.kcp.io/shard-drain"
+)
+
+// NewController returns a new controller for ShardDrains.
+//
+// Every shard runs the controller but only acts on the ShardDrains for
+// itself, as only the source shard knows which logical clusters it holds.
+// ShardDrains and LogicalClusterMigrations are watched through the cache
+// server and changed through the front-proxy, like the
+// logicalclustermigration controller does.
+func NewController(
+	shardName string,
+	kcpClusterClient kcpclientset.ClusterInterface,
+	cachedShardDrainInformer migrationv1alpha1informers.ShardDrainClusterInformer,
+	cachedLogicalClusterMigrationInformer migrationv1alpha1informers.LogicalClusterMigrationClusterInformer,
+	cachedShardInformer corev1alpha1informers.ShardClusterInformer,
+	localLogicalClusterInformer corev1alpha1informers.LogicalClusterClusterInformer,
+) (*controller, error) {
+	c := &controller{
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name: ControllerName,
+			},
+		),
+		shardName: shardName,
+		getShardDrain: func(ctx context.Context, clusterName logicalcluster.Name, name string) (*migrationv1alpha1.ShardDrain, error) {
+			return kcpClusterClient.Cluster(clusterName.Path()).MigrationV1alpha1().ShardDrains().Get(ctx, name, metav1.GetOptions{})
+		},
+		listShardDrains: func() ([]*migrationv1alpha1.ShardDrain, error) {
+			return cachedShardDrainInformer.Lister().List(labels.Everything())
+		},
+		// listMigrations calls the API directly instead of using the cache
+		// server informer, as otherwise more migrations than allowed by
+		// spec.concurrency are started when the cache isn't updated quickly
+		// enough.
+		listMigrations: func(ctx context.Context, drain *migrationv1alpha1.ShardDrain) ([]migrationv1alpha1.LogicalClusterMigration, error) {
+			list, err := kcpClusterClient.Cluster(logicalcluster.From(drain).Path()).MigrationV1alpha1().LogicalClusterMigrations().List(ctx, metav1.ListOptions{
+				LabelSelector: labels.SelectorFromSet(labels.Set{ShardDrainLabelKey: drain.Name}).String(),
+			})
+			if err != nil {
+				return nil, err
+			}
+			return list.Items, nil
+		},
+		createMigration: func(ctx context.Context, path logicalcluster.Path, migration *migrationv1alpha1.LogicalClusterMigration) error {
+			_, err := kcpClusterClient.Cluster(path).MigrationV1alpha1().LogicalClusterMigrations().Create(ctx, migration, metav1.CreateOptions{})
+			return err
+		},
+		listShards: func(selector labels.Selector) ([]*corev1alpha1.Shard, error) {
+			return cachedShardInformer.Lister().List(selector)
+		},
+		listLogicalClusters: func() ([]*corev1alpha1.LogicalCluster, error) {
+			return localLogicalClusterInformer.Lister().List(labels.Everything())
+		},
+		commit: committer.NewCommitter[*ShardDrain, Patcher, *ShardDrainSpec, *ShardDrainStatus](kcpClusterClient.MigrationV1alpha1().ShardDrains()),
+	}
+
+	_, _ = cachedShardDrainInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			if d, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = d.Obj
+			}
+			drain, ok := obj.(*migrationv1alpha1.ShardDrain)
+			return ok && drain.Spec.SourceShard == shardName
+		},
+		Handler: cache.ResourceEventHandlerFuncs{
+			AddFunc:    func(obj interface{}) { c.enqueueShardDrain(obj) },
+			UpdateFunc: func(_, obj interface{}) { c.enqueueShardDrain(obj) },
+			DeleteFunc: func(obj interface{}) { c.enqueueShardDrain(obj) },
+		},
+	})
+
+	_, _ = cachedLogicalClusterMigrationInformer.Informer().AddEventHandler(events.WithoutSyncs(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { c.enqueueMigration(obj) },
+		UpdateFunc: func(_, obj interface{}) { c.enqueueMigration(obj) },
+		DeleteFunc: func(obj interface{}) { c.enqueueMigration(obj) },
+	}))
+
+	_, _ = cachedShardInformer.Informer().AddEventHandler(events.WithoutSyncs(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) { c.enqueueAllShardDrains("Shard added") },
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			// only labels, capacity and usage affect the choice of destination
+			if filterShardEvent(oldObj, newObj) {
+				c.enqueueAllShardDrains("Shard changed")
+			}
+		},
+		DeleteFunc: func(obj interface{}) { c.enqueueAllShardDrains("Shard deleted") },
+	}))
+
+	// New logical clusters have to be drained as well and migrated ones
+	// disappear from the shard once their migration finished.
+	_, _ = localLogicalClusterInformer.Informer().AddEventHandler(events.WithoutSyncs(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { c.enqueueAllShardDrains("LogicalCluster added") },
+		DeleteFunc: func(obj interface{}) { c.enqueueAllShardDrains("LogicalCluster deleted") },
+	}))
+
+	return c, nil
+}
+
+type ShardDrain = migrationv1alpha1.ShardDrain
+type ShardDrainSpec = migrationv1alpha1.ShardDrainSpec
+type ShardDrainStatus = migrationv1alpha1.ShardDrainStatus
+type Patcher = migrationv1alpha1client.ShardDrainInterface
+type Resource = committer.Resource[*ShardDrainSpec, *ShardDrainStatus]
+type CommitFunc = func(context.Context, *Resource, *Resource) error
+
+// controller reconciles ShardDrains. It starts LogicalClusterMigrations for
+// the logical clusters on the source shard and reports their progress.
+type controller struct {
+	queue workqueue.TypedRateLimitingInterface[string]
+
+	shardName string
+
+	getShardDrain       func(ctx context.Context, clusterName logicalcluster.Name, name string) (*migrationv1alpha1.ShardDrain, error)
+	listShardDrains     func() ([]*migrationv1alpha1.ShardDrain, error)
+	listMigrations      func(ctx context.Context, drain *migrationv1alpha1.ShardDrain) ([]migrationv1alpha1.LogicalClusterMigration, error)
+	createMigration     func(ctx context.Context, path logicalcluster.Path, migration *migrationv1alpha1.LogicalClusterMigration) error
+	listShards          func(selector labels.Selector) ([]*corev1alpha1.Shard, error)
+	listLogicalClusters func() ([]*corev1alpha1.LogicalCluster, error)
+	commit              CommitFunc
+}
+
+func (c *controller) enqueueShardDrain(obj interface{}) {
+	key, err := kcpcache.DeletionHandlingMetaClusterNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+
+	logger := logging.WithQueueKey(logging.WithReconciler(klog.Background(), ControllerName), key)
+	logger.V(4).Info("queueing ShardDrain")
+	c.queue.Add(key)
+}
+
+// enqueueMigration maps a LogicalClusterMigration to the ShardDrain that
+// created it.
+func (c *controller) enqueueMigration(obj interface{}) {
+	if d, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = d.Obj
+	}
+	migration, ok := obj.(*migrationv1alpha1.LogicalClusterMigration)
+	if !ok {
+		utilruntime.HandleError(fmt.Errorf("obj is supposed to be a LogicalClusterMigration, but is %T", obj))
+		return
+	}
+
+	drainName := migration.Labels[ShardDrainLabelKey]
+	if drainName == "" {
+		return
+	}
+
+	key := kcpcache.ToClusterAwareKey(logicalcluster.From(migration).String(), "", drainName)
+	logger := logging.WithQueueKey(logging.WithReconciler(klog.Background(), ControllerName), key)
+	logger.V(4).Info("queueing ShardDrain because LogicalClusterMigration changed")
+	c.queue.Add(key)
+}
+
+// enqueueAllShardDrains enqueues all ShardDrains for this shard.
+func (c *controller) enqueueAllShardDrains(reason string) {
+	list, err := c.listShardDrains()
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+
+	logger := logging.WithReconciler(klog.Background(), ControllerName)
+	for _, drain := range list {
+		if drain.Spec.SourceShard != c.shardName {
+			continue
+		}
+		key, err := kcpcache.MetaClusterNamespaceKeyFunc(drain)
+		if err != nil {
+			utilruntime.HandleError(err)
+			continue
+		}
+
+		logging.WithQueueKey(logger, key).V(4).Info("queueing ShardDrain", "reason", reason)
+		c.queue.Add(key)
+	}
+}
+
+// Start starts the controller, which stops when ctx.Done() is closed.
+func (c *controller) Start(ctx context.Context, numThreads int) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	logger := logging.WithReconciler(klog.FromContext(ctx), ControllerName)
+	ctx = klog.NewContext(ctx, logger)
+	logger.Info("Starting controller")
+	defer logger.Info("Shutting down controller")
+
+	for range numThreads {
+		go wait.UntilWithContext(ctx, c.startWorker, time.Second)
+	}
+
+	<-ctx.Done()
+}
+
+func (c *controller) startWorker(ctx context.Context) {
+	for c.processNextWorkItem(ctx) {
+	}
+}
+
+func (c *controller) processNextWorkItem(ctx context.Context) bool {
+	k, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	key := k
+
+	logger := logging.WithQueueKey(klog.FromContext(ctx), key)
+	ctx = klog.NewContext(ctx, logger)
+	logger.V(4).Info("processing key")
+
+	defer c.queue.Done(key)
+
+	if err := c.process(ctx, key); err != nil {
+		utilruntime.HandleError(fmt.Errorf("%q controller failed to sync %q, err: %w", ControllerName, key, err))
+		c.queue.AddRateLimited(key)
+		return true
+	}
+	c.queue.Forget(key)
+	return true
+}
+
+func (c *controller) process(ctx context.Context, key string) error {
+	clusterName, _, name, err := kcpcache.SplitMetaClusterNamespaceKey(key)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return nil
+	}
+
+	obj, err := c.getShardDrain(ctx, clusterName, name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil // object deleted before we handled it
+		}
+		return err
+	}
+	if obj.Spec.SourceShard != c.shardName {
+		return nil
+	}
+
+	old := obj
+	obj = obj.DeepCopy()
+
+	logger := logging.WithObject(klog.FromContext(ctx), obj)
+	ctx = klog.NewContext(ctx, logger)
+
+	var errs []error
+	if err := c.reconcile(ctx, obj); err != nil {
+		errs = append(errs, err)
+	}
+
+	oldResource := &Resource{ObjectMeta: old.ObjectMeta, Spec: &old.Spec, Status: &old.Status}
+	newResource := &Resource{ObjectMeta: obj.ObjectMeta, Spec: &obj.Spec, Status: &obj.Status}
+	if err := c.commit(ctx, oldResource, newResource); err != nil {
+		errs = append(errs, err)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// filterShardEvent returns true if the shard change can affect which
+// destination shard is picked.
+func filterShardEvent(oldObj, newObj interface{}) bool {
+	oldShard, ok := oldObj.(*corev1alpha1.Shard)
+	if !ok {
+		return false
+	}
+	newShard, ok := newObj.(*corev1alpha1.Shard)
+	if !ok {
+		return false
+	}
+	return !reflect.DeepEqual(oldShard.Labels, newShard.Labels) ||
+		!reflect.DeepEqual(oldShard.Status.Capacity, newShard.Status.Capacity) ||
+		!reflect.DeepEqual(oldShard.Status.Usage, newShard.Status.Usage)
+}
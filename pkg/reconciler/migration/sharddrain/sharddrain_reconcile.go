@@ -0,0 +1,260 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sharddrain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	"github.com/kcp-dev/sdk/apis/core"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	migrationv1alpha1 "github.com/kcp-dev/sdk/apis/migration/v1alpha1"
+	conditionsv1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+
+	"github.com/kcp-dev/kcp/pkg/reconciler/migration/logicalclustermigration"
+	"github.com/kcp-dev/kcp/pkg/reconciler/tenancy/workspace"
+)
+
+func (c *controller) reconcile(ctx context.Context, drain *migrationv1alpha1.ShardDrain) error {
+	logger := klog.FromContext(ctx)
+
+	switch drain.Status.Phase {
+	case migrationv1alpha1.ShardDrainPhaseCompleted, migrationv1alpha1.ShardDrainPhaseFailed:
+		return nil
+	case "":
+		drain.Status.Phase = migrationv1alpha1.ShardDrainPhaseDraining
+	}
+
+	selector := labels.Everything()
+	if drain.Spec.DestinationShardSelector != nil {
+		var err error
+		selector, err = metav1.LabelSelectorAsSelector(drain.Spec.DestinationShardSelector)
+		if err != nil {
+			conditions.MarkFalse(
+				drain,
+				migrationv1alpha1.ShardDrainDestinationsAvailable,
+				migrationv1alpha1.ShardDrainInvalidSelectorReason,
+				conditionsv1alpha1.ConditionSeverityError,
+				"%v", err,
+			)
+			// No need to requeue if the selector is not valid
+			return nil
+		}
+	}
+
+	migrations, err := c.listMigrations(ctx, drain)
+	if err != nil {
+		return fmt.Errorf("failed to list LogicalClusterMigrations: %w", err)
+	}
+	logicalClusters, err := c.listLogicalClusters()
+	if err != nil {
+		return fmt.Errorf("failed to list LogicalClusters: %w", err)
+	}
+	shards, err := c.listShards(selector)
+	if err != nil {
+		return fmt.Errorf("failed to list Shards: %w", err)
+	}
+
+	progress := summarize(migrations, logicalClusters)
+
+	concurrency := drain.Spec.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	destinationsAvailable := true
+	for len(progress.pending) > 0 && progress.migrating < concurrency {
+		destination, ok := pickDestinationShard(shards, c.shardName, progress.assigned)
+		if !ok {
+			destinationsAvailable = false
+			break
+		}
+
+		lcName := progress.pending[0]
+		migration := &migrationv1alpha1.LogicalClusterMigration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   drain.Name + "-" + lcName.String(),
+				Labels: map[string]string{ShardDrainLabelKey: drain.Name},
+			},
+			Spec: migrationv1alpha1.LogicalClusterMigrationSpec{
+				LogicalCluster:   lcName.String(),
+				DestinationShard: destination,
+				Strategy:         drain.Spec.Strategy,
+			},
+		}
+		if err := c.createMigration(ctx, logicalcluster.From(drain).Path(), migration); err != nil && !apierrors.IsAlreadyExists(err) {
+			progress.apply(drain)
+			return fmt.Errorf("failed to create LogicalClusterMigration for %s: %w", lcName, err)
+		}
+		logger.V(2).Info("started migration", "logicalCluster", lcName, "destinationShard", destination)
+
+		progress.pending = progress.pending[1:]
+		progress.migrating++
+		progress.assigned[destination]++
+	}
+
+	if destinationsAvailable {
+		conditions.MarkTrue(drain, migrationv1alpha1.ShardDrainDestinationsAvailable)
+	} else {
+		conditions.MarkFalse(
+			drain,
+			migrationv1alpha1.ShardDrainDestinationsAvailable,
+			migrationv1alpha1.ShardDrainNoDestinationShardReason,
+			conditionsv1alpha1.ConditionSeverityWarning,
+			"no shard matching the destination selector has capacity left",
+		)
+	}
+
+	progress.apply(drain)
+	return nil
+}
+
+// drainProgress is the state of the logical clusters of a ShardDrain.
+type drainProgress struct {
+	// pending are the logical clusters on the source shard no migration has
+	// been started for, sorted by name.
+	pending []logicalcluster.Name
+
+	migrating int32
+	migrated  int32
+	failed    int32
+
+	// assigned counts the migrations per destination shard that are still
+	// in progress, i.e. whose logical clusters are not yet reflected in the
+	// usage reported by the shard.
+	assigned map[string]int64
+}
+
+// summarize sorts the logical clusters on the source shard and the
+// migrations created for the drain so far into drainProgress.
+func summarize(migrations []migrationv1alpha1.LogicalClusterMigration, logicalClusters []*corev1alpha1.LogicalCluster) *drainProgress {
+	progress := &drainProgress{assigned: map[string]int64{}}
+
+	started := make(map[string]struct{}, len(migrations))
+	for _, migration := range migrations {
+		started[migration.Spec.LogicalCluster] = struct{}{}
+
+		switch migration.Status.Phase {
+		case migrationv1alpha1.LogicalClusterMigrationPhaseCompleted:
+			progress.migrated++
+			continue
+		case migrationv1alpha1.LogicalClusterMigrationPhaseFailed, migrationv1alpha1.LogicalClusterMigrationPhaseRolledBack:
+			progress.failed++
+			continue
+		default:
+			progress.migrating++
+		}
+		progress.assigned[migration.Spec.DestinationShard]++
+	}
+
+	for _, lc := range logicalClusters {
+		lcName := logicalcluster.From(lc)
+		if _, ok := started[lcName.String()]; ok {
+			continue
+		}
+		if !isDrainable(lc) {
+			continue
+		}
+		progress.pending = append(progress.pending, lcName)
+	}
+	sort.Slice(progress.pending, func(i, j int) bool { return progress.pending[i] < progress.pending[j] })
+
+	return progress
+}
+
+// isDrainable returns whether a migration should be started for the
+// logical cluster.
+func isDrainable(lc *corev1alpha1.LogicalCluster) bool {
+	lcName := logicalcluster.From(lc)
+	if lcName == core.RootCluster || strings.HasPrefix(lcName.String(), "system:") {
+		return false
+	}
+	if !lc.DeletionTimestamp.IsZero() {
+		return false
+	}
+	// Being migrated by someone else already, it leaves the shard
+	// without the drain.
+	if lc.Annotations[logicalclustermigration.MigratingAnnotationKey] != "" {
+		return false
+	}
+	return true
+}
+
+// apply writes the progress into the status of the drain and finishes it
+// once no migrations are pending or running anymore.
+func (p *drainProgress) apply(drain *migrationv1alpha1.ShardDrain) {
+	drain.Status.Pending = int32(len(p.pending))
+	drain.Status.Migrating = p.migrating
+	drain.Status.Migrated = p.migrated
+	drain.Status.Failed = p.failed
+	drain.Status.LogicalClusters = drain.Status.Pending + p.migrating + p.migrated + p.failed
+
+	switch {
+	case drain.Status.Pending > 0 || p.migrating > 0:
+		conditions.MarkFalse(
+			drain,
+			migrationv1alpha1.ShardDrainDrained,
+			"Draining",
+			conditionsv1alpha1.ConditionSeverityInfo,
+			"%d of %d logical clusters migrated", p.migrated, drain.Status.LogicalClusters,
+		)
+	case p.failed > 0:
+		conditions.MarkFalse(
+			drain,
+			migrationv1alpha1.ShardDrainDrained,
+			migrationv1alpha1.ShardDrainMigrationsFailedReason,
+			conditionsv1alpha1.ConditionSeverityError,
+			"%d of %d logical clusters failed to migrate", p.failed, drain.Status.LogicalClusters,
+		)
+		drain.Status.Phase = migrationv1alpha1.ShardDrainPhaseFailed
+	default:
+		conditions.MarkTrue(drain, migrationv1alpha1.ShardDrainDrained)
+		drain.Status.Phase = migrationv1alpha1.ShardDrainPhaseCompleted
+	}
+}
+
+// pickDestinationShard returns the least loaded shard that has room for the
+// next logical cluster. sourceShard is never picked. The logical clusters
+// assigned to a shard by the drain since it last reported its usage count
+// towards its load, like the workspaces scheduled to it do for the workspace
+// scheduler.
+func pickDestinationShard(shards []*corev1alpha1.Shard, sourceShard string, assigned map[string]int64) (string, bool) {
+	var candidates []workspace.ShardLoad
+	for _, shard := range shards {
+		if shard.Name == sourceShard {
+			continue
+		}
+		load := workspace.NewShardLoad(shard, assigned[shard.Name])
+		if load.Full() {
+			continue
+		}
+		candidates = append(candidates, load)
+	}
+	if len(candidates) == 0 {
+		return "", false
+	}
+	return workspace.LeastLoaded(candidates).Name, true
+}
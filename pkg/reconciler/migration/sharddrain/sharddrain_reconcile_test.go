@@ -0,0 +1,340 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sharddrain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	migrationv1alpha1 "github.com/kcp-dev/sdk/apis/migration/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+
+	"github.com/kcp-dev/kcp/pkg/reconciler/migration/logicalclustermigration"
+)
+
+func newLogicalCluster(name string) *corev1alpha1.LogicalCluster {
+	return &corev1alpha1.LogicalCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        corev1alpha1.LogicalClusterName,
+			Annotations: map[string]string{logicalcluster.AnnotationKey: name},
+		},
+	}
+}
+
+func newShard(name string, capacity int64) *corev1alpha1.Shard {
+	shard := &corev1alpha1.Shard{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if capacity >= 0 {
+		shard.Status.Capacity = corev1.ResourceList{
+			corev1alpha1.ShardCapacityLogicalClusters: *resource.NewQuantity(capacity, resource.DecimalSI),
+		}
+	}
+	return shard
+}
+
+func withUsage(shard *corev1alpha1.Shard, usage corev1.ResourceList) *corev1alpha1.Shard {
+	shard.Status.Usage = usage
+	return shard
+}
+
+func newMigration(lcName, destination string, phase migrationv1alpha1.LogicalClusterMigrationPhaseType) migrationv1alpha1.LogicalClusterMigration {
+	return migrationv1alpha1.LogicalClusterMigration{
+		ObjectMeta: metav1.ObjectMeta{Name: "drain-" + lcName},
+		Spec: migrationv1alpha1.LogicalClusterMigrationSpec{
+			LogicalCluster:   lcName,
+			DestinationShard: destination,
+		},
+		Status: migrationv1alpha1.LogicalClusterMigrationStatus{Phase: phase},
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		concurrency     int32
+		logicalClusters []*corev1alpha1.LogicalCluster
+		migrations      []migrationv1alpha1.LogicalClusterMigration
+		shards          []*corev1alpha1.Shard
+		createErr       error
+
+		wantErr          bool
+		wantCreated      map[string]string
+		wantPhase        migrationv1alpha1.ShardDrainPhaseType
+		wantStatus       migrationv1alpha1.ShardDrainStatus
+		wantDestinations bool
+	}{
+		"starts migrations up to the concurrency limit": {
+			concurrency: 2,
+			logicalClusters: []*corev1alpha1.LogicalCluster{
+				newLogicalCluster("c"), newLogicalCluster("a"), newLogicalCluster("b"),
+			},
+			shards:           []*corev1alpha1.Shard{newShard("source", 10), newShard("one", 10), newShard("two", 5)},
+			wantCreated:      map[string]string{"a": "one", "b": "two"},
+			wantPhase:        migrationv1alpha1.ShardDrainPhaseDraining,
+			wantStatus:       migrationv1alpha1.ShardDrainStatus{LogicalClusters: 3, Pending: 1, Migrating: 2},
+			wantDestinations: true,
+		},
+		"skips system and already migrating logical clusters": {
+			concurrency: 5,
+			logicalClusters: func() []*corev1alpha1.LogicalCluster {
+				migrating := newLogicalCluster("migrating")
+				migrating.Annotations[logicalclustermigration.MigratingAnnotationKey] = "root:other"
+				return []*corev1alpha1.LogicalCluster{
+					newLogicalCluster("root"), newLogicalCluster("system:admin"), migrating, newLogicalCluster("a"),
+				}
+			}(),
+			shards:           []*corev1alpha1.Shard{newShard("one", -1)},
+			wantCreated:      map[string]string{"a": "one"},
+			wantPhase:        migrationv1alpha1.ShardDrainPhaseDraining,
+			wantStatus:       migrationv1alpha1.ShardDrainStatus{LogicalClusters: 1, Migrating: 1},
+			wantDestinations: true,
+		},
+		"waits for running migrations": {
+			concurrency:     1,
+			logicalClusters: []*corev1alpha1.LogicalCluster{newLogicalCluster("a"), newLogicalCluster("b")},
+			migrations: []migrationv1alpha1.LogicalClusterMigration{
+				newMigration("a", "one", migrationv1alpha1.LogicalClusterMigrationPhaseMigrating),
+			},
+			shards:           []*corev1alpha1.Shard{newShard("one", -1)},
+			wantCreated:      map[string]string{},
+			wantPhase:        migrationv1alpha1.ShardDrainPhaseDraining,
+			wantStatus:       migrationv1alpha1.ShardDrainStatus{LogicalClusters: 2, Pending: 1, Migrating: 1},
+			wantDestinations: true,
+		},
+		"reports missing destinations": {
+			concurrency:     1,
+			logicalClusters: []*corev1alpha1.LogicalCluster{newLogicalCluster("a")},
+			shards:          []*corev1alpha1.Shard{newShard("source", 10), newShard("full", 0)},
+			wantCreated:     map[string]string{},
+			wantPhase:       migrationv1alpha1.ShardDrainPhaseDraining,
+			wantStatus:      migrationv1alpha1.ShardDrainStatus{LogicalClusters: 1, Pending: 1},
+		},
+		"completes once all migrations completed": {
+			concurrency: 1,
+			migrations: []migrationv1alpha1.LogicalClusterMigration{
+				newMigration("a", "one", migrationv1alpha1.LogicalClusterMigrationPhaseCompleted),
+				newMigration("b", "one", migrationv1alpha1.LogicalClusterMigrationPhaseCompleted),
+			},
+			shards:           []*corev1alpha1.Shard{newShard("one", -1)},
+			wantCreated:      map[string]string{},
+			wantPhase:        migrationv1alpha1.ShardDrainPhaseCompleted,
+			wantStatus:       migrationv1alpha1.ShardDrainStatus{LogicalClusters: 2, Migrated: 2},
+			wantDestinations: true,
+		},
+		"fails once all migrations finished and some failed": {
+			concurrency:     1,
+			logicalClusters: []*corev1alpha1.LogicalCluster{newLogicalCluster("b")},
+			migrations: []migrationv1alpha1.LogicalClusterMigration{
+				newMigration("a", "one", migrationv1alpha1.LogicalClusterMigrationPhaseCompleted),
+				newMigration("b", "one", migrationv1alpha1.LogicalClusterMigrationPhaseRolledBack),
+			},
+			shards:           []*corev1alpha1.Shard{newShard("one", -1)},
+			wantCreated:      map[string]string{},
+			wantPhase:        migrationv1alpha1.ShardDrainPhaseFailed,
+			wantStatus:       migrationv1alpha1.ShardDrainStatus{LogicalClusters: 2, Migrated: 1, Failed: 1},
+			wantDestinations: true,
+		},
+		"returns create errors": {
+			concurrency:     1,
+			logicalClusters: []*corev1alpha1.LogicalCluster{newLogicalCluster("a")},
+			shards:          []*corev1alpha1.Shard{newShard("one", -1)},
+			createErr:       errors.New("boom"),
+			wantErr:         true,
+			wantCreated:     map[string]string{},
+			wantPhase:       migrationv1alpha1.ShardDrainPhaseDraining,
+			wantStatus:      migrationv1alpha1.ShardDrainStatus{LogicalClusters: 1, Pending: 1},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			created := map[string]string{}
+			c := &controller{
+				shardName: "source",
+				listMigrations: func(_ context.Context, _ *migrationv1alpha1.ShardDrain) ([]migrationv1alpha1.LogicalClusterMigration, error) {
+					return tc.migrations, nil
+				},
+				listLogicalClusters: func() ([]*corev1alpha1.LogicalCluster, error) {
+					return tc.logicalClusters, nil
+				},
+				listShards: func(_ labels.Selector) ([]*corev1alpha1.Shard, error) {
+					return tc.shards, nil
+				},
+				createMigration: func(_ context.Context, path logicalcluster.Path, migration *migrationv1alpha1.LogicalClusterMigration) error {
+					if tc.createErr != nil {
+						return tc.createErr
+					}
+					require.Equal(t, "root:org", path.String())
+					require.Equal(t, "drain", migration.Labels[ShardDrainLabelKey])
+					created[migration.Spec.LogicalCluster] = migration.Spec.DestinationShard
+					return nil
+				},
+			}
+
+			drain := &migrationv1alpha1.ShardDrain{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "drain",
+					Annotations: map[string]string{logicalcluster.AnnotationKey: "root:org"},
+				},
+				Spec: migrationv1alpha1.ShardDrainSpec{
+					SourceShard: "source",
+					Concurrency: tc.concurrency,
+				},
+			}
+
+			err := c.reconcile(context.Background(), drain)
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.wantCreated, created)
+			require.Equal(t, tc.wantPhase, drain.Status.Phase)
+
+			if !tc.wantErr {
+				require.Equal(t, tc.wantDestinations, conditions.IsTrue(drain, migrationv1alpha1.ShardDrainDestinationsAvailable))
+			}
+
+			drain.Status.Phase = ""
+			drain.Status.Conditions = nil
+			require.Equal(t, tc.wantStatus, drain.Status)
+		})
+	}
+}
+
+func TestPickDestinationShard(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		shards   []*corev1alpha1.Shard
+		assigned map[string]int64
+		want     string
+		wantOK   bool
+	}{
+		"no shards": {},
+		"only the source shard": {
+			shards: []*corev1alpha1.Shard{newShard("source", 10)},
+		},
+		"most capacity left": {
+			shards:   []*corev1alpha1.Shard{newShard("one", 10), newShard("two", 8)},
+			assigned: map[string]int64{"one": 4},
+			want:     "two",
+			wantOK:   true,
+		},
+		"full shards are skipped": {
+			shards:   []*corev1alpha1.Shard{newShard("one", 2), newShard("two", 0)},
+			assigned: map[string]int64{"one": 2},
+		},
+		"least used shard by reported usage": {
+			shards: []*corev1alpha1.Shard{
+				withUsage(newShard("one", 10), corev1.ResourceList{corev1alpha1.ShardCapacityLogicalClusters: resource.MustParse("7")}),
+				withUsage(newShard("two", 4), corev1.ResourceList{corev1alpha1.ShardCapacityLogicalClusters: resource.MustParse("1")}),
+			},
+			want:   "two",
+			wantOK: true,
+		},
+		"reported usage and assigned logical clusters add up": {
+			shards: []*corev1alpha1.Shard{
+				withUsage(newShard("one", 10), corev1.ResourceList{corev1alpha1.ShardCapacityLogicalClusters: resource.MustParse("2")}),
+				withUsage(newShard("two", 10), corev1.ResourceList{corev1alpha1.ShardCapacityLogicalClusters: resource.MustParse("4")}),
+			},
+			assigned: map[string]int64{"one": 3},
+			want:     "two",
+			wantOK:   true,
+		},
+		"shards full by reported usage are skipped": {
+			shards: []*corev1alpha1.Shard{
+				withUsage(newShard("one", 10), corev1.ResourceList{corev1alpha1.ShardCapacityLogicalClusters: resource.MustParse("10")}),
+				withUsage(newShard("two", 100), corev1.ResourceList{corev1alpha1.ShardCapacityLogicalClusters: resource.MustParse("90")}),
+			},
+			want:   "two",
+			wantOK: true,
+		},
+		"shards full by objects are skipped": {
+			shards: []*corev1alpha1.Shard{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "one"},
+					Status: corev1alpha1.ShardStatus{
+						Capacity: corev1.ResourceList{corev1alpha1.ShardCapacityObjects: resource.MustParse("1000")},
+						Usage:    corev1.ResourceList{corev1alpha1.ShardCapacityObjects: resource.MustParse("1000")},
+					},
+				},
+				withUsage(newShard("two", 10), corev1.ResourceList{corev1alpha1.ShardCapacityLogicalClusters: resource.MustParse("8")}),
+			},
+			want:   "two",
+			wantOK: true,
+		},
+		"shards without capacity are assumed to have the largest capacity": {
+			shards: []*corev1alpha1.Shard{
+				withUsage(newShard("a", -1), corev1.ResourceList{corev1alpha1.ShardCapacityLogicalClusters: resource.MustParse("5")}),
+				withUsage(newShard("b", 10), corev1.ResourceList{corev1alpha1.ShardCapacityLogicalClusters: resource.MustParse("2")}),
+			},
+			want:   "b",
+			wantOK: true,
+		},
+		"fewest assigned without capacity": {
+			shards:   []*corev1alpha1.Shard{newShard("a", -1), newShard("b", -1), newShard("c", -1)},
+			assigned: map[string]int64{"a": 2, "b": 1, "c": 1},
+			want:     "b",
+			wantOK:   true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assigned := tc.assigned
+			if assigned == nil {
+				assigned = map[string]int64{}
+			}
+			got, ok := pickDestinationShard(tc.shards, "source", assigned)
+			require.Equal(t, tc.wantOK, ok)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestFilterShardEvent(t *testing.T) {
+	t.Parallel()
+
+	base := withUsage(newShard("one", 10), corev1.ResourceList{corev1alpha1.ShardCapacityLogicalClusters: resource.MustParse("10")})
+
+	relabeled := base.DeepCopy()
+	relabeled.Labels = map[string]string{"region": "eu"}
+	require.True(t, filterShardEvent(base, relabeled))
+
+	drained := withUsage(base.DeepCopy(), corev1.ResourceList{corev1alpha1.ShardCapacityLogicalClusters: resource.MustParse("9")})
+	require.True(t, filterShardEvent(base, drained), "usage dropping below the capacity frees room for a stalled drain")
+
+	touched := base.DeepCopy()
+	touched.Annotations = map[string]string{"example.io/touched": "true"}
+	require.False(t, filterShardEvent(base, touched))
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package initialization
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	kcpcache "github.com/kcp-dev/apimachinery/v2/pkg/cache"
+	kcpkubernetesclientset "github.com/kcp-dev/client-go/kubernetes"
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	migrationv1alpha1 "github.com/kcp-dev/sdk/apis/migration/v1alpha1"
+	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
+	corev1alpha1client "github.com/kcp-dev/sdk/client/clientset/versioned/typed/core/v1alpha1"
+	apisv1alpha2informers "github.com/kcp-dev/sdk/client/informers/externalversions/apis/v1alpha2"
+	corev1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/core/v1alpha1"
+
+	"github.com/kcp-dev/kcp/pkg/logging"
+	"github.com/kcp-dev/kcp/pkg/reconciler/committer"
+)
+
+const (
+	CloneControllerName = "kcp-clone-initializer"
+)
+
+// NewCloner returns a new controller which copies the contents of the workspace
+// referenced by spec.cloneFrom into new Workspaces.
+//
+// The source workspace is dumped through the front-proxy with
+// externalKcpClusterClient, hence it can live on any shard. The entries are
+// written directly to the local etcd, as the new logical cluster is always
+// scheduled to this shard.
+func NewCloner(
+	kcpClusterClient kcpclientset.ClusterInterface,
+	externalKcpClusterClient kcpclientset.ClusterInterface,
+	externalKubeClusterClient kcpkubernetesclientset.ClusterInterface,
+	etcdClient clientv3.KV,
+	etcdStoragePrefix string,
+	logicalClusterInformer corev1alpha1informers.LogicalClusterClusterInformer,
+	apiBindingsInformer apisv1alpha2informers.APIBindingClusterInformer,
+) (*Cloner, error) {
+	c := &Cloner{
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name: CloneControllerName,
+			},
+		),
+
+		etcdClient:        etcdClient,
+		etcdStoragePrefix: etcdStoragePrefix,
+
+		getLogicalCluster: func(clusterName logicalcluster.Name) (*corev1alpha1.LogicalCluster, error) {
+			return logicalClusterInformer.Lister().Cluster(clusterName).Get(corev1alpha1.LogicalClusterName)
+		},
+		listAPIBindings: func(clusterName logicalcluster.Name) ([]*apisv1alpha2.APIBinding, error) {
+			return apiBindingsInformer.Lister().Cluster(clusterName).List(labels.Everything())
+		},
+		getSourceLogicalCluster: func(ctx context.Context, path logicalcluster.Path) (*corev1alpha1.LogicalCluster, error) {
+			return externalKcpClusterClient.Cluster(path).CoreV1alpha1().LogicalClusters().Get(ctx, corev1alpha1.LogicalClusterName, metav1.GetOptions{})
+		},
+		createSubjectAccessReview: func(ctx context.Context, path logicalcluster.Path, sar *authorizationv1.SubjectAccessReview) (*authorizationv1.SubjectAccessReview, error) {
+			return externalKubeClusterClient.Cluster(path).AuthorizationV1().SubjectAccessReviews().Create(ctx, sar, metav1.CreateOptions{})
+		},
+		listSourceAPIExports: func(ctx context.Context, path logicalcluster.Path) ([]apisv1alpha2.APIExport, error) {
+			exports, err := externalKcpClusterClient.Cluster(path).ApisV1alpha2().APIExports().List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return exports.Items, nil
+		},
+		dumpLogicalCluster: func(ctx context.Context, path logicalcluster.Path, spec migrationv1alpha1.LogicalClusterDumpSpec) (*migrationv1alpha1.LogicalClusterDumpStatus, error) {
+			dump, err := externalKcpClusterClient.Cluster(path).MigrationV1alpha1().LogicalClusterDumps().Create(ctx, &migrationv1alpha1.LogicalClusterDump{Spec: spec}, metav1.CreateOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return &dump.Status, nil
+		},
+
+		commit: committer.NewCommitter[*corev1alpha1.LogicalCluster, corev1alpha1client.LogicalClusterInterface, *corev1alpha1.LogicalClusterSpec, *corev1alpha1.LogicalClusterStatus](kcpClusterClient.CoreV1alpha1().LogicalClusters()),
+	}
+
+	logger := logging.WithReconciler(klog.Background(), CloneControllerName)
+
+	_, _ = logicalClusterInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			c.enqueueLogicalCluster(obj, logger)
+		},
+		// The clone waits for the APIBindings initializer to be removed.
+		UpdateFunc: func(_, obj interface{}) {
+			c.enqueueLogicalCluster(obj, logger)
+		},
+		DeleteFunc: func(obj interface{}) {
+			c.enqueueLogicalCluster(obj, logger)
+		},
+	})
+
+	return c, nil
+}
+
+// Cloner is a controller which copies the contents of the workspace referenced
+// by spec.cloneFrom into new Workspaces.
+type Cloner struct {
+	queue workqueue.TypedRateLimitingInterface[string]
+
+	etcdClient        clientv3.KV
+	etcdStoragePrefix string
+
+	getLogicalCluster         func(clusterName logicalcluster.Name) (*corev1alpha1.LogicalCluster, error)
+	listAPIBindings           func(clusterName logicalcluster.Name) ([]*apisv1alpha2.APIBinding, error)
+	getSourceLogicalCluster   func(ctx context.Context, path logicalcluster.Path) (*corev1alpha1.LogicalCluster, error)
+	createSubjectAccessReview func(ctx context.Context, path logicalcluster.Path, sar *authorizationv1.SubjectAccessReview) (*authorizationv1.SubjectAccessReview, error)
+	listSourceAPIExports      func(ctx context.Context, path logicalcluster.Path) ([]apisv1alpha2.APIExport, error)
+	dumpLogicalCluster        func(ctx context.Context, path logicalcluster.Path, spec migrationv1alpha1.LogicalClusterDumpSpec) (*migrationv1alpha1.LogicalClusterDumpStatus, error)
+
+	// commit creates a patch and submits it, if needed.
+	commit func(ctx context.Context, old, new *logicalClusterResource) error
+}
+
+func (c *Cloner) enqueueLogicalCluster(obj interface{}, logger logr.Logger) {
+	key, err := kcpcache.DeletionHandlingMetaClusterNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+
+	logging.WithQueueKey(logger, key).V(4).Info("queueing LogicalCluster")
+	c.queue.Add(key)
+}
+
+func (c *Cloner) startWorker(ctx context.Context) {
+	for c.processNextWorkItem(ctx) {
+	}
+}
+
+func (c *Cloner) Start(ctx context.Context, numThreads int) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+	logger := logging.WithReconciler(klog.FromContext(ctx), CloneControllerName)
+	ctx = klog.NewContext(ctx, logger)
+
+	logger.Info("Starting controller")
+	defer logger.Info("Shutting down controller")
+
+	for range numThreads {
+		go wait.UntilWithContext(ctx, c.startWorker, time.Second)
+	}
+	<-ctx.Done()
+}
+
+func (c *Cloner) ShutDown() {
+	c.queue.ShutDown()
+}
+
+func (c *Cloner) processNextWorkItem(ctx context.Context) bool {
+	// Wait until there is a new item in the working queue
+	k, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	key := k
+
+	logger := logging.WithQueueKey(klog.FromContext(ctx), key)
+	ctx = klog.NewContext(ctx, logger)
+	logger.V(4).Info("processing key")
+
+	// No matter what, tell the queue we're done with this key, to unblock
+	// other workers.
+	defer c.queue.Done(key)
+
+	if err := c.process(ctx, key); err != nil {
+		utilruntime.HandleError(fmt.Errorf("%s: failed to sync %q, err: %w", CloneControllerName, key, err))
+		c.queue.AddRateLimited(key)
+		return true
+	}
+
+	c.queue.Forget(key)
+	return true
+}
+
+func (c *Cloner) process(ctx context.Context, key string) error {
+	logger := klog.FromContext(ctx)
+
+	clusterName, _, _, err := kcpcache.SplitMetaClusterNamespaceKey(key)
+	if err != nil {
+		logger.Error(err, "unable to decode key")
+		return nil
+	}
+
+	logicalCluster, err := c.getLogicalCluster(clusterName)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			logger.Error(err, "failed to get LogicalCluster from lister", "cluster", clusterName)
+		}
+
+		return nil // nothing we can do here
+	}
+
+	old := logicalCluster
+	logicalCluster = logicalCluster.DeepCopy()
+
+	logger = logging.WithObject(logger, logicalCluster)
+	ctx = klog.NewContext(ctx, logger)
+
+	var errs []error
+	err = c.reconcile(ctx, logicalCluster)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	// If the object being reconciled changed as a result, update it.
+	oldResource := &logicalClusterResource{ObjectMeta: old.ObjectMeta, Spec: &old.Spec, Status: &old.Status}
+	newResource := &logicalClusterResource{ObjectMeta: logicalCluster.ObjectMeta, Spec: &logicalCluster.Spec, Status: &logicalCluster.Status}
+	if err := c.commit(ctx, oldResource, newResource); err != nil {
+		errs = append(errs, err)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
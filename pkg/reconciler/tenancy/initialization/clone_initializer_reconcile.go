@@ -0,0 +1,474 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package initialization
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	"github.com/kcp-dev/sdk/apis/apis"
+	"github.com/kcp-dev/sdk/apis/core"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	migrationv1alpha1 "github.com/kcp-dev/sdk/apis/migration/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/tenancy"
+	"github.com/kcp-dev/sdk/apis/tenancy/initialization"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+	conditionsv1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+
+	kcpetcd "github.com/kcp-dev/kcp/pkg/etcd"
+	"github.com/kcp-dev/kcp/pkg/reconciler/apis/apiexport"
+)
+
+func (c *Cloner) reconcile(ctx context.Context, logicalCluster *corev1alpha1.LogicalCluster) error {
+	if !initialization.InitializerPresent(tenancyv1alpha1.WorkspaceCloneInitializer, logicalCluster.Status.Initializers) {
+		return nil
+	}
+	logger := klog.FromContext(ctx)
+
+	// The initial APIBindings must be bound first, so that cloned APIBindings
+	// for the same APIExports can be skipped.
+	if initialization.InitializerPresent(tenancyv1alpha1.WorkspaceAPIBindingsInitializer, logicalCluster.Status.Initializers) {
+		conditions.MarkFalse(
+			logicalCluster,
+			tenancyv1alpha1.WorkspaceCloned,
+			tenancyv1alpha1.WorkspaceClonedWaitingOnAPIBindings,
+			conditionsv1alpha1.ConditionSeverityInfo,
+			"waiting for the initial APIBindings to be bound",
+		)
+		return nil
+	}
+
+	sourcePath := logicalcluster.NewPath(logicalCluster.Annotations[tenancyv1alpha1.LogicalClusterCloneFromAnnotationKey])
+	parentPath, sourceName := sourcePath.Split()
+	if parentPath.Empty() || !sourcePath.IsValid() {
+		conditions.MarkFalse(
+			logicalCluster,
+			tenancyv1alpha1.WorkspaceCloned,
+			tenancyv1alpha1.WorkspaceClonedSourceInvalid,
+			conditionsv1alpha1.ConditionSeverityError,
+			"invalid annotation %s=%q",
+			tenancyv1alpha1.LogicalClusterCloneFromAnnotationKey, sourcePath,
+		)
+		return nil
+	}
+	logger = logger.WithValues("source", sourcePath.String())
+
+	// Workspaces created by system privileged users have no owner, all
+	// others must be allowed to access all contents of the source.
+	if user := logicalCluster.Spec.CreatedBy; user != nil {
+		sar, err := c.createSubjectAccessReview(ctx, parentPath, &authorizationv1.SubjectAccessReview{
+			Spec: authorizationv1.SubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Verb:        "admin",
+					Group:       tenancyv1alpha1.SchemeGroupVersion.Group,
+					Version:     tenancyv1alpha1.SchemeGroupVersion.Version,
+					Resource:    "workspaces",
+					Subresource: "content",
+					Name:        sourceName,
+				},
+				User:   user.Username,
+				UID:    user.UID,
+				Groups: user.Groups,
+				Extra:  extraFrom(user),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to authorize cloning %s: %w", sourcePath, err)
+		}
+		if !sar.Status.Allowed {
+			conditions.MarkFalse(
+				logicalCluster,
+				tenancyv1alpha1.WorkspaceCloned,
+				tenancyv1alpha1.WorkspaceClonedSourceInvalid,
+				conditionsv1alpha1.ConditionSeverityError,
+				"user %q is not allowed to clone workspace %s: missing verb='admin' permission on workspaces/content",
+				user.Username, sourcePath,
+			)
+			return nil
+		}
+	}
+
+	source, err := c.getSourceLogicalCluster(ctx, sourcePath)
+	if err != nil {
+		if !apierrors.IsNotFound(err) && !apierrors.IsForbidden(err) {
+			return fmt.Errorf("failed to get logical cluster of workspace %s: %w", sourcePath, err)
+		}
+		conditions.MarkFalse(
+			logicalCluster,
+			tenancyv1alpha1.WorkspaceCloned,
+			tenancyv1alpha1.WorkspaceClonedSourceInvalid,
+			conditionsv1alpha1.ConditionSeverityError,
+			"workspace %s cannot be resolved: %v",
+			sourcePath, err,
+		)
+		return nil
+	}
+	if source.Status.Phase != corev1alpha1.LogicalClusterPhaseReady {
+		conditions.MarkFalse(
+			logicalCluster,
+			tenancyv1alpha1.WorkspaceCloned,
+			tenancyv1alpha1.WorkspaceClonedSourceInvalid,
+			conditionsv1alpha1.ConditionSeverityWarning,
+			"workspace %s is not ready",
+			sourcePath,
+		)
+		return fmt.Errorf("workspace %s to clone is in phase %q", sourcePath, source.Status.Phase)
+	}
+
+	bindings, err := c.listAPIBindings(logicalcluster.From(logicalCluster))
+	if err != nil {
+		return err
+	}
+	boundExports := sets.New[string]()
+	for _, binding := range bindings {
+		if export := binding.Spec.Reference.Export; export != nil {
+			boundExports.Insert(logicalcluster.NewPath(export.Path).Join(export.Name).String())
+		}
+	}
+
+	// APIExports are not cloned, and neither are their identity secrets, as
+	// two APIExports must never share an identity.
+	exports, err := c.listSourceAPIExports(ctx, sourcePath)
+	if err != nil {
+		return fmt.Errorf("failed to list APIExports of workspace %s: %w", sourcePath, err)
+	}
+	identitySecrets := sets.New[string]()
+	for _, export := range exports {
+		identitySecrets.Insert(apiexport.DefaultIdentitySecretNamespace + "/" + export.Name)
+		if export.Spec.Identity != nil && export.Spec.Identity.SecretRef != nil {
+			identitySecrets.Insert(export.Spec.Identity.SecretRef.Namespace + "/" + export.Spec.Identity.SecretRef.Name)
+		}
+	}
+
+	cl := &clone{
+		source:          logicalcluster.From(source),
+		target:          logicalcluster.From(logicalCluster),
+		sourcePath:      sourcePath,
+		boundExports:    boundExports,
+		identitySecrets: identitySecrets,
+	}
+	if canonical, ok := source.Annotations[core.LogicalClusterPathAnnotationKey]; ok {
+		cl.sourcePath = logicalcluster.NewPath(canonical)
+	}
+	if canonical, ok := logicalCluster.Annotations[core.LogicalClusterPathAnnotationKey]; ok {
+		cl.targetPath = logicalcluster.NewPath(canonical)
+	}
+
+	copied, err := c.copyFrom(ctx, sourcePath, cl)
+	if err != nil {
+		conditions.MarkFalse(
+			logicalCluster,
+			tenancyv1alpha1.WorkspaceCloned,
+			tenancyv1alpha1.WorkspaceClonedErrors,
+			conditionsv1alpha1.ConditionSeverityWarning,
+			"error copying the contents of workspace %s: %v",
+			sourcePath, err,
+		)
+		return err
+	}
+	logger.V(2).Info("cloned workspace contents", "copied", copied)
+
+	conditions.MarkTrue(logicalCluster, tenancyv1alpha1.WorkspaceCloned)
+	logicalCluster.Status.Initializers = initialization.EnsureInitializerAbsent(tenancyv1alpha1.WorkspaceCloneInitializer, logicalCluster.Status.Initializers)
+
+	return nil
+}
+
+// copyFrom pages through the LogicalClusterDump of the workspace at path and
+// writes the entries to the local etcd. All pages are read at the revision of
+// the first one, so the copy is a consistent snapshot. Entries that already
+// exist are skipped, so a failed copy can be retried.
+func (c *Cloner) copyFrom(ctx context.Context, path logicalcluster.Path, cl *clone) (int64, error) {
+	var copied int64
+	spec := migrationv1alpha1.LogicalClusterDumpSpec{}
+	for {
+		dump, err := c.dumpLogicalCluster(ctx, path, spec)
+		if err != nil {
+			return copied, fmt.Errorf("failed to dump workspace %s: %w", path, err)
+		}
+		if spec.Revision == 0 {
+			spec.Revision = dump.Revision
+		}
+
+		n, err := cl.copyEntries(ctx, c.etcdClient, c.etcdStoragePrefix, dump.Entries)
+		copied += n
+		if err != nil {
+			return copied, err
+		}
+
+		if dump.Continue == "" {
+			return copied, nil
+		}
+		spec.Continue = dump.Continue
+	}
+}
+
+func extraFrom(user *corev1alpha1.OwnerUserInfo) map[string]authorizationv1.ExtraValue {
+	if len(user.Extra) == 0 {
+		return nil
+	}
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+	return extra
+}
+
+// clone copies the etcd entries of a source logical cluster into a target
+// logical cluster.
+type clone struct {
+	source, target logicalcluster.Name
+	// sourcePath is the canonical path of the source. It replaces empty
+	// APIExport paths of cloned APIBindings, which are relative to the source.
+	sourcePath logicalcluster.Path
+	// targetPath is the canonical path of the target. It replaces the path
+	// annotation of cloned objects, which must not resolve to the source's.
+	targetPath logicalcluster.Path
+	// boundExports are the APIExports, as path:name, the target already has
+	// APIBindings for. Cloned APIBindings for them are skipped.
+	boundExports sets.Set[string]
+	// identitySecrets are the identity secrets, as namespace/name, of the
+	// APIExports of the source. They are skipped.
+	identitySecrets sets.Set[string]
+}
+
+// copyEntries writes entries dumped from the source logical cluster into the
+// target logical cluster. Objects that must not or cannot be cloned are
+// skipped, all others get a new UID, see cloneUID. It returns the number of
+// entries written.
+func (cl *clone) copyEntries(ctx context.Context, kv clientv3.KV, storagePrefix string, entries []migrationv1alpha1.EtcdEntry) (int64, error) {
+	prefix := storagePrefix
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	var copied int64
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return copied, err
+		}
+
+		split, ok := kcpetcd.SplitKey("", strings.TrimPrefix(entry.Key, "/"), cl.source)
+		if !ok || split.Cluster != cl.source {
+			return copied, fmt.Errorf("key %q does not belong to logical cluster %s", entry.Key, cl.source)
+		}
+
+		value, ok, err := cl.rewrite(split, entry.Value)
+		if err != nil {
+			return copied, fmt.Errorf("failed to clone %s: %w", entry.Key, err)
+		}
+		if !ok {
+			continue
+		}
+
+		split.Cluster = cl.target
+		key := split.Key(prefix)
+		resp, err := kv.Txn(ctx).
+			If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).
+			Then(clientv3.OpPut(key, string(value))).
+			Commit()
+		if err != nil {
+			return copied, fmt.Errorf("failed to write %s: %w", key, err)
+		}
+		if resp.Succeeded {
+			copied++
+		}
+	}
+
+	return copied, nil
+}
+
+// rewrite returns the value to store in the target logical cluster for the
+// object stored under split in the source, or false if it is not cloned.
+func (cl *clone) rewrite(split kcpetcd.KeyParts, value []byte) ([]byte, bool, error) {
+	switch {
+	case len(value) == 0:
+		return nil, false, nil
+	case split.Group == core.GroupName && split.Resource == "logicalclusters":
+		// the target has its own
+		return nil, false, nil
+	case split.Group == tenancy.GroupName && split.Resource == "workspaces":
+		// child workspaces point to the logical clusters of the source's
+		// children, which must not be shared with the clone
+		return nil, false, nil
+	case split.Group == apis.GroupName && split.Resource == "apiexports":
+		// an APIExport is identified by its identity, cloned APIBindings bind
+		// to the APIExports of the source instead, see rewriteAPIBinding
+		return nil, false, nil
+	case split.Segment == "" && split.Resource == "events":
+		return nil, false, nil
+	case split.Segment == "" && split.Resource == "secrets" && cl.identitySecrets.Has(split.Rest):
+		return nil, false, nil
+	case split.Segment == "" && split.Resource == "secrets":
+		// tokens are bound to the service accounts of the source, they are
+		// issued again for the clones
+		obj, _, err := scheme.Codecs.UniversalDeserializer().Decode(value, nil, nil)
+		if err != nil {
+			return nil, false, err
+		}
+		if secret, ok := obj.(*corev1.Secret); ok && secret.Type == corev1.SecretTypeServiceAccountToken {
+			return nil, false, nil
+		}
+	}
+
+	if kcpetcd.IsProtobuf(value) {
+		return kcpetcd.RewriteProtobufMeta(value, cl.rewriteMeta)
+	}
+	return cl.rewriteJSON(split, value)
+}
+
+// rewriteMeta updates the metadata of a cloned object. It returns false for
+// objects that are not cloned.
+func (cl *clone) rewriteMeta(meta *metav1.ObjectMeta) bool {
+	if meta.DeletionTimestamp != nil {
+		return false
+	}
+
+	meta.UID = cl.cloneUID(meta.UID)
+	meta.ResourceVersion = ""
+	for i := range meta.OwnerReferences {
+		meta.OwnerReferences[i].UID = cl.cloneUID(meta.OwnerReferences[i].UID)
+	}
+
+	// Objects resolved by path and name, e.g. WorkspaceTypes, must not be
+	// found twice under the source's path. Without a path of the target, the
+	// annotation is set again by the next update through the API.
+	if _, ok := meta.Annotations[core.LogicalClusterPathAnnotationKey]; ok {
+		if cl.targetPath.Empty() {
+			delete(meta.Annotations, core.LogicalClusterPathAnnotationKey)
+		} else {
+			meta.Annotations[core.LogicalClusterPathAnnotationKey] = cl.targetPath.String()
+		}
+	}
+	return true
+}
+
+// cloneUID returns the UID of the clone of the object with the given UID in
+// the source. It is derived from the source UID, so owner references within
+// the source keep pointing to the clones of their owners.
+func (cl *clone) cloneUID(uid types.UID) types.UID {
+	if uid == "" {
+		return ""
+	}
+	return types.UID(uuid.NewSHA1(uuid.NameSpaceOID, []byte(cl.target.String()+"/"+string(uid))).String())
+}
+
+// rewriteJSON rewrites the metadata of a JSON encoded value. APIBindings lose
+// their status, so that they are bound again in the target.
+func (cl *clone) rewriteJSON(split kcpetcd.KeyParts, value []byte) ([]byte, bool, error) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(value, &obj); err != nil {
+		return nil, false, err
+	}
+
+	var meta metav1.ObjectMeta
+	if err := json.Unmarshal(obj["metadata"], &meta); err != nil {
+		return nil, false, fmt.Errorf("failed to decode metadata: %w", err)
+	}
+	if split.Group == apis.GroupName && split.Resource == "apiexportendpointslices" && ownedByAPIExport(&meta) {
+		// the APIExports are not cloned, their endpoint slices neither
+		return nil, false, nil
+	}
+	if !cl.rewriteMeta(&meta) {
+		return nil, false, nil
+	}
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return nil, false, err
+	}
+	obj["metadata"] = data
+
+	if split.Group == apis.GroupName && split.Resource == "apibindings" {
+		ok, err := cl.rewriteAPIBinding(obj)
+		if err != nil || !ok {
+			return nil, false, err
+		}
+	}
+
+	data, err = json.Marshal(obj)
+	if err != nil {
+		return nil, false, err
+	}
+	return data, true, nil
+}
+
+// ownedByAPIExport returns whether the object is owned by an APIExport.
+func ownedByAPIExport(meta *metav1.ObjectMeta) bool {
+	for _, ref := range meta.OwnerReferences {
+		if ref.Kind == "APIExport" && strings.HasPrefix(ref.APIVersion, apis.GroupName+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// rewriteAPIBinding drops the status of a cloned APIBinding and makes its
+// APIExport reference absolute. It returns false if the target is already
+// bound to the APIExport.
+func (cl *clone) rewriteAPIBinding(obj map[string]json.RawMessage) (bool, error) {
+	var spec struct {
+		Reference struct {
+			Export *struct {
+				Path string `json:"path,omitempty"`
+				Name string `json:"name"`
+			} `json:"export,omitempty"`
+		} `json:"reference"`
+	}
+	if err := json.Unmarshal(obj["spec"], &spec); err != nil {
+		return false, fmt.Errorf("failed to decode spec: %w", err)
+	}
+	delete(obj, "status")
+
+	export := spec.Reference.Export
+	if export == nil {
+		return true, nil
+	}
+	if export.Path == "" {
+		var raw map[string]interface{}
+		if err := json.Unmarshal(obj["spec"], &raw); err != nil {
+			return false, err
+		}
+		if err := unstructured.SetNestedField(raw, cl.sourcePath.String(), "reference", "export", "path"); err != nil {
+			return false, err
+		}
+		data, err := json.Marshal(raw)
+		if err != nil {
+			return false, err
+		}
+		obj["spec"] = data
+		export.Path = cl.sourcePath.String()
+	}
+
+	return !cl.boundExports.Has(logicalcluster.NewPath(export.Path).Join(export.Name).String()), nil
+}
@@ -0,0 +1,398 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package initialization
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer/protobuf"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+	"github.com/kcp-dev/sdk/apis/core"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	migrationv1alpha1 "github.com/kcp-dev/sdk/apis/migration/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+)
+
+func TestCloneCopyEntries(t *testing.T) {
+	t.Parallel()
+
+	now := metav1.Now()
+	cl := &clone{
+		source:       "source",
+		target:       "target",
+		sourcePath:   logicalcluster.NewPath("root:golden"),
+		targetPath:   logicalcluster.NewPath("root:clone"),
+		boundExports: sets.New[string]("root:tenancy"),
+	}
+
+	kv := newFakeKV(map[string]string{
+		"/registry/core/configmaps/target/default/kube-root-ca.crt": "existing",
+	})
+	copied, err := cl.copyEntries(context.Background(), kv, "/registry", []migrationv1alpha1.EtcdEntry{
+		{Key: "core/configmaps/source/default/cm", Value: encodeProtobuf(t, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "cm", Namespace: "default", UID: "cm-uid", ResourceVersion: "42",
+				OwnerReferences: []metav1.OwnerReference{{APIVersion: "v1", Kind: "ConfigMap", Name: "owner", UID: "owner-uid"}},
+			},
+			Data: map[string]string{"foo": "bar"},
+		})},
+		{Key: "core/configmaps/source/default/kube-root-ca.crt", Value: encodeProtobuf(t, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "kube-root-ca.crt", Namespace: "default", UID: "ca-uid"},
+		})},
+		{Key: "core/configmaps/source/default/deleting", Value: encodeProtobuf(t, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "deleting", Namespace: "default", UID: "deleting-uid", DeletionTimestamp: &now, Finalizers: []string{"foo"}},
+		})},
+		{Key: "core/configmaps/source/default/annotated", Value: encodeProtobuf(t, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "annotated", Namespace: "default", UID: "annotated-uid",
+				Annotations: map[string]string{core.LogicalClusterPathAnnotationKey: "root:golden"},
+			},
+		})},
+		{Key: "core/secrets/source/default/token", Value: encodeProtobuf(t, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "token", Namespace: "default", UID: "token-uid"},
+			Type:       corev1.SecretTypeServiceAccountToken,
+		})},
+		{Key: "core/events/source/default/event", Value: []byte("event")},
+		{Key: "core.kcp.io/logicalclusters/customresources/source/cluster", Value: []byte(`{"metadata":{"name":"cluster"}}`)},
+		{Key: "mygroup.io/widgets/customresources/source/default/w", Value: []byte(`{"apiVersion":"mygroup.io/v1","kind":"Widget","metadata":{"name":"w","namespace":"default","uid":"w-uid","ownerReferences":[{"apiVersion":"v1","kind":"ConfigMap","name":"cm","uid":"cm-uid"}]},"spec":{"size":3}}`)},
+		{Key: "apis.kcp.io/apibindings/customresources/source/local", Value: []byte(`{"apiVersion":"apis.kcp.io/v1alpha2","kind":"APIBinding","metadata":{"name":"local","uid":"local-uid"},"spec":{"reference":{"export":{"name":"widgets"}}},"status":{"phase":"Bound"}}`)},
+		{Key: "tenancy.kcp.io/workspacetypes/customresources/source/team", Value: []byte(`{"apiVersion":"tenancy.kcp.io/v1alpha1","kind":"WorkspaceType","metadata":{"name":"team","uid":"team-uid","annotations":{"kcp.io/path":"root:golden"}}}`)},
+		{Key: "apis.kcp.io/apibindings/customresources/source/tenancy", Value: []byte(`{"apiVersion":"apis.kcp.io/v1alpha2","kind":"APIBinding","metadata":{"name":"tenancy","uid":"tenancy-uid"},"spec":{"reference":{"export":{"path":"root","name":"tenancy"}}}}`)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), copied)
+
+	keys := sets.List(sets.KeySet(kv.kvs))
+	require.Equal(t, []string{
+		"/registry/apis.kcp.io/apibindings/customresources/target/local",
+		"/registry/core/configmaps/target/default/annotated",
+		"/registry/core/configmaps/target/default/cm",
+		"/registry/core/configmaps/target/default/kube-root-ca.crt",
+		"/registry/mygroup.io/widgets/customresources/target/default/w",
+		"/registry/tenancy.kcp.io/workspacetypes/customresources/target/team",
+	}, keys)
+	require.Equal(t, "existing", kv.kvs["/registry/core/configmaps/target/default/kube-root-ca.crt"], "existing objects must not be overwritten")
+
+	obj, _, err := scheme.Codecs.UniversalDeserializer().Decode([]byte(kv.kvs["/registry/core/configmaps/target/default/cm"]), nil, nil)
+	require.NoError(t, err)
+	cm := obj.(*corev1.ConfigMap)
+	require.Equal(t, map[string]string{"foo": "bar"}, cm.Data)
+	require.Equal(t, cl.cloneUID("cm-uid"), cm.UID)
+	require.NotEqual(t, "cm-uid", string(cm.UID))
+	require.Empty(t, cm.ResourceVersion)
+	require.Equal(t, cl.cloneUID("owner-uid"), cm.OwnerReferences[0].UID)
+
+	var widget map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(kv.kvs["/registry/mygroup.io/widgets/customresources/target/default/w"]), &widget))
+	require.Equal(t, map[string]interface{}{"size": float64(3)}, widget["spec"])
+	metadata := widget["metadata"].(map[string]interface{})
+	require.Equal(t, string(cl.cloneUID("w-uid")), metadata["uid"])
+	require.Equal(t, string(cm.UID), metadata["ownerReferences"].([]interface{})[0].(map[string]interface{})["uid"], "owner references must point to the clone of the owner")
+
+	obj, _, err = scheme.Codecs.UniversalDeserializer().Decode([]byte(kv.kvs["/registry/core/configmaps/target/default/annotated"]), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "root:clone", obj.(*corev1.ConfigMap).Annotations[core.LogicalClusterPathAnnotationKey], "the path annotation must point to the clone")
+
+	var workspaceType tenancyv1alpha1.WorkspaceType
+	require.NoError(t, json.Unmarshal([]byte(kv.kvs["/registry/tenancy.kcp.io/workspacetypes/customresources/target/team"]), &workspaceType))
+	require.Equal(t, "root:clone", workspaceType.Annotations[core.LogicalClusterPathAnnotationKey], "the path annotation must point to the clone")
+
+	var binding apisv1alpha2.APIBinding
+	require.NoError(t, json.Unmarshal([]byte(kv.kvs["/registry/apis.kcp.io/apibindings/customresources/target/local"]), &binding))
+	require.Equal(t, "root:golden", binding.Spec.Reference.Export.Path, "relative export references must point to the source")
+	require.Empty(t, binding.Status.Phase)
+}
+
+func TestCloneSkipsObjectsTiedToTheSource(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		entry    migrationv1alpha1.EtcdEntry
+		wantCopy bool
+	}{
+		"child workspaces": {
+			entry: migrationv1alpha1.EtcdEntry{Key: "tenancy.kcp.io/workspaces/customresources/source/child", Value: []byte(`{"apiVersion":"tenancy.kcp.io/v1alpha1","kind":"Workspace","metadata":{"name":"child","uid":"child-uid"},"spec":{"cluster":"child-cluster"}}`)},
+		},
+		"APIExports": {
+			entry: migrationv1alpha1.EtcdEntry{Key: "apis.kcp.io/apiexports/customresources/source/widgets", Value: []byte(`{"apiVersion":"apis.kcp.io/v1alpha2","kind":"APIExport","metadata":{"name":"widgets","uid":"widgets-uid"},"spec":{"identity":{"secretRef":{"namespace":"kcp-system","name":"widgets"}}}}`)},
+		},
+		"APIExport identity secrets": {
+			entry: migrationv1alpha1.EtcdEntry{Key: "core/secrets/source/kcp-system/widgets", Value: encodeProtobuf(t, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "widgets", Namespace: "kcp-system", UID: "secret-uid"},
+				Data:       map[string][]byte{"key": []byte("identity")},
+			})},
+		},
+		"APIExportEndpointSlices of APIExports": {
+			entry: migrationv1alpha1.EtcdEntry{Key: "apis.kcp.io/apiexportendpointslices/customresources/source/widgets", Value: []byte(`{"apiVersion":"apis.kcp.io/v1alpha1","kind":"APIExportEndpointSlice","metadata":{"name":"widgets","uid":"slice-uid","ownerReferences":[{"apiVersion":"apis.kcp.io/v1alpha1","kind":"APIExport","name":"widgets","uid":"widgets-uid"}]},"spec":{"export":{"name":"widgets"}}}`)},
+		},
+		"other secrets": {
+			entry: migrationv1alpha1.EtcdEntry{Key: "core/secrets/source/kcp-system/other", Value: encodeProtobuf(t, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "kcp-system", UID: "other-uid"},
+				Data:       map[string][]byte{"key": []byte("value")},
+			})},
+			wantCopy: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cl := &clone{
+				source:          "source",
+				target:          "target",
+				sourcePath:      logicalcluster.NewPath("root:golden"),
+				boundExports:    sets.New[string](),
+				identitySecrets: sets.New[string]("kcp-system/widgets"),
+			}
+			kv := newFakeKV(map[string]string{})
+			copied, err := cl.copyEntries(context.Background(), kv, "/registry", []migrationv1alpha1.EtcdEntry{tc.entry})
+			require.NoError(t, err)
+			if tc.wantCopy {
+				require.Equal(t, int64(1), copied)
+			} else {
+				require.Zero(t, copied)
+				require.Empty(t, kv.kvs)
+			}
+		})
+	}
+}
+
+func TestClonerReconcile(t *testing.T) {
+	t.Parallel()
+
+	newLogicalCluster := func(initializers ...corev1alpha1.LogicalClusterInitializer) *corev1alpha1.LogicalCluster {
+		return &corev1alpha1.LogicalCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: corev1alpha1.LogicalClusterName,
+				Annotations: map[string]string{
+					logicalcluster.AnnotationKey:                         "target",
+					tenancyv1alpha1.LogicalClusterCloneFromAnnotationKey: "root:golden",
+				},
+			},
+			Spec: corev1alpha1.LogicalClusterSpec{
+				CreatedBy: &corev1alpha1.OwnerUserInfo{Username: "user", Groups: []string{"team"}},
+			},
+			Status: corev1alpha1.LogicalClusterStatus{
+				Initializers: initializers,
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		logicalCluster   *corev1alpha1.LogicalCluster
+		allowed          bool
+		wantReason       string
+		wantInitializers []corev1alpha1.LogicalClusterInitializer
+		wantCopied       []string
+	}{
+		"waits for the initial APIBindings": {
+			logicalCluster:   newLogicalCluster(tenancyv1alpha1.WorkspaceAPIBindingsInitializer, tenancyv1alpha1.WorkspaceCloneInitializer),
+			allowed:          true,
+			wantReason:       tenancyv1alpha1.WorkspaceClonedWaitingOnAPIBindings,
+			wantInitializers: []corev1alpha1.LogicalClusterInitializer{tenancyv1alpha1.WorkspaceAPIBindingsInitializer, tenancyv1alpha1.WorkspaceCloneInitializer},
+		},
+		"owner not allowed to clone": {
+			logicalCluster:   newLogicalCluster(tenancyv1alpha1.WorkspaceCloneInitializer),
+			wantReason:       tenancyv1alpha1.WorkspaceClonedSourceInvalid,
+			wantInitializers: []corev1alpha1.LogicalClusterInitializer{tenancyv1alpha1.WorkspaceCloneInitializer},
+		},
+		"copies all pages and removes the initializer": {
+			logicalCluster:   newLogicalCluster("root:org", tenancyv1alpha1.WorkspaceCloneInitializer),
+			allowed:          true,
+			wantInitializers: []corev1alpha1.LogicalClusterInitializer{"root:org"},
+			wantCopied: []string{
+				"/registry/mygroup.io/widgets/customresources/target/default/a",
+				"/registry/mygroup.io/widgets/customresources/target/default/b",
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			pages := []migrationv1alpha1.LogicalClusterDumpStatus{
+				{
+					Entries: []migrationv1alpha1.EtcdEntry{
+						{Key: "core/secrets/source/default/widgets-identity", Value: encodeProtobuf(t, &corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{Name: "widgets-identity", Namespace: "default", UID: "identity-uid"},
+							Data:       map[string][]byte{"key": []byte("identity")},
+						})},
+						{Key: "mygroup.io/widgets/customresources/source/default/a", Value: []byte(`{"metadata":{"name":"a","uid":"a"}}`)},
+					},
+					Continue: "mygroup.io/widgets/customresources/source/default/a",
+					Revision: 42,
+				},
+				{
+					Entries:  []migrationv1alpha1.EtcdEntry{{Key: "mygroup.io/widgets/customresources/source/default/b", Value: []byte(`{"metadata":{"name":"b","uid":"b"}}`)}},
+					Revision: 42,
+				},
+			}
+
+			kv := newFakeKV(map[string]string{})
+			var sars []*authorizationv1.SubjectAccessReview
+			var dumps []migrationv1alpha1.LogicalClusterDumpSpec
+			c := &Cloner{
+				etcdClient:        kv,
+				etcdStoragePrefix: "/registry/",
+				listAPIBindings: func(clusterName logicalcluster.Name) ([]*apisv1alpha2.APIBinding, error) {
+					return nil, nil
+				},
+				createSubjectAccessReview: func(_ context.Context, path logicalcluster.Path, sar *authorizationv1.SubjectAccessReview) (*authorizationv1.SubjectAccessReview, error) {
+					require.Equal(t, "root", path.String())
+					sars = append(sars, sar)
+					sar.Status.Allowed = tc.allowed
+					return sar, nil
+				},
+				listSourceAPIExports: func(_ context.Context, path logicalcluster.Path) ([]apisv1alpha2.APIExport, error) {
+					require.Equal(t, "root:golden", path.String())
+					return []apisv1alpha2.APIExport{{
+						ObjectMeta: metav1.ObjectMeta{Name: "widgets"},
+						Spec: apisv1alpha2.APIExportSpec{
+							Identity: &apisv1alpha2.Identity{SecretRef: &corev1.SecretReference{Namespace: "default", Name: "widgets-identity"}},
+						},
+					}}, nil
+				},
+				getSourceLogicalCluster: func(_ context.Context, path logicalcluster.Path) (*corev1alpha1.LogicalCluster, error) {
+					require.Equal(t, "root:golden", path.String())
+					return &corev1alpha1.LogicalCluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: corev1alpha1.LogicalClusterName,
+							Annotations: map[string]string{
+								logicalcluster.AnnotationKey:         "source",
+								core.LogicalClusterPathAnnotationKey: "root:golden",
+							},
+						},
+						Status: corev1alpha1.LogicalClusterStatus{Phase: corev1alpha1.LogicalClusterPhaseReady},
+					}, nil
+				},
+				dumpLogicalCluster: func(_ context.Context, path logicalcluster.Path, spec migrationv1alpha1.LogicalClusterDumpSpec) (*migrationv1alpha1.LogicalClusterDumpStatus, error) {
+					require.Equal(t, "root:golden", path.String())
+					dumps = append(dumps, spec)
+					return &pages[len(dumps)-1], nil
+				},
+			}
+
+			lc := tc.logicalCluster.DeepCopy()
+			require.NoError(t, c.reconcile(context.Background(), lc))
+
+			require.Equal(t, tc.wantInitializers, lc.Status.Initializers)
+			if tc.wantReason != "" {
+				require.True(t, conditions.IsFalse(lc, tenancyv1alpha1.WorkspaceCloned))
+				require.Equal(t, tc.wantReason, conditions.GetReason(lc, tenancyv1alpha1.WorkspaceCloned))
+			} else {
+				require.True(t, conditions.IsTrue(lc, tenancyv1alpha1.WorkspaceCloned))
+			}
+			if len(sars) > 0 {
+				require.Equal(t, "user", sars[0].Spec.User)
+				require.Equal(t, "admin", sars[0].Spec.ResourceAttributes.Verb)
+				require.Equal(t, "golden", sars[0].Spec.ResourceAttributes.Name)
+			}
+
+			if tc.wantCopied == nil {
+				require.Empty(t, kv.kvs)
+			} else {
+				require.Equal(t, tc.wantCopied, sets.List(sets.KeySet(kv.kvs)))
+				require.Equal(t, []migrationv1alpha1.LogicalClusterDumpSpec{
+					{},
+					{Continue: "mygroup.io/widgets/customresources/source/default/a", Revision: 42},
+				}, dumps, "all pages after the first one must be pinned to its revision")
+			}
+		})
+	}
+}
+
+func encodeProtobuf(t *testing.T, obj runtime.Object) []byte {
+	t.Helper()
+
+	gvks, _, err := scheme.Scheme.ObjectKinds(obj)
+	require.NoError(t, err)
+	obj.GetObjectKind().SetGroupVersionKind(gvks[0])
+
+	var buf bytes.Buffer
+	require.NoError(t, protobuf.NewSerializer(scheme.Scheme, scheme.Scheme).Encode(obj, &buf))
+	return buf.Bytes()
+}
+
+// fakeKV is an in-memory clientv3.KV that only supports put-if-absent
+// transactions.
+type fakeKV struct {
+	clientv3.KV
+	kvs map[string]string
+}
+
+func newFakeKV(kvs map[string]string) *fakeKV { return &fakeKV{kvs: kvs} }
+
+func (f *fakeKV) Txn(context.Context) clientv3.Txn { return &fakeTxn{kv: f} }
+
+type fakeTxn struct {
+	kv   *fakeKV
+	cmps []clientv3.Cmp
+	then []clientv3.Op
+}
+
+func (t *fakeTxn) If(cs ...clientv3.Cmp) clientv3.Txn   { t.cmps = cs; return t }
+func (t *fakeTxn) Then(ops ...clientv3.Op) clientv3.Txn { t.then = ops; return t }
+func (t *fakeTxn) Else(...clientv3.Op) clientv3.Txn     { return t }
+
+func (t *fakeTxn) Commit() (*clientv3.TxnResponse, error) {
+	for _, cmp := range t.cmps {
+		if _, ok := t.kv.kvs[string(cmp.Key)]; ok {
+			return &clientv3.TxnResponse{}, nil
+		}
+	}
+	for _, op := range t.then {
+		t.kv.kvs[string(op.KeyBytes())] = string(op.ValueBytes())
+	}
+	return &clientv3.TxnResponse{Succeeded: true}, nil
+}
+
+func TestCloneDropsPathAnnotationWithoutTargetPath(t *testing.T) {
+	t.Parallel()
+
+	cl := &clone{
+		source:       "source",
+		target:       "target",
+		sourcePath:   logicalcluster.NewPath("root:golden"),
+		boundExports: sets.New[string](),
+	}
+	kv := newFakeKV(map[string]string{})
+	_, err := cl.copyEntries(context.Background(), kv, "/registry", []migrationv1alpha1.EtcdEntry{
+		{Key: "tenancy.kcp.io/workspacequotas/customresources/source/quota", Value: []byte(`{"apiVersion":"tenancy.kcp.io/v1alpha1","kind":"WorkspaceQuota","metadata":{"name":"quota","uid":"quota-uid","annotations":{"kcp.io/path":"root:golden","foo":"bar"}}}`)},
+	})
+	require.NoError(t, err)
+
+	var quota tenancyv1alpha1.WorkspaceQuota
+	require.NoError(t, json.Unmarshal([]byte(kv.kvs["/registry/tenancy.kcp.io/workspacequotas/customresources/target/quota"]), &quota))
+	require.Equal(t, map[string]string{"foo": "bar"}, quota.Annotations)
+}
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -33,6 +34,9 @@ import (
 
 	kcpcache "github.com/kcp-dev/apimachinery/v2/pkg/cache"
 	"github.com/kcp-dev/client-go/kubernetes"
+	"github.com/kcp-dev/logicalcluster/v3"
+	"github.com/kcp-dev/sdk/apis/core"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
 	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
 	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
 	tenancyv1alpha1client "github.com/kcp-dev/sdk/client/clientset/versioned/typed/tenancy/v1alpha1"
@@ -134,6 +138,7 @@ func NewController(
 	globalWorkspaceTypeInformer tenancyv1alpha1informers.WorkspaceTypeClusterInformer,
 	logicalClusterInformer corev1alpha1informers.LogicalClusterClusterInformer,
 	cacheLogicalClusterInformer corev1alpha1informers.LogicalClusterClusterInformer,
+	shardScorer ShardScorer,
 ) (*Controller, error) {
 	c := &Controller{
 		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
@@ -154,12 +159,15 @@ func NewController(
 		workspaceLister:  workspaceInformer.Lister(),
 
 		globalShardLister: globalShardInformer.Lister(),
+		shardScorer:       shardScorer,
+		shardPlacements:   newShardPlacements(),
 
 		globalWorkspaceTypeIndexer: globalWorkspaceTypeInformer.Informer().GetIndexer(),
 		globalWorkspaceTypeLister:  globalWorkspaceTypeInformer.Lister(),
 
-		logicalClusterIndexer: logicalClusterInformer.Informer().GetIndexer(),
-		logicalClusterLister:  logicalClusterInformer.Lister(),
+		logicalClusterIndexer:     logicalClusterInformer.Informer().GetIndexer(),
+		logicalClusterLister:      logicalClusterInformer.Lister(),
+		cacheLogicalClusterLister: cacheLogicalClusterInformer.Lister(),
 
 		clientPool: newClientPool(logicalClusterAdminConfig),
 
@@ -198,7 +206,10 @@ func NewController(
 	// cache one covers the cross-shard case where the LogicalCluster is
 	// only visible via replication.
 	_, _ = logicalClusterInformer.Informer().AddEventHandler(events.WithoutSyncs(cache.ResourceEventHandlerFuncs{
-		UpdateFunc: func(_, obj interface{}) { c.enqueueLogicalCluster(obj) },
+		UpdateFunc: func(oldObj, obj interface{}) {
+			c.enqueueLogicalCluster(obj)
+			c.enqueueChildWorkspaces(oldObj, obj)
+		},
 		DeleteFunc: func(obj interface{}) { c.enqueueLogicalCluster(obj) },
 	}))
 
@@ -229,12 +240,15 @@ type Controller struct {
 	workspaceLister  tenancyv1alpha1listers.WorkspaceClusterLister
 
 	globalShardLister corev1alpha1listers.ShardClusterLister
+	shardScorer       ShardScorer
+	shardPlacements   *shardPlacements
 
 	globalWorkspaceTypeIndexer cache.Indexer
 	globalWorkspaceTypeLister  tenancyv1alpha1listers.WorkspaceTypeClusterLister
 
-	logicalClusterIndexer cache.Indexer
-	logicalClusterLister  corev1alpha1listers.LogicalClusterClusterLister
+	logicalClusterIndexer     cache.Indexer
+	logicalClusterLister      corev1alpha1listers.LogicalClusterClusterLister
+	cacheLogicalClusterLister corev1alpha1listers.LogicalClusterClusterLister
 
 	// clientPool manages reusable clients to prevent connection leaks
 	clientPool *clientPool
@@ -290,6 +304,39 @@ func (c *Controller) enqueueLogicalCluster(obj interface{}) {
 	}
 }
 
+// enqueueChildWorkspaces enqueues the Workspaces in a LogicalCluster whose path
+// changed, i.e. after a move, to update the paths of their LogicalClusters.
+func (c *Controller) enqueueChildWorkspaces(oldObj, obj interface{}) {
+	oldLogicalCluster, ok := oldObj.(*corev1alpha1.LogicalCluster)
+	if !ok {
+		return
+	}
+	logicalCluster, ok := obj.(*corev1alpha1.LogicalCluster)
+	if !ok {
+		return
+	}
+	if oldLogicalCluster.Annotations[core.LogicalClusterPathAnnotationKey] == logicalCluster.Annotations[core.LogicalClusterPathAnnotationKey] {
+		return
+	}
+
+	logger := logging.WithReconciler(klog.Background(), ControllerName)
+	clusterName := logicalcluster.From(logicalCluster)
+	workspaces, err := c.workspaceLister.Cluster(clusterName).List(labels.Everything())
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	for _, workspace := range workspaces {
+		key, err := kcpcache.MetaClusterNamespaceKeyFunc(workspace)
+		if err != nil {
+			utilruntime.HandleError(err)
+			continue
+		}
+		logging.WithQueueKey(logger, key).V(3).Info("queueing Workspace because of LogicalCluster path change", "logicalCluster", clusterName)
+		c.queue.Add(key)
+	}
+}
+
 func (c *Controller) enqueueShard(obj interface{}) {
 	logger := logging.WithReconciler(klog.Background(), ControllerName)
 	key, err := kcpcache.DeletionHandlingMetaClusterNamespaceKeyFunc(obj)
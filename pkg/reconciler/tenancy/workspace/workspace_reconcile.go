@@ -18,9 +18,14 @@ package workspace
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	utilserrors "k8s.io/apimachinery/pkg/util/errors"
 
 	kcpcache "github.com/kcp-dev/apimachinery/v2/pkg/cache"
@@ -80,21 +85,77 @@ func (c *Controller) reconcile(ctx context.Context, ws *tenancyv1alpha1.Workspac
 			deleteLogicalCluster: func(ctx context.Context, cluster logicalcluster.Path) error {
 				return c.kcpExternalClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Delete(ctx, corev1alpha1.LogicalClusterName, metav1.DeleteOptions{})
 			},
+			retainLogicalCluster: func(ctx context.Context, cluster logicalcluster.Path, until time.Time) error {
+				patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, corev1alpha1.LogicalClusterDeletedAnnotationKey, until.UTC().Format(time.RFC3339))
+				_, err := c.kcpExternalClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Patch(ctx, corev1alpha1.LogicalClusterName, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+				return err
+			},
+			getWorkspaceType:                getType,
+			now:                             time.Now,
 			getShard:                        getShard,
 			kcpLogicalClusterAdminClientFor: kcpDirectClientFor,
 		},
+		&moveReconciler{
+			getLogicalCluster: func(ctx context.Context, cluster logicalcluster.Path) (*corev1alpha1.LogicalCluster, error) {
+				return c.kcpExternalClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Get(ctx, corev1alpha1.LogicalClusterName, metav1.GetOptions{})
+			},
+			deleteWorkspace: func(ctx context.Context, workspace *tenancyv1alpha1.Workspace) error {
+				return c.kcpClusterClient.Cluster(logicalcluster.From(workspace).Path()).TenancyV1alpha1().Workspaces().Delete(ctx, workspace.Name, metav1.DeleteOptions{
+					Preconditions: &metav1.Preconditions{UID: &workspace.UID, ResourceVersion: &workspace.ResourceVersion},
+				})
+			},
+		},
 		&schedulingReconciler{
 			generateClusterName: randomClusterName,
 			getShard:            getShard,
 			listShards:          c.globalShardLister.List,
-			getWorkspaceType:    getType,
+			listWorkspaces: func(clusterName logicalcluster.Name) ([]*tenancyv1alpha1.Workspace, error) {
+				return c.workspaceLister.Cluster(clusterName).List(labels.Everything())
+			},
+			shardScorer:      c.shardScorer,
+			shardPlacements:  c.shardPlacements,
+			getWorkspaceType: getType,
 			getLogicalCluster: func(clusterName logicalcluster.Name) (*corev1alpha1.LogicalCluster, error) {
 				return c.logicalClusterLister.Cluster(clusterName).Get(corev1alpha1.LogicalClusterName)
 			},
+			getExternalLogicalCluster: func(ctx context.Context, cluster logicalcluster.Path) (*corev1alpha1.LogicalCluster, error) {
+				return c.kcpExternalClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Get(ctx, corev1alpha1.LogicalClusterName, metav1.GetOptions{})
+			},
+			getExternalWorkspace: func(ctx context.Context, cluster logicalcluster.Path, name string) (*tenancyv1alpha1.Workspace, error) {
+				return c.kcpExternalClient.Cluster(cluster).TenancyV1alpha1().Workspaces().Get(ctx, name, metav1.GetOptions{})
+			},
+			listExternalWorkspaces: func(ctx context.Context, cluster logicalcluster.Path) ([]*tenancyv1alpha1.Workspace, error) {
+				list, err := c.kcpExternalClient.Cluster(cluster).TenancyV1alpha1().Workspaces().List(ctx, metav1.ListOptions{})
+				if err != nil {
+					return nil, err
+				}
+				workspaces := make([]*tenancyv1alpha1.Workspace, 0, len(list.Items))
+				for i := range list.Items {
+					workspaces = append(workspaces, &list.Items[i])
+				}
+				return workspaces, nil
+			},
 			transitiveTypeResolver:           workspacetypeexists.NewTransitiveTypeResolver(getType),
 			kcpLogicalClusterAdminClientFor:  kcpDirectClientFor,
 			kubeLogicalClusterAdminClientFor: kubeDirectClientFor,
 		},
+		&pathReconciler{
+			getLogicalCluster: func(clusterName logicalcluster.Name) (*corev1alpha1.LogicalCluster, error) {
+				return c.logicalClusterLister.Cluster(clusterName).Get(corev1alpha1.LogicalClusterName)
+			},
+			getWorkspaceLogicalCluster: func(clusterName logicalcluster.Name) (*corev1alpha1.LogicalCluster, error) {
+				logicalCluster, err := c.logicalClusterLister.Cluster(clusterName).Get(corev1alpha1.LogicalClusterName)
+				if apierrors.IsNotFound(err) {
+					return c.cacheLogicalClusterLister.Cluster(clusterName).Get(corev1alpha1.LogicalClusterName)
+				}
+				return logicalCluster, err
+			},
+			patchLogicalClusterPath: func(ctx context.Context, cluster logicalcluster.Path, path logicalcluster.Path) error {
+				patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, core.LogicalClusterPathAnnotationKey, path.String())
+				_, err := c.kcpExternalClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Patch(ctx, corev1alpha1.LogicalClusterName, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+				return err
+			},
+		},
 		&phaseReconciler{
 			getLogicalCluster: func(ctx context.Context, cluster logicalcluster.Path) (*corev1alpha1.LogicalCluster, error) {
 				return c.kcpExternalClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Get(ctx, corev1alpha1.LogicalClusterName, metav1.GetOptions{})
@@ -104,6 +165,21 @@ func (c *Controller) reconcile(ctx context.Context, ws *tenancyv1alpha1.Workspac
 				c.queue.AddAfter(kcpcache.ToClusterAwareKey(logicalcluster.From(workspace).String(), "", workspace.Name), after)
 			},
 		},
+		&expiryReconciler{
+			deleteWorkspace: func(ctx context.Context, workspace *tenancyv1alpha1.Workspace) error {
+				return c.kcpClusterClient.Cluster(logicalcluster.From(workspace).Path()).TenancyV1alpha1().Workspaces().Delete(ctx, workspace.Name, metav1.DeleteOptions{
+					Preconditions: &metav1.Preconditions{UID: &workspace.UID, ResourceVersion: &workspace.ResourceVersion},
+				})
+			},
+			createEvent: func(ctx context.Context, workspace *tenancyv1alpha1.Workspace, event *corev1.Event) error {
+				_, err := c.kubeClusterClient.Cluster(logicalcluster.From(workspace).Path()).CoreV1().Events(event.Namespace).Create(ctx, event, metav1.CreateOptions{})
+				return err
+			},
+			requeueAfter: func(workspace *tenancyv1alpha1.Workspace, after time.Duration) {
+				c.queue.AddAfter(kcpcache.ToClusterAwareKey(logicalcluster.From(workspace).String(), "", workspace.Name), after)
+			},
+			now: time.Now,
+		},
 	}
 
 	var errs []error
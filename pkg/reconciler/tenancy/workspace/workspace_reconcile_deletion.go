@@ -19,6 +19,7 @@ package workspace
 import (
 	"context"
 	"fmt"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -34,6 +35,11 @@ import (
 type deletionReconciler struct {
 	getLogicalCluster    func(ctx context.Context, cluster logicalcluster.Path) (*corev1alpha1.LogicalCluster, error)
 	deleteLogicalCluster func(ctx context.Context, cluster logicalcluster.Path) error
+	// retainLogicalCluster marks the logical cluster as deleted, to be purged after the given time.
+	retainLogicalCluster func(ctx context.Context, cluster logicalcluster.Path, until time.Time) error
+
+	getWorkspaceType func(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error)
+	now              func() time.Time
 
 	getShard func(name string) (*corev1alpha1.Shard, error)
 
@@ -107,7 +113,42 @@ func (r *deletionReconciler) reconcile(ctx context.Context, workspace *tenancyv1
 		return reconcileStatusContinue, nil
 	}
 
+	if owner := logicalCluster.Spec.Owner; owner != nil && owner.UID != "" && owner.UID != workspace.UID {
+		// The logical cluster belongs to another workspace, i.e. this one was moved,
+		// or was deleted before taking it over. Release the workspace object only.
+		if finSet.Has(corev1alpha1.LogicalClusterFinalizerName) {
+			logger.Info(fmt.Sprintf("Removing finalizer %s, LogicalCluster is owned by another workspace", corev1alpha1.LogicalClusterFinalizerName), "owner", owner.Name)
+			workspace.Finalizers = sets.List(finSet.Delete(corev1alpha1.LogicalClusterFinalizerName))
+			return reconcileStatusStopAndRequeue, nil // spec change
+		}
+		return reconcileStatusContinue, nil
+	}
+
 	if logicalCluster.DeletionTimestamp.IsZero() {
+		if corev1alpha1.IsLogicalClusterDeleted(logicalCluster.Annotations) {
+			// The logical cluster is retained. Release the workspace object when asked to
+			// undelete it, so that it can be recreated. Otherwise wait for the purge.
+			if workspace.Annotations[tenancyv1alpha1.WorkspaceUndeleteAnnotationKey] == clusterName.String() && finSet.Has(corev1alpha1.LogicalClusterFinalizerName) {
+				logger.Info(fmt.Sprintf("Removing finalizer %s to undelete workspace", corev1alpha1.LogicalClusterFinalizerName))
+				workspace.Finalizers = sets.List(finSet.Delete(corev1alpha1.LogicalClusterFinalizerName))
+				return reconcileStatusStopAndRequeue, nil // spec change
+			}
+			return reconcileStatusContinue, nil
+		}
+
+		retention, err := r.deletionRetention(workspace)
+		if err != nil {
+			return reconcileStatusStopAndRequeue, err
+		}
+		if retention > 0 {
+			until := r.now().Add(retention)
+			logger.Info("Retaining LogicalCluster", "until", until)
+			if err := r.retainLogicalCluster(ctx, clusterName.Path(), until); err != nil {
+				return reconcileStatusStopAndRequeue, err
+			}
+			return reconcileStatusContinue, nil
+		}
+
 		logger.Info("Deleting LogicalCluster")
 		if err := r.deleteLogicalCluster(ctx, clusterName.Path()); err != nil {
 			return reconcileStatusStopAndRequeue, err
@@ -118,3 +159,22 @@ func (r *deletionReconciler) reconcile(ctx context.Context, workspace *tenancyv1
 
 	return reconcileStatusContinue, nil
 }
+
+// deletionRetention returns the time the logical cluster of the deleted
+// workspace is retained, according to its type. Workspaces whose type does not
+// exist anymore are not retained.
+func (r *deletionReconciler) deletionRetention(workspace *tenancyv1alpha1.Workspace) (time.Duration, error) {
+	if workspace.Spec.Type == nil {
+		return 0, nil
+	}
+	wt, err := r.getWorkspaceType(logicalcluster.NewPath(workspace.Spec.Type.Path), string(workspace.Spec.Type.Name))
+	if apierrors.IsNotFound(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	if wt.Spec.DeletionRetention == nil {
+		return 0, nil
+	}
+	return wt.Spec.DeletionRetention.Duration, nil
+}
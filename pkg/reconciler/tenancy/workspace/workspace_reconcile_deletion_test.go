@@ -20,6 +20,7 @@ import (
 	"context"
 	"slices"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
@@ -57,6 +58,26 @@ func (f *fakeDeletionReconciler) deleteLogicalCluster() func(ctx context.Context
 	}
 }
 
+func (f *fakeDeletionReconciler) retainLogicalCluster() func(ctx context.Context, cluster logicalcluster.Path, until time.Time) error {
+	return func(ctx context.Context, cluster logicalcluster.Path, until time.Time) error {
+		f.clusters[cluster.String()].Annotations = map[string]string{
+			corev1alpha1.LogicalClusterDeletedAnnotationKey: until.UTC().Format(time.RFC3339),
+		}
+		return nil
+	}
+}
+
+func (f *fakeDeletionReconciler) getWorkspaceType() func(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error) {
+	return func(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error) {
+		if path.String() != "root" || name != "retained" {
+			return nil, apierrors.NewNotFound(tenancyv1alpha1.Resource("workspacetypes"), name)
+		}
+		return &tenancyv1alpha1.WorkspaceType{
+			Spec: tenancyv1alpha1.WorkspaceTypeSpec{DeletionRetention: &metav1.Duration{Duration: time.Hour}},
+		}, nil
+	}
+}
+
 func (f *fakeDeletionReconciler) getShard() func(name string) (*corev1alpha1.Shard, error) {
 	return func(name string) (*corev1alpha1.Shard, error) {
 		// for now we can work with a fixed shard
@@ -149,6 +170,115 @@ func TestReconcile(t *testing.T) {
 			// we do expect our logicalCluster to be removed
 			expLogicalClusters: map[string]*corev1alpha1.LogicalCluster{},
 		},
+		{
+			name:      "type with deletionRetention, logicalcluster is retained",
+			expStatus: reconcileStatusContinue,
+			workspace: &tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{
+					DeletionTimestamp: ptr.To(metav1.Now()),
+					Finalizers: []string{
+						corev1alpha1.LogicalClusterFinalizerName,
+					},
+				},
+				Spec: tenancyv1alpha1.WorkspaceSpec{
+					Cluster: "test",
+					Type:    &tenancyv1alpha1.WorkspaceTypeReference{Name: "retained", Path: "root"},
+				},
+			},
+			logicalclusters: map[string]*corev1alpha1.LogicalCluster{
+				"test": {},
+			},
+			// the workspace is held until the logicalcluster is purged or undeleted
+			expFinalizers: []string{
+				corev1alpha1.LogicalClusterFinalizerName,
+			},
+			expLogicalClusters: map[string]*corev1alpha1.LogicalCluster{
+				"test": {ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+					corev1alpha1.LogicalClusterDeletedAnnotationKey: "2026-01-01T13:00:00Z",
+				}}},
+			},
+		},
+		{
+			name:      "logicalcluster is retained, workspace is held",
+			expStatus: reconcileStatusContinue,
+			workspace: &tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{
+					DeletionTimestamp: ptr.To(metav1.Now()),
+					Finalizers: []string{
+						corev1alpha1.LogicalClusterFinalizerName,
+					},
+				},
+				Spec: tenancyv1alpha1.WorkspaceSpec{
+					Cluster: "test",
+				},
+			},
+			logicalclusters: map[string]*corev1alpha1.LogicalCluster{
+				"test": {ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+					corev1alpha1.LogicalClusterDeletedAnnotationKey: "2026-01-01T13:00:00Z",
+				}}},
+			},
+			expFinalizers: []string{
+				corev1alpha1.LogicalClusterFinalizerName,
+			},
+			expLogicalClusters: map[string]*corev1alpha1.LogicalCluster{
+				"test": {ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+					corev1alpha1.LogicalClusterDeletedAnnotationKey: "2026-01-01T13:00:00Z",
+				}}},
+			},
+		},
+		{
+			name:      "logicalcluster is retained, workspace is released for undelete",
+			expStatus: reconcileStatusStopAndRequeue,
+			workspace: &tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{
+					DeletionTimestamp: ptr.To(metav1.Now()),
+					Finalizers: []string{
+						corev1alpha1.LogicalClusterFinalizerName,
+					},
+					Annotations: map[string]string{
+						tenancyv1alpha1.WorkspaceUndeleteAnnotationKey: "test",
+					},
+				},
+				Spec: tenancyv1alpha1.WorkspaceSpec{
+					Cluster: "test",
+				},
+			},
+			logicalclusters: map[string]*corev1alpha1.LogicalCluster{
+				"test": {ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+					corev1alpha1.LogicalClusterDeletedAnnotationKey: "2026-01-01T13:00:00Z",
+				}}},
+			},
+			// the logicalcluster stays for the new workspace to pick it up
+			expFinalizers: []string{},
+			expLogicalClusters: map[string]*corev1alpha1.LogicalCluster{
+				"test": {ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+					corev1alpha1.LogicalClusterDeletedAnnotationKey: "2026-01-01T13:00:00Z",
+				}}},
+			},
+		},
+		{
+			name:      "workspace was moved, logicalcluster is kept for the new owner",
+			expStatus: reconcileStatusStopAndRequeue,
+			workspace: &tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{
+					DeletionTimestamp: ptr.To(metav1.Now()),
+					Finalizers: []string{
+						corev1alpha1.LogicalClusterFinalizerName,
+					},
+					UID: "old",
+				},
+				Spec: tenancyv1alpha1.WorkspaceSpec{
+					Cluster: "test",
+				},
+			},
+			logicalclusters: map[string]*corev1alpha1.LogicalCluster{
+				"test": {Spec: corev1alpha1.LogicalClusterSpec{Owner: &corev1alpha1.LogicalClusterOwner{Name: "new", UID: "new"}}},
+			},
+			expFinalizers: []string{},
+			expLogicalClusters: map[string]*corev1alpha1.LogicalCluster{
+				"test": {Spec: corev1alpha1.LogicalClusterSpec{Owner: &corev1alpha1.LogicalClusterOwner{Name: "new", UID: "new"}}},
+			},
+		},
 		{
 			name:      "workspace is marked for deletion, logicalcluster is already deleted",
 			expStatus: reconcileStatusStopAndRequeue,
@@ -185,6 +315,9 @@ func TestReconcile(t *testing.T) {
 			}
 			fdr.deletionReconciler.getLogicalCluster = fdr.getLogicalCluster()
 			fdr.deletionReconciler.deleteLogicalCluster = fdr.deleteLogicalCluster()
+			fdr.deletionReconciler.retainLogicalCluster = fdr.retainLogicalCluster()
+			fdr.deletionReconciler.getWorkspaceType = fdr.getWorkspaceType()
+			fdr.deletionReconciler.now = func() time.Time { return time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC) }
 			fdr.deletionReconciler.getShard = fdr.getShard()
 			fdr.deletionReconciler.kcpLogicalClusterAdminClientFor = fdr.kcpLogicalClusterAdminClientFor()
 
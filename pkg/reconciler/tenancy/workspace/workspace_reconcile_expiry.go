@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+	conditionsv1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+)
+
+// expiryWarningPeriod is the time before spec.expiresAt at which owners are
+// warned that the workspace is about to expire.
+const expiryWarningPeriod = time.Hour
+
+// expiryReconciler deletes workspaces after spec.expiresAt, and warns through
+// the WorkspaceExpiring condition and events before. The deletion is a normal
+// one, i.e. terminators of the workspace run as usual.
+type expiryReconciler struct {
+	deleteWorkspace func(ctx context.Context, workspace *tenancyv1alpha1.Workspace) error
+	createEvent     func(ctx context.Context, workspace *tenancyv1alpha1.Workspace, event *corev1.Event) error
+
+	requeueAfter func(workspace *tenancyv1alpha1.Workspace, after time.Duration)
+	now          func() time.Time
+}
+
+func (r *expiryReconciler) reconcile(ctx context.Context, workspace *tenancyv1alpha1.Workspace) (reconcileStatus, error) {
+	logger := klog.FromContext(ctx).WithValues("reconciler", "expiry")
+
+	if workspace.Spec.ExpiresAt == nil {
+		conditions.Delete(workspace, tenancyv1alpha1.WorkspaceExpiring)
+		return reconcileStatusContinue, nil
+	}
+	if !workspace.DeletionTimestamp.IsZero() {
+		return reconcileStatusContinue, nil
+	}
+
+	remaining := workspace.Spec.ExpiresAt.Sub(r.now())
+	switch {
+	case remaining > expiryWarningPeriod:
+		conditions.Delete(workspace, tenancyv1alpha1.WorkspaceExpiring)
+		r.requeueAfter(workspace, remaining-expiryWarningPeriod)
+	case remaining > 0:
+		message := fmt.Sprintf("Workspace expires at %s. Extend the lease with the %s annotation.", workspace.Spec.ExpiresAt.UTC().Format(time.RFC3339), tenancyv1alpha1.WorkspaceExtendLeaseAnnotationKey)
+		if conditions.GetReason(workspace, tenancyv1alpha1.WorkspaceExpiring) != tenancyv1alpha1.WorkspaceExpiringExpiresSoon {
+			r.recordEvent(ctx, workspace, tenancyv1alpha1.WorkspaceExpiringExpiresSoon, message)
+		}
+		markExpiring(workspace, tenancyv1alpha1.WorkspaceExpiringExpiresSoon, message)
+		r.requeueAfter(workspace, remaining)
+	default:
+		message := fmt.Sprintf("Workspace expired at %s and is deleted.", workspace.Spec.ExpiresAt.UTC().Format(time.RFC3339))
+		markExpiring(workspace, tenancyv1alpha1.WorkspaceExpiringExpired, message)
+
+		logger.Info("deleting expired workspace", "expiresAt", workspace.Spec.ExpiresAt)
+		if err := r.deleteWorkspace(ctx, workspace); err != nil {
+			return reconcileStatusContinue, err
+		}
+		r.recordEvent(ctx, workspace, tenancyv1alpha1.WorkspaceExpiringExpired, message)
+	}
+
+	return reconcileStatusContinue, nil
+}
+
+// recordEvent creates an event for the workspace. Failures are only logged
+// because events are best-effort.
+func (r *expiryReconciler) recordEvent(ctx context.Context, workspace *tenancyv1alpha1.Workspace, reason, message string) {
+	now := metav1.NewTime(r.now())
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s.%x", workspace.Name, now.UnixNano()),
+			Namespace: metav1.NamespaceDefault,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion:      tenancyv1alpha1.SchemeGroupVersion.String(),
+			Kind:            "Workspace",
+			Name:            workspace.Name,
+			UID:             workspace.UID,
+			ResourceVersion: workspace.ResourceVersion,
+		},
+		Reason:         reason,
+		Message:        message,
+		Type:           corev1.EventTypeWarning,
+		Source:         corev1.EventSource{Component: ControllerName},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+	if err := r.createEvent(ctx, workspace, event); err != nil {
+		klog.FromContext(ctx).WithValues("reconciler", "expiry").Error(err, "failed to record event", "reason", reason)
+	}
+}
+
+func markExpiring(workspace *tenancyv1alpha1.Workspace, reason, message string) {
+	conditions.Set(workspace, &conditionsv1alpha1.Condition{
+		Type:     tenancyv1alpha1.WorkspaceExpiring,
+		Status:   corev1.ConditionTrue,
+		Severity: conditionsv1alpha1.ConditionSeverityWarning,
+		Reason:   reason,
+		Message:  message,
+	})
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+	conditionsv1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+)
+
+func TestReconcileExpiry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.January, 1, 12, 0, 0, 0, time.UTC)
+	for _, testCase := range []struct {
+		name        string
+		expiresAt   *time.Time
+		deleting    bool
+		reason      string
+		deleteError error
+
+		wantReason  string
+		wantDeleted bool
+		wantEvents  []string
+		wantRequeue time.Duration
+	}{
+		{
+			name: "no expiry",
+		},
+		{
+			name:   "expiry removed",
+			reason: tenancyv1alpha1.WorkspaceExpiringExpiresSoon,
+		},
+		{
+			name:        "expires later",
+			expiresAt:   ptr.To(now.Add(3 * time.Hour)),
+			wantRequeue: 2 * time.Hour,
+		},
+		{
+			name:        "lease extended",
+			expiresAt:   ptr.To(now.Add(3 * time.Hour)),
+			reason:      tenancyv1alpha1.WorkspaceExpiringExpiresSoon,
+			wantRequeue: 2 * time.Hour,
+		},
+		{
+			name:        "expires soon",
+			expiresAt:   ptr.To(now.Add(10 * time.Minute)),
+			wantReason:  tenancyv1alpha1.WorkspaceExpiringExpiresSoon,
+			wantEvents:  []string{tenancyv1alpha1.WorkspaceExpiringExpiresSoon},
+			wantRequeue: 10 * time.Minute,
+		},
+		{
+			name:        "expires soon and already warned",
+			expiresAt:   ptr.To(now.Add(10 * time.Minute)),
+			reason:      tenancyv1alpha1.WorkspaceExpiringExpiresSoon,
+			wantReason:  tenancyv1alpha1.WorkspaceExpiringExpiresSoon,
+			wantRequeue: 10 * time.Minute,
+		},
+		{
+			name:        "expired",
+			expiresAt:   ptr.To(now.Add(-time.Second)),
+			reason:      tenancyv1alpha1.WorkspaceExpiringExpiresSoon,
+			wantReason:  tenancyv1alpha1.WorkspaceExpiringExpired,
+			wantDeleted: true,
+			wantEvents:  []string{tenancyv1alpha1.WorkspaceExpiringExpired},
+		},
+		{
+			name:        "expired and deletion fails",
+			expiresAt:   ptr.To(now),
+			deleteError: errors.New("boom"),
+			wantReason:  tenancyv1alpha1.WorkspaceExpiringExpired,
+		},
+		{
+			name:      "expired and already deleting",
+			expiresAt: ptr.To(now.Add(-time.Hour)),
+			deleting:  true,
+			reason:    tenancyv1alpha1.WorkspaceExpiringExpired,
+
+			wantReason: tenancyv1alpha1.WorkspaceExpiringExpired,
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ws := &tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			}
+			if testCase.expiresAt != nil {
+				ws.Spec.ExpiresAt = &metav1.Time{Time: *testCase.expiresAt}
+			}
+			if testCase.deleting {
+				ws.DeletionTimestamp = &metav1.Time{Time: now}
+			}
+			if testCase.reason != "" {
+				markExpiring(ws, testCase.reason, "")
+			}
+
+			var deleted bool
+			var events []string
+			var requeue time.Duration
+			r := &expiryReconciler{
+				deleteWorkspace: func(ctx context.Context, workspace *tenancyv1alpha1.Workspace) error {
+					if testCase.deleteError != nil {
+						return testCase.deleteError
+					}
+					deleted = true
+					return nil
+				},
+				createEvent: func(ctx context.Context, workspace *tenancyv1alpha1.Workspace, event *corev1.Event) error {
+					require.Equal(t, metav1.NamespaceDefault, event.Namespace)
+					require.Equal(t, workspace.Name, event.InvolvedObject.Name)
+					events = append(events, event.Reason)
+					return nil
+				},
+				requeueAfter: func(workspace *tenancyv1alpha1.Workspace, after time.Duration) {
+					requeue = after
+				},
+				now: func() time.Time { return now },
+			}
+
+			status, err := r.reconcile(context.Background(), ws)
+			if testCase.deleteError != nil {
+				require.ErrorIs(t, err, testCase.deleteError)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, reconcileStatusContinue, status)
+			require.Equal(t, testCase.wantDeleted, deleted)
+			require.Equal(t, testCase.wantEvents, events)
+			require.Equal(t, testCase.wantRequeue, requeue)
+
+			if testCase.wantReason == "" {
+				require.False(t, conditions.Has(ws, tenancyv1alpha1.WorkspaceExpiring), "unexpected condition %s", tenancyv1alpha1.WorkspaceExpiring)
+				return
+			}
+			cond := conditions.Get(ws, tenancyv1alpha1.WorkspaceExpiring)
+			require.NotNil(t, cond)
+			require.Equal(t, corev1.ConditionTrue, cond.Status)
+			require.Equal(t, conditionsv1alpha1.ConditionSeverityWarning, cond.Severity)
+			require.Equal(t, testCase.wantReason, cond.Reason)
+		})
+	}
+}
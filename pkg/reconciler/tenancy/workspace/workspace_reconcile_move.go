@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+)
+
+// moveReconciler deletes workspaces annotated to be moved once the workspace
+// created at the target path took over their logical cluster. The deletion
+// reconciler then releases them without deleting the logical cluster.
+type moveReconciler struct {
+	getLogicalCluster func(ctx context.Context, cluster logicalcluster.Path) (*corev1alpha1.LogicalCluster, error)
+	deleteWorkspace   func(ctx context.Context, workspace *tenancyv1alpha1.Workspace) error
+}
+
+func (r *moveReconciler) reconcile(ctx context.Context, workspace *tenancyv1alpha1.Workspace) (reconcileStatus, error) {
+	logger := klog.FromContext(ctx).WithValues("reconciler", "move")
+
+	to, found := workspace.Annotations[tenancyv1alpha1.WorkspaceMoveToAnnotationKey]
+	if !found || !workspace.DeletionTimestamp.IsZero() || workspace.Spec.Cluster == "" {
+		return reconcileStatusContinue, nil
+	}
+
+	logicalCluster, err := r.getLogicalCluster(ctx, logicalcluster.NewPath(workspace.Spec.Cluster))
+	if apierrors.IsNotFound(err) {
+		return reconcileStatusContinue, nil
+	} else if err != nil {
+		return reconcileStatusStopAndRequeue, err
+	}
+	if owner := logicalCluster.Spec.Owner; owner == nil || owner.UID == "" || owner.UID == workspace.UID {
+		return reconcileStatusContinue, nil // not moved yet
+	}
+
+	logger.Info("deleting moved workspace", "to", to)
+	if err := r.deleteWorkspace(ctx, workspace); err != nil && !apierrors.IsNotFound(err) {
+		return reconcileStatusStopAndRequeue, err
+	}
+	return reconcileStatusStopAndRequeue, nil
+}
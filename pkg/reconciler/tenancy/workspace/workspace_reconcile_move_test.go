@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+)
+
+func TestReconcileMove(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		name     string
+		moveTo   string
+		deleting bool
+		owner    *corev1alpha1.LogicalClusterOwner
+
+		wantStatus  reconcileStatus
+		wantDeleted bool
+	}{
+		{
+			name:       "not to be moved",
+			owner:      &corev1alpha1.LogicalClusterOwner{Name: "new", UID: "new"},
+			wantStatus: reconcileStatusContinue,
+		},
+		{
+			name:       "not moved yet",
+			moveTo:     "root:new",
+			owner:      &corev1alpha1.LogicalClusterOwner{Name: "old", UID: "old"},
+			wantStatus: reconcileStatusContinue,
+		},
+		{
+			name:        "moved",
+			moveTo:      "root:new",
+			owner:       &corev1alpha1.LogicalClusterOwner{Name: "new", UID: "new"},
+			wantStatus:  reconcileStatusStopAndRequeue,
+			wantDeleted: true,
+		},
+		{
+			name:       "moved and already deleting",
+			moveTo:     "root:new",
+			deleting:   true,
+			owner:      &corev1alpha1.LogicalClusterOwner{Name: "new", UID: "new"},
+			wantStatus: reconcileStatusContinue,
+		},
+		{
+			name:       "logical cluster gone",
+			moveTo:     "root:new",
+			wantStatus: reconcileStatusContinue,
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			workspace := &tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "old",
+					UID:         "old",
+					Annotations: map[string]string{},
+				},
+				Spec: tenancyv1alpha1.WorkspaceSpec{Cluster: "cluster"},
+			}
+			if testCase.moveTo != "" {
+				workspace.Annotations[tenancyv1alpha1.WorkspaceMoveToAnnotationKey] = testCase.moveTo
+			}
+			if testCase.deleting {
+				workspace.DeletionTimestamp = ptr.To(metav1.Now())
+			}
+
+			deleted := false
+			r := &moveReconciler{
+				getLogicalCluster: func(ctx context.Context, cluster logicalcluster.Path) (*corev1alpha1.LogicalCluster, error) {
+					require.Equal(t, "cluster", cluster.String())
+					if testCase.owner == nil {
+						return nil, apierrors.NewNotFound(corev1alpha1.Resource("logicalclusters"), corev1alpha1.LogicalClusterName)
+					}
+					return &corev1alpha1.LogicalCluster{Spec: corev1alpha1.LogicalClusterSpec{Owner: testCase.owner}}, nil
+				},
+				deleteWorkspace: func(ctx context.Context, ws *tenancyv1alpha1.Workspace) error {
+					deleted = true
+					return nil
+				},
+			}
+
+			status, err := r.reconcile(context.Background(), workspace)
+			require.NoError(t, err)
+			require.Equal(t, testCase.wantStatus, status)
+			require.Equal(t, testCase.wantDeleted, deleted)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	"github.com/kcp-dev/sdk/apis/core"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+)
+
+// pathReconciler keeps the path annotation of the logical cluster of a
+// workspace in sync with the path of its parent, which changes when the
+// parent or one of its ancestors is moved.
+type pathReconciler struct {
+	// getLogicalCluster gets the logical cluster of the parent, on this shard.
+	getLogicalCluster func(clusterName logicalcluster.Name) (*corev1alpha1.LogicalCluster, error)
+	// getWorkspaceLogicalCluster gets the logical cluster of the workspace, on any shard.
+	getWorkspaceLogicalCluster func(clusterName logicalcluster.Name) (*corev1alpha1.LogicalCluster, error)
+	patchLogicalClusterPath    func(ctx context.Context, cluster logicalcluster.Path, path logicalcluster.Path) error
+}
+
+func (r *pathReconciler) reconcile(ctx context.Context, workspace *tenancyv1alpha1.Workspace) (reconcileStatus, error) {
+	logger := klog.FromContext(ctx).WithValues("reconciler", "path")
+
+	if !workspace.DeletionTimestamp.IsZero() || workspace.Spec.Mount != nil || workspace.Spec.Cluster == "" {
+		return reconcileStatusContinue, nil
+	}
+	if _, found := workspace.Annotations[tenancyv1alpha1.WorkspaceMoveToAnnotationKey]; found {
+		return reconcileStatusContinue, nil // the workspace taking over sets the path
+	}
+
+	parent, err := r.getLogicalCluster(logicalcluster.From(workspace))
+	if apierrors.IsNotFound(err) {
+		return reconcileStatusContinue, nil
+	} else if err != nil {
+		return reconcileStatusStopAndRequeue, err
+	}
+	if parent.Annotations[core.LogicalClusterPathAnnotationKey] == "" {
+		return reconcileStatusContinue, nil
+	}
+	path := workspaceCanonicalPath(workspace, parent)
+
+	clusterName := logicalcluster.Name(workspace.Spec.Cluster)
+	logicalCluster, err := r.getWorkspaceLogicalCluster(clusterName)
+	if apierrors.IsNotFound(err) {
+		return reconcileStatusContinue, nil
+	} else if err != nil {
+		return reconcileStatusStopAndRequeue, err
+	}
+	if owner := logicalCluster.Spec.Owner; owner == nil || owner.UID != workspace.UID {
+		return reconcileStatusContinue, nil
+	}
+	if logicalCluster.Annotations[core.LogicalClusterPathAnnotationKey] == path.String() {
+		return reconcileStatusContinue, nil
+	}
+
+	logger.Info("updating LogicalCluster path", "cluster", clusterName, "path", path)
+	if err := r.patchLogicalClusterPath(ctx, clusterName.Path(), path); err != nil {
+		return reconcileStatusStopAndRequeue, err
+	}
+	return reconcileStatusContinue, nil
+}
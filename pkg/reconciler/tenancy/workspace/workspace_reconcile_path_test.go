@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	"github.com/kcp-dev/sdk/apis/core"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+)
+
+func TestReconcilePath(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		name       string
+		parentPath string
+		path       string
+		ownerUID   types.UID
+		moveTo     string
+
+		wantPatch string
+	}{
+		{
+			name:       "path is up-to-date",
+			parentPath: "root:new",
+			path:       "root:new:foo",
+			ownerUID:   "foo",
+		},
+		{
+			name:       "parent was moved",
+			parentPath: "root:new",
+			path:       "root:old:foo",
+			ownerUID:   "foo",
+			wantPatch:  "root:new:foo",
+		},
+		{
+			name:       "logical cluster is owned by another workspace",
+			parentPath: "root:new",
+			path:       "root:old:foo",
+			ownerUID:   "bar",
+		},
+		{
+			name:       "workspace is to be moved",
+			parentPath: "root:new",
+			path:       "root:old:foo",
+			ownerUID:   "foo",
+			moveTo:     "root:other",
+		},
+		{
+			name:     "parent has no path",
+			path:     "root:old:foo",
+			ownerUID: "foo",
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			workspace := &tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+					UID:  "foo",
+					Annotations: map[string]string{
+						logicalcluster.AnnotationKey: "parent",
+					},
+				},
+				Spec: tenancyv1alpha1.WorkspaceSpec{Cluster: "child"},
+			}
+			if testCase.moveTo != "" {
+				workspace.Annotations[tenancyv1alpha1.WorkspaceMoveToAnnotationKey] = testCase.moveTo
+			}
+
+			var patched string
+			r := &pathReconciler{
+				getLogicalCluster: func(clusterName logicalcluster.Name) (*corev1alpha1.LogicalCluster, error) {
+					require.Equal(t, "parent", clusterName.String())
+					parent := &corev1alpha1.LogicalCluster{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{}}}
+					if testCase.parentPath != "" {
+						parent.Annotations[core.LogicalClusterPathAnnotationKey] = testCase.parentPath
+					}
+					return parent, nil
+				},
+				getWorkspaceLogicalCluster: func(clusterName logicalcluster.Name) (*corev1alpha1.LogicalCluster, error) {
+					if clusterName != "child" {
+						return nil, apierrors.NewNotFound(corev1alpha1.Resource("logicalclusters"), corev1alpha1.LogicalClusterName)
+					}
+					return &corev1alpha1.LogicalCluster{
+						ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{core.LogicalClusterPathAnnotationKey: testCase.path}},
+						Spec:       corev1alpha1.LogicalClusterSpec{Owner: &corev1alpha1.LogicalClusterOwner{UID: testCase.ownerUID}},
+					}, nil
+				},
+				patchLogicalClusterPath: func(ctx context.Context, cluster logicalcluster.Path, path logicalcluster.Path) error {
+					require.Equal(t, "child", cluster.String())
+					patched = path.String()
+					return nil
+				},
+			}
+
+			status, err := r.reconcile(context.Background(), workspace)
+			require.NoError(t, err)
+			require.Equal(t, reconcileStatusContinue, status)
+			require.Equal(t, testCase.wantPatch, patched)
+		})
+	}
+}
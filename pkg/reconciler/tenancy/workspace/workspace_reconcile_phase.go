@@ -92,7 +92,9 @@ func (r *phaseReconciler) reconcile(ctx context.Context, workspace *tenancyv1alp
 			// being created -- so callers see the workspace ready and the APIs it
 			// promises missing.
 			switch logicalCluster.Status.Phase {
-			case "", corev1alpha1.LogicalClusterPhaseScheduling:
+			case "", corev1alpha1.LogicalClusterPhaseScheduling,
+				// an undeleted LogicalCluster is about to leave the Deleted phase
+				corev1alpha1.LogicalClusterPhaseDeleted:
 				// This window is milliseconds wide in the normal case, so poll fast at
 				// first, but back off for a LogicalCluster that is genuinely stuck
 				// rather than spinning on it.
@@ -154,6 +156,7 @@ func (r *phaseReconciler) reconcile(ctx context.Context, workspace *tenancyv1alp
 			return reconcileStatusContinue, nil
 
 		case corev1alpha1.LogicalClusterPhaseReady,
+			corev1alpha1.LogicalClusterPhaseDeleted,
 			corev1alpha1.LogicalClusterPhaseTerminating,
 			corev1alpha1.LogicalClusterPhaseDeleting:
 			// On delete we need to wait for the logical cluster to be deleted
@@ -170,6 +173,21 @@ func (r *phaseReconciler) reconcile(ctx context.Context, workspace *tenancyv1alp
 					return reconcileStatusContinue, nil
 				}
 
+				// A retained LogicalCluster is purged, and its terminators run, only
+				// after the retention. Until then, the workspace can be undeleted.
+				if corev1alpha1.IsLogicalClusterDeleted(logicalCluster.Annotations) && logicalCluster.DeletionTimestamp.IsZero() {
+					until := logicalCluster.Annotations[corev1alpha1.LogicalClusterDeletedAnnotationKey]
+					workspace.Status.Phase = corev1alpha1.LogicalClusterPhaseDeleted
+					conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceContentDeleted, tenancyv1alpha1.WorkspaceContentDeletedRetained, conditionsv1alpha1.ConditionSeverityInfo, "Content is retained until %s, the workspace can be undeleted until then", until)
+
+					after := time.Minute
+					if t, err := time.Parse(time.RFC3339, until); err == nil && time.Until(t) > after {
+						after = time.Until(t)
+					}
+					r.requeueAfter(workspace, after)
+					return reconcileStatusContinue, nil
+				}
+
 				// Mirror terminators from the LogicalCluster, then derive the phase the
 				// same way the LogicalCluster's phase reconciler does. Computing locally
 				// (instead of mirroring logicalCluster.Status.Phase) avoids a window
@@ -226,10 +244,11 @@ func (r *phaseReconciler) reconcile(ctx context.Context, workspace *tenancyv1alp
 // updateTerminalConditionPhase checks if the workspace is ready by checking conditions and sets the phase accordingly.
 // It returns true if the phase was changed, false otherwise.
 func updateTerminalConditionPhase(workspace *tenancyv1alpha1.Workspace) bool {
-	// terminating/deleting workspaces are not subject to Unavailable/Ready transitions;
+	// deleted/terminating/deleting workspaces are not subject to Unavailable/Ready transitions;
 	// failing conditions (e.g. WorkspaceContentDeleted=False while waiting on
 	// terminators) are expected during termination.
-	if workspace.Status.Phase == corev1alpha1.LogicalClusterPhaseTerminating ||
+	if workspace.Status.Phase == corev1alpha1.LogicalClusterPhaseDeleted ||
+		workspace.Status.Phase == corev1alpha1.LogicalClusterPhaseTerminating ||
 		workspace.Status.Phase == corev1alpha1.LogicalClusterPhaseDeleting {
 		return false
 	}
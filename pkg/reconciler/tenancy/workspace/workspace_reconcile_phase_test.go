@@ -569,6 +569,42 @@ func TestReconcilePhase(t *testing.T) {
 				Status: corev1.ConditionTrue,
 			},
 		},
+		{
+			name: "workspace ready and being deleted, LogicalCluster is retained - phase becomes Deleted",
+			input: &tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{
+					DeletionTimestamp: &metav1.Time{Time: time.Now()},
+				},
+				Spec: tenancyv1alpha1.WorkspaceSpec{
+					URL:     "http://example.com",
+					Cluster: "cluster-1",
+				},
+				Status: tenancyv1alpha1.WorkspaceStatus{
+					Phase: corev1alpha1.LogicalClusterPhaseReady,
+				},
+			},
+			getLogicalCluster: func(ctx context.Context, cluster logicalcluster.Path) (*corev1alpha1.LogicalCluster, error) {
+				return &corev1alpha1.LogicalCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{corev1alpha1.LogicalClusterDeletedAnnotationKey: "2026-01-01T12:00:00Z"},
+					},
+					Status: corev1alpha1.LogicalClusterStatus{
+						Phase:       corev1alpha1.LogicalClusterPhaseDeleted,
+						Terminators: []corev1alpha1.LogicalClusterTerminator{"terminator-1"},
+					},
+				}, nil
+			},
+			wantPhase:   corev1alpha1.LogicalClusterPhaseDeleted,
+			wantStatus:  reconcileStatusContinue,
+			wantRequeue: true,
+			wantCondition: conditionsv1alpha1.Condition{
+				Type:     tenancyv1alpha1.WorkspaceContentDeleted,
+				Status:   corev1.ConditionFalse,
+				Severity: conditionsv1alpha1.ConditionSeverityInfo,
+				Reason:   tenancyv1alpha1.WorkspaceContentDeletedRetained,
+				Message:  "Content is retained until 2026-01-01T12:00:00Z, the workspace can be undeleted until then",
+			},
+		},
 	} {
 		t.Run(testCase.name, func(t *testing.T) {
 			t.Parallel()
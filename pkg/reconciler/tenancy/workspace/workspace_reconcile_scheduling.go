@@ -21,9 +21,10 @@ import (
 	"crypto/rand"
 	"encoding/json"
 	"fmt"
-	mathrand "math/rand"
 	"net/url"
 	"path"
+	"strconv"
+	"strings"
 
 	authenticationv1 "k8s.io/api/authentication/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -90,9 +91,20 @@ type schedulingReconciler struct {
 	getShard   func(name string) (*corev1alpha1.Shard, error)
 	listShards func(selector labels.Selector) ([]*corev1alpha1.Shard, error)
 
+	listWorkspaces func(clusterName logicalcluster.Name) ([]*tenancyv1alpha1.Workspace, error)
+
+	shardScorer     ShardScorer
+	shardPlacements *shardPlacements
+
 	getWorkspaceType func(clusterName logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error)
 
 	getLogicalCluster func(clusterName logicalcluster.Name) (*corev1alpha1.LogicalCluster, error)
+	// getExternalLogicalCluster gets a logical cluster on any shard, to be undeleted or moved.
+	getExternalLogicalCluster func(ctx context.Context, cluster logicalcluster.Path) (*corev1alpha1.LogicalCluster, error)
+	// getExternalWorkspace gets a workspace on any shard, to be moved.
+	getExternalWorkspace func(ctx context.Context, cluster logicalcluster.Path, name string) (*tenancyv1alpha1.Workspace, error)
+	// listExternalWorkspaces lists the workspaces in a logical cluster on any shard, below a workspace to move.
+	listExternalWorkspaces func(ctx context.Context, cluster logicalcluster.Path) ([]*tenancyv1alpha1.Workspace, error)
 
 	transitiveTypeResolver workspacetypeexists.TransitiveTypeResolver
 
@@ -140,12 +152,7 @@ func (r *schedulingReconciler) reconcile(ctx context.Context, workspace *tenancy
 			return reconcileStatusStopAndRequeue, err // requeue
 		}
 
-		canonicalPath := logicalcluster.From(workspace).Path().Join(workspace.Name)
-		if parentThis != nil {
-			if parentPath := parentThis.Annotations[core.LogicalClusterPathAnnotationKey]; parentPath != "" {
-				canonicalPath = logicalcluster.NewPath(parentThis.Annotations[core.LogicalClusterPathAnnotationKey]).Join(workspace.Name)
-			}
-		}
+		canonicalPath := workspaceCanonicalPath(workspace, parentThis)
 
 		u.Path = path.Join(u.Path, canonicalPath.RequestPath())
 		if workspace.Spec.URL != u.String() || workspace.Spec.Cluster != clusterName.String() {
@@ -169,13 +176,20 @@ func (r *schedulingReconciler) reconcile(ctx context.Context, workspace *tenancy
 			return reconcileStatusStopAndRequeue, nil // wait for parent LogicalCluster to be created
 		}
 
+		if undelete, found := workspace.Annotations[tenancyv1alpha1.WorkspaceUndeleteAnnotationKey]; found && !hasCluster {
+			return r.scheduleUndelete(ctx, workspace, logicalcluster.Name(undelete), hasFinalizer)
+		}
+		if from, found := workspace.Annotations[tenancyv1alpha1.WorkspaceMoveFromAnnotationKey]; found && !hasCluster {
+			return r.scheduleMove(ctx, workspace, workspaceCanonicalPath(workspace, parentThis), logicalcluster.NewPath(from), hasFinalizer)
+		}
+
 		if !hasShard {
-			shard, reason, err := r.chooseShardAndMarkCondition(logger, workspace) // call first with status side-effect, before any annotation aka spec change
+			shard, reason, message, err := r.chooseShardAndMarkCondition(logger, workspace) // call first with status side-effect, before any annotation aka spec change
 			if err != nil {
 				return reconcileStatusStopAndRequeue, err
 			}
 			if shard == nil {
-				conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceScheduled, tenancyv1alpha1.WorkspaceReasonUnschedulable, conditionsv1alpha1.ConditionSeverityError, "%s", reason)
+				conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceScheduled, reason, conditionsv1alpha1.ConditionSeverityError, "%s", message)
 				return reconcileStatusContinue, nil // retry is automatic when new shards show up
 			}
 			logger.V(2).Info("Chose shard", "shard", shard.Name)
@@ -214,23 +228,42 @@ func (r *schedulingReconciler) reconcile(ctx context.Context, workspace *tenancy
 			return reconcileStatusContinue, nil
 		}
 
-		canonicalPath := logicalcluster.From(workspace).Path().Join(workspace.Name)
-		if parentThis != nil {
-			if parentPath := parentThis.Annotations[core.LogicalClusterPathAnnotationKey]; parentPath != "" {
-				canonicalPath = logicalcluster.NewPath(parentThis.Annotations[core.LogicalClusterPathAnnotationKey]).Join(workspace.Name)
-			}
-		}
+		canonicalPath := workspaceCanonicalPath(workspace, parentThis)
 
-		if err := r.createLogicalCluster(ctx, shard, clusterName.Path(), canonicalPath, workspace); err != nil && !apierrors.IsAlreadyExists(err) {
-			return reconcileStatusStopAndRequeue, err
-		} else if apierrors.IsAlreadyExists(err) {
-			// we have checked in createLogicalCluster that this is a logicalcluster from another owner. Let's choose another cluster name.
-			delete(workspace.Annotations, workspaceClusterAnnotationKey)
-			logging.WithObject(logger, shard).Info("logical cluster already exists")
-			return reconcileStatusStopAndRequeue, nil
-		}
-		if err := r.updateLogicalClusterPhase(ctx, shard, clusterName.Path(), corev1alpha1.LogicalClusterPhaseInitializing); err != nil {
-			return reconcileStatusStopAndRequeue, err
+		if _, undelete := workspace.Annotations[tenancyv1alpha1.WorkspaceUndeleteAnnotationKey]; undelete {
+			restored, err := r.adoptLogicalCluster(ctx, shard, clusterName.Path(), canonicalPath, workspace, tenancyv1alpha1.WorkspaceReasonUndeleteFailed, func(logicalCluster *corev1alpha1.LogicalCluster) error {
+				return validateUndelete(workspace, logicalCluster)
+			})
+			if err != nil {
+				return reconcileStatusStopAndRequeue, err
+			}
+			if !restored {
+				return reconcileStatusContinue, nil
+			}
+			delete(workspace.Annotations, tenancyv1alpha1.WorkspaceUndeleteAnnotationKey)
+		} else if from, move := workspace.Annotations[tenancyv1alpha1.WorkspaceMoveFromAnnotationKey]; move {
+			moved, err := r.adoptLogicalCluster(ctx, shard, clusterName.Path(), canonicalPath, workspace, tenancyv1alpha1.WorkspaceReasonMoveFailed, func(logicalCluster *corev1alpha1.LogicalCluster) error {
+				return r.validateMovedLogicalCluster(ctx, workspace, canonicalPath, logicalcluster.NewPath(from), logicalCluster)
+			})
+			if err != nil {
+				return reconcileStatusStopAndRequeue, err
+			}
+			if !moved {
+				return reconcileStatusContinue, nil
+			}
+			delete(workspace.Annotations, tenancyv1alpha1.WorkspaceMoveFromAnnotationKey)
+		} else {
+			if err := r.createLogicalCluster(ctx, shard, clusterName.Path(), canonicalPath, workspace); err != nil && !apierrors.IsAlreadyExists(err) {
+				return reconcileStatusStopAndRequeue, err
+			} else if apierrors.IsAlreadyExists(err) {
+				// we have checked in createLogicalCluster that this is a logicalcluster from another owner. Let's choose another cluster name.
+				delete(workspace.Annotations, workspaceClusterAnnotationKey)
+				logging.WithObject(logger, shard).Info("logical cluster already exists")
+				return reconcileStatusStopAndRequeue, nil
+			}
+			if err := r.updateLogicalClusterPhase(ctx, shard, clusterName.Path(), corev1alpha1.LogicalClusterPhaseInitializing); err != nil {
+				return reconcileStatusStopAndRequeue, err
+			}
 		}
 
 		// now complete the second part of our two-phase commit: set location in workspace
@@ -249,24 +282,24 @@ func (r *schedulingReconciler) reconcile(ctx context.Context, workspace *tenancy
 	return reconcileStatusContinue, nil
 }
 
-func (r *schedulingReconciler) chooseShardAndMarkCondition(logger klog.Logger, workspace *tenancyv1alpha1.Workspace) (shard *corev1alpha1.Shard, reason string, err error) {
+func (r *schedulingReconciler) chooseShardAndMarkCondition(logger klog.Logger, workspace *tenancyv1alpha1.Workspace) (shard *corev1alpha1.Shard, reason, message string, err error) {
 	selector := labels.Everything()
 	if workspace.Spec.Location != nil {
 		if workspace.Spec.Location.Selector != nil {
 			var err error
 			selector, err = metav1.LabelSelectorAsSelector(workspace.Spec.Location.Selector)
 			if err != nil {
-				return nil, fmt.Sprintf("spec.location.selector is invalid: %v", err), nil // don't retry, cannot do anything useful
+				return nil, tenancyv1alpha1.WorkspaceReasonUnschedulable, fmt.Sprintf("spec.location.selector is invalid: %v", err), nil // don't retry, cannot do anything useful
 			}
 		}
 	}
 
 	shards, err := r.listShards(selector)
 	if err != nil {
-		return nil, "", err
+		return nil, "", "", err
 	}
 
-	validShards := make([]*corev1alpha1.Shard, 0, len(shards))
+	validShards := make([]ShardLoad, 0, len(shards))
 	invalidShards := map[string]struct {
 		reason, message string
 	}{}
@@ -275,8 +308,18 @@ func (r *schedulingReconciler) chooseShardAndMarkCondition(logger klog.Logger, w
 			logger.V(4).Info("Skipping a shard because it is annotated as unschedulable", "shard", shard.Name, "annotation", unschedulableAnnotationKey)
 			continue
 		}
+		load := NewShardLoad(shard, r.shardPlacements.pending(shard))
+		if load.Full() {
+			invalidShards[shard.Name] = struct {
+				reason, message string
+			}{
+				reason:  "CapacityExhausted",
+				message: "shard has no capacity left",
+			}
+			continue
+		}
 		if valid, reason, message := isValidShard(shard); valid {
-			validShards = append(validShards, shard)
+			validShards = append(validShards, load)
 		} else {
 			invalidShards[shard.Name] = struct {
 				reason, message string
@@ -293,10 +336,20 @@ func (r *schedulingReconciler) chooseShardAndMarkCondition(logger klog.Logger, w
 			failures = append(failures, fmt.Errorf("  %s: reason %q, message %q", name, x.reason, x.message))
 		}
 		logger.Error(utilerrors.NewAggregate(failures), "no valid shards found for workspace, skipping")
-		return nil, "No available shards to schedule the workspace", nil // retry is automatic when new shards show up
+		return nil, tenancyv1alpha1.WorkspaceReasonUnschedulable, "No available shards to schedule the workspace", nil // retry is automatic when new shards show up
+	}
+
+	validShards, message, err = r.filterByTopologySpread(workspace, validShards)
+	if err != nil {
+		return nil, "", "", err
 	}
-	targetShard := validShards[mathrand.Intn(len(validShards))]
-	return targetShard, "", nil
+	if len(validShards) == 0 {
+		return nil, tenancyv1alpha1.WorkspaceReasonTopologySpreadUnsatisfiable, message, nil // retry is automatic when shards change
+	}
+
+	targetShard := r.shardScorer.Pick(validShards)
+	r.shardPlacements.record(targetShard)
+	return targetShard, "", "", nil
 }
 
 func (r *schedulingReconciler) createLogicalCluster(ctx context.Context, shard *corev1alpha1.Shard, cluster logicalcluster.Path, canonicalPath logicalcluster.Path, workspace *tenancyv1alpha1.Workspace) error {
@@ -304,7 +357,7 @@ func (r *schedulingReconciler) createLogicalCluster(ctx context.Context, shard *
 		ObjectMeta: metav1.ObjectMeta{
 			Name: corev1alpha1.LogicalClusterName,
 			Annotations: map[string]string{
-				tenancyv1alpha1.LogicalClusterTypeAnnotationKey: logicalcluster.NewPath(workspace.Spec.Type.Path).Join(string(workspace.Spec.Type.Name)).String(),
+				tenancyv1alpha1.LogicalClusterTypeAnnotationKey: workspaceTypeKey(workspace),
 				// The shard must be set in the annotation when
 				// scheduling the LC, otherwise the LC metadata
 				// reconciler will detect this is a drift and try to set
@@ -344,6 +397,10 @@ func (r *schedulingReconciler) createLogicalCluster(ctx context.Context, shard *
 		logicalCluster.Annotations[authorization.RequiredGroupsAnnotationKey] = groups
 	}
 
+	if depth, found := workspace.Annotations[tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey]; found {
+		logicalCluster.Annotations[tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey] = depth
+	}
+
 	// add initializers
 	var err error
 	logicalCluster.Spec.Initializers, err = LogicalClustersInitializers(r.transitiveTypeResolver, r.getWorkspaceType, logicalcluster.NewPath(workspace.Spec.Type.Path), string(workspace.Spec.Type.Name))
@@ -351,6 +408,12 @@ func (r *schedulingReconciler) createLogicalCluster(ctx context.Context, shard *
 		return err
 	}
 
+	// copy the contents of the workspace to clone while initializing
+	if workspace.Spec.CloneFrom != nil {
+		logicalCluster.Annotations[tenancyv1alpha1.LogicalClusterCloneFromAnnotationKey] = workspace.Spec.CloneFrom.Path
+		logicalCluster.Spec.Initializers = append(logicalCluster.Spec.Initializers, tenancyv1alpha1.WorkspaceCloneInitializer)
+	}
+
 	// add terminators
 	logicalCluster.Spec.Terminators, err = LogicalClusterTerminators(r.transitiveTypeResolver, r.getWorkspaceType, logicalcluster.NewPath(workspace.Spec.Type.Path), string(workspace.Spec.Type.Name))
 	if err != nil {
@@ -377,6 +440,296 @@ func (r *schedulingReconciler) createLogicalCluster(ctx context.Context, shard *
 	return err
 }
 
+// scheduleUndelete is the first part of the two-phase commit for a workspace
+// undeleting a retained logical cluster. Instead of choosing a shard and a
+// cluster name, it takes those of the retained logical cluster.
+func (r *schedulingReconciler) scheduleUndelete(ctx context.Context, workspace *tenancyv1alpha1.Workspace, clusterName logicalcluster.Name, hasFinalizer bool) (reconcileStatus, error) {
+	logicalCluster, err := r.getExternalLogicalCluster(ctx, clusterName.Path())
+	if apierrors.IsNotFound(err) {
+		conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceScheduled, tenancyv1alpha1.WorkspaceReasonUndeleteFailed, conditionsv1alpha1.ConditionSeverityError, "logical cluster %s to undelete not found", clusterName)
+		return reconcileStatusContinue, nil
+	} else if err != nil {
+		return reconcileStatusStopAndRequeue, err
+	}
+	if err := validateUndelete(workspace, logicalCluster); err != nil {
+		conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceScheduled, tenancyv1alpha1.WorkspaceReasonUndeleteFailed, conditionsv1alpha1.ConditionSeverityError, "%v", err)
+		return reconcileStatusContinue, nil
+	}
+
+	shardName := logicalCluster.Annotations[corev1alpha1.LogicalClusterShardAnnotationKey]
+	shard, err := r.getShard(shardName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceScheduled, tenancyv1alpha1.WorkspaceReasonUndeleteFailed, conditionsv1alpha1.ConditionSeverityError, "shard %q of logical cluster %s does not exist: %v", shardName, clusterName, err)
+			return reconcileStatusContinue, nil
+		}
+		return reconcileStatusStopAndRequeue, err
+	}
+
+	applyShardToWorkspaceMetadata(workspace, shard)
+	workspace.Annotations[workspaceClusterAnnotationKey] = clusterName.String()
+	if !hasFinalizer {
+		workspace.Finalizers = append(workspace.Finalizers, corev1alpha1.LogicalClusterFinalizerName)
+	}
+	return reconcileStatusStopAndRequeue, nil
+}
+
+// adoptLogicalCluster makes the workspace the owner of an existing logical
+// cluster, i.e. a retained one when undeleting or the one of another workspace
+// when moving, and removes its deletion mark. It returns false without error if
+// validate rejects the logical cluster, with the WorkspaceScheduled condition
+// explaining why.
+func (r *schedulingReconciler) adoptLogicalCluster(ctx context.Context, shard *corev1alpha1.Shard, cluster logicalcluster.Path, canonicalPath logicalcluster.Path, workspace *tenancyv1alpha1.Workspace, reason string, validate func(logicalCluster *corev1alpha1.LogicalCluster) error) (bool, error) {
+	logicalClusterAdminClient, err := r.kcpLogicalClusterAdminClientFor(shard)
+	if err != nil {
+		return false, err
+	}
+	logicalCluster, err := logicalClusterAdminClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Get(ctx, corev1alpha1.LogicalClusterName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return false, err
+	}
+	if apierrors.IsNotFound(err) {
+		conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceScheduled, reason, conditionsv1alpha1.ConditionSeverityError, "logical cluster %s not found on shard %q", cluster, shard.Name)
+		return false, nil
+	}
+	if logicalCluster.Spec.Owner != nil && logicalCluster.Spec.Owner.UID == workspace.UID && !corev1alpha1.IsLogicalClusterDeleted(logicalCluster.Annotations) {
+		return true, nil // adopted before
+	}
+	if err := validate(logicalCluster); err != nil {
+		conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceScheduled, reason, conditionsv1alpha1.ConditionSeverityError, "%v", err)
+		return false, nil
+	}
+
+	logicalCluster.Spec.Owner.Cluster = logicalcluster.From(workspace).String()
+	logicalCluster.Spec.Owner.Name = workspace.Name
+	logicalCluster.Spec.Owner.UID = workspace.UID
+	logicalCluster.Annotations[core.LogicalClusterPathAnnotationKey] = canonicalPath.String()
+	if depth, found := workspace.Annotations[tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey]; found {
+		logicalCluster.Annotations[tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey] = depth
+	} else {
+		delete(logicalCluster.Annotations, tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey)
+	}
+	delete(logicalCluster.Annotations, corev1alpha1.LogicalClusterDeletedAnnotationKey)
+	logging.WithObject(klog.FromContext(ctx), logicalCluster).Info("adopting LogicalCluster", "path", canonicalPath)
+	if _, err := logicalClusterAdminClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Update(ctx, logicalCluster, metav1.UpdateOptions{}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// validateUndelete checks that the logical cluster is retained after the
+// deletion of a workspace with the same name, parent and type.
+func validateUndelete(workspace *tenancyv1alpha1.Workspace, logicalCluster *corev1alpha1.LogicalCluster) error {
+	clusterName := logicalcluster.From(logicalCluster)
+	if !logicalCluster.DeletionTimestamp.IsZero() {
+		return fmt.Errorf("logical cluster %s is being purged", clusterName)
+	}
+	if !corev1alpha1.IsLogicalClusterDeleted(logicalCluster.Annotations) {
+		return fmt.Errorf("logical cluster %s is not deleted", clusterName)
+	}
+	owner := logicalCluster.Spec.Owner
+	if owner == nil || owner.Resource != "workspaces" || owner.Cluster != logicalcluster.From(workspace).String() || owner.Name != workspace.Name {
+		return fmt.Errorf("logical cluster %s does not belong to a workspace %s in %s", clusterName, workspace.Name, logicalcluster.From(workspace))
+	}
+	if workspace.Spec.Type == nil {
+		return fmt.Errorf("workspace %s has no type", workspace.Name)
+	}
+	if wsType, lcType := workspaceTypeKey(workspace), logicalCluster.Annotations[tenancyv1alpha1.LogicalClusterTypeAnnotationKey]; wsType != lcType {
+		return fmt.Errorf("logical cluster %s is of type %s, not %s", clusterName, lcType, wsType)
+	}
+	return nil
+}
+
+// scheduleMove is the first part of the two-phase commit for a workspace
+// taking over the logical cluster of the workspace to move. Instead of
+// choosing a shard and a cluster name, it takes those of that workspace.
+func (r *schedulingReconciler) scheduleMove(ctx context.Context, workspace *tenancyv1alpha1.Workspace, canonicalPath, from logicalcluster.Path, hasFinalizer bool) (reconcileStatus, error) {
+	source, err := r.getMovedWorkspace(ctx, from)
+	if apierrors.IsNotFound(err) {
+		conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceScheduled, tenancyv1alpha1.WorkspaceReasonMoveFailed, conditionsv1alpha1.ConditionSeverityError, "workspace %s to move not found", from)
+		return reconcileStatusContinue, nil
+	} else if err != nil {
+		return reconcileStatusStopAndRequeue, err
+	}
+	if err := validateMove(workspace, canonicalPath, from, source); err != nil {
+		conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceScheduled, tenancyv1alpha1.WorkspaceReasonMoveFailed, conditionsv1alpha1.ConditionSeverityError, "%v", err)
+		return reconcileStatusContinue, nil
+	}
+	if cycle, err := r.movesBelowItself(ctx, workspace, canonicalPath, source); err != nil {
+		return reconcileStatusStopAndRequeue, err
+	} else if cycle {
+		conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceScheduled, tenancyv1alpha1.WorkspaceReasonMoveFailed, conditionsv1alpha1.ConditionSeverityError, "workspace %s cannot be moved below itself", from)
+		return reconcileStatusContinue, nil
+	}
+	if tooDeep, err := r.movesTooDeep(ctx, workspace, source); err != nil {
+		return reconcileStatusStopAndRequeue, err
+	} else if tooDeep {
+		conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceScheduled, tenancyv1alpha1.WorkspaceReasonMoveFailed, conditionsv1alpha1.ConditionSeverityError, "workspaces below %s are nested deeper than allowed below %s", from, canonicalPath)
+		return reconcileStatusContinue, nil
+	}
+
+	clusterName := logicalcluster.Name(source.Spec.Cluster)
+	logicalCluster, err := r.getExternalLogicalCluster(ctx, clusterName.Path())
+	if err != nil {
+		return reconcileStatusStopAndRequeue, err
+	}
+	shardName := logicalCluster.Annotations[corev1alpha1.LogicalClusterShardAnnotationKey]
+	shard, err := r.getShard(shardName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceScheduled, tenancyv1alpha1.WorkspaceReasonMoveFailed, conditionsv1alpha1.ConditionSeverityError, "shard %q of logical cluster %s does not exist: %v", shardName, clusterName, err)
+			return reconcileStatusContinue, nil
+		}
+		return reconcileStatusStopAndRequeue, err
+	}
+
+	applyShardToWorkspaceMetadata(workspace, shard)
+	workspace.Annotations[workspaceClusterAnnotationKey] = clusterName.String()
+	if !hasFinalizer {
+		workspace.Finalizers = append(workspace.Finalizers, corev1alpha1.LogicalClusterFinalizerName)
+	}
+	return reconcileStatusStopAndRequeue, nil
+}
+
+// validateMovedLogicalCluster checks that the logical cluster still belongs to
+// the workspace to move, and that the move is still allowed.
+func (r *schedulingReconciler) validateMovedLogicalCluster(ctx context.Context, workspace *tenancyv1alpha1.Workspace, canonicalPath, from logicalcluster.Path, logicalCluster *corev1alpha1.LogicalCluster) error {
+	source, err := r.getMovedWorkspace(ctx, from)
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf("workspace %s to move not found", from)
+	} else if err != nil {
+		return err
+	}
+	if err := validateMove(workspace, canonicalPath, from, source); err != nil {
+		return err
+	}
+	if cycle, err := r.movesBelowItself(ctx, workspace, canonicalPath, source); err != nil {
+		return err
+	} else if cycle {
+		return fmt.Errorf("workspace %s cannot be moved below itself", from)
+	}
+	if tooDeep, err := r.movesTooDeep(ctx, workspace, source); err != nil {
+		return err
+	} else if tooDeep {
+		return fmt.Errorf("workspaces below %s are nested deeper than allowed below %s", from, canonicalPath)
+	}
+	clusterName := logicalcluster.From(logicalCluster)
+	if source.Spec.Cluster != clusterName.String() {
+		return fmt.Errorf("workspace %s is not backed by logical cluster %s", from, clusterName)
+	}
+	if owner := logicalCluster.Spec.Owner; owner == nil || owner.UID != source.UID {
+		return fmt.Errorf("logical cluster %s does not belong to workspace %s", clusterName, from)
+	}
+	return nil
+}
+
+func (r *schedulingReconciler) getMovedWorkspace(ctx context.Context, from logicalcluster.Path) (*tenancyv1alpha1.Workspace, error) {
+	parent, name := from.Split()
+	if parent.Empty() {
+		return nil, apierrors.NewNotFound(tenancyv1alpha1.Resource("workspaces"), from.String())
+	}
+	return r.getExternalWorkspace(ctx, parent, name)
+}
+
+// movesBelowItself checks whether the logical cluster of the workspace to move
+// is the one the new workspace lives in, or one of its ancestors, following
+// the owners of the LogicalClusters up to the root. Such a move would make the
+// moved workspace its own ancestor. An ancestry deeper than the canonical path
+// of the new workspace is inconsistent and treated as a cycle as well.
+func (r *schedulingReconciler) movesBelowItself(ctx context.Context, workspace *tenancyv1alpha1.Workspace, canonicalPath logicalcluster.Path, source *tenancyv1alpha1.Workspace) (bool, error) {
+	moved := logicalcluster.Name(source.Spec.Cluster)
+	cluster := logicalcluster.From(workspace)
+	for depth := len(strings.Split(canonicalPath.String(), ":")); depth > 0; depth-- {
+		if cluster == moved {
+			return true, nil
+		}
+		if cluster == core.RootCluster {
+			return false, nil
+		}
+		logicalCluster, err := r.getExternalLogicalCluster(ctx, cluster.Path())
+		if err != nil {
+			return false, err
+		}
+		owner := logicalCluster.Spec.Owner
+		if owner == nil || owner.Resource != "workspaces" || owner.Cluster == "" {
+			return false, nil
+		}
+		cluster = logicalcluster.Name(owner.Cluster)
+	}
+	return true, nil
+}
+
+// movesTooDeep checks whether the workspaces below the workspace to move are
+// nested deeper than the max-depth annotation of the new workspace allows.
+func (r *schedulingReconciler) movesTooDeep(ctx context.Context, workspace, source *tenancyv1alpha1.Workspace) (bool, error) {
+	value, found := workspace.Annotations[tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey]
+	if !found {
+		return false, nil
+	}
+	maxDepth, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("invalid annotation %s on workspace %s: %w", tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey, workspace.Name, err)
+	}
+	return r.nestedDeeperThan(ctx, logicalcluster.Name(source.Spec.Cluster), maxDepth)
+}
+
+// nestedDeeperThan checks whether there are workspaces nested more than depth
+// levels below the given logical cluster.
+func (r *schedulingReconciler) nestedDeeperThan(ctx context.Context, cluster logicalcluster.Name, depth int64) (bool, error) {
+	children, err := r.listExternalWorkspaces(ctx, cluster.Path())
+	if err != nil {
+		return false, err
+	}
+	for _, child := range children {
+		if depth <= 0 {
+			return true, nil
+		}
+		if child.Spec.Cluster == "" {
+			continue
+		}
+		if deeper, err := r.nestedDeeperThan(ctx, logicalcluster.Name(child.Spec.Cluster), depth-1); err != nil || deeper {
+			return deeper, err
+		}
+	}
+	return false, nil
+}
+
+// validateMove checks that the workspace to move is ready, of the same type,
+// and allows to be moved to the canonical path of the new workspace, which
+// must not be below itself.
+func validateMove(workspace *tenancyv1alpha1.Workspace, canonicalPath, from logicalcluster.Path, source *tenancyv1alpha1.Workspace) error {
+	if !source.DeletionTimestamp.IsZero() {
+		return fmt.Errorf("workspace %s is being deleted", from)
+	}
+	if canonicalPath == from || strings.HasPrefix(canonicalPath.String(), from.String()+":") {
+		return fmt.Errorf("workspace %s cannot be moved below itself to %s", from, canonicalPath)
+	}
+	if to := source.Annotations[tenancyv1alpha1.WorkspaceMoveToAnnotationKey]; to != canonicalPath.String() {
+		return fmt.Errorf("workspace %s must be annotated with %s=%s to be moved", from, tenancyv1alpha1.WorkspaceMoveToAnnotationKey, canonicalPath)
+	}
+	if source.Status.Phase != corev1alpha1.LogicalClusterPhaseReady || source.Spec.Cluster == "" {
+		return fmt.Errorf("workspace %s is not ready", from)
+	}
+	if workspace.Spec.Type == nil || source.Spec.Type == nil {
+		return fmt.Errorf("workspace %s has no type", workspace.Name)
+	}
+	if wsType, sourceType := workspaceTypeKey(workspace), workspaceTypeKey(source); wsType != sourceType {
+		return fmt.Errorf("workspace %s is of type %s, not %s", from, sourceType, wsType)
+	}
+	return nil
+}
+
+// workspaceCanonicalPath returns the canonical path of the workspace, based on
+// the one of its parent if known.
+func workspaceCanonicalPath(workspace *tenancyv1alpha1.Workspace, parent *corev1alpha1.LogicalCluster) logicalcluster.Path {
+	if parent != nil {
+		if parentPath := parent.Annotations[core.LogicalClusterPathAnnotationKey]; parentPath != "" {
+			return logicalcluster.NewPath(parentPath).Join(workspace.Name)
+		}
+	}
+	return logicalcluster.From(workspace).Path().Join(workspace.Name)
+}
+
 // LogicalClustersInitializers returns the initializers for a LogicalCluster of a given
 // fully-qualified WorkspaceType reference.
 func LogicalClustersInitializers(
@@ -28,6 +28,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -273,6 +274,29 @@ func TestReconcileScheduling(t *testing.T) {
 			},
 			expectedStatus: reconcileStatusStopAndRequeue,
 		},
+		{
+			name:                 "a full shard is skipped",
+			targetWorkspace:      workspace("foo"),
+			targetLogicalCluster: &corev1alpha1.LogicalCluster{},
+			initialShards: []*corev1alpha1.Shard{func() *corev1alpha1.Shard {
+				s := shard("root")
+				s.Status.Capacity = corev1.ResourceList{corev1alpha1.ShardCapacityLogicalClusters: resource.MustParse("10")}
+				s.Status.Usage = corev1.ResourceList{corev1alpha1.ShardCapacityLogicalClusters: resource.MustParse("10")}
+				return s
+			}(), shard("amber")},
+			validateWorkspace: func(t *testing.T, initialWS, wsAfterReconciliation *tenancyv1alpha1.Workspace) {
+				t.Helper()
+
+				initialWS.Annotations["internal.tenancy.kcp.io/cluster"] = "root-foo"
+				initialWS.Annotations["internal.tenancy.kcp.io/shard"] = "29hdqnv7"
+				initialWS.Annotations[corev1alpha1.LogicalClusterShardAnnotationKey] = "amber"
+				initialWS.Finalizers = append(initialWS.Finalizers, "core.kcp.io/logicalcluster")
+				if !equality.Semantic.DeepEqual(wsAfterReconciliation, initialWS) {
+					t.Fatalf("unexpected Workspace:\n%s", cmp.Diff(wsAfterReconciliation, initialWS))
+				}
+			},
+			expectedStatus: reconcileStatusStopAndRequeue,
+		},
 		{
 			name: "only an unschedulable shard is available, the ws is unscheduled",
 			initialShards: []*corev1alpha1.Shard{func() *corev1alpha1.Shard {
@@ -330,6 +354,244 @@ func TestReconcileScheduling(t *testing.T) {
 			},
 			expectedStatus: reconcileStatusContinue,
 		},
+		{
+			name:          "undelete, part one: the workspace takes over the shard and cluster of the retained LogicalCluster",
+			initialShards: []*corev1alpha1.Shard{shard("root")},
+			initialKcpClientObjects: []runtime.Object{func() runtime.Object {
+				lc := wellKnownLogicalClusterForFooWS()
+				lc.Annotations["kcp.io/cluster"] = "root-old"
+				lc.Annotations[corev1alpha1.LogicalClusterDeletedAnnotationKey] = "2026-01-01T12:00:00Z"
+				return lc
+			}()},
+			targetWorkspace: func() *tenancyv1alpha1.Workspace {
+				ws := workspace("foo")
+				ws.Annotations[tenancyv1alpha1.WorkspaceUndeleteAnnotationKey] = "root-old"
+				ws.Spec.Type = &tenancyv1alpha1.WorkspaceTypeReference{Name: "universal", Path: "root"}
+				return ws
+			}(),
+			targetLogicalCluster: &corev1alpha1.LogicalCluster{},
+			validateWorkspace: func(t *testing.T, initialWS, ws *tenancyv1alpha1.Workspace) {
+				t.Helper()
+
+				initialWS.Annotations["internal.tenancy.kcp.io/cluster"] = "root-old"
+				initialWS.Annotations["internal.tenancy.kcp.io/shard"] = "1pfxsevk"
+				initialWS.Annotations[corev1alpha1.LogicalClusterShardAnnotationKey] = "root"
+				initialWS.Finalizers = append(initialWS.Finalizers, "core.kcp.io/logicalcluster")
+				if !equality.Semantic.DeepEqual(ws, initialWS) {
+					t.Fatalf("unexpected Workspace:\n%s", cmp.Diff(ws, initialWS))
+				}
+			},
+			expectedStatus:           reconcileStatusStopAndRequeue,
+			expectedKcpClientActions: []string{"get:logicalclusters"},
+		},
+		{
+			name:          "undelete, part one failure: the retained LogicalCluster belongs to another workspace",
+			initialShards: []*corev1alpha1.Shard{shard("root")},
+			initialKcpClientObjects: []runtime.Object{func() runtime.Object {
+				lc := wellKnownLogicalClusterForFooWS()
+				lc.Annotations["kcp.io/cluster"] = "root-old"
+				lc.Annotations[corev1alpha1.LogicalClusterDeletedAnnotationKey] = "2026-01-01T12:00:00Z"
+				lc.Spec.Owner.Name = "bar"
+				return lc
+			}()},
+			targetWorkspace: func() *tenancyv1alpha1.Workspace {
+				ws := workspace("foo")
+				ws.Annotations[tenancyv1alpha1.WorkspaceUndeleteAnnotationKey] = "root-old"
+				ws.Spec.Type = &tenancyv1alpha1.WorkspaceTypeReference{Name: "universal", Path: "root"}
+				return ws
+			}(),
+			targetLogicalCluster: &corev1alpha1.LogicalCluster{},
+			validateWorkspace: func(t *testing.T, initialWS, ws *tenancyv1alpha1.Workspace) {
+				t.Helper()
+
+				clearLastTransitionTimeOnWsConditions(ws)
+				initialWS.Status.Conditions = append(initialWS.Status.Conditions, conditionsapi.Condition{
+					Type:     tenancyv1alpha1.WorkspaceScheduled,
+					Status:   corev1.ConditionFalse,
+					Severity: conditionsapi.ConditionSeverityError,
+					Reason:   tenancyv1alpha1.WorkspaceReasonUndeleteFailed,
+					Message:  "logical cluster root-old does not belong to a workspace foo in root",
+				})
+				if !equality.Semantic.DeepEqual(ws, initialWS) {
+					t.Fatalf("unexpected Workspace:\n%s", cmp.Diff(ws, initialWS))
+				}
+			},
+			expectedStatus:           reconcileStatusContinue,
+			expectedKcpClientActions: []string{"get:logicalclusters"},
+		},
+		{
+			name:          "undelete, part two: the retained LogicalCluster is restored",
+			initialShards: []*corev1alpha1.Shard{shard("root")},
+			initialKcpClientObjects: []runtime.Object{func() runtime.Object {
+				lc := wellKnownLogicalClusterForFooWS()
+				lc.Annotations["kcp.io/cluster"] = "root-foo"
+				lc.Annotations[corev1alpha1.LogicalClusterDeletedAnnotationKey] = "2026-01-01T12:00:00Z"
+				lc.Annotations[tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey] = "3"
+				lc.Spec.Owner.UID = "old"
+				return lc
+			}()},
+			targetWorkspace: func() *tenancyv1alpha1.Workspace {
+				ws := wellKnownFooWSForPhaseTwo()
+				ws.UID = "new"
+				ws.Annotations[tenancyv1alpha1.WorkspaceUndeleteAnnotationKey] = "root-foo"
+				return ws
+			}(),
+			targetLogicalCluster: &corev1alpha1.LogicalCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						corev1alpha1.LogicalClusterShardAnnotationKey: "root",
+					},
+				},
+			},
+			validateWorkspace: func(t *testing.T, initialWS, wsAfterReconciliation *tenancyv1alpha1.Workspace) {
+				t.Helper()
+
+				clearLastTransitionTimeOnWsConditions(wsAfterReconciliation)
+				delete(initialWS.Annotations, tenancyv1alpha1.WorkspaceUndeleteAnnotationKey)
+				initialWS.Spec.URL = `https://root/clusters/root:foo`
+				initialWS.Spec.Cluster = "root-foo"
+				initialWS.Status.Conditions = append(initialWS.Status.Conditions, conditionsapi.Condition{
+					Type:   tenancyv1alpha1.WorkspaceScheduled,
+					Status: corev1.ConditionTrue,
+				})
+				if !equality.Semantic.DeepEqual(wsAfterReconciliation, initialWS) {
+					t.Fatalf("unexpected Workspace:\n%s", cmp.Diff(wsAfterReconciliation, initialWS))
+				}
+			},
+			validateKcpClientActions: func(t *testing.T, actions []kcpclientgotesting.Action) {
+				t.Helper()
+
+				for _, action := range actions {
+					if action.Matches("update", "logicalclusters") {
+						lc := action.(kcpclientgotesting.UpdateAction).GetObject().(*corev1alpha1.LogicalCluster)
+						if lc.Spec.Owner.UID != "new" {
+							t.Errorf("expected owner UID %q, got %q", "new", lc.Spec.Owner.UID)
+						}
+						if corev1alpha1.IsLogicalClusterDeleted(lc.Annotations) {
+							t.Errorf("expected LogicalCluster not to be deleted anymore")
+						}
+						if depth, found := lc.Annotations[tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey]; found {
+							t.Errorf("expected the max depth of the deleted workspace to be dropped, got %q", depth)
+						}
+					}
+				}
+			},
+			expectedStatus:           reconcileStatusContinue,
+			expectedKcpClientActions: []string{"get:logicalclusters", "update:logicalclusters"},
+		},
+		{
+			name:          "move, part one: the workspace takes over the shard and cluster of the workspace to move",
+			initialShards: []*corev1alpha1.Shard{shard("root")},
+			initialKcpClientObjects: []runtime.Object{movedWorkspace("root:foo"), func() runtime.Object {
+				lc := wellKnownLogicalClusterForFooWS()
+				lc.Annotations["kcp.io/cluster"] = "root-foo"
+				return lc
+			}()},
+			targetWorkspace: func() *tenancyv1alpha1.Workspace {
+				ws := workspace("foo")
+				ws.Annotations[tenancyv1alpha1.WorkspaceMoveFromAnnotationKey] = "root:old"
+				ws.Spec.Type = &tenancyv1alpha1.WorkspaceTypeReference{Name: "universal", Path: "root"}
+				return ws
+			}(),
+			targetLogicalCluster: &corev1alpha1.LogicalCluster{},
+			validateWorkspace: func(t *testing.T, initialWS, ws *tenancyv1alpha1.Workspace) {
+				t.Helper()
+
+				initialWS.Annotations["internal.tenancy.kcp.io/cluster"] = "root-foo"
+				initialWS.Annotations["internal.tenancy.kcp.io/shard"] = "1pfxsevk"
+				initialWS.Annotations[corev1alpha1.LogicalClusterShardAnnotationKey] = "root"
+				initialWS.Finalizers = append(initialWS.Finalizers, "core.kcp.io/logicalcluster")
+				if !equality.Semantic.DeepEqual(ws, initialWS) {
+					t.Fatalf("unexpected Workspace:\n%s", cmp.Diff(ws, initialWS))
+				}
+			},
+			expectedStatus:           reconcileStatusStopAndRequeue,
+			expectedKcpClientActions: []string{"get:workspaces", "get:logicalclusters"},
+		},
+		{
+			name:                    "move, part one failure: the workspace to move is not annotated to be moved here",
+			initialShards:           []*corev1alpha1.Shard{shard("root")},
+			initialKcpClientObjects: []runtime.Object{movedWorkspace("root:bar")},
+			targetWorkspace: func() *tenancyv1alpha1.Workspace {
+				ws := workspace("foo")
+				ws.Annotations[tenancyv1alpha1.WorkspaceMoveFromAnnotationKey] = "root:old"
+				ws.Spec.Type = &tenancyv1alpha1.WorkspaceTypeReference{Name: "universal", Path: "root"}
+				return ws
+			}(),
+			targetLogicalCluster: &corev1alpha1.LogicalCluster{},
+			validateWorkspace: func(t *testing.T, initialWS, ws *tenancyv1alpha1.Workspace) {
+				t.Helper()
+
+				clearLastTransitionTimeOnWsConditions(ws)
+				initialWS.Status.Conditions = append(initialWS.Status.Conditions, conditionsapi.Condition{
+					Type:     tenancyv1alpha1.WorkspaceScheduled,
+					Status:   corev1.ConditionFalse,
+					Severity: conditionsapi.ConditionSeverityError,
+					Reason:   tenancyv1alpha1.WorkspaceReasonMoveFailed,
+					Message:  "workspace root:old must be annotated with tenancy.kcp.io/move-to=root:foo to be moved",
+				})
+				if !equality.Semantic.DeepEqual(ws, initialWS) {
+					t.Fatalf("unexpected Workspace:\n%s", cmp.Diff(ws, initialWS))
+				}
+			},
+			expectedStatus:           reconcileStatusContinue,
+			expectedKcpClientActions: []string{"get:workspaces"},
+		},
+		{
+			name:          "move, part two: the LogicalCluster of the workspace to move is adopted",
+			initialShards: []*corev1alpha1.Shard{shard("root")},
+			initialKcpClientObjects: []runtime.Object{movedWorkspace("root:foo"), func() runtime.Object {
+				lc := wellKnownLogicalClusterForFooWS()
+				lc.Annotations["kcp.io/cluster"] = "root-foo"
+				lc.Annotations["kcp.io/path"] = "root:old"
+				lc.Spec.Owner.Name = "old"
+				lc.Spec.Owner.UID = "old"
+				return lc
+			}()},
+			targetWorkspace: func() *tenancyv1alpha1.Workspace {
+				ws := wellKnownFooWSForPhaseTwo()
+				ws.UID = "new"
+				ws.Annotations[tenancyv1alpha1.WorkspaceMoveFromAnnotationKey] = "root:old"
+				ws.Annotations[tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey] = "1"
+				return ws
+			}(),
+			targetLogicalCluster: &corev1alpha1.LogicalCluster{},
+			validateWorkspace: func(t *testing.T, initialWS, wsAfterReconciliation *tenancyv1alpha1.Workspace) {
+				t.Helper()
+
+				clearLastTransitionTimeOnWsConditions(wsAfterReconciliation)
+				delete(initialWS.Annotations, tenancyv1alpha1.WorkspaceMoveFromAnnotationKey)
+				initialWS.Spec.URL = `https://root/clusters/root:foo`
+				initialWS.Spec.Cluster = "root-foo"
+				initialWS.Status.Conditions = append(initialWS.Status.Conditions, conditionsapi.Condition{
+					Type:   tenancyv1alpha1.WorkspaceScheduled,
+					Status: corev1.ConditionTrue,
+				})
+				if !equality.Semantic.DeepEqual(wsAfterReconciliation, initialWS) {
+					t.Fatalf("unexpected Workspace:\n%s", cmp.Diff(wsAfterReconciliation, initialWS))
+				}
+			},
+			validateKcpClientActions: func(t *testing.T, actions []kcpclientgotesting.Action) {
+				t.Helper()
+
+				for _, action := range actions {
+					if action.Matches("update", "logicalclusters") {
+						lc := action.(kcpclientgotesting.UpdateAction).GetObject().(*corev1alpha1.LogicalCluster)
+						if lc.Spec.Owner.Name != "foo" || lc.Spec.Owner.UID != "new" {
+							t.Errorf("expected owner foo with UID %q, got %s with UID %q", "new", lc.Spec.Owner.Name, lc.Spec.Owner.UID)
+						}
+						if path := lc.Annotations["kcp.io/path"]; path != "root:foo" {
+							t.Errorf("expected path %q, got %q", "root:foo", path)
+						}
+						if depth := lc.Annotations[tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey]; depth != "1" {
+							t.Errorf("expected max depth %q, got %q", "1", depth)
+						}
+					}
+				}
+			},
+			expectedStatus:           reconcileStatusContinue,
+			expectedKcpClientActions: []string{"get:logicalclusters", "get:workspaces", "list:workspaces", "update:logicalclusters"},
+		},
 	}
 	for _, scenario := range scenarios {
 		t.Run(scenario.name, func(t *testing.T) {
@@ -368,6 +630,8 @@ func TestReconcileScheduling(t *testing.T) {
 					}
 					return nil, kerrors.NewNotFound(tenancyv1alpha1.Resource("shard"), name)
 				},
+				shardScorer:     randomScorer{},
+				shardPlacements: newShardPlacements(),
 				listShards: func(selector labels.Selector) ([]*corev1alpha1.Shard, error) {
 					var shards []*corev1alpha1.Shard
 					for _, shard := range scenario.initialShards {
@@ -387,6 +651,15 @@ func TestReconcileScheduling(t *testing.T) {
 					}
 					return scenario.targetLogicalCluster, nil
 				},
+				getExternalLogicalCluster: func(ctx context.Context, cluster logicalcluster.Path) (*corev1alpha1.LogicalCluster, error) {
+					return fakeKcpClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Get(ctx, corev1alpha1.LogicalClusterName, metav1.GetOptions{})
+				},
+				getExternalWorkspace: func(ctx context.Context, cluster logicalcluster.Path, name string) (*tenancyv1alpha1.Workspace, error) {
+					return fakeKcpClient.Cluster(cluster).TenancyV1alpha1().Workspaces().Get(ctx, name, metav1.GetOptions{})
+				},
+				listExternalWorkspaces: func(ctx context.Context, cluster logicalcluster.Path) ([]*tenancyv1alpha1.Workspace, error) {
+					return listWorkspaces(ctx, fakeKcpClient, cluster)
+				},
 				transitiveTypeResolver: workspacetypeexists.NewTransitiveTypeResolver(getType),
 			}
 			targetWorkspaceCopy := scenario.targetWorkspace.DeepCopy()
@@ -420,6 +693,210 @@ func TestReconcileScheduling(t *testing.T) {
 	}
 }
 
+func TestScheduleMoveBelowItself(t *testing.T) {
+	t.Parallel()
+
+	// logicalClusterOwnedBy returns the LogicalCluster of the given cluster,
+	// owned by a workspace in the owner cluster.
+	logicalClusterOwnedBy := func(cluster, path, owner string) *corev1alpha1.LogicalCluster {
+		return &corev1alpha1.LogicalCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: corev1alpha1.LogicalClusterName,
+				Annotations: map[string]string{
+					logicalcluster.AnnotationKey:                  cluster,
+					core.LogicalClusterPathAnnotationKey:          path,
+					corev1alpha1.LogicalClusterShardAnnotationKey: "root",
+				},
+			},
+			Spec: corev1alpha1.LogicalClusterSpec{
+				Owner: &corev1alpha1.LogicalClusterOwner{Resource: "workspaces", Cluster: owner},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		canonicalPath string
+		wantMessage   string
+		wantStatus    reconcileStatus
+	}{
+		"moving a workspace below itself": {
+			canonicalPath: "root:old:b:old",
+			wantMessage:   "workspace root:old cannot be moved below itself to root:old:b:old",
+			wantStatus:    reconcileStatusContinue,
+		},
+		"moving a workspace onto itself": {
+			canonicalPath: "root:old",
+			wantMessage:   "workspace root:old cannot be moved below itself to root:old",
+			wantStatus:    reconcileStatusContinue,
+		},
+		"moving a workspace below one of its logical clusters with a stale path": {
+			canonicalPath: "root:other:b:new",
+			wantMessage:   "workspace root:old cannot be moved below itself",
+			wantStatus:    reconcileStatusContinue,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			source := movedWorkspace(tc.canonicalPath)
+			fakeKcpClient := kcpfakeclient.NewSimpleClientset( //nolint:staticcheck
+				source,
+				logicalClusterOwnedBy("root-foo", "root:old", "root"),
+				logicalClusterOwnedBy("root-b", "root:other:b", "root-foo"),
+			)
+
+			r := schedulingReconciler{
+				getShard: func(name string) (*corev1alpha1.Shard, error) {
+					return shard(name), nil
+				},
+				getExternalLogicalCluster: func(ctx context.Context, cluster logicalcluster.Path) (*corev1alpha1.LogicalCluster, error) {
+					return fakeKcpClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Get(ctx, corev1alpha1.LogicalClusterName, metav1.GetOptions{})
+				},
+				getExternalWorkspace: func(ctx context.Context, cluster logicalcluster.Path, name string) (*tenancyv1alpha1.Workspace, error) {
+					return fakeKcpClient.Cluster(cluster).TenancyV1alpha1().Workspaces().Get(ctx, name, metav1.GetOptions{})
+				},
+			}
+
+			// The new workspace lives in the logical cluster root-b, which is
+			// a child of root-foo, the logical cluster of the workspace to move.
+			ws := workspace("new")
+			ws.Annotations[logicalcluster.AnnotationKey] = "root-b"
+			ws.Annotations[tenancyv1alpha1.WorkspaceMoveFromAnnotationKey] = "root:old"
+			ws.Spec.Type = &tenancyv1alpha1.WorkspaceTypeReference{Name: "universal", Path: "root"}
+
+			status, err := r.scheduleMove(context.Background(), ws, logicalcluster.NewPath(tc.canonicalPath), logicalcluster.NewPath("root:old"), false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if status != tc.wantStatus {
+				t.Fatalf("unexpected reconciliation status:%v, expected:%v", status, tc.wantStatus)
+			}
+
+			clearLastTransitionTimeOnWsConditions(ws)
+			want := conditionsapi.Conditions{{
+				Type:     tenancyv1alpha1.WorkspaceScheduled,
+				Status:   corev1.ConditionFalse,
+				Severity: conditionsapi.ConditionSeverityError,
+				Reason:   tenancyv1alpha1.WorkspaceReasonMoveFailed,
+				Message:  tc.wantMessage,
+			}}
+			if !equality.Semantic.DeepEqual(ws.Status.Conditions, want) {
+				t.Fatalf("unexpected conditions:\n%s", cmp.Diff(ws.Status.Conditions, want))
+			}
+			if cluster := ws.Annotations[workspaceClusterAnnotationKey]; cluster != "" {
+				t.Errorf("expected the workspace not to take over a logical cluster, got %s", cluster)
+			}
+		})
+	}
+}
+
+func TestScheduleMoveTooDeep(t *testing.T) {
+	t.Parallel()
+
+	// childWorkspace returns a workspace in the given cluster, backed by the
+	// logical cluster child.
+	childWorkspace := func(cluster, name, child string) *tenancyv1alpha1.Workspace {
+		ws := workspace(name)
+		ws.Annotations[logicalcluster.AnnotationKey] = cluster
+		ws.Spec.Cluster = child
+		return ws
+	}
+
+	tests := map[string]struct {
+		maxDepth    string
+		wantMessage string
+	}{
+		"moving a workspace with grandchildren where only children are allowed": {
+			maxDepth:    "1",
+			wantMessage: "workspaces below root:old are nested deeper than allowed below root:new",
+		},
+		"moving a workspace with children where none are allowed": {
+			maxDepth:    "0",
+			wantMessage: "workspaces below root:old are nested deeper than allowed below root:new",
+		},
+		"moving a workspace with grandchildren where they are allowed": {
+			maxDepth: "2",
+		},
+		"moving a workspace with grandchildren without limit": {},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// root:old has the children a and b, and a has the child c.
+			fakeKcpClient := kcpfakeclient.NewSimpleClientset( //nolint:staticcheck
+				movedWorkspace("root:new"),
+				func() runtime.Object {
+					lc := wellKnownLogicalClusterForFooWS()
+					lc.Annotations[logicalcluster.AnnotationKey] = "root-foo"
+					return lc
+				}(),
+				childWorkspace("root-foo", "a", "root-a"),
+				childWorkspace("root-foo", "b", ""),
+				childWorkspace("root-a", "c", "root-c"),
+			)
+
+			r := schedulingReconciler{
+				getShard: func(name string) (*corev1alpha1.Shard, error) {
+					return shard(name), nil
+				},
+				getExternalLogicalCluster: func(ctx context.Context, cluster logicalcluster.Path) (*corev1alpha1.LogicalCluster, error) {
+					return fakeKcpClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Get(ctx, corev1alpha1.LogicalClusterName, metav1.GetOptions{})
+				},
+				getExternalWorkspace: func(ctx context.Context, cluster logicalcluster.Path, name string) (*tenancyv1alpha1.Workspace, error) {
+					return fakeKcpClient.Cluster(cluster).TenancyV1alpha1().Workspaces().Get(ctx, name, metav1.GetOptions{})
+				},
+				listExternalWorkspaces: func(ctx context.Context, cluster logicalcluster.Path) ([]*tenancyv1alpha1.Workspace, error) {
+					return listWorkspaces(ctx, fakeKcpClient, cluster)
+				},
+			}
+
+			ws := workspace("new")
+			ws.Annotations[tenancyv1alpha1.WorkspaceMoveFromAnnotationKey] = "root:old"
+			if tc.maxDepth != "" {
+				ws.Annotations[tenancyv1alpha1.LogicalClusterMaxDepthAnnotationKey] = tc.maxDepth
+			}
+			ws.Spec.Type = &tenancyv1alpha1.WorkspaceTypeReference{Name: "universal", Path: "root"}
+
+			status, err := r.scheduleMove(context.Background(), ws, logicalcluster.NewPath("root:new"), logicalcluster.NewPath("root:old"), false)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tc.wantMessage == "" {
+				if status != reconcileStatusStopAndRequeue {
+					t.Fatalf("unexpected reconciliation status:%v, expected:%v", status, reconcileStatusStopAndRequeue)
+				}
+				if cluster := ws.Annotations[workspaceClusterAnnotationKey]; cluster != "root-foo" {
+					t.Fatalf("expected the workspace to take over the logical cluster root-foo, got %q", cluster)
+				}
+				return
+			}
+
+			if status != reconcileStatusContinue {
+				t.Fatalf("unexpected reconciliation status:%v, expected:%v", status, reconcileStatusContinue)
+			}
+			clearLastTransitionTimeOnWsConditions(ws)
+			want := conditionsapi.Conditions{{
+				Type:     tenancyv1alpha1.WorkspaceScheduled,
+				Status:   corev1.ConditionFalse,
+				Severity: conditionsapi.ConditionSeverityError,
+				Reason:   tenancyv1alpha1.WorkspaceReasonMoveFailed,
+				Message:  tc.wantMessage,
+			}}
+			if !equality.Semantic.DeepEqual(ws.Status.Conditions, want) {
+				t.Fatalf("unexpected conditions:\n%s", cmp.Diff(ws.Status.Conditions, want))
+			}
+			if cluster := ws.Annotations[workspaceClusterAnnotationKey]; cluster != "" {
+				t.Errorf("expected the workspace not to take over a logical cluster, got %s", cluster)
+			}
+		})
+	}
+}
+
 func workspace(name string) *tenancyv1alpha1.Workspace {
 	return &tenancyv1alpha1.Workspace{
 		ObjectMeta: metav1.ObjectMeta{
@@ -435,6 +912,31 @@ func workspace(name string) *tenancyv1alpha1.Workspace {
 	}
 }
 
+// movedWorkspace returns the ready workspace root:old backed by the cluster
+// root-foo, annotated to be moved to the given path.
+func movedWorkspace(to string) *tenancyv1alpha1.Workspace {
+	ws := workspace("old")
+	ws.UID = "old"
+	ws.Annotations[tenancyv1alpha1.WorkspaceMoveToAnnotationKey] = to
+	ws.Spec.Type = &tenancyv1alpha1.WorkspaceTypeReference{Name: "universal", Path: "root"}
+	ws.Spec.Cluster = "root-foo"
+	ws.Spec.URL = "https://root/clusters/root:old"
+	ws.Status.Phase = corev1alpha1.LogicalClusterPhaseReady
+	return ws
+}
+
+func listWorkspaces(ctx context.Context, client kcpclientset.ClusterInterface, cluster logicalcluster.Path) ([]*tenancyv1alpha1.Workspace, error) {
+	list, err := client.Cluster(cluster).TenancyV1alpha1().Workspaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	workspaces := make([]*tenancyv1alpha1.Workspace, 0, len(list.Items))
+	for i := range list.Items {
+		workspaces = append(workspaces, &list.Items[i])
+	}
+	return workspaces, nil
+}
+
 func wellKnownFooWSForPhaseTwo() *tenancyv1alpha1.Workspace {
 	ws := workspace("foo")
 	// since this is part two we can assume the following fields are assigned
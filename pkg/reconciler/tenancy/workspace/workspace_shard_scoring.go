@@ -0,0 +1,265 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"fmt"
+	mathrand "math/rand"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+)
+
+const (
+	// ShardSchedulingPolicyRandom schedules new workspaces to a random shard.
+	ShardSchedulingPolicyRandom = "random"
+	// ShardSchedulingPolicyLeastLoaded schedules new workspaces to the shard
+	// with the lowest utilization.
+	ShardSchedulingPolicyLeastLoaded = "least-loaded"
+	// ShardSchedulingPolicyBinpack schedules new workspaces to the shard with
+	// the highest utilization, filling up shards one after the other.
+	ShardSchedulingPolicyBinpack = "binpack"
+	// ShardSchedulingPolicySpread spreads new workspaces evenly across the
+	// values of a shard label, e.g. regions, and schedules them to the least
+	// loaded shard of the value holding the fewest logical clusters.
+	ShardSchedulingPolicySpread = "spread"
+)
+
+// ShardSchedulingPolicies are all known shard scheduling policies.
+var ShardSchedulingPolicies = []string{
+	ShardSchedulingPolicyRandom,
+	ShardSchedulingPolicyLeastLoaded,
+	ShardSchedulingPolicyBinpack,
+	ShardSchedulingPolicySpread,
+}
+
+// ShardScorer picks the shard a new workspace is scheduled to.
+type ShardScorer interface {
+	// Pick returns one of the candidates, which is never empty. Full shards
+	// are never passed as candidates.
+	Pick(candidates []ShardLoad) *corev1alpha1.Shard
+}
+
+// NewShardScorer returns the ShardScorer for the given policy. spreadLabel is
+// the shard label used by ShardSchedulingPolicySpread.
+func NewShardScorer(policy, spreadLabel string) (ShardScorer, error) {
+	switch policy {
+	case ShardSchedulingPolicyRandom, "":
+		return randomScorer{}, nil
+	case ShardSchedulingPolicyLeastLoaded:
+		return leastLoadedScorer{}, nil
+	case ShardSchedulingPolicyBinpack:
+		return binpackScorer{}, nil
+	case ShardSchedulingPolicySpread:
+		if spreadLabel == "" {
+			return nil, fmt.Errorf("shard scheduling policy %q requires a label to spread by", policy)
+		}
+		return spreadScorer{label: spreadLabel}, nil
+	default:
+		return nil, fmt.Errorf("unknown shard scheduling policy %q, must be one of %s", policy, strings.Join(ShardSchedulingPolicies, ", "))
+	}
+}
+
+// ShardLoad is the load of a shard as seen by the scheduler.
+type ShardLoad struct {
+	Shard *corev1alpha1.Shard
+
+	// LogicalClusters is the number of logical clusters reported by the
+	// shard, plus the workspaces scheduled to it since.
+	LogicalClusters int64
+
+	// ratios holds usage/capacity for every resource the shard reports a
+	// capacity for.
+	ratios []float64
+}
+
+// NewShardLoad computes the load of shard, with pending workspaces scheduled
+// to it since it last reported its usage.
+func NewShardLoad(shard *corev1alpha1.Shard, pending int64) ShardLoad {
+	usage := shard.Status.Usage[corev1alpha1.ShardCapacityLogicalClusters]
+	load := ShardLoad{
+		Shard:           shard,
+		LogicalClusters: usage.Value() + pending,
+	}
+	for _, name := range []corev1.ResourceName{corev1alpha1.ShardCapacityLogicalClusters, corev1alpha1.ShardCapacityObjects} {
+		capacity, ok := shard.Status.Capacity[name]
+		if !ok {
+			continue
+		}
+		if capacity.Value() <= 0 {
+			// A shard without any capacity is full.
+			load.ratios = append(load.ratios, 1)
+			continue
+		}
+		used := shard.Status.Usage[name]
+		value := used.Value()
+		if name == corev1alpha1.ShardCapacityLogicalClusters {
+			value = load.LogicalClusters
+		}
+		load.ratios = append(load.ratios, float64(value)/float64(capacity.Value()))
+	}
+	return load
+}
+
+// Full returns true if the shard has no capacity left for any of the
+// resources it reports a capacity for.
+func (l ShardLoad) Full() bool {
+	for _, ratio := range l.ratios {
+		if ratio >= 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// utilization returns the highest ratio of usage to capacity. Shards not
+// reporting a capacity are assumed to have assumedCapacity logical clusters,
+// or, if that is 0 too, their number of logical clusters is returned.
+func (l ShardLoad) utilization(assumedCapacity int64) float64 {
+	if len(l.ratios) == 0 {
+		if assumedCapacity > 0 {
+			return float64(l.LogicalClusters) / float64(assumedCapacity)
+		}
+		return float64(l.LogicalClusters)
+	}
+	highest := l.ratios[0]
+	for _, ratio := range l.ratios[1:] {
+		highest = max(highest, ratio)
+	}
+	return highest
+}
+
+// largestCapacity returns the largest logical cluster capacity of candidates.
+func largestCapacity(candidates []ShardLoad) int64 {
+	var largest int64
+	for _, l := range candidates {
+		capacity := l.Shard.Status.Capacity[corev1alpha1.ShardCapacityLogicalClusters]
+		largest = max(largest, capacity.Value())
+	}
+	return largest
+}
+
+type randomScorer struct{}
+
+func (randomScorer) Pick(candidates []ShardLoad) *corev1alpha1.Shard {
+	return candidates[mathrand.Intn(len(candidates))].Shard
+}
+
+// LeastLoaded returns the candidate with the lowest utilization, which must not
+// be empty. Ties are broken by shard name.
+func LeastLoaded(candidates []ShardLoad) *corev1alpha1.Shard {
+	return leastLoadedScorer{}.Pick(candidates)
+}
+
+type leastLoadedScorer struct{}
+
+func (leastLoadedScorer) Pick(candidates []ShardLoad) *corev1alpha1.Shard {
+	return pickByUtilization(candidates, func(a, b float64) bool { return a < b })
+}
+
+type binpackScorer struct{}
+
+func (binpackScorer) Pick(candidates []ShardLoad) *corev1alpha1.Shard {
+	return pickByUtilization(candidates, func(a, b float64) bool { return a > b })
+}
+
+// pickByUtilization returns the candidate whose utilization is better than
+// all others. Ties are broken by shard name to keep the result stable.
+func pickByUtilization(candidates []ShardLoad, better func(a, b float64) bool) *corev1alpha1.Shard {
+	assumedCapacity := largestCapacity(candidates)
+
+	best := candidates[0]
+	bestUtilization := best.utilization(assumedCapacity)
+	for _, l := range candidates[1:] {
+		u := l.utilization(assumedCapacity)
+		if better(u, bestUtilization) || (u == bestUtilization && l.Shard.Name < best.Shard.Name) {
+			best, bestUtilization = l, u
+		}
+	}
+	return best.Shard
+}
+
+type spreadScorer struct {
+	label string
+}
+
+func (s spreadScorer) Pick(candidates []ShardLoad) *corev1alpha1.Shard {
+	// Shards without the label form a group of their own.
+	groups := map[string][]ShardLoad{}
+	totals := map[string]int64{}
+	for _, l := range candidates {
+		value := l.Shard.Labels[s.label]
+		groups[value] = append(groups[value], l)
+		totals[value] += l.LogicalClusters
+	}
+
+	var best string
+	first := true
+	for value, total := range totals {
+		if first || total < totals[best] || (total == totals[best] && value < best) {
+			best, first = value, false
+		}
+	}
+	return leastLoadedScorer{}.Pick(groups[best])
+}
+
+// shardPlacements counts the workspaces scheduled to every shard since the
+// Shard object last changed, i.e. usually since the shard last reported its
+// usage. This keeps a burst of new workspaces from being scheduled onto the
+// same shard before its reported usage catches up.
+type shardPlacements struct {
+	lock       sync.Mutex
+	placements map[string]shardPlacement
+}
+
+type shardPlacement struct {
+	resourceVersion string
+	count           int64
+}
+
+func newShardPlacements() *shardPlacements {
+	return &shardPlacements{placements: map[string]shardPlacement{}}
+}
+
+// pending returns the number of workspaces scheduled to shard since it last
+// changed.
+func (p *shardPlacements) pending(shard *corev1alpha1.Shard) int64 {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	placement, ok := p.placements[shard.Name]
+	if !ok || placement.resourceVersion != shard.ResourceVersion {
+		return 0
+	}
+	return placement.count
+}
+
+// record records a workspace scheduled to shard.
+func (p *shardPlacements) record(shard *corev1alpha1.Shard) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	placement := p.placements[shard.Name]
+	if placement.resourceVersion != shard.ResourceVersion {
+		placement = shardPlacement{resourceVersion: shard.ResourceVersion}
+	}
+	placement.count++
+	p.placements[shard.Name] = placement
+}
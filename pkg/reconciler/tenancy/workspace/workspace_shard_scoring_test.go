@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+)
+
+func TestShardScorers(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		policy      string
+		spreadLabel string
+		shards      []*corev1alpha1.Shard
+		want        string
+	}{
+		"least-loaded picks the lowest utilization": {
+			policy: ShardSchedulingPolicyLeastLoaded,
+			shards: []*corev1alpha1.Shard{
+				loadedShard("old", "", 80, 100),
+				loadedShard("new", "", 10, 20),
+				loadedShard("big", "", 300, 1000),
+			},
+			want: "big",
+		},
+		"least-loaded assumes the largest capacity for shards not reporting one": {
+			policy: ShardSchedulingPolicyLeastLoaded,
+			shards: []*corev1alpha1.Shard{
+				loadedShard("reporting", "", 50, 100),
+				loadedShard("unknown", "", 40, 0),
+			},
+			want: "unknown",
+		},
+		"least-loaded compares logical clusters if no shard reports a capacity": {
+			policy: ShardSchedulingPolicyLeastLoaded,
+			shards: []*corev1alpha1.Shard{
+				loadedShard("b", "", 5, 0),
+				loadedShard("a", "", 5, 0),
+				loadedShard("c", "", 7, 0),
+			},
+			want: "a",
+		},
+		"binpack picks the highest utilization": {
+			policy: ShardSchedulingPolicyBinpack,
+			shards: []*corev1alpha1.Shard{
+				loadedShard("old", "", 80, 100),
+				loadedShard("new", "", 1, 100),
+			},
+			want: "old",
+		},
+		"spread picks the least loaded shard of the emptiest label value": {
+			policy:      ShardSchedulingPolicySpread,
+			spreadLabel: "region",
+			shards: []*corev1alpha1.Shard{
+				loadedShard("eu-1", "eu", 10, 100),
+				loadedShard("eu-2", "eu", 10, 100),
+				loadedShard("us-1", "us", 15, 100),
+				loadedShard("us-2", "us", 1, 100),
+			},
+			want: "us-2",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			scorer, err := NewShardScorer(tc.policy, tc.spreadLabel)
+			require.NoError(t, err)
+
+			candidates := make([]ShardLoad, 0, len(tc.shards))
+			for _, shard := range tc.shards {
+				candidates = append(candidates, NewShardLoad(shard, 0))
+			}
+			require.Equal(t, tc.want, scorer.Pick(candidates).Name)
+		})
+	}
+}
+
+func TestNewShardScorer_validates(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewShardScorer("fastest", "")
+	require.EqualError(t, err, `unknown shard scheduling policy "fastest", must be one of random, least-loaded, binpack, spread`)
+
+	_, err = NewShardScorer(ShardSchedulingPolicySpread, "")
+	require.EqualError(t, err, `shard scheduling policy "spread" requires a label to spread by`)
+}
+
+func TestShardLoadFull(t *testing.T) {
+	t.Parallel()
+
+	shard := loadedShard("shard", "", 9, 10)
+	require.False(t, NewShardLoad(shard, 0).Full())
+	require.True(t, NewShardLoad(shard, 1).Full(), "pending placements must count towards the capacity")
+
+	shard.Status.Capacity[corev1alpha1.ShardCapacityObjects] = resource.MustParse("1000")
+	shard.Status.Usage[corev1alpha1.ShardCapacityObjects] = resource.MustParse("1000")
+	require.True(t, NewShardLoad(shard, 0).Full(), "any exhausted resource makes the shard full")
+}
+
+func TestShardPlacements(t *testing.T) {
+	t.Parallel()
+
+	p := newShardPlacements()
+	shard := loadedShard("shard", "", 0, 0)
+	shard.ResourceVersion = "1"
+
+	p.record(shard)
+	p.record(shard)
+	require.Equal(t, int64(2), p.pending(shard))
+
+	shard.ResourceVersion = "2"
+	require.Equal(t, int64(0), p.pending(shard), "placements must reset once the shard reports again")
+
+	p.record(shard)
+	require.Equal(t, int64(1), p.pending(shard))
+}
+
+// loadedShard returns a shard with the given logical cluster usage and
+// capacity. A capacity of 0 is not reported.
+func loadedShard(name, region string, usage, capacity int64) *corev1alpha1.Shard {
+	s := shard(name)
+	if region != "" {
+		s.Labels["region"] = region
+	}
+	s.Status.Usage = corev1.ResourceList{corev1alpha1.ShardCapacityLogicalClusters: *resource.NewQuantity(usage, resource.DecimalSI)}
+	s.Status.Capacity = corev1.ResourceList{}
+	if capacity > 0 {
+		s.Status.Capacity[corev1alpha1.ShardCapacityLogicalClusters] = *resource.NewQuantity(capacity, resource.DecimalSI)
+	}
+	return s
+}
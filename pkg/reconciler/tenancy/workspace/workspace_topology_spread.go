@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+)
+
+// workspaceTypeKey returns the type of workspace in the format "root:org:name",
+// as used in the tenancyv1alpha1.LogicalClusterTypeAnnotationKey annotation.
+func workspaceTypeKey(workspace *tenancyv1alpha1.Workspace) string {
+	return logicalcluster.NewPath(workspace.Spec.Type.Path).Join(string(workspace.Spec.Type.Name)).String()
+}
+
+// filterByTopologySpread returns the candidates satisfying all topology
+// spread constraints of the type of workspace. If there are none, a message
+// explaining the violated constraint is returned.
+func (r *schedulingReconciler) filterByTopologySpread(workspace *tenancyv1alpha1.Workspace, candidates []ShardLoad) ([]ShardLoad, string, error) {
+	if workspace.Spec.Type == nil {
+		return candidates, "", nil
+	}
+	wt, err := r.getWorkspaceType(logicalcluster.NewPath(workspace.Spec.Type.Path), string(workspace.Spec.Type.Name))
+	if err != nil {
+		return nil, "", err
+	}
+
+	for _, constraint := range wt.Spec.TopologySpreadConstraints {
+		counts, err := r.countWorkspacesPerDomain(workspace, constraint)
+		if err != nil {
+			return nil, "", err
+		}
+		maxSkew := int64(max(constraint.MaxSkew, 1))
+
+		// The skew is relative to the emptiest domain a workspace can be
+		// scheduled to, otherwise a domain without any available shard
+		// would block all others.
+		var minCount int64
+		first := true
+		for _, l := range candidates {
+			domain, ok := l.Shard.Labels[constraint.SpreadBy]
+			if !ok {
+				continue
+			}
+			if first || counts[domain] < minCount {
+				minCount, first = counts[domain], false
+			}
+		}
+		if first {
+			return nil, fmt.Sprintf("no shard has the label %q to spread workspaces of type %s by", constraint.SpreadBy, workspaceTypeKey(workspace)), nil
+		}
+
+		filtered := make([]ShardLoad, 0, len(candidates))
+		for _, l := range candidates {
+			domain, ok := l.Shard.Labels[constraint.SpreadBy]
+			if ok && counts[domain]+1-minCount <= maxSkew {
+				filtered = append(filtered, l)
+			}
+		}
+		if len(filtered) == 0 {
+			return nil, fmt.Sprintf("scheduling would exceed the maximum skew of %d across %q", maxSkew, constraint.SpreadBy), nil
+		}
+		candidates = filtered
+	}
+
+	return candidates, "", nil
+}
+
+// countWorkspacesPerDomain counts the workspaces selected by the scope of
+// constraint per value of the spreadBy shard label, not including workspace
+// itself.
+func (r *schedulingReconciler) countWorkspacesPerDomain(workspace *tenancyv1alpha1.Workspace, constraint tenancyv1alpha1.WorkspaceTopologySpreadConstraint) (map[string]int64, error) {
+	counts := map[string]int64{}
+	typeKey := workspaceTypeKey(workspace)
+
+	if constraint.Scope == tenancyv1alpha1.WorkspaceTopologySpreadScopeType {
+		// The shards report the number of logical clusters of types with
+		// constraints of scope Type.
+		shards, err := r.listShards(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		for _, shard := range shards {
+			domain, ok := shard.Labels[constraint.SpreadBy]
+			if !ok {
+				continue
+			}
+			usage := shard.Status.Usage[corev1alpha1.ShardUsageLogicalClustersOfTypePrefix+corev1.ResourceName(typeKey)]
+			counts[domain] += usage.Value()
+		}
+		return counts, nil
+	}
+
+	siblings, err := r.listWorkspaces(logicalcluster.From(workspace))
+	if err != nil {
+		return nil, err
+	}
+	for _, sibling := range siblings {
+		if sibling.Name == workspace.Name || sibling.Spec.Type == nil || workspaceTypeKey(sibling) != typeKey {
+			continue
+		}
+		shardName, ok := sibling.Annotations[corev1alpha1.LogicalClusterShardAnnotationKey]
+		if !ok {
+			continue
+		}
+		shard, err := r.getShard(shardName)
+		if apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if domain, ok := shard.Labels[constraint.SpreadBy]; ok {
+			counts[domain]++
+		}
+	}
+	return counts, nil
+}
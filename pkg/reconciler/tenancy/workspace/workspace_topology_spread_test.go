@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+)
+
+func TestFilterByTopologySpread(t *testing.T) {
+	t.Parallel()
+
+	const region = "topology.kcp.io/region"
+
+	tests := map[string]struct {
+		constraints []tenancyv1alpha1.WorkspaceTopologySpreadConstraint
+		shards      []*corev1alpha1.Shard
+		siblings    map[string]string // sibling workspace name -> shard name
+		want        []string
+		wantMessage string
+	}{
+		"no constraints": {
+			shards: []*corev1alpha1.Shard{regionShard("a", ""), regionShard("b", "eu")},
+			want:   []string{"a", "b"},
+		},
+		"siblings are spread": {
+			constraints: []tenancyv1alpha1.WorkspaceTopologySpreadConstraint{{SpreadBy: region, MaxSkew: 1}},
+			shards:      []*corev1alpha1.Shard{regionShard("eu-1", "eu"), regionShard("eu-2", "eu"), regionShard("us-1", "us")},
+			siblings:    map[string]string{"one": "eu-1"},
+			want:        []string{"us-1"},
+		},
+		"max skew allows imbalance": {
+			constraints: []tenancyv1alpha1.WorkspaceTopologySpreadConstraint{{SpreadBy: region, MaxSkew: 2}},
+			shards:      []*corev1alpha1.Shard{regionShard("eu-1", "eu"), regionShard("us-1", "us")},
+			siblings:    map[string]string{"one": "eu-1"},
+			want:        []string{"eu-1", "us-1"},
+		},
+		"shards without the label are not scheduled to": {
+			constraints: []tenancyv1alpha1.WorkspaceTopologySpreadConstraint{{SpreadBy: region}},
+			shards:      []*corev1alpha1.Shard{regionShard("unlabeled", ""), regionShard("eu-1", "eu")},
+			want:        []string{"eu-1"},
+		},
+		"no shard has the label": {
+			constraints: []tenancyv1alpha1.WorkspaceTopologySpreadConstraint{{SpreadBy: region}},
+			shards:      []*corev1alpha1.Shard{regionShard("unlabeled", "")},
+			wantMessage: `no shard has the label "topology.kcp.io/region" to spread workspaces of type root:team by`,
+		},
+		"all workspaces of the type are spread": {
+			constraints: []tenancyv1alpha1.WorkspaceTopologySpreadConstraint{{SpreadBy: region, MaxSkew: 1, Scope: tenancyv1alpha1.WorkspaceTopologySpreadScopeType}},
+			shards: []*corev1alpha1.Shard{
+				withTypeUsage(regionShard("eu-1", "eu"), 3),
+				withTypeUsage(regionShard("us-1", "us"), 2),
+			},
+			siblings: map[string]string{"one": "us-1", "two": "us-1"},
+			want:     []string{"us-1"},
+		},
+		"a domain without candidates does not block the others": {
+			constraints: []tenancyv1alpha1.WorkspaceTopologySpreadConstraint{{SpreadBy: region, MaxSkew: 1}},
+			shards: []*corev1alpha1.Shard{
+				regionShard("eu-1", "eu"),
+				regionShard("us-1", "us"),
+				func() *corev1alpha1.Shard {
+					s := regionShard("ap-1", "ap")
+					s.Annotations[unschedulableAnnotationKey] = "true"
+					return s
+				}(),
+			},
+			siblings: map[string]string{"one": "eu-1", "two": "us-1"},
+			want:     []string{"eu-1", "us-1"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ws := workspace("new")
+			ws.Spec.Type = &tenancyv1alpha1.WorkspaceTypeReference{Name: "team", Path: "root"}
+
+			r := &schedulingReconciler{
+				getWorkspaceType: func(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error) {
+					require.Equal(t, "root", path.String())
+					require.Equal(t, "team", name)
+					wt := workspaceType("team")
+					wt.Spec.TopologySpreadConstraints = tc.constraints
+					return wt, nil
+				},
+				listShards: func(selector labels.Selector) ([]*corev1alpha1.Shard, error) {
+					return tc.shards, nil
+				},
+				getShard: func(name string) (*corev1alpha1.Shard, error) {
+					for _, shard := range tc.shards {
+						if shard.Name == name {
+							return shard, nil
+						}
+					}
+					return nil, kerrors.NewNotFound(corev1alpha1.Resource("shards"), name)
+				},
+				listWorkspaces: func(clusterName logicalcluster.Name) ([]*tenancyv1alpha1.Workspace, error) {
+					require.Equal(t, "root", clusterName.String())
+					siblings := []*tenancyv1alpha1.Workspace{ws}
+					for name, shard := range tc.siblings {
+						sibling := workspace(name)
+						sibling.Spec.Type = ws.Spec.Type.DeepCopy()
+						sibling.Annotations[corev1alpha1.LogicalClusterShardAnnotationKey] = shard
+						siblings = append(siblings, sibling)
+					}
+					other := workspace("other-type")
+					other.Spec.Type = &tenancyv1alpha1.WorkspaceTypeReference{Name: "org", Path: "root"}
+					other.Annotations[corev1alpha1.LogicalClusterShardAnnotationKey] = tc.shards[0].Name
+					return append(siblings, other), nil
+				},
+				shardScorer:     randomScorer{},
+				shardPlacements: newShardPlacements(),
+			}
+
+			if tc.wantMessage != "" {
+				shard, reason, message, err := r.chooseShardAndMarkCondition(klog.Background(), ws)
+				require.NoError(t, err)
+				require.Nil(t, shard)
+				require.Equal(t, tenancyv1alpha1.WorkspaceReasonTopologySpreadUnsatisfiable, reason)
+				require.Equal(t, tc.wantMessage, message)
+				return
+			}
+
+			candidates := make([]ShardLoad, 0, len(tc.shards))
+			for _, shard := range tc.shards {
+				if _, ok := shard.Annotations[unschedulableAnnotationKey]; !ok {
+					candidates = append(candidates, NewShardLoad(shard, 0))
+				}
+			}
+			filtered, message, err := r.filterByTopologySpread(ws, candidates)
+			require.NoError(t, err)
+			require.Empty(t, message)
+			names := make([]string, 0, len(filtered))
+			for _, l := range filtered {
+				names = append(names, l.Shard.Name)
+			}
+			require.Equal(t, tc.want, names)
+		})
+	}
+}
+
+func regionShard(name, region string) *corev1alpha1.Shard {
+	s := shard(name)
+	if region != "" {
+		s.Labels["topology.kcp.io/region"] = region
+	}
+	return s
+}
+
+func withTypeUsage(s *corev1alpha1.Shard, count int64) *corev1alpha1.Shard {
+	s.Status.Usage = corev1.ResourceList{
+		corev1alpha1.ShardUsageLogicalClustersOfTypePrefix + "root:team": *resource.NewQuantity(count, resource.DecimalSI),
+	}
+	return s
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspacequota
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	quota "k8s.io/apiserver/pkg/quota/v1"
+	quotacore "k8s.io/kubernetes/pkg/quota/v1/evaluator/core"
+	"k8s.io/utils/clock"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	"github.com/kcp-dev/sdk/apis/core"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+)
+
+// PathOf returns the logical cluster path of the given object, falling back
+// to the name of its logical cluster if it has no path annotation.
+func PathOf(obj metav1.Object) logicalcluster.Path {
+	if path, ok := obj.GetAnnotations()[core.LogicalClusterPathAnnotationKey]; ok && path != "" {
+		return logicalcluster.NewPath(path)
+	}
+	return logicalcluster.From(obj).Path()
+}
+
+// QuotasFor returns the WorkspaceQuotas covering the workspace at the given
+// path, i.e. those living in any of its ancestors. quotasAt returns the
+// WorkspaceQuotas of the workspace at a path. System logical clusters are not
+// workspaces and are never covered.
+func QuotasFor(path logicalcluster.Path, quotasAt func(path logicalcluster.Path) ([]*tenancyv1alpha1.WorkspaceQuota, error)) ([]*tenancyv1alpha1.WorkspaceQuota, error) {
+	if strings.HasPrefix(path.String(), "system:") {
+		return nil, nil
+	}
+	var ret []*tenancyv1alpha1.WorkspaceQuota
+	for parent, ok := path.Parent(); ok; parent, ok = parent.Parent() {
+		quotas, err := quotasAt(parent)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, quotas...)
+	}
+	return ret, nil
+}
+
+// CountResourceName returns the WorkspaceQuota resource name for the object
+// count of the given resource, e.g. count/deployments.apps.
+func CountResourceName(resource schema.GroupResource) corev1.ResourceName {
+	return corev1.ResourceName(tenancyv1alpha1.ResourceCountPrefix + resource.String())
+}
+
+// CountedResources returns the resources whose objects are counted by the
+// given hard limits.
+func CountedResources(hard corev1.ResourceList) map[corev1.ResourceName]schema.GroupResource {
+	ret := map[corev1.ResourceName]schema.GroupResource{}
+	for name := range hard {
+		if resource, ok := strings.CutPrefix(string(name), tenancyv1alpha1.ResourceCountPrefix); ok && resource != "" {
+			ret[name] = schema.ParseGroupResource(resource)
+		}
+	}
+	return ret
+}
+
+// podEvaluator computes the compute resource usage of pods like Kubernetes
+// resource quota does. It is never used to list pods.
+var podEvaluator = quotacore.NewPodEvaluator(nil, clock.RealClock{})
+
+// PodsResource is the resource whose objects are charged for the compute
+// resources of a WorkspaceQuota.
+var PodsResource = corev1.SchemeGroupVersion.WithResource("pods")
+
+// ComputeResources returns the resources of the given hard limits which are
+// summed up over the pods, like by Kubernetes resource quota, e.g. pods,
+// requests.cpu or limits.memory.
+func ComputeResources(hard corev1.ResourceList) []corev1.ResourceName {
+	var ret []corev1.ResourceName
+	for _, name := range podEvaluator.MatchingResources(quota.ResourceNames(hard)) {
+		if !strings.HasPrefix(string(name), tenancyv1alpha1.ResourceCountPrefix) {
+			ret = append(ret, name)
+		}
+	}
+	return ret
+}
+
+// ToPod converts the given pod object, either typed or unstructured, to a
+// corev1.Pod.
+func ToPod(obj runtime.Object) (*corev1.Pod, error) {
+	switch t := obj.(type) {
+	case *corev1.Pod:
+		return t, nil
+	case *unstructured.Unstructured:
+		pod := &corev1.Pod{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(t.Object, pod); err != nil {
+			return nil, fmt.Errorf("failed to convert unstructured to Pod: %w", err)
+		}
+		return pod, nil
+	default:
+		return nil, fmt.Errorf("unexpected pod type %T", obj)
+	}
+}
+
+// PodUsage returns the usage of the given pod for the given compute resources.
+// Pods in a terminal phase only count towards count/pods, not towards compute
+// resources.
+func PodUsage(pod *corev1.Pod, resources []corev1.ResourceName) (corev1.ResourceList, error) {
+	usage, err := podEvaluator.Usage(pod)
+	if err != nil {
+		return nil, err
+	}
+	return quota.Mask(usage, resources), nil
+}
+
+// PodConstraints returns an error if the given pod does not specify the
+// requests or limits required by the given compute resources. Like for
+// Kubernetes resource quota, a limit on cpu or memory requires every container
+// to specify it.
+func PodConstraints(pod *corev1.Pod, resources []corev1.ResourceName) error {
+	return podEvaluator.Constraints(resources, pod)
+}
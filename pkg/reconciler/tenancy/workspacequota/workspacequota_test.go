@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspacequota
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+)
+
+func TestQuotasFor(t *testing.T) {
+	t.Parallel()
+
+	quotasAt := func(path logicalcluster.Path) ([]*tenancyv1alpha1.WorkspaceQuota, error) {
+		return []*tenancyv1alpha1.WorkspaceQuota{{ObjectMeta: metav1.ObjectMeta{Name: path.String()}}}, nil
+	}
+	names := func(path string) []string {
+		quotas, err := QuotasFor(logicalcluster.NewPath(path), quotasAt)
+		require.NoError(t, err)
+		var ret []string
+		for _, q := range quotas {
+			ret = append(ret, q.Name)
+		}
+		return ret
+	}
+
+	require.Equal(t, []string{"root:org", "root"}, names("root:org:ws"))
+	require.Nil(t, names("root"))
+	require.Nil(t, names("system:admin"))
+}
+
+func TestCountedResources(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, map[corev1.ResourceName]schema.GroupResource{
+		"count/secrets":          {Resource: "secrets"},
+		"count/deployments.apps": {Group: "apps", Resource: "deployments"},
+	}, CountedResources(corev1.ResourceList{
+		"workspaces":             resource.MustParse("1"),
+		"count/secrets":          resource.MustParse("1"),
+		"count/deployments.apps": resource.MustParse("1"),
+	}))
+
+	require.Equal(t, corev1.ResourceName("count/deployments.apps"), CountResourceName(schema.GroupResource{Group: "apps", Resource: "deployments"}))
+}
@@ -0,0 +1,402 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspacequota
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	kcpdynamic "github.com/kcp-dev/client-go/dynamic"
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
+	tenancyv1alpha1client "github.com/kcp-dev/sdk/client/clientset/versioned/typed/tenancy/v1alpha1"
+	corev1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/core/v1alpha1"
+	tenancyv1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/tenancy/v1alpha1"
+
+	cacheclient "github.com/kcp-dev/kcp/pkg/cache/client"
+	"github.com/kcp-dev/kcp/pkg/cache/client/shard"
+	"github.com/kcp-dev/kcp/pkg/indexers"
+	"github.com/kcp-dev/kcp/pkg/logging"
+	"github.com/kcp-dev/kcp/pkg/objectcount"
+	"github.com/kcp-dev/kcp/pkg/reconciler/committer"
+)
+
+const (
+	UsageReporterName = "kcp-workspacequota-usage-reporter"
+)
+
+type WorkspaceQuota = tenancyv1alpha1.WorkspaceQuota
+type WorkspaceQuotaSpec = tenancyv1alpha1.WorkspaceQuotaSpec
+type WorkspaceQuotaStatus = tenancyv1alpha1.WorkspaceQuotaStatus
+type Patcher = tenancyv1alpha1client.WorkspaceQuotaInterface
+type Resource = committer.Resource[*WorkspaceQuotaSpec, *WorkspaceQuotaStatus]
+type CommitFunc = func(ctx context.Context, original, updated *Resource) error
+
+// NewUsageReporter returns a reporter which periodically computes the usage
+// of the workspaces on this shard for every WorkspaceQuota above them, and
+// publishes it as a WorkspaceQuotaUsage in the cache server. For the
+// WorkspaceQuotas on this shard it sums up the usages of all shards into
+// their status.
+//
+// Object counts are taken from the object count registry, whose scanner
+// counts the logical clusters below a WorkspaceQuota with count/ limits per
+// resource. Compute resources like requests.cpu are summed up over the pods
+// of the logical clusters below a WorkspaceQuota limiting them.
+func NewUsageReporter(
+	shardName string,
+	interval time.Duration,
+	kcpClusterClient kcpclientset.ClusterInterface,
+	dynamicClusterClient kcpdynamic.ClusterInterface,
+	cacheKcpClusterClient kcpclientset.ClusterInterface,
+	logicalClusterInformer corev1alpha1informers.LogicalClusterClusterInformer,
+	workspaceQuotaInformer, globalWorkspaceQuotaInformer tenancyv1alpha1informers.WorkspaceQuotaClusterInformer,
+	globalWorkspaceQuotaUsageInformer tenancyv1alpha1informers.WorkspaceQuotaUsageClusterInformer,
+	registry *objectcount.Registry,
+) *UsageReporter {
+	cacheCtx := func(ctx context.Context) context.Context {
+		return cacheclient.WithShardInContext(ctx, shard.New(shardName))
+	}
+
+	return &UsageReporter{
+		shard:    shardName,
+		interval: interval,
+		listLogicalClusters: func() ([]*corev1alpha1.LogicalCluster, error) {
+			return logicalClusterInformer.Lister().List(labels.Everything())
+		},
+		listWorkspaceQuotas: func() ([]*tenancyv1alpha1.WorkspaceQuota, error) {
+			return workspaceQuotaInformer.Lister().List(labels.Everything())
+		},
+		getWorkspaceQuotasAt: QuotasAtFunc(workspaceQuotaInformer.Informer().GetIndexer(), globalWorkspaceQuotaInformer.Informer().GetIndexer()),
+		listWorkspaceQuotaUsages: func() ([]*tenancyv1alpha1.WorkspaceQuotaUsage, error) {
+			return globalWorkspaceQuotaUsageInformer.Lister().List(labels.Everything())
+		},
+		countObjects: registry.ResourceCount,
+		listPods: func(ctx context.Context, cluster logicalcluster.Name) ([]corev1.Pod, error) {
+			var pods []corev1.Pod
+			continueToken := ""
+			for {
+				list, err := dynamicClusterClient.Cluster(cluster.Path()).Resource(PodsResource).List(ctx, metav1.ListOptions{Limit: 500, Continue: continueToken})
+				if apierrors.IsNotFound(err) {
+					// Pods are not served in this logical cluster.
+					return nil, nil
+				}
+				if err != nil {
+					return nil, err
+				}
+				for i := range list.Items {
+					pod, err := ToPod(&list.Items[i])
+					if err != nil {
+						return nil, err
+					}
+					pods = append(pods, *pod)
+				}
+				continueToken = list.GetContinue()
+				if continueToken == "" {
+					return pods, nil
+				}
+			}
+		},
+		createWorkspaceQuotaUsage: func(ctx context.Context, cluster logicalcluster.Name, usage *tenancyv1alpha1.WorkspaceQuotaUsage) error {
+			_, err := cacheKcpClusterClient.TenancyV1alpha1().WorkspaceQuotaUsages().Cluster(cluster.Path()).Create(cacheCtx(ctx), usage, metav1.CreateOptions{})
+			return err
+		},
+		updateWorkspaceQuotaUsage: func(ctx context.Context, cluster logicalcluster.Name, usage *tenancyv1alpha1.WorkspaceQuotaUsage) error {
+			_, err := cacheKcpClusterClient.TenancyV1alpha1().WorkspaceQuotaUsages().Cluster(cluster.Path()).Update(cacheCtx(ctx), usage, metav1.UpdateOptions{})
+			return err
+		},
+		deleteWorkspaceQuotaUsage: func(ctx context.Context, cluster logicalcluster.Name, name string) error {
+			return cacheKcpClusterClient.TenancyV1alpha1().WorkspaceQuotaUsages().Cluster(cluster.Path()).Delete(cacheCtx(ctx), name, metav1.DeleteOptions{})
+		},
+		commit: committer.NewCommitter[*WorkspaceQuota, Patcher, *WorkspaceQuotaSpec, *WorkspaceQuotaStatus](kcpClusterClient.TenancyV1alpha1().WorkspaceQuotas()),
+	}
+}
+
+// QuotasAtFunc returns a function which looks up the WorkspaceQuotas of the
+// workspace at a path, in the local indexer first and then in the cache
+// server's. Both indexers get the ByLogicalClusterPath index added.
+func QuotasAtFunc(indexer, globalIndexer cache.Indexer) func(path logicalcluster.Path) ([]*tenancyv1alpha1.WorkspaceQuota, error) {
+	indexers.AddIfNotPresentOrDie(indexer, cache.Indexers{
+		indexers.ByLogicalClusterPath: indexers.IndexByLogicalClusterPath,
+	})
+	indexers.AddIfNotPresentOrDie(globalIndexer, cache.Indexers{
+		indexers.ByLogicalClusterPath: indexers.IndexByLogicalClusterPath,
+	})
+	return func(path logicalcluster.Path) ([]*tenancyv1alpha1.WorkspaceQuota, error) {
+		return indexers.ByIndexWithFallback[*tenancyv1alpha1.WorkspaceQuota](indexer, globalIndexer, indexers.ByLogicalClusterPath, path.String())
+	}
+}
+
+// UsageReporter periodically reports the usage of the workspaces on this
+// shard towards the WorkspaceQuotas above them.
+type UsageReporter struct {
+	shard    string
+	interval time.Duration
+
+	listLogicalClusters      func() ([]*corev1alpha1.LogicalCluster, error)
+	listWorkspaceQuotas      func() ([]*tenancyv1alpha1.WorkspaceQuota, error)
+	getWorkspaceQuotasAt     func(path logicalcluster.Path) ([]*tenancyv1alpha1.WorkspaceQuota, error)
+	listWorkspaceQuotaUsages func() ([]*tenancyv1alpha1.WorkspaceQuotaUsage, error)
+	countObjects             func(cluster logicalcluster.Name, resource schema.GroupResource) (int64, bool)
+	listPods                 func(ctx context.Context, cluster logicalcluster.Name) ([]corev1.Pod, error)
+
+	createWorkspaceQuotaUsage func(ctx context.Context, cluster logicalcluster.Name, usage *tenancyv1alpha1.WorkspaceQuotaUsage) error
+	updateWorkspaceQuotaUsage func(ctx context.Context, cluster logicalcluster.Name, usage *tenancyv1alpha1.WorkspaceQuotaUsage) error
+	deleteWorkspaceQuotaUsage func(ctx context.Context, cluster logicalcluster.Name, name string) error
+
+	commit CommitFunc
+}
+
+// quotaKey identifies a WorkspaceQuota across logical clusters.
+type quotaKey struct {
+	cluster logicalcluster.Name
+	name    string
+}
+
+// Start runs the reporter until ctx is done.
+func (r *UsageReporter) Start(ctx context.Context) {
+	logger := logging.WithReconciler(klog.FromContext(ctx), UsageReporterName)
+	ctx = klog.NewContext(ctx, logger)
+	logger.Info("Starting reporter")
+	defer logger.Info("Shutting down reporter")
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := r.report(ctx); err != nil {
+			logger.Error(err, "failed to report workspace quota usage")
+		}
+	}, r.interval)
+}
+
+func (r *UsageReporter) report(ctx context.Context) error {
+	usages, err := r.listWorkspaceQuotaUsages()
+	if err != nil {
+		return err
+	}
+	reported := map[quotaKey]*tenancyv1alpha1.WorkspaceQuotaUsage{}
+	for _, u := range usages {
+		if u.Spec.Shard == r.shard {
+			reported[quotaKey{cluster: logicalcluster.From(u), name: u.Spec.Quota}] = u
+		}
+	}
+
+	used, err := r.usedOnShard(ctx, reported)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for key, u := range used {
+		if err := r.publish(ctx, key, reported[key], u); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for key, u := range reported {
+		if _, ok := used[key]; ok {
+			continue
+		}
+		// No workspace below the quota on this shard anymore, or the quota is gone.
+		if err := r.deleteWorkspaceQuotaUsage(ctx, key.cluster, u.Name); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+
+	quotas, err := r.listWorkspaceQuotas()
+	if err != nil {
+		return utilerrors.NewAggregate(append(errs, err))
+	}
+	for _, quota := range quotas {
+		if err := r.reportStatus(ctx, quota, usages, used); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// usedOnShard computes the usage of the logical clusters on this shard for
+// every WorkspaceQuota above them. Object counts of logical clusters not yet
+// counted per resource, and compute resources of logical clusters whose pods
+// could not be listed, keep the last reported value.
+func (r *UsageReporter) usedOnShard(ctx context.Context, reported map[quotaKey]*tenancyv1alpha1.WorkspaceQuotaUsage) (map[quotaKey]corev1.ResourceList, error) {
+	lcs, err := r.listLogicalClusters()
+	if err != nil {
+		return nil, err
+	}
+
+	used := map[quotaKey]corev1.ResourceList{}
+	uncounted := map[quotaKey]map[corev1.ResourceName]bool{}
+	markUncounted := func(key quotaKey, name corev1.ResourceName) {
+		if uncounted[key] == nil {
+			uncounted[key] = map[corev1.ResourceName]bool{}
+		}
+		uncounted[key][name] = true
+	}
+	add := func(key quotaKey, name corev1.ResourceName, q resource.Quantity) {
+		sum := used[key][name]
+		sum.Add(q)
+		used[key][name] = sum
+	}
+	for _, lc := range lcs {
+		quotas, err := QuotasFor(PathOf(lc), r.getWorkspaceQuotasAt)
+		if err != nil {
+			return nil, err
+		}
+
+		// The pods are listed at most once per logical cluster.
+		var pods []corev1.Pod
+		var podsErr error
+		podsListed := false
+
+		for _, quota := range quotas {
+			key := quotaKey{cluster: logicalcluster.From(quota), name: quota.Name}
+			if _, ok := used[key]; !ok {
+				used[key] = corev1.ResourceList{}
+				for name := range quota.Spec.Hard {
+					used[key][name] = *resource.NewQuantity(0, resource.DecimalSI)
+				}
+			}
+			if _, ok := quota.Spec.Hard[tenancyv1alpha1.ResourceWorkspaces]; ok {
+				add(key, tenancyv1alpha1.ResourceWorkspaces, *resource.NewQuantity(1, resource.DecimalSI))
+			}
+			for name, gr := range CountedResources(quota.Spec.Hard) {
+				count, ok := r.countObjects(logicalcluster.From(lc), gr)
+				if !ok {
+					markUncounted(key, name)
+				}
+				add(key, name, *resource.NewQuantity(count, resource.DecimalSI))
+			}
+
+			computeResources := ComputeResources(quota.Spec.Hard)
+			if len(computeResources) == 0 {
+				continue
+			}
+			if !podsListed {
+				pods, podsErr = r.listPods(ctx, logicalcluster.From(lc))
+				podsListed = true
+				if podsErr != nil {
+					klog.FromContext(ctx).Error(podsErr, "failed to list pods", "cluster", logicalcluster.From(lc))
+				}
+			}
+			if podsErr != nil {
+				for _, name := range computeResources {
+					markUncounted(key, name)
+				}
+				continue
+			}
+			for i := range pods {
+				usage, err := PodUsage(&pods[i], computeResources)
+				if err != nil {
+					return nil, err
+				}
+				for name, q := range usage {
+					add(key, name, q)
+				}
+			}
+		}
+	}
+
+	for key, resources := range used {
+		for name := range resources {
+			if !uncounted[key][name] {
+				continue
+			}
+			delete(resources, name)
+			if last, ok := reported[key]; ok {
+				if q, ok := last.Spec.Used[name]; ok {
+					resources[name] = q
+				}
+			}
+		}
+	}
+	return used, nil
+}
+
+// publish creates or updates the WorkspaceQuotaUsage of this shard for the
+// given quota in the cache server.
+func (r *UsageReporter) publish(ctx context.Context, key quotaKey, existing *tenancyv1alpha1.WorkspaceQuotaUsage, used corev1.ResourceList) error {
+	if existing == nil {
+		return r.createWorkspaceQuotaUsage(ctx, key.cluster, &tenancyv1alpha1.WorkspaceQuotaUsage{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: UsageName(key.name, r.shard),
+			},
+			Spec: tenancyv1alpha1.WorkspaceQuotaUsageSpec{
+				Quota: key.name,
+				Shard: r.shard,
+				Used:  used,
+			},
+		})
+	}
+	if equality.Semantic.DeepEqual(existing.Spec.Used, used) {
+		return nil
+	}
+	updated := existing.DeepCopy()
+	updated.Spec.Used = used
+	return r.updateWorkspaceQuotaUsage(ctx, key.cluster, updated)
+}
+
+// reportStatus sums up the usages of all shards into the status of the given
+// WorkspaceQuota on this shard. The usage of this shard is taken from used
+// rather than from the cache server, which might lag behind.
+func (r *UsageReporter) reportStatus(ctx context.Context, quota *tenancyv1alpha1.WorkspaceQuota, usages []*tenancyv1alpha1.WorkspaceQuotaUsage, used map[quotaKey]corev1.ResourceList) error {
+	key := quotaKey{cluster: logicalcluster.From(quota), name: quota.Name}
+
+	total := corev1.ResourceList{}
+	for name := range quota.Spec.Hard {
+		total[name] = *resource.NewQuantity(0, resource.DecimalSI)
+	}
+	add := func(used corev1.ResourceList) {
+		for name, q := range used {
+			if sum, ok := total[name]; ok {
+				sum.Add(q)
+				total[name] = sum
+			}
+		}
+	}
+	add(used[key])
+	for _, u := range usages {
+		if u.Spec.Shard != r.shard && u.Spec.Quota == quota.Name && logicalcluster.From(u) == key.cluster {
+			add(u.Spec.Used)
+		}
+	}
+
+	updated := quota.DeepCopy()
+	updated.Status.Hard = quota.Spec.Hard.DeepCopy()
+	updated.Status.Used = total
+
+	oldResource := &Resource{ObjectMeta: quota.ObjectMeta, Spec: &quota.Spec, Status: &quota.Status}
+	newResource := &Resource{ObjectMeta: updated.ObjectMeta, Spec: &updated.Spec, Status: &updated.Status}
+	return r.commit(ctx, oldResource, newResource)
+}
+
+// UsageName returns the name of the WorkspaceQuotaUsage of the given shard for
+// the given WorkspaceQuota.
+func UsageName(quota, shard string) string {
+	return quota + "." + shard
+}
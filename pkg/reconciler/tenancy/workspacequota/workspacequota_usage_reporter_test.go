@@ -0,0 +1,308 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspacequota
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	"github.com/kcp-dev/sdk/apis/core"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+)
+
+func newLogicalCluster(name, path string) *corev1alpha1.LogicalCluster {
+	return &corev1alpha1.LogicalCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: corev1alpha1.LogicalClusterName,
+			Annotations: map[string]string{
+				logicalcluster.AnnotationKey:         name,
+				core.LogicalClusterPathAnnotationKey: path,
+			},
+		},
+	}
+}
+
+func newUsage(cluster, quota, shard string, used corev1.ResourceList) *tenancyv1alpha1.WorkspaceQuotaUsage {
+	return &tenancyv1alpha1.WorkspaceQuotaUsage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        UsageName(quota, shard),
+			Annotations: map[string]string{logicalcluster.AnnotationKey: cluster},
+		},
+		Spec: tenancyv1alpha1.WorkspaceQuotaUsageSpec{Quota: quota, Shard: shard, Used: used},
+	}
+}
+
+func TestUsageReporterReport(t *testing.T) {
+	t.Parallel()
+
+	// The quota lives in root:org, logical cluster "org", on this shard.
+	quota := &tenancyv1alpha1.WorkspaceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "quota",
+			Annotations: map[string]string{
+				logicalcluster.AnnotationKey:         "org",
+				core.LogicalClusterPathAnnotationKey: "root:org",
+			},
+		},
+		Spec: tenancyv1alpha1.WorkspaceQuotaSpec{Hard: corev1.ResourceList{
+			"workspaces":       resource.MustParse("10"),
+			"count/configmaps": resource.MustParse("100"),
+		}},
+	}
+
+	tests := map[string]struct {
+		scanned   bool
+		usages    []*tenancyv1alpha1.WorkspaceQuotaUsage
+		wantUsed  corev1.ResourceList
+		wantTotal corev1.ResourceList
+		wantOps   []string
+	}{
+		"publishes the usage of this shard and sums up all shards": {
+			scanned: true,
+			usages: []*tenancyv1alpha1.WorkspaceQuotaUsage{
+				newUsage("org", "quota", "other", corev1.ResourceList{"workspaces": resource.MustParse("2"), "count/configmaps": resource.MustParse("4")}),
+			},
+			wantUsed:  corev1.ResourceList{"workspaces": resource.MustParse("2"), "count/configmaps": resource.MustParse("5")},
+			wantTotal: corev1.ResourceList{"workspaces": resource.MustParse("4"), "count/configmaps": resource.MustParse("9")},
+			wantOps:   []string{"create quota.this"},
+		},
+		"updates the usage of this shard and deletes stale usages": {
+			scanned: true,
+			usages: []*tenancyv1alpha1.WorkspaceQuotaUsage{
+				newUsage("org", "quota", "this", corev1.ResourceList{"workspaces": resource.MustParse("1"), "count/configmaps": resource.MustParse("1")}),
+				newUsage("org", "gone", "this", corev1.ResourceList{"workspaces": resource.MustParse("1")}),
+			},
+			wantUsed:  corev1.ResourceList{"workspaces": resource.MustParse("2"), "count/configmaps": resource.MustParse("5")},
+			wantTotal: corev1.ResourceList{"workspaces": resource.MustParse("2"), "count/configmaps": resource.MustParse("5")},
+			wantOps:   []string{"update quota.this", "delete gone.this"},
+		},
+		"keeps the last reported count until counted per resource": {
+			usages: []*tenancyv1alpha1.WorkspaceQuotaUsage{
+				newUsage("org", "quota", "this", corev1.ResourceList{"workspaces": resource.MustParse("2"), "count/configmaps": resource.MustParse("7")}),
+			},
+			wantUsed:  corev1.ResourceList{"workspaces": resource.MustParse("2"), "count/configmaps": resource.MustParse("7")},
+			wantTotal: corev1.ResourceList{"workspaces": resource.MustParse("2"), "count/configmaps": resource.MustParse("7")},
+		},
+	}
+
+	counts := map[logicalcluster.Name]int64{"a": 2, "b": 3, "c": 100}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var ops []string
+			var used corev1.ResourceList
+			var status *tenancyv1alpha1.WorkspaceQuotaStatus
+			r := &UsageReporter{
+				shard: "this",
+				listLogicalClusters: func() ([]*corev1alpha1.LogicalCluster, error) {
+					return []*corev1alpha1.LogicalCluster{
+						newLogicalCluster("org", "root:org"),
+						newLogicalCluster("a", "root:org:a"),
+						newLogicalCluster("b", "root:org:a:b"),
+						newLogicalCluster("c", "root:other"),
+					}, nil
+				},
+				listWorkspaceQuotas: func() ([]*tenancyv1alpha1.WorkspaceQuota, error) {
+					return []*tenancyv1alpha1.WorkspaceQuota{quota}, nil
+				},
+				getWorkspaceQuotasAt: func(path logicalcluster.Path) ([]*tenancyv1alpha1.WorkspaceQuota, error) {
+					if path.String() == "root:org" {
+						return []*tenancyv1alpha1.WorkspaceQuota{quota}, nil
+					}
+					return nil, nil
+				},
+				listWorkspaceQuotaUsages: func() ([]*tenancyv1alpha1.WorkspaceQuotaUsage, error) {
+					return tc.usages, nil
+				},
+				countObjects: func(cluster logicalcluster.Name, resource schema.GroupResource) (int64, bool) {
+					require.Equal(t, schema.GroupResource{Resource: "configmaps"}, resource)
+					return counts[cluster], tc.scanned
+				},
+				createWorkspaceQuotaUsage: func(_ context.Context, cluster logicalcluster.Name, usage *tenancyv1alpha1.WorkspaceQuotaUsage) error {
+					require.Equal(t, logicalcluster.Name("org"), cluster)
+					require.Equal(t, "quota", usage.Spec.Quota)
+					require.Equal(t, "this", usage.Spec.Shard)
+					ops = append(ops, "create "+usage.Name)
+					used = usage.Spec.Used
+					return nil
+				},
+				updateWorkspaceQuotaUsage: func(_ context.Context, cluster logicalcluster.Name, usage *tenancyv1alpha1.WorkspaceQuotaUsage) error {
+					require.Equal(t, logicalcluster.Name("org"), cluster)
+					ops = append(ops, "update "+usage.Name)
+					used = usage.Spec.Used
+					return nil
+				},
+				deleteWorkspaceQuotaUsage: func(_ context.Context, cluster logicalcluster.Name, name string) error {
+					require.Equal(t, logicalcluster.Name("org"), cluster)
+					ops = append(ops, "delete "+name)
+					return nil
+				},
+				commit: func(_ context.Context, _, updated *Resource) error {
+					status = updated.Status
+					return nil
+				},
+			}
+
+			require.NoError(t, r.report(context.Background()))
+
+			require.Equal(t, tc.wantOps, ops)
+			if used != nil {
+				require.True(t, equality.Semantic.DeepEqual(tc.wantUsed, used), "expected used %v, got %v", tc.wantUsed, used)
+			}
+			require.NotNil(t, status)
+			require.True(t, equality.Semantic.DeepEqual(quota.Spec.Hard, status.Hard), "expected hard %v, got %v", quota.Spec.Hard, status.Hard)
+			require.True(t, equality.Semantic.DeepEqual(tc.wantTotal, status.Used), "expected total %v, got %v", tc.wantTotal, status.Used)
+		})
+	}
+}
+
+func newPod(phase corev1.PodPhase, requests, limits corev1.ResourceList) corev1.Pod {
+	return corev1.Pod{
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{
+			Name:      "app",
+			Resources: corev1.ResourceRequirements{Requests: requests, Limits: limits},
+		}}},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+}
+
+func TestUsageReporterComputeResources(t *testing.T) {
+	t.Parallel()
+
+	quota := &tenancyv1alpha1.WorkspaceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "quota",
+			Annotations: map[string]string{
+				logicalcluster.AnnotationKey:         "org",
+				core.LogicalClusterPathAnnotationKey: "root:org",
+			},
+		},
+		Spec: tenancyv1alpha1.WorkspaceQuotaSpec{Hard: corev1.ResourceList{
+			"pods":          resource.MustParse("10"),
+			"requests.cpu":  resource.MustParse("4"),
+			"limits.memory": resource.MustParse("4Gi"),
+		}},
+	}
+
+	tests := map[string]struct {
+		pods      map[logicalcluster.Name][]corev1.Pod
+		failing   logicalcluster.Name
+		usages    []*tenancyv1alpha1.WorkspaceQuotaUsage
+		wantTotal corev1.ResourceList
+	}{
+		"sums up requests and limits over the pods below the quota": {
+			pods: map[logicalcluster.Name][]corev1.Pod{
+				"a": {
+					newPod(corev1.PodRunning, corev1.ResourceList{"cpu": resource.MustParse("500m")}, corev1.ResourceList{"memory": resource.MustParse("1Gi")}),
+					newPod(corev1.PodSucceeded, corev1.ResourceList{"cpu": resource.MustParse("2")}, nil),
+				},
+				"b": {
+					newPod(corev1.PodPending, corev1.ResourceList{"cpu": resource.MustParse("250m")}, corev1.ResourceList{"memory": resource.MustParse("512Mi")}),
+				},
+				"c": {
+					newPod(corev1.PodRunning, corev1.ResourceList{"cpu": resource.MustParse("8")}, nil),
+				},
+			},
+			wantTotal: corev1.ResourceList{
+				"pods":          resource.MustParse("2"),
+				"requests.cpu":  resource.MustParse("750m"),
+				"limits.memory": resource.MustParse("1536Mi"),
+			},
+		},
+		"keeps the last reported usage if the pods cannot be listed": {
+			pods: map[logicalcluster.Name][]corev1.Pod{
+				"a": {
+					newPod(corev1.PodRunning, corev1.ResourceList{"cpu": resource.MustParse("500m")}, nil),
+				},
+			},
+			failing: "b",
+			usages: []*tenancyv1alpha1.WorkspaceQuotaUsage{
+				newUsage("org", "quota", "this", corev1.ResourceList{"pods": resource.MustParse("3"), "requests.cpu": resource.MustParse("3"), "limits.memory": resource.MustParse("3Gi")}),
+			},
+			wantTotal: corev1.ResourceList{
+				"pods":          resource.MustParse("3"),
+				"requests.cpu":  resource.MustParse("3"),
+				"limits.memory": resource.MustParse("3Gi"),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var status *tenancyv1alpha1.WorkspaceQuotaStatus
+			r := &UsageReporter{
+				shard: "this",
+				listLogicalClusters: func() ([]*corev1alpha1.LogicalCluster, error) {
+					return []*corev1alpha1.LogicalCluster{
+						newLogicalCluster("org", "root:org"),
+						newLogicalCluster("a", "root:org:a"),
+						newLogicalCluster("b", "root:org:a:b"),
+						newLogicalCluster("c", "root:other"),
+					}, nil
+				},
+				listWorkspaceQuotas: func() ([]*tenancyv1alpha1.WorkspaceQuota, error) {
+					return []*tenancyv1alpha1.WorkspaceQuota{quota}, nil
+				},
+				getWorkspaceQuotasAt: func(path logicalcluster.Path) ([]*tenancyv1alpha1.WorkspaceQuota, error) {
+					if path.String() == "root:org" {
+						return []*tenancyv1alpha1.WorkspaceQuota{quota}, nil
+					}
+					return nil, nil
+				},
+				listWorkspaceQuotaUsages: func() ([]*tenancyv1alpha1.WorkspaceQuotaUsage, error) {
+					return tc.usages, nil
+				},
+				listPods: func(_ context.Context, cluster logicalcluster.Name) ([]corev1.Pod, error) {
+					if cluster == tc.failing {
+						return nil, errors.New("boom")
+					}
+					return tc.pods[cluster], nil
+				},
+				createWorkspaceQuotaUsage: func(context.Context, logicalcluster.Name, *tenancyv1alpha1.WorkspaceQuotaUsage) error {
+					return nil
+				},
+				updateWorkspaceQuotaUsage: func(context.Context, logicalcluster.Name, *tenancyv1alpha1.WorkspaceQuotaUsage) error {
+					return nil
+				},
+				commit: func(_ context.Context, _, updated *Resource) error {
+					status = updated.Status
+					return nil
+				},
+			}
+
+			require.NoError(t, r.report(context.Background()))
+
+			require.NotNil(t, status)
+			require.True(t, equality.Semantic.DeepEqual(tc.wantTotal, status.Used), "expected total %v, got %v", tc.wantTotal, status.Used)
+		})
+	}
+}
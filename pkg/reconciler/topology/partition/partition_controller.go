@@ -0,0 +1,337 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package partition
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	kcpcache "github.com/kcp-dev/apimachinery/v2/pkg/cache"
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha1 "github.com/kcp-dev/sdk/apis/apis/v1alpha1"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	topologyv1alpha1 "github.com/kcp-dev/sdk/apis/topology/v1alpha1"
+	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
+	topologyv1alpha1client "github.com/kcp-dev/sdk/client/clientset/versioned/typed/topology/v1alpha1"
+	apisv1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/apis/v1alpha1"
+	coreinformers "github.com/kcp-dev/sdk/client/informers/externalversions/core/v1alpha1"
+	topologyinformers "github.com/kcp-dev/sdk/client/informers/externalversions/topology/v1alpha1"
+
+	"github.com/kcp-dev/kcp/pkg/logging"
+	"github.com/kcp-dev/kcp/pkg/reconciler/committer"
+	"github.com/kcp-dev/kcp/pkg/reconciler/events"
+	"github.com/kcp-dev/kcp/pkg/tombstone"
+)
+
+const (
+	ControllerName = "kcp-topology-partition"
+)
+
+// NewController returns a new controller for Partitions. It reports the shards
+// selected by every Partition in its status, and maintains the
+// APIExportEndpointSlices requested by the owning PartitionSets.
+func NewController(
+	partitionClusterInformer topologyinformers.PartitionClusterInformer,
+	partitionSetClusterInformer topologyinformers.PartitionSetClusterInformer,
+	apiExportEndpointSliceClusterInformer apisv1alpha1informers.APIExportEndpointSliceClusterInformer,
+	globalShardClusterInformer coreinformers.ShardClusterInformer,
+	kcpClusterClient kcpclientset.ClusterInterface,
+) (*controller, error) {
+	c := &controller{
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name: ControllerName,
+			},
+		),
+		getPartition: func(clusterName logicalcluster.Name, name string) (*topologyv1alpha1.Partition, error) {
+			return partitionClusterInformer.Lister().Cluster(clusterName).Get(name)
+		},
+		listPartitions: func(clusterName logicalcluster.Name) ([]*topologyv1alpha1.Partition, error) {
+			return partitionClusterInformer.Lister().Cluster(clusterName).List(labels.Everything())
+		},
+		listAllPartitions: func() ([]*topologyv1alpha1.Partition, error) {
+			return partitionClusterInformer.Lister().List(labels.Everything())
+		},
+		getPartitionSet: func(clusterName logicalcluster.Name, name string) (*topologyv1alpha1.PartitionSet, error) {
+			return partitionSetClusterInformer.Lister().Cluster(clusterName).Get(name)
+		},
+		listShards: func(selector labels.Selector) ([]*corev1alpha1.Shard, error) {
+			return globalShardClusterInformer.Lister().List(selector)
+		},
+		getAPIExportEndpointSlice: func(clusterName logicalcluster.Name, name string) (*apisv1alpha1.APIExportEndpointSlice, error) {
+			return apiExportEndpointSliceClusterInformer.Lister().Cluster(clusterName).Get(name)
+		},
+		createAPIExportEndpointSlice: func(ctx context.Context, path logicalcluster.Path, slice *apisv1alpha1.APIExportEndpointSlice) error {
+			_, err := kcpClusterClient.Cluster(path).ApisV1alpha1().APIExportEndpointSlices().Create(ctx, slice, metav1.CreateOptions{})
+			return err
+		},
+		deleteAPIExportEndpointSlice: func(ctx context.Context, path logicalcluster.Path, name string) error {
+			return kcpClusterClient.Cluster(path).ApisV1alpha1().APIExportEndpointSlices().Delete(ctx, name, metav1.DeleteOptions{})
+		},
+
+		commit: committer.NewCommitter[*Partition, Patcher, *PartitionSpec, *PartitionStatus](kcpClusterClient.TopologyV1alpha1().Partitions()),
+	}
+
+	logger := logging.WithReconciler(klog.Background(), ControllerName)
+
+	_, _ = partitionClusterInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			c.enqueuePartition(tombstone.Obj[*topologyv1alpha1.Partition](obj), logger, "")
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			c.enqueuePartition(tombstone.Obj[*topologyv1alpha1.Partition](newObj), logger, "")
+		},
+		DeleteFunc: func(obj interface{}) {
+			c.enqueuePartition(tombstone.Obj[*topologyv1alpha1.Partition](obj), logger, "")
+		},
+	})
+
+	_, _ = partitionSetClusterInformer.Informer().AddEventHandler(events.WithoutSyncs(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			c.enqueuePartitionSet(tombstone.Obj[*topologyv1alpha1.PartitionSet](obj), logger)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldSet := tombstone.Obj[*topologyv1alpha1.PartitionSet](oldObj)
+			newSet := tombstone.Obj[*topologyv1alpha1.PartitionSet](newObj)
+			if !equality.Semantic.DeepEqual(oldSet.Spec.APIExportEndpointSlice, newSet.Spec.APIExportEndpointSlice) {
+				c.enqueuePartitionSet(newSet, logger)
+			}
+		},
+	}))
+
+	_, _ = apiExportEndpointSliceClusterInformer.Informer().AddEventHandler(events.WithoutSyncs(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			c.enqueueAPIExportEndpointSlice(tombstone.Obj[*apisv1alpha1.APIExportEndpointSlice](obj), logger)
+		},
+		DeleteFunc: func(obj interface{}) {
+			c.enqueueAPIExportEndpointSlice(tombstone.Obj[*apisv1alpha1.APIExportEndpointSlice](obj), logger)
+		},
+	}))
+
+	_, _ = globalShardClusterInformer.Informer().AddEventHandler(events.WithoutSyncs(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			c.enqueueAllPartitions(tombstone.Obj[*corev1alpha1.Shard](obj), logger)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			// limit reconciliation of all Partitions to shard changes impacting their status
+			if filterShardEvent(oldObj, newObj) {
+				c.enqueueAllPartitions(tombstone.Obj[*corev1alpha1.Shard](newObj), logger)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			c.enqueueAllPartitions(tombstone.Obj[*corev1alpha1.Shard](obj), logger)
+		},
+	}))
+
+	return c, nil
+}
+
+type Partition = topologyv1alpha1.Partition
+type PartitionSpec = topologyv1alpha1.PartitionSpec
+type PartitionStatus = topologyv1alpha1.PartitionStatus
+type Patcher = topologyv1alpha1client.PartitionInterface
+type Resource = committer.Resource[*PartitionSpec, *PartitionStatus]
+type CommitFunc = func(context.Context, *Resource, *Resource) error
+
+// controller reconciles Partitions. It keeps their status aligned with the
+// Shards they select.
+type controller struct {
+	queue workqueue.TypedRateLimitingInterface[string]
+
+	getPartition                 func(clusterName logicalcluster.Name, name string) (*topologyv1alpha1.Partition, error)
+	listPartitions               func(clusterName logicalcluster.Name) ([]*topologyv1alpha1.Partition, error)
+	listAllPartitions            func() ([]*topologyv1alpha1.Partition, error)
+	getPartitionSet              func(clusterName logicalcluster.Name, name string) (*topologyv1alpha1.PartitionSet, error)
+	listShards                   func(selector labels.Selector) ([]*corev1alpha1.Shard, error)
+	getAPIExportEndpointSlice    func(clusterName logicalcluster.Name, name string) (*apisv1alpha1.APIExportEndpointSlice, error)
+	createAPIExportEndpointSlice func(ctx context.Context, path logicalcluster.Path, slice *apisv1alpha1.APIExportEndpointSlice) error
+	deleteAPIExportEndpointSlice func(ctx context.Context, path logicalcluster.Path, name string) error
+	commit                       CommitFunc
+}
+
+// enqueuePartition enqueues a Partition.
+func (c *controller) enqueuePartition(partition *topologyv1alpha1.Partition, logger logr.Logger, suffix string) {
+	key, err := kcpcache.DeletionHandlingMetaClusterNamespaceKeyFunc(partition)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+
+	logging.WithQueueKey(logger, key).V(4).Info(fmt.Sprintf("queueing Partition%s", suffix))
+	c.queue.Add(key)
+}
+
+// enqueuePartitionSet enqueues the Partitions owned by a PartitionSet.
+func (c *controller) enqueuePartitionSet(partitionSet *topologyv1alpha1.PartitionSet, logger logr.Logger) {
+	partitions, err := c.listPartitions(logicalcluster.From(partitionSet))
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+
+	for _, partition := range partitions {
+		if metav1.IsControlledBy(partition, partitionSet) {
+			c.enqueuePartition(partition, logging.WithObject(logger, partitionSet), " because of PartitionSet change")
+		}
+	}
+}
+
+// enqueueAPIExportEndpointSlice enqueues the Partition controlling an
+// APIExportEndpointSlice.
+func (c *controller) enqueueAPIExportEndpointSlice(slice *apisv1alpha1.APIExportEndpointSlice, logger logr.Logger) {
+	owner := metav1.GetControllerOf(slice)
+	if owner == nil || owner.Kind != "Partition" {
+		return
+	}
+	partition, err := c.getPartition(logicalcluster.From(slice), owner.Name)
+	if errors.IsNotFound(err) {
+		return // the slice is going to be garbage collected
+	} else if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	c.enqueuePartition(partition, logging.WithObject(logger, slice), " because of APIExportEndpointSlice change")
+}
+
+// enqueueAllPartitions enqueues all Partitions.
+func (c *controller) enqueueAllPartitions(shard *corev1alpha1.Shard, logger logr.Logger) {
+	partitions, err := c.listAllPartitions()
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+
+	for _, partition := range partitions {
+		c.enqueuePartition(partition, logging.WithObject(logger, shard), " because of Shard change")
+	}
+}
+
+// Start starts the controller, which stops when ctx.Done() is closed.
+func (c *controller) Start(ctx context.Context, numThreads int) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	logger := logging.WithReconciler(klog.FromContext(ctx), ControllerName)
+	ctx = klog.NewContext(ctx, logger)
+	logger.Info("Starting controller")
+	defer logger.Info("Shutting down controller")
+
+	for range numThreads {
+		go wait.UntilWithContext(ctx, c.startWorker, time.Second)
+	}
+
+	<-ctx.Done()
+}
+
+func (c *controller) startWorker(ctx context.Context) {
+	for c.processNextWorkItem(ctx) {
+	}
+}
+
+func (c *controller) processNextWorkItem(ctx context.Context) bool {
+	// Wait until there is a new item in the working queue
+	k, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	key := k
+
+	logger := logging.WithQueueKey(klog.FromContext(ctx), key)
+	ctx = klog.NewContext(ctx, logger)
+	logger.V(4).Info("processing key")
+
+	// No matter what, tell the queue we're done with this key, to unblock
+	// other workers.
+	defer c.queue.Done(key)
+
+	if err := c.process(ctx, key); err != nil {
+		utilruntime.HandleError(fmt.Errorf("%q controller failed to sync %q, err: %w", ControllerName, key, err))
+		c.queue.AddRateLimited(key)
+		return true
+	}
+	c.queue.Forget(key)
+	return true
+}
+
+func (c *controller) process(ctx context.Context, key string) error {
+	clusterName, _, name, err := kcpcache.SplitMetaClusterNamespaceKey(key)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return nil
+	}
+	obj, err := c.getPartition(clusterName, name)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil // object deleted before we handled it
+		}
+		return err
+	}
+
+	old := obj
+	obj = obj.DeepCopy()
+
+	logger := logging.WithObject(klog.FromContext(ctx), obj)
+	ctx = klog.NewContext(ctx, logger)
+
+	var errs []error
+	if err := c.reconcile(ctx, obj); err != nil {
+		errs = append(errs, err)
+	}
+
+	// Regardless of whether reconcile returned an error or not, always try to patch status if needed. Return the
+	// reconciliation error at the end.
+
+	// If the object being reconciled changed as a result, update it.
+	oldResource := &Resource{ObjectMeta: old.ObjectMeta, Spec: &old.Spec, Status: &old.Status}
+	newResource := &Resource{ObjectMeta: obj.ObjectMeta, Spec: &obj.Spec, Status: &obj.Status}
+	if err := c.commit(ctx, oldResource, newResource); err != nil {
+		errs = append(errs, err)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// filterShardEvent returns true if the event passes the filter and needs to be processed false otherwise.
+func filterShardEvent(oldObj, newObj interface{}) bool {
+	oldShard, ok := oldObj.(*corev1alpha1.Shard)
+	if !ok {
+		return false
+	}
+	newShard, ok := newObj.(*corev1alpha1.Shard)
+	if !ok {
+		return false
+	}
+	return !reflect.DeepEqual(oldShard.Labels, newShard.Labels) ||
+		!equality.Semantic.DeepEqual(oldShard.Status.Capacity, newShard.Status.Capacity) ||
+		!reflect.DeepEqual(oldShard.Status.Conditions, newShard.Status.Conditions)
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package partition
+
+import (
+	"context"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha1 "github.com/kcp-dev/sdk/apis/apis/v1alpha1"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	conditionsv1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+	topologyv1alpha1 "github.com/kcp-dev/sdk/apis/topology/v1alpha1"
+)
+
+func (c *controller) reconcile(ctx context.Context, partition *topologyv1alpha1.Partition) error {
+	if err := c.reconcileShards(partition); err != nil {
+		return err
+	}
+	return c.reconcileAPIExportEndpointSlice(ctx, partition)
+}
+
+// reconcileShards records the shards selected by partition in its status.
+func (c *controller) reconcileShards(partition *topologyv1alpha1.Partition) error {
+	// This matches how APIExportEndpointSlices filter their endpoints.
+	selector, err := metav1.LabelSelectorAsSelector(partition.Spec.Selector)
+	if err != nil {
+		partition.Status.Shards = nil
+		partition.Status.ShardCount = 0
+		partition.Status.ReadyShardCount = 0
+		partition.Status.Capacity = nil
+		conditions.MarkFalse(
+			partition,
+			topologyv1alpha1.PartitionShardsReady,
+			topologyv1alpha1.InvalidSelectorReason,
+			conditionsv1alpha1.ConditionSeverityError,
+			"%v",
+			err,
+		)
+		// No need to requeue if the selector is not valid
+		return nil
+	}
+
+	shards, err := c.listShards(selector)
+	if err != nil {
+		return err
+	}
+	sort.Slice(shards, func(i, j int) bool {
+		return shards[i].Name < shards[j].Name
+	})
+
+	partition.Status.Shards = make([]topologyv1alpha1.PartitionShard, 0, len(shards))
+	partition.Status.ReadyShardCount = 0
+	capacity := corev1.ResourceList{}
+	for _, shard := range shards {
+		ready := shardReady(shard)
+		partition.Status.Shards = append(partition.Status.Shards, topologyv1alpha1.PartitionShard{
+			Name:  shard.Name,
+			Ready: ready,
+		})
+		if !ready {
+			continue
+		}
+		partition.Status.ReadyShardCount++
+		for name, quantity := range shard.Status.Capacity {
+			sum := capacity[name]
+			sum.Add(quantity)
+			capacity[name] = sum
+		}
+	}
+	partition.Status.ShardCount = int32(len(shards))
+	if len(shards) == 0 {
+		partition.Status.Shards = nil
+	}
+	partition.Status.Capacity = nil
+	if len(capacity) > 0 {
+		partition.Status.Capacity = capacity
+	}
+
+	switch {
+	case len(shards) == 0:
+		conditions.MarkFalse(
+			partition,
+			topologyv1alpha1.PartitionShardsReady,
+			topologyv1alpha1.NoShardsReason,
+			conditionsv1alpha1.ConditionSeverityWarning,
+			"No shard matches the selector",
+		)
+	case partition.Status.ReadyShardCount < partition.Status.ShardCount:
+		conditions.MarkFalse(
+			partition,
+			topologyv1alpha1.PartitionShardsReady,
+			topologyv1alpha1.ShardsNotReadyReason,
+			conditionsv1alpha1.ConditionSeverityWarning,
+			"%d of %d shards are not ready",
+			partition.Status.ShardCount-partition.Status.ReadyShardCount,
+			partition.Status.ShardCount,
+		)
+	default:
+		conditions.MarkTrue(partition, topologyv1alpha1.PartitionShardsReady)
+	}
+
+	return nil
+}
+
+// reconcileAPIExportEndpointSlice ensures the APIExportEndpointSlice requested
+// by the PartitionSet controlling partition exists, and removes it when it is
+// not requested anymore. The slice carries the name of the partition.
+func (c *controller) reconcileAPIExportEndpointSlice(ctx context.Context, partition *topologyv1alpha1.Partition) error {
+	logger := klog.FromContext(ctx)
+	clusterName := logicalcluster.From(partition)
+
+	var template *topologyv1alpha1.PartitionSetAPIExportEndpointSlice
+	if owner := metav1.GetControllerOf(partition); owner != nil && owner.Kind == "PartitionSet" {
+		partitionSet, err := c.getPartitionSet(clusterName, owner.Name)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		if partitionSet != nil && partitionSet.UID == owner.UID {
+			template = partitionSet.Spec.APIExportEndpointSlice
+		}
+	}
+
+	slice, err := c.getAPIExportEndpointSlice(clusterName, partition.Name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if apierrors.IsNotFound(err) {
+		slice = nil
+	}
+	owned := slice != nil && metav1.IsControlledBy(slice, partition)
+
+	if template == nil {
+		partition.Status.APIExportEndpointSlice = ""
+		conditions.Delete(partition, topologyv1alpha1.APIExportEndpointSliceReady)
+		if owned && slice.DeletionTimestamp.IsZero() {
+			logger.V(2).Info("deleting APIExportEndpointSlice which is not requested anymore", "slice", slice.Name)
+			if err := c.deleteAPIExportEndpointSlice(ctx, clusterName.Path(), slice.Name); err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+		}
+		return nil
+	}
+
+	switch {
+	case slice == nil:
+		slice = &apisv1alpha1.APIExportEndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: partition.Name,
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(partition, topologyv1alpha1.SchemeGroupVersion.WithKind("Partition")),
+				},
+			},
+			Spec: apisv1alpha1.APIExportEndpointSliceSpec{
+				APIExport: template.APIExport,
+				Partition: partition.Name,
+			},
+		}
+		logger.V(2).Info("creating APIExportEndpointSlice", "slice", slice.Name)
+		if err := c.createAPIExportEndpointSlice(ctx, clusterName.Path(), slice); err != nil && !apierrors.IsAlreadyExists(err) {
+			partition.Status.APIExportEndpointSlice = ""
+			conditions.MarkFalse(
+				partition,
+				topologyv1alpha1.APIExportEndpointSliceReady,
+				topologyv1alpha1.ErrorCreatingAPIExportEndpointSliceReason,
+				conditionsv1alpha1.ConditionSeverityError,
+				"%v",
+				err,
+			)
+			return err
+		}
+	case !owned || slice.Spec.Partition != partition.Name:
+		partition.Status.APIExportEndpointSlice = ""
+		conditions.MarkFalse(
+			partition,
+			topologyv1alpha1.APIExportEndpointSliceReady,
+			topologyv1alpha1.APIExportEndpointSliceConflictReason,
+			conditionsv1alpha1.ConditionSeverityError,
+			"APIExportEndpointSlice %s exists, but is not filtered by this partition",
+			slice.Name,
+		)
+		return nil
+	case !equality.Semantic.DeepEqual(slice.Spec.APIExport, template.APIExport):
+		// The export of a slice is immutable, it gets recreated once deleted.
+		if slice.DeletionTimestamp.IsZero() {
+			logger.V(2).Info("deleting APIExportEndpointSlice for a different APIExport", "slice", slice.Name)
+			if err := c.deleteAPIExportEndpointSlice(ctx, clusterName.Path(), slice.Name); err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+		}
+		partition.Status.APIExportEndpointSlice = ""
+		conditions.MarkFalse(
+			partition,
+			topologyv1alpha1.APIExportEndpointSliceReady,
+			topologyv1alpha1.ReplacingAPIExportEndpointSliceReason,
+			conditionsv1alpha1.ConditionSeverityInfo,
+			"APIExportEndpointSlice %s is recreated for a different APIExport",
+			slice.Name,
+		)
+		return nil
+	}
+
+	partition.Status.APIExportEndpointSlice = slice.Name
+	conditions.MarkTrue(partition, topologyv1alpha1.APIExportEndpointSliceReady)
+	return nil
+}
+
+// shardReady returns whether a shard is counted as ready by partitions. Shards
+// not reporting a Ready condition are.
+func shardReady(shard *corev1alpha1.Shard) bool {
+	return !conditions.IsFalse(shard, conditionsv1alpha1.ReadyCondition)
+}
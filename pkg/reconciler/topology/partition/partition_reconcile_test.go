@@ -0,0 +1,263 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package partition
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha1 "github.com/kcp-dev/sdk/apis/apis/v1alpha1"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	conditionsv1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+	topologyv1alpha1 "github.com/kcp-dev/sdk/apis/topology/v1alpha1"
+)
+
+func TestReconcileShards(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		selector *metav1.LabelSelector
+		shards   []*corev1alpha1.Shard
+
+		wantShards   []topologyv1alpha1.PartitionShard
+		wantReady    int32
+		wantCapacity corev1.ResourceList
+		wantReason   string
+	}{
+		"all shards ready": {
+			selector:     &metav1.LabelSelector{MatchLabels: map[string]string{"region": "eu"}},
+			shards:       []*corev1alpha1.Shard{shard("eu-2", "eu", true, 20), shard("eu-1", "eu", true, 10), shard("us-1", "us", true, 10)},
+			wantShards:   []topologyv1alpha1.PartitionShard{{Name: "eu-1", Ready: true}, {Name: "eu-2", Ready: true}},
+			wantReady:    2,
+			wantCapacity: corev1.ResourceList{corev1alpha1.ShardCapacityLogicalClusters: resource.MustParse("30")},
+		},
+		"shards not ready do not add capacity": {
+			selector:     &metav1.LabelSelector{MatchLabels: map[string]string{"region": "eu"}},
+			shards:       []*corev1alpha1.Shard{shard("eu-1", "eu", true, 10), shard("eu-2", "eu", false, 20)},
+			wantShards:   []topologyv1alpha1.PartitionShard{{Name: "eu-1", Ready: true}, {Name: "eu-2", Ready: false}},
+			wantReady:    1,
+			wantCapacity: corev1.ResourceList{corev1alpha1.ShardCapacityLogicalClusters: resource.MustParse("10")},
+			wantReason:   topologyv1alpha1.ShardsNotReadyReason,
+		},
+		"shards without capacity": {
+			selector:   &metav1.LabelSelector{MatchLabels: map[string]string{"region": "eu"}},
+			shards:     []*corev1alpha1.Shard{shard("eu-1", "eu", true, 0)},
+			wantShards: []topologyv1alpha1.PartitionShard{{Name: "eu-1", Ready: true}},
+			wantReady:  1,
+		},
+		"no shard matches": {
+			selector:   &metav1.LabelSelector{MatchLabels: map[string]string{"region": "ap"}},
+			shards:     []*corev1alpha1.Shard{shard("eu-1", "eu", true, 10)},
+			wantReason: topologyv1alpha1.NoShardsReason,
+		},
+		"invalid selector": {
+			selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "region", Operator: "Near", Values: []string{"eu"}},
+			}},
+			shards:     []*corev1alpha1.Shard{shard("eu-1", "eu", true, 10)},
+			wantReason: topologyv1alpha1.InvalidSelectorReason,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := &controller{
+				listShards: func(selector labels.Selector) ([]*corev1alpha1.Shard, error) {
+					var shards []*corev1alpha1.Shard
+					for _, shard := range tc.shards {
+						if selector.Matches(labels.Set(shard.Labels)) {
+							shards = append(shards, shard)
+						}
+					}
+					return shards, nil
+				},
+			}
+
+			partition := &topologyv1alpha1.Partition{
+				ObjectMeta: metav1.ObjectMeta{Name: "partition"},
+				Spec:       topologyv1alpha1.PartitionSpec{Selector: tc.selector},
+			}
+			require.NoError(t, c.reconcileShards(partition))
+
+			require.Equal(t, tc.wantShards, partition.Status.Shards)
+			require.Equal(t, int32(len(tc.wantShards)), partition.Status.ShardCount)
+			require.Equal(t, tc.wantReady, partition.Status.ReadyShardCount)
+			require.True(t, equality.Semantic.DeepEqual(tc.wantCapacity, partition.Status.Capacity), "unexpected capacity %v", partition.Status.Capacity)
+			if tc.wantReason == "" {
+				require.True(t, conditions.IsTrue(partition, topologyv1alpha1.PartitionShardsReady))
+			} else {
+				require.True(t, conditions.IsFalse(partition, topologyv1alpha1.PartitionShardsReady))
+				require.Equal(t, tc.wantReason, conditions.GetReason(partition, topologyv1alpha1.PartitionShardsReady))
+			}
+		})
+	}
+}
+
+func TestReconcileAPIExportEndpointSlice(t *testing.T) {
+	t.Parallel()
+
+	export := apisv1alpha1.ExportBindingReference{Path: "root:providers", Name: "widgets"}
+	partition := &topologyv1alpha1.Partition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "regions-eu-x7k2p",
+			UID:         "partition-uid",
+			Annotations: map[string]string{logicalcluster.AnnotationKey: "consumer"},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: topologyv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "PartitionSet",
+				Name:       "regions",
+				UID:        "partitionset-uid",
+				Controller: ptr.To(true),
+			}},
+		},
+	}
+	ownedSlice := func(export apisv1alpha1.ExportBindingReference) *apisv1alpha1.APIExportEndpointSlice {
+		return &apisv1alpha1.APIExportEndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            partition.Name,
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(partition, topologyv1alpha1.SchemeGroupVersion.WithKind("Partition"))},
+			},
+			Spec: apisv1alpha1.APIExportEndpointSliceSpec{APIExport: export, Partition: partition.Name},
+		}
+	}
+
+	tests := map[string]struct {
+		template      *topologyv1alpha1.PartitionSetAPIExportEndpointSlice
+		existingSlice *apisv1alpha1.APIExportEndpointSlice
+
+		wantCreated   *apisv1alpha1.APIExportEndpointSlice
+		wantDeleted   bool
+		wantSliceName string
+		wantCondition bool
+		wantReason    string
+	}{
+		"not requested": {},
+		"created when requested": {
+			template:      &topologyv1alpha1.PartitionSetAPIExportEndpointSlice{APIExport: export},
+			wantCreated:   ownedSlice(export),
+			wantSliceName: partition.Name,
+			wantCondition: true,
+		},
+		"existing slice is reported": {
+			template:      &topologyv1alpha1.PartitionSetAPIExportEndpointSlice{APIExport: export},
+			existingSlice: ownedSlice(export),
+			wantSliceName: partition.Name,
+			wantCondition: true,
+		},
+		"slice of the same name not owned by the partition is a conflict": {
+			template: &topologyv1alpha1.PartitionSetAPIExportEndpointSlice{APIExport: export},
+			existingSlice: &apisv1alpha1.APIExportEndpointSlice{
+				ObjectMeta: metav1.ObjectMeta{Name: partition.Name},
+				Spec:       apisv1alpha1.APIExportEndpointSliceSpec{APIExport: export},
+			},
+			wantReason: topologyv1alpha1.APIExportEndpointSliceConflictReason,
+		},
+		"slice for a different export is replaced": {
+			template:      &topologyv1alpha1.PartitionSetAPIExportEndpointSlice{APIExport: export},
+			existingSlice: ownedSlice(apisv1alpha1.ExportBindingReference{Path: "root:providers", Name: "gadgets"}),
+			wantDeleted:   true,
+			wantReason:    topologyv1alpha1.ReplacingAPIExportEndpointSliceReason,
+		},
+		"slice is deleted when not requested anymore": {
+			existingSlice: ownedSlice(export),
+			wantDeleted:   true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var created *apisv1alpha1.APIExportEndpointSlice
+			var deleted bool
+			c := &controller{
+				getPartitionSet: func(clusterName logicalcluster.Name, name string) (*topologyv1alpha1.PartitionSet, error) {
+					require.Equal(t, "consumer", clusterName.String())
+					require.Equal(t, "regions", name)
+					return &topologyv1alpha1.PartitionSet{
+						ObjectMeta: metav1.ObjectMeta{Name: name, UID: types.UID("partitionset-uid")},
+						Spec:       topologyv1alpha1.PartitionSetSpec{APIExportEndpointSlice: tc.template},
+					}, nil
+				},
+				getAPIExportEndpointSlice: func(clusterName logicalcluster.Name, name string) (*apisv1alpha1.APIExportEndpointSlice, error) {
+					if tc.existingSlice == nil {
+						return nil, apierrors.NewNotFound(apisv1alpha1.Resource("apiexportendpointslices"), name)
+					}
+					return tc.existingSlice, nil
+				},
+				createAPIExportEndpointSlice: func(ctx context.Context, path logicalcluster.Path, slice *apisv1alpha1.APIExportEndpointSlice) error {
+					require.Equal(t, "consumer", path.String())
+					created = slice
+					return nil
+				},
+				deleteAPIExportEndpointSlice: func(ctx context.Context, path logicalcluster.Path, name string) error {
+					require.Equal(t, "consumer", path.String())
+					require.Equal(t, partition.Name, name)
+					deleted = true
+					return nil
+				},
+			}
+
+			p := partition.DeepCopy()
+			require.NoError(t, c.reconcileAPIExportEndpointSlice(context.Background(), p))
+
+			require.Equal(t, tc.wantCreated, created)
+			require.Equal(t, tc.wantDeleted, deleted)
+			require.Equal(t, tc.wantSliceName, p.Status.APIExportEndpointSlice)
+			switch {
+			case tc.wantCondition:
+				require.True(t, conditions.IsTrue(p, topologyv1alpha1.APIExportEndpointSliceReady))
+			case tc.wantReason != "":
+				require.True(t, conditions.IsFalse(p, topologyv1alpha1.APIExportEndpointSliceReady))
+				require.Equal(t, tc.wantReason, conditions.GetReason(p, topologyv1alpha1.APIExportEndpointSliceReady))
+			default:
+				require.False(t, conditions.Has(p, topologyv1alpha1.APIExportEndpointSliceReady))
+			}
+		})
+	}
+}
+
+func shard(name, region string, ready bool, capacity int64) *corev1alpha1.Shard {
+	s := &corev1alpha1.Shard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{"region": region},
+		},
+	}
+	if capacity > 0 {
+		s.Status.Capacity = corev1.ResourceList{corev1alpha1.ShardCapacityLogicalClusters: *resource.NewQuantity(capacity, resource.DecimalSI)}
+	}
+	if !ready {
+		conditions.MarkFalse(s, conditionsv1alpha1.ReadyCondition, "Unavailable", conditionsv1alpha1.ConditionSeverityError, "")
+	}
+	return s
+}
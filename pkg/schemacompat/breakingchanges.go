@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemacompat
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"go.uber.org/multierr"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/pruning"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// BreakingChanges returns the changes from the existing schema to the new one that documents valid
+// under the existing schema might not survive: the incompatible changes reported by
+// EnsureStructuralSchemaCompatibility (removed fields, narrowed enums, type changes, ...), and
+// properties that became required. An error is returned if the schemas are not structural.
+func BreakingChanges(fldPath *field.Path, existing, new *apiextensionsv1.JSONSchemaProps) (field.ErrorList, error) {
+	var changes field.ErrorList
+
+	if _, err := EnsureStructuralSchemaCompatibility(fldPath, existing, new, false); err != nil {
+		for _, err := range multierr.Errors(err) {
+			var fieldErr *field.Error
+			if !errors.As(err, &fieldErr) {
+				return nil, err
+			}
+			changes = append(changes, fieldErr)
+		}
+	}
+
+	return append(changes, newlyRequiredProperties(fldPath, existing, new)...), nil
+}
+
+// newlyRequiredProperties returns the properties that are required in the new schema, but were
+// not in the existing one, for all object schemas present in both.
+func newlyRequiredProperties(fldPath *field.Path, existing, new *apiextensionsv1.JSONSchemaProps) field.ErrorList {
+	if existing == nil || new == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	existingRequired := sets.New[string](existing.Required...)
+	for _, name := range new.Required {
+		if !existingRequired.Has(name) {
+			errs = append(errs, field.Required(fldPath.Child("properties").Key(name), "property has become required"))
+		}
+	}
+
+	names := make([]string, 0, len(new.Properties))
+	for name := range new.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		existingProperty, ok := existing.Properties[name]
+		if !ok {
+			continue
+		}
+		newProperty := new.Properties[name]
+		errs = append(errs, newlyRequiredProperties(fldPath.Child("properties").Key(name), &existingProperty, &newProperty)...)
+	}
+
+	if existing.Items != nil && new.Items != nil {
+		errs = append(errs, newlyRequiredProperties(fldPath.Child("items"), existing.Items.Schema, new.Items.Schema)...)
+	}
+	if existing.AdditionalProperties != nil && new.AdditionalProperties != nil {
+		errs = append(errs, newlyRequiredProperties(fldPath.Child("additionalProperties"), existing.AdditionalProperties.Schema, new.AdditionalProperties.Schema)...)
+	}
+
+	return errs
+}
+
+// ObjectViolations returns why the given object, valid or not under its existing schema, would not be
+// stored unchanged under the given schema: fields that would be pruned, and validation errors. An
+// error is returned if the schema is not structural.
+func ObjectViolations(obj map[string]interface{}, s *apiextensionsv1.JSONSchemaProps) ([]string, error) {
+	var internal apiextensions.JSONSchemaProps
+	if err := apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(s, &internal, nil); err != nil {
+		return nil, err
+	}
+	structural, err := schema.NewStructural(&internal)
+	if err != nil {
+		return nil, err
+	}
+	validator, _, err := validation.NewSchemaValidator(&internal)
+	if err != nil {
+		return nil, err
+	}
+
+	obj = runtime.DeepCopyJSON(obj)
+	var violations []string
+	for _, path := range pruning.PruneWithOptions(obj, structural, true, schema.UnknownFieldPathOptions{TrackUnknownFieldPaths: true}) {
+		violations = append(violations, fmt.Sprintf("%s: field would be pruned", path))
+	}
+	for _, err := range validation.ValidateCustomResource(nil, obj, validator) {
+		violations = append(violations, err.Error())
+	}
+	return violations, nil
+}
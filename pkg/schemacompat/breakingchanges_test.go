@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemacompat
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestBreakingChanges(t *testing.T) {
+	t.Parallel()
+
+	existing := &apiextensionsv1.JSONSchemaProps{
+		Type: "object",
+		Properties: map[string]apiextensionsv1.JSONSchemaProps{
+			"spec": {
+				Type:     "object",
+				Required: []string{"name"},
+				Properties: map[string]apiextensionsv1.JSONSchemaProps{
+					"name":  {Type: "string"},
+					"size":  {Type: "integer"},
+					"color": {Type: "string", Enum: []apiextensionsv1.JSON{{Raw: []byte(`"red"`)}, {Raw: []byte(`"blue"`)}}},
+					"items": {
+						Type: "array",
+						Items: &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &apiextensionsv1.JSONSchemaProps{
+							Type:       "object",
+							Properties: map[string]apiextensionsv1.JSONSchemaProps{"id": {Type: "string"}},
+						}},
+					},
+				},
+			},
+		},
+	}
+
+	for _, c := range []struct {
+		desc     string
+		new      *apiextensionsv1.JSONSchemaProps
+		wantErrs []string
+	}{{
+		desc: "unchanged",
+		new:  existing,
+	}, {
+		desc: "new optional property",
+		new: modified(existing, func(s *apiextensionsv1.JSONSchemaProps) {
+			spec := s.Properties["spec"]
+			spec.Properties["label"] = apiextensionsv1.JSONSchemaProps{Type: "string"}
+		}),
+	}, {
+		desc: "removed property",
+		new: modified(existing, func(s *apiextensionsv1.JSONSchemaProps) {
+			delete(s.Properties["spec"].Properties, "size")
+		}),
+		wantErrs: []string{`schema.properties[spec].properties: Invalid value: ["size"]: properties have been removed in an incompatible way`},
+	}, {
+		desc: "type change",
+		new: modified(existing, func(s *apiextensionsv1.JSONSchemaProps) {
+			s.Properties["spec"].Properties["size"] = apiextensionsv1.JSONSchemaProps{Type: "string"}
+		}),
+		wantErrs: []string{`schema.properties[spec].properties[size].type: Invalid value: "string": The type changed (was "integer", now "string")`},
+	}, {
+		desc: "narrowed enum",
+		new: modified(existing, func(s *apiextensionsv1.JSONSchemaProps) {
+			s.Properties["spec"].Properties["color"] = apiextensionsv1.JSONSchemaProps{Type: "string", Enum: []apiextensionsv1.JSON{{Raw: []byte(`"red"`)}}}
+		}),
+		wantErrs: []string{`schema.properties[spec].properties[color].enum: Invalid value: ["blue"]: enum value has been changed in an incompatible way`},
+	}, {
+		desc: "new required properties, also in array items",
+		new: modified(existing, func(s *apiextensionsv1.JSONSchemaProps) {
+			spec := s.Properties["spec"]
+			spec.Required = []string{"name", "size"}
+			items := spec.Properties["items"]
+			items.Items.Schema.Required = []string{"id"}
+			spec.Properties["items"] = items
+			s.Properties["spec"] = spec
+		}),
+		wantErrs: []string{
+			`schema.properties[spec].properties[size]: Required value: property has become required`,
+			`schema.properties[spec].properties[items].items.properties[id]: Required value: property has become required`,
+		},
+	}} {
+		t.Run(c.desc, func(t *testing.T) {
+			t.Parallel()
+			changes, err := BreakingChanges(field.NewPath("schema"), existing, c.new)
+			if err != nil {
+				t.Fatalf("unexpected err %v", err)
+			}
+			var gotErrs []string
+			for _, change := range changes {
+				gotErrs = append(gotErrs, change.Error())
+			}
+			if d := cmp.Diff(c.wantErrs, gotErrs); d != "" {
+				t.Errorf("Diff(-want,+got): %s", d)
+			}
+		})
+	}
+}
+
+func modified(s *apiextensionsv1.JSONSchemaProps, modify func(*apiextensionsv1.JSONSchemaProps)) *apiextensionsv1.JSONSchemaProps {
+	s = s.DeepCopy()
+	modify(s)
+	return s
+}
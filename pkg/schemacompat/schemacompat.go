@@ -249,7 +249,7 @@ func lcdForStringValidation(fldPath *field.Path, existing, new, lcd *schema.Valu
 	newEnumValues := toEnumSets(new.Enum)
 	if !newEnumValues.IsSuperset(existingEnumValues) {
 		if !narrowExisting {
-			multierr.AppendInto(&err, field.Invalid(fldPath.Child("enum"), sets.List[string](newEnumValues.Difference(existingEnumValues)), "enum value has been changed in an incompatible way"))
+			multierr.AppendInto(&err, field.Invalid(fldPath.Child("enum"), sets.List[string](existingEnumValues.Difference(newEnumValues)), "enum value has been changed in an incompatible way"))
 		}
 		lcd.Enum = nil
 		lcdEnumValues := sets.List[string](existingEnumValues.Intersection(newEnumValues))
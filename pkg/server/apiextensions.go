@@ -345,7 +345,7 @@ func tryDecorateCRDWithSchemaStorage(in *apiextensionsv1.CustomResourceDefinitio
 		resourceStorage apisv1alpha2.ResourceSchemaStorage
 		foundResource   bool
 	)
-	for _, resource := range apiExport.Spec.Resources {
+	for _, resource := range apiExport.BindableResources() {
 		if resource.Group == in.Spec.Group && resource.Name == in.Status.AcceptedNames.Plural {
 			resourceStorage = resource.Storage
 			foundResource = true
@@ -55,7 +55,10 @@ var (
 	// KcpRootGroupResourceExportNames lists the APIExports in the root workspace for standard kcp group resources.
 	KcpRootGroupResourceExportNames = map[schema.GroupResource]string{
 		{Group: "core.kcp.io", Resource: "shards"}:                        "shards.core.kcp.io",
+		{Group: "core.kcp.io", Resource: "frontproxyroutes"}:              "shards.core.kcp.io",
+		{Group: "core.kcp.io", Resource: "frontproxyratelimits"}:          "shards.core.kcp.io",
 		{Group: "migration.kcp.io", Resource: "logicalclustermigrations"}: "migration.kcp.io",
+		{Group: "migration.kcp.io", Resource: "sharddrains"}:              "migration.kcp.io",
 	}
 )
 
@@ -28,15 +28,38 @@ import (
 	corev1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/core/v1alpha1"
 )
 
-// installClientCacheEvictor registers a LogicalCluster delete handler that
-// notifies apiclient.EvictCluster, which fans out to every per-cluster client
+// installClientCacheEvictor registers LogicalCluster delete and update handlers that
+// notify apiclient.EvictCluster, which fans out to every per-cluster client
 // cache (kube, sdk, dynamic, metadata, ...) constructed via apiclient.NewCache.
 // Without this, those caches grow monotonically and pin per-cluster REST
 // clients, codec factories, JSON-decoded schemas, etc. for the lifetime of
 // the process. See https://github.com/kcp-dev/kcp/issues/4071.
+//
+// The caches are also evicted when a logical cluster is hibernated.
 func installClientCacheEvictor(ctx context.Context, informer corev1alpha1informers.LogicalClusterClusterInformer) {
 	logger := klog.FromContext(ctx)
 	_, _ = informer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj any) {
+			oldLC, ok := oldObj.(*corev1alpha1.LogicalCluster)
+			if !ok {
+				return
+			}
+			newLC, ok := newObj.(*corev1alpha1.LogicalCluster)
+			if !ok {
+				return
+			}
+			// A hibernated logical cluster is idle, the caches are rebuilt
+			// on demand once it is used again.
+			if corev1alpha1.IsLogicalClusterHibernated(oldLC.Annotations) || !corev1alpha1.IsLogicalClusterHibernated(newLC.Annotations) {
+				return
+			}
+			name := logicalcluster.From(newLC)
+			if name == "" {
+				return
+			}
+			logger.V(4).Info("evicting per-cluster client caches of hibernated logical cluster", "logicalcluster", name)
+			apiclient.EvictCluster(name.Path())
+		},
 		DeleteFunc: func(obj any) {
 			lc, ok := obj.(*corev1alpha1.LogicalCluster)
 			if !ok {
@@ -72,6 +72,7 @@ import (
 	bootstrappolicy "github.com/kcp-dev/kcp/pkg/authorization/bootstrap"
 	"github.com/kcp-dev/kcp/pkg/contextmanager"
 	kcpfeatures "github.com/kcp-dev/kcp/pkg/features"
+	"github.com/kcp-dev/kcp/pkg/hibernation"
 	"github.com/kcp-dev/kcp/pkg/indexers"
 	"github.com/kcp-dev/kcp/pkg/informer"
 	"github.com/kcp-dev/kcp/pkg/network"
@@ -149,6 +150,11 @@ type ExtraConfig struct {
 	// shared between the objectcountlimit admission plugin and the scanner.
 	ObjectCountRegistry *objectcount.Registry
 
+	// HibernationTracker records the requests of users per logical cluster in
+	// this process. It is fed by the handler chain and read by the hibernation
+	// controller.
+	HibernationTracker *hibernation.Tracker
+
 	// URL getters depending on genericspiserver.ExternalAddress which is initialized on server run
 	ShardBaseURL             func() string
 	ShardExternalURL         func() string
@@ -572,6 +578,10 @@ func NewConfig(ctx context.Context, opts kcpserveroptions.CompletedOptions) (*Co
 			apiHandler = filters.WithStorageVersionPrecondition(apiHandler, genericConfig.StorageVersionManager, genericConfig.Serializer)
 		}
 
+		// WithWakeHibernatedLogicalClusters runs after authorization, so that only
+		// authorized requests of users keep a logical cluster awake.
+		apiHandler = kcpfilters.WithWakeHibernatedLogicalClusters(apiHandler, c.KcpSharedInformerFactory.Core().V1alpha1().LogicalClusters(), c.HibernationTracker, hibernation.NewWaker(c.KcpClusterClient).Wake)
+
 		// The following ensures that only the default main api handler chain executes authorizers which log audit messages.
 		// All other invocations of the same authorizer chain still work but do not produce audit log entries.
 		// This compromises audit log size and information overflow vs. having audit reasons for the main api handler only.
@@ -628,7 +638,7 @@ func NewConfig(ctx context.Context, opts kcpserveroptions.CompletedOptions) (*Co
 		// 3. Scoping handlers to ensure that the request is scoped to the user's clusters before authz is done.
 		// 4. Rest of the handlers.
 		if kcpfeatures.DefaultFeatureGate.Enabled(kcpfeatures.LogicalClusterMigration) {
-			apiHandler = kcpfilters.WithMigrationDumpHandler(apiHandler, c.MigrationDumpHandler)
+			apiHandler = kcpfilters.WithMigrationDumpHandler(apiHandler, c.MigrationDumpHandler, http.HandlerFunc(c.MigrationDumpHandler.ServeRestore))
 			apiHandler = kcpfilters.WithBlockMigratingLogicalClusters(apiHandler, c.MigratingLogicalClusters.IsMigrating)
 		}
 		apiHandler = kcpfilters.WithImpersonationScoping(apiHandler)
@@ -689,6 +699,11 @@ func NewConfig(ctx context.Context, opts kcpserveroptions.CompletedOptions) (*Co
 	c.ExtraConfig.quotaAdmissionStopCh = make(chan struct{})
 	c.ExtraConfig.MigratingLogicalClusters = logicalclustermigration.NewMigratingLogicalClusters()
 	c.ExtraConfig.ObjectCountRegistry = objectcount.NewRegistry(opts.Extra.LogicalClusterTotalObjectLimit)
+	c.ExtraConfig.HibernationTracker = hibernation.NewTracker()
+	if opts.Extra.ShardCapacityObjects > 0 {
+		// the object usage is reported for the capacity to be enforced by the scheduler.
+		c.ExtraConfig.ObjectCountRegistry.TrackUsage()
+	}
 
 	// DynamicRESTMapper is initialized here, but it starts to be populated only once its controller starts.
 	c.DynamicRESTMapper = dynamicrestmapper.NewDynamicRESTMapper()
@@ -30,6 +30,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsscheme "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/scheme"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -42,6 +43,7 @@ import (
 	k8sscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/cache"
 	certutil "k8s.io/client-go/util/cert"
 	"k8s.io/client-go/util/keyutil"
 	"k8s.io/klog/v2"
@@ -67,6 +69,7 @@ import (
 	bootstrappolicy "github.com/kcp-dev/kcp/pkg/authorization/bootstrap"
 	cacheclient "github.com/kcp-dev/kcp/pkg/cache/client"
 	kcpfeatures "github.com/kcp-dev/kcp/pkg/features"
+	"github.com/kcp-dev/kcp/pkg/indexers"
 	"github.com/kcp-dev/kcp/pkg/informer"
 	"github.com/kcp-dev/kcp/pkg/objectcount"
 	permissionclaimlabler "github.com/kcp-dev/kcp/pkg/permissionclaim"
@@ -83,6 +86,7 @@ import (
 	apisreplicateclusterrole "github.com/kcp-dev/kcp/pkg/reconciler/apis/replicateclusterrole"
 	apisreplicateclusterrolebinding "github.com/kcp-dev/kcp/pkg/reconciler/apis/replicateclusterrolebinding"
 	apisreplicatelogicalcluster "github.com/kcp-dev/kcp/pkg/reconciler/apis/replicatelogicalcluster"
+	"github.com/kcp-dev/kcp/pkg/reconciler/apis/storageversionmigration"
 	"github.com/kcp-dev/kcp/pkg/reconciler/cache/clustercachedresourceendpointslice"
 	"github.com/kcp-dev/kcp/pkg/reconciler/cache/clustercachedresourceendpointsliceurls"
 	"github.com/kcp-dev/kcp/pkg/reconciler/cache/clustercachedresources"
@@ -91,6 +95,10 @@ import (
 	"github.com/kcp-dev/kcp/pkg/reconciler/cache/replication"
 	logicalclusterctrl "github.com/kcp-dev/kcp/pkg/reconciler/core/logicalcluster"
 	"github.com/kcp-dev/kcp/pkg/reconciler/core/logicalclusterdeletion"
+	"github.com/kcp-dev/kcp/pkg/reconciler/core/logicalclusterhibernation"
+	"github.com/kcp-dev/kcp/pkg/reconciler/core/logicalclusterlimits"
+	"github.com/kcp-dev/kcp/pkg/reconciler/core/logicalclusterpath"
+	"github.com/kcp-dev/kcp/pkg/reconciler/core/logicalclusterpurge"
 	coresreplicateclusterrole "github.com/kcp-dev/kcp/pkg/reconciler/core/replicateclusterrole"
 	corereplicateclusterrolebinding "github.com/kcp-dev/kcp/pkg/reconciler/core/replicateclusterrolebinding"
 	"github.com/kcp-dev/kcp/pkg/reconciler/core/shard"
@@ -98,6 +106,7 @@ import (
 	"github.com/kcp-dev/kcp/pkg/reconciler/garbagecollector"
 	"github.com/kcp-dev/kcp/pkg/reconciler/kubequota"
 	"github.com/kcp-dev/kcp/pkg/reconciler/migration/logicalclustermigration"
+	"github.com/kcp-dev/kcp/pkg/reconciler/migration/sharddrain"
 	"github.com/kcp-dev/kcp/pkg/reconciler/tenancy/bootstrap"
 	"github.com/kcp-dev/kcp/pkg/reconciler/tenancy/defaultapibindinglifecycle"
 	"github.com/kcp-dev/kcp/pkg/reconciler/tenancy/initialization"
@@ -107,7 +116,9 @@ import (
 	tenancyreplicatelogicalcluster "github.com/kcp-dev/kcp/pkg/reconciler/tenancy/replicatelogicalcluster"
 	"github.com/kcp-dev/kcp/pkg/reconciler/tenancy/workspace"
 	"github.com/kcp-dev/kcp/pkg/reconciler/tenancy/workspacemounts"
+	"github.com/kcp-dev/kcp/pkg/reconciler/tenancy/workspacequota"
 	"github.com/kcp-dev/kcp/pkg/reconciler/tenancy/workspacetype"
+	"github.com/kcp-dev/kcp/pkg/reconciler/topology/partition"
 	"github.com/kcp-dev/kcp/pkg/reconciler/topology/partitionset"
 	initializingworkspacesbuilder "github.com/kcp-dev/kcp/pkg/virtual/initializingworkspaces/builder"
 
@@ -556,6 +567,11 @@ func (s *Server) installWorkspaceScheduler(ctx context.Context, config *rest.Con
 	externalLogicalClusterAdminConfig = rest.CopyConfig(externalLogicalClusterAdminConfig)
 	externalLogicalClusterAdminConfig = rest.AddUserAgent(externalLogicalClusterAdminConfig, workspace.ControllerName+"+"+s.Options.Extra.ShardName)
 
+	shardScorer, err := workspace.NewShardScorer(s.Options.Extra.WorkspaceSchedulingPolicy, s.Options.Extra.WorkspaceSchedulingSpreadLabel)
+	if err != nil {
+		return err
+	}
+
 	workspaceController, err := workspace.NewController(
 		s.Options.Extra.ShardName,
 		kcpClusterClient,
@@ -567,6 +583,7 @@ func (s *Server) installWorkspaceScheduler(ctx context.Context, config *rest.Con
 		s.CacheKcpSharedInformerFactory.Tenancy().V1alpha1().WorkspaceTypes(),
 		s.KcpSharedInformerFactory.Core().V1alpha1().LogicalClusters(),
 		s.CacheKcpSharedInformerFactory.Core().V1alpha1().LogicalClusters(),
+		shardScorer,
 	)
 	if err != nil {
 		return err
@@ -1094,6 +1111,103 @@ func (s *Server) installAPIBinderController(ctx context.Context, config *rest.Co
 	})
 }
 
+func (s *Server) installCloneController(ctx context.Context, config *rest.Config) error {
+	// Client used to remove the initializer from the initializing workspace
+	config = rest.CopyConfig(config)
+	config = rest.AddUserAgent(config, initialization.CloneControllerName)
+
+	config.Host += initializingworkspacesbuilder.URLFor(tenancyv1alpha1.WorkspaceCloneInitializer)
+
+	if !s.Options.Virtual.Enabled && s.Options.Extra.ShardVirtualWorkspaceURL != "" {
+		if s.Options.Extra.ShardVirtualWorkspaceCAFile == "" {
+			// TODO move verification up
+			return fmt.Errorf("s.Options.Extra.ShardVirtualWorkspaceCAFile is required")
+		}
+		if s.Options.Extra.ShardClientCertFile == "" {
+			// TODO move verification up
+			return fmt.Errorf("s.Options.Extra.ShardClientCertFile is required")
+		}
+		if s.Options.Extra.ShardClientKeyFile == "" {
+			// TODO move verification up
+			return fmt.Errorf("s.Options.Extra.ShardClientKeyFile is required")
+		}
+
+		config.TLSClientConfig.CAData = nil
+		config.TLSClientConfig.CertData = nil
+		config.TLSClientConfig.KeyData = nil
+		config.TLSClientConfig.CAFile = s.Options.Extra.ShardVirtualWorkspaceCAFile
+		config.TLSClientConfig.CertFile = s.Options.Extra.ShardClientCertFile
+		config.TLSClientConfig.KeyFile = s.Options.Extra.ShardClientKeyFile
+		config.TLSClientConfig.ServerName = ""
+
+		config.Host = strings.TrimSuffix(s.Options.Extra.ShardVirtualWorkspaceURL, "/")
+		config.Host += initializingworkspacesbuilder.URLFor(tenancyv1alpha1.WorkspaceCloneInitializer)
+	}
+
+	initializingWorkspacesKcpClusterClient, err := kcpclientset.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	informerClient, err := kcpclientset.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	// This informer factory is created here because it is specifically against the initializing workspaces virtual
+	// workspace.
+	initializingWorkspacesKcpInformers := kcpinformers.NewSharedInformerFactoryWithOptions(
+		informerClient,
+		resyncPeriod,
+	)
+
+	// Clients used to read the workspace to clone, which can live on any shard.
+	externalConfig := rest.CopyConfig(s.ExternalLogicalClusterAdminConfig)
+	externalConfig = rest.AddUserAgent(externalConfig, initialization.CloneControllerName)
+	externalKcpClusterClient, err := kcpclientset.NewForConfig(externalConfig)
+	if err != nil {
+		return err
+	}
+	externalKubeClusterClient, err := kcpkubernetesclientset.NewForConfig(externalConfig)
+	if err != nil {
+		return err
+	}
+
+	etcdClient, err := s.newEtcdClient()
+	if err != nil {
+		return err
+	}
+
+	c, err := initialization.NewCloner(
+		initializingWorkspacesKcpClusterClient,
+		externalKcpClusterClient,
+		externalKubeClusterClient,
+		etcdClient,
+		s.Options.GenericControlPlane.Etcd.StorageConfig.Prefix,
+		initializingWorkspacesKcpInformers.Core().V1alpha1().LogicalClusters(),
+		s.KcpSharedInformerFactory.Apis().V1alpha2().APIBindings(),
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.registerController(&controllerWrapper{
+		Name: initialization.CloneControllerName,
+		Wait: func(ctx context.Context, s *Server) error {
+			return wait.PollUntilContextCancel(ctx, waitPollInterval, true, func(ctx context.Context) (bool, error) {
+				return s.KcpSharedInformerFactory.Apis().V1alpha2().APIBindings().Informer().HasSynced(), nil
+			})
+		},
+		Runner: func(ctx context.Context) {
+			defer etcdClient.Close()
+
+			initializingWorkspacesKcpInformers.Start(ctx.Done())
+			initializingWorkspacesKcpInformers.WaitForCacheSync(ctx.Done())
+
+			c.Start(ctx, 2)
+		},
+	})
+}
+
 func (s *Server) installCRDCleanupController(ctx context.Context, config *rest.Config) error {
 	config = rest.CopyConfig(config)
 	config = rest.AddUserAgent(config, crdcleanup.ControllerName)
@@ -1130,6 +1244,43 @@ func (s *Server) installCRDCleanupController(ctx context.Context, config *rest.C
 	})
 }
 
+func (s *Server) installStorageVersionMigrationController(ctx context.Context, config *rest.Config) error {
+	config = rest.CopyConfig(config)
+	config = rest.AddUserAgent(config, storageversionmigration.ControllerName)
+
+	kcpClusterClient, err := kcpclientset.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	dynamicClusterClient, err := kcpdynamic.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	c, err := storageversionmigration.NewController(
+		kcpClusterClient,
+		dynamicClusterClient,
+		s.KcpSharedInformerFactory.Apis().V1alpha2().APIBindings(),
+		s.ApiExtensionsSharedInformerFactory.Apiextensions().V1().CustomResourceDefinitions(),
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.registerController(&controllerWrapper{
+		Name: storageversionmigration.ControllerName,
+		Wait: func(ctx context.Context, s *Server) error {
+			return wait.PollUntilContextCancel(ctx, waitPollInterval, true, func(ctx context.Context) (bool, error) {
+				return s.ApiExtensionsSharedInformerFactory.Apiextensions().V1().CustomResourceDefinitions().Informer().HasSynced() &&
+					s.KcpSharedInformerFactory.Apis().V1alpha2().APIBindings().Informer().HasSynced(), nil
+			})
+		},
+		Runner: func(ctx context.Context) {
+			c.Start(ctx, 2)
+		},
+	})
+}
+
 func (s *Server) installLogicalClusterCleanupController(ctx context.Context, config *rest.Config) error {
 	config = rest.CopyConfig(config)
 	config = rest.AddUserAgent(config, logicalclustercleanup.ControllerName)
@@ -1229,23 +1380,310 @@ func (s *Server) installLogicalClusterMigrationController(ctx context.Context, c
 	})
 }
 
+func (s *Server) installShardDrainController(_ context.Context) error {
+	externalConfig := rest.CopyConfig(s.ExternalLogicalClusterAdminConfig)
+	externalConfig = rest.AddUserAgent(externalConfig, sharddrain.ControllerName)
+
+	kcpClusterClient, err := kcpclientset.NewForConfig(externalConfig)
+	if err != nil {
+		return err
+	}
+
+	c, err := sharddrain.NewController(
+		s.Options.Extra.ShardName,
+		kcpClusterClient,
+		s.CacheKcpSharedInformerFactory.Migration().V1alpha1().ShardDrains(),
+		s.CacheKcpSharedInformerFactory.Migration().V1alpha1().LogicalClusterMigrations(),
+		s.CacheKcpSharedInformerFactory.Core().V1alpha1().Shards(),
+		s.KcpSharedInformerFactory.Core().V1alpha1().LogicalClusters(),
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.registerController(&controllerWrapper{
+		Name: sharddrain.ControllerName,
+		Wait: func(ctx context.Context, s *Server) error {
+			return wait.PollUntilContextCancel(ctx, waitPollInterval, true, func(ctx context.Context) (bool, error) {
+				return s.CacheKcpSharedInformerFactory.Migration().V1alpha1().ShardDrains().Informer().HasSynced() &&
+					s.CacheKcpSharedInformerFactory.Migration().V1alpha1().LogicalClusterMigrations().Informer().HasSynced() &&
+					s.CacheKcpSharedInformerFactory.Core().V1alpha1().Shards().Informer().HasSynced() &&
+					s.KcpSharedInformerFactory.Core().V1alpha1().LogicalClusters().Informer().HasSynced(), nil
+			})
+		},
+		Runner: func(ctx context.Context) {
+			c.Start(ctx, 2)
+		},
+	})
+}
+
+// installShardUsageReporter periodically reports the capacity and the usage
+// of this shard in the status of its Shard object, which the workspace
+// scheduler uses to place new workspaces.
+func (s *Server) installShardUsageReporter(_ context.Context) error {
+	capacity := corev1.ResourceList{}
+	if s.Options.Extra.ShardCapacityLogicalClusters > 0 {
+		capacity[corev1alpha1.ShardCapacityLogicalClusters] = *resource.NewQuantity(s.Options.Extra.ShardCapacityLogicalClusters, resource.DecimalSI)
+	}
+	if s.Options.Extra.ShardCapacityObjects > 0 {
+		capacity[corev1alpha1.ShardCapacityObjects] = *resource.NewQuantity(s.Options.Extra.ShardCapacityObjects, resource.DecimalSI)
+	}
+
+	reporter := shard.NewUsageReporter(
+		s.Options.Extra.ShardName,
+		s.Options.Extra.ShardUsageReportInterval,
+		capacity,
+		s.RootShardKcpClusterClient,
+		s.CacheKcpSharedInformerFactory.Core().V1alpha1().Shards(),
+		s.CacheKcpSharedInformerFactory.Tenancy().V1alpha1().WorkspaceTypes(),
+		s.KcpSharedInformerFactory.Core().V1alpha1().LogicalClusters(),
+		s.ObjectCountRegistry,
+	)
+
+	return s.registerController(&controllerWrapper{
+		Name: shard.UsageReporterName,
+		Wait: func(ctx context.Context, s *Server) error {
+			return wait.PollUntilContextCancel(ctx, waitPollInterval, true, func(ctx context.Context) (bool, error) {
+				return s.CacheKcpSharedInformerFactory.Core().V1alpha1().Shards().Informer().HasSynced() &&
+					s.CacheKcpSharedInformerFactory.Tenancy().V1alpha1().WorkspaceTypes().Informer().HasSynced() &&
+					s.KcpSharedInformerFactory.Core().V1alpha1().LogicalClusters().Informer().HasSynced(), nil
+			})
+		},
+		Runner: func(ctx context.Context) {
+			reporter.Start(ctx)
+		},
+	})
+}
+
+// installLogicalClusterLimitsStatusReporter periodically reports the object
+// counts of the limited resources in the status of the LogicalClusterLimits
+// objects on this shard.
+func (s *Server) installLogicalClusterLimitsStatusReporter(_ context.Context, config *rest.Config) error {
+	config = rest.CopyConfig(config)
+	config = rest.AddUserAgent(config, logicalclusterlimits.StatusReporterName)
+	kcpClusterClient, err := kcpclientset.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	reporter := logicalclusterlimits.NewStatusReporter(
+		s.Options.Extra.LogicalClusterObjectCountScanInterval,
+		kcpClusterClient,
+		s.KcpSharedInformerFactory.Core().V1alpha1().LogicalClusters(),
+		s.KcpSharedInformerFactory.Core().V1alpha1().LogicalClusterLimits(),
+		s.ObjectCountRegistry,
+	)
+
+	return s.registerController(&controllerWrapper{
+		Name: logicalclusterlimits.StatusReporterName,
+		Wait: func(ctx context.Context, s *Server) error {
+			return wait.PollUntilContextCancel(ctx, waitPollInterval, true, func(ctx context.Context) (bool, error) {
+				return s.KcpSharedInformerFactory.Core().V1alpha1().LogicalClusters().Informer().HasSynced() &&
+					s.KcpSharedInformerFactory.Core().V1alpha1().LogicalClusterLimits().Informer().HasSynced(), nil
+			})
+		},
+		Runner: func(ctx context.Context) {
+			reporter.Start(ctx)
+		},
+	})
+}
+
+// installLogicalClusterHibernationController hibernates the logical clusters
+// on this shard which have been idle for the hibernateAfter duration of their
+// WorkspaceType.
+func (s *Server) installLogicalClusterHibernationController(_ context.Context, config *rest.Config) error {
+	config = rest.CopyConfig(config)
+	config = rest.AddUserAgent(config, logicalclusterhibernation.ControllerName)
+	kcpClusterClient, err := kcpclientset.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	c, err := logicalclusterhibernation.NewController(
+		kcpClusterClient,
+		s.KcpSharedInformerFactory.Core().V1alpha1().LogicalClusters(),
+		s.KcpSharedInformerFactory.Tenancy().V1alpha1().WorkspaceTypes(),
+		s.CacheKcpSharedInformerFactory.Tenancy().V1alpha1().WorkspaceTypes(),
+		s.HibernationTracker,
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.registerController(&controllerWrapper{
+		Name: logicalclusterhibernation.ControllerName,
+		Wait: func(ctx context.Context, s *Server) error {
+			return wait.PollUntilContextCancel(ctx, waitPollInterval, true, func(ctx context.Context) (bool, error) {
+				return s.KcpSharedInformerFactory.Core().V1alpha1().LogicalClusters().Informer().HasSynced() &&
+					s.KcpSharedInformerFactory.Tenancy().V1alpha1().WorkspaceTypes().Informer().HasSynced() &&
+					s.CacheKcpSharedInformerFactory.Tenancy().V1alpha1().WorkspaceTypes().Informer().HasSynced(), nil
+			})
+		},
+		Runner: func(ctx context.Context) {
+			c.Start(ctx)
+		},
+	})
+}
+
+// installLogicalClusterPurgeController deletes the logical clusters on this
+// shard which have been retained after the deletion of their workspace, once
+// their retention has passed.
+func (s *Server) installLogicalClusterPurgeController(_ context.Context, config *rest.Config) error {
+	config = rest.CopyConfig(config)
+	config = rest.AddUserAgent(config, logicalclusterpurge.ControllerName)
+	kcpClusterClient, err := kcpclientset.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	c := logicalclusterpurge.NewController(
+		kcpClusterClient,
+		s.KcpSharedInformerFactory.Core().V1alpha1().LogicalClusters(),
+	)
+
+	return s.registerController(&controllerWrapper{
+		Name: logicalclusterpurge.ControllerName,
+		Wait: func(ctx context.Context, s *Server) error {
+			return wait.PollUntilContextCancel(ctx, waitPollInterval, true, func(ctx context.Context) (bool, error) {
+				return s.KcpSharedInformerFactory.Core().V1alpha1().LogicalClusters().Informer().HasSynced(), nil
+			})
+		},
+		Runner: func(ctx context.Context) {
+			c.Start(ctx)
+		},
+	})
+}
+
+// installLogicalClusterPathController keeps the path annotations of objects in
+// the logical clusters on this shard in sync with moved workspaces.
+func (s *Server) installLogicalClusterPathController(_ context.Context, config *rest.Config) error {
+	config = rest.CopyConfig(config)
+	config = rest.AddUserAgent(config, logicalclusterpath.ControllerName)
+	kcpClusterClient, err := kcpclientset.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	c := logicalclusterpath.NewController(
+		kcpClusterClient,
+		s.KcpSharedInformerFactory.Core().V1alpha1().LogicalClusters(),
+		s.KcpSharedInformerFactory.Apis().V1alpha2().APIExports(),
+		s.KcpSharedInformerFactory.Apis().V1alpha2().APIBindings(),
+		s.KcpSharedInformerFactory.Tenancy().V1alpha1().WorkspaceTypes(),
+		s.KcpSharedInformerFactory.Tenancy().V1alpha1().WorkspaceQuotas(),
+		s.KcpSharedInformerFactory.Cache().V1alpha1().ClusterCachedResources(),
+	)
+
+	return s.registerController(&controllerWrapper{
+		Name: logicalclusterpath.ControllerName,
+		Wait: func(ctx context.Context, s *Server) error {
+			return wait.PollUntilContextCancel(ctx, waitPollInterval, true, func(ctx context.Context) (bool, error) {
+				return s.KcpSharedInformerFactory.Core().V1alpha1().LogicalClusters().Informer().HasSynced() &&
+					s.KcpSharedInformerFactory.Apis().V1alpha2().APIExports().Informer().HasSynced() &&
+					s.KcpSharedInformerFactory.Apis().V1alpha2().APIBindings().Informer().HasSynced() &&
+					s.KcpSharedInformerFactory.Tenancy().V1alpha1().WorkspaceTypes().Informer().HasSynced() &&
+					s.KcpSharedInformerFactory.Tenancy().V1alpha1().WorkspaceQuotas().Informer().HasSynced() &&
+					s.KcpSharedInformerFactory.Cache().V1alpha1().ClusterCachedResources().Informer().HasSynced(), nil
+			})
+		},
+		Runner: func(ctx context.Context) {
+			c.Start(ctx, 2)
+		},
+	})
+}
+
+// installWorkspaceQuotaUsageReporter periodically reports the usage of the
+// workspaces on this shard towards the WorkspaceQuotas above them through the
+// cache server, and sums up the usages of all shards in the status of the
+// WorkspaceQuotas on this shard.
+func (s *Server) installWorkspaceQuotaUsageReporter(_ context.Context, config *rest.Config) error {
+	config = rest.CopyConfig(config)
+	config = rest.AddUserAgent(config, workspacequota.UsageReporterName)
+	kcpClusterClient, err := kcpclientset.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	dynamicClusterClient, err := kcpdynamic.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	reporter := workspacequota.NewUsageReporter(
+		s.Options.Extra.ShardName,
+		s.Options.Extra.LogicalClusterObjectCountScanInterval,
+		kcpClusterClient,
+		dynamicClusterClient,
+		s.KcpCacheClusterClient,
+		s.KcpSharedInformerFactory.Core().V1alpha1().LogicalClusters(),
+		s.KcpSharedInformerFactory.Tenancy().V1alpha1().WorkspaceQuotas(),
+		s.CacheKcpSharedInformerFactory.Tenancy().V1alpha1().WorkspaceQuotas(),
+		s.CacheKcpSharedInformerFactory.Tenancy().V1alpha1().WorkspaceQuotaUsages(),
+		s.ObjectCountRegistry,
+	)
+
+	return s.registerController(&controllerWrapper{
+		Name: workspacequota.UsageReporterName,
+		Wait: func(ctx context.Context, s *Server) error {
+			return wait.PollUntilContextCancel(ctx, waitPollInterval, true, func(ctx context.Context) (bool, error) {
+				return s.KcpSharedInformerFactory.Core().V1alpha1().LogicalClusters().Informer().HasSynced() &&
+					s.KcpSharedInformerFactory.Tenancy().V1alpha1().WorkspaceQuotas().Informer().HasSynced() &&
+					s.CacheKcpSharedInformerFactory.Tenancy().V1alpha1().WorkspaceQuotas().Informer().HasSynced() &&
+					s.CacheKcpSharedInformerFactory.Tenancy().V1alpha1().WorkspaceQuotaUsages().Informer().HasSynced(), nil
+			})
+		},
+		Runner: func(ctx context.Context) {
+			reporter.Start(ctx)
+		},
+	})
+}
+
 // installObjectCountScanner starts the periodic etcd scan feeding the
 // per-logical-cluster object count registry used by the
-// core.kcp.io/LogicalClusterObjectCountLimit admission plugin. It runs on
-// every replica because the registry is per-process, in-memory state the
-// local admission chain depends on.
+// core.kcp.io/LogicalClusterObjectCountLimit and
+// core.kcp.io/LogicalClusterStorageLimit admission plugins. It runs on every
+// replica because the registry is per-process, in-memory state the local
+// admission chain depends on.
 func (s *Server) installObjectCountScanner(_ context.Context) error {
 	etcdClient, err := s.newEtcdClient()
 	if err != nil {
 		return err
 	}
 
+	workspaceTypeInformer := s.KcpSharedInformerFactory.Tenancy().V1alpha1().WorkspaceTypes()
+	globalWorkspaceTypeInformer := s.CacheKcpSharedInformerFactory.Tenancy().V1alpha1().WorkspaceTypes()
+	indexers.AddIfNotPresentOrDie(workspaceTypeInformer.Informer().GetIndexer(), cache.Indexers{
+		indexers.ByLogicalClusterPathAndName: indexers.IndexByLogicalClusterPathAndName,
+	})
+	indexers.AddIfNotPresentOrDie(globalWorkspaceTypeInformer.Informer().GetIndexer(), cache.Indexers{
+		indexers.ByLogicalClusterPathAndName: indexers.IndexByLogicalClusterPathAndName,
+	})
+	workspaceQuotaInformer := s.KcpSharedInformerFactory.Tenancy().V1alpha1().WorkspaceQuotas()
+	globalWorkspaceQuotaInformer := s.CacheKcpSharedInformerFactory.Tenancy().V1alpha1().WorkspaceQuotas()
+	getWorkspaceQuotasAt := workspacequota.QuotasAtFunc(workspaceQuotaInformer.Informer().GetIndexer(), globalWorkspaceQuotaInformer.Informer().GetIndexer())
+
 	scanner := objectcount.NewScanner(
 		etcdClient,
 		s.Options.GenericControlPlane.Etcd.StorageConfig.Prefix,
 		s.Options.Extra.LogicalClusterObjectCountScanInterval,
 		s.ObjectCountRegistry,
 		s.KcpSharedInformerFactory.Core().V1alpha1().LogicalClusters().Lister(),
+		s.KcpSharedInformerFactory.Core().V1alpha1().LogicalClusterLimits().Lister(),
+		func(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error) {
+			return indexers.ByPathAndNameWithFallback[*tenancyv1alpha1.WorkspaceType](tenancyv1alpha1.Resource("workspacetypes"), workspaceTypeInformer.Informer().GetIndexer(), globalWorkspaceTypeInformer.Informer().GetIndexer(), path, name)
+		},
+		func(lc *corev1alpha1.LogicalCluster) bool {
+			// Count per resource below a WorkspaceQuota with count/ limits.
+			quotas, err := workspacequota.QuotasFor(workspacequota.PathOf(lc), getWorkspaceQuotasAt)
+			if err != nil {
+				return false
+			}
+			for _, quota := range quotas {
+				if len(workspacequota.CountedResources(quota.Spec.Hard)) > 0 {
+					return true
+				}
+			}
+			return false
+		},
 		s.Options.Extra.ShardName,
 	)
 
@@ -1253,7 +1691,12 @@ func (s *Server) installObjectCountScanner(_ context.Context) error {
 		Name: "kcp-logicalcluster-objectcount-scanner",
 		Wait: func(ctx context.Context, s *Server) error {
 			return wait.PollUntilContextCancel(ctx, waitPollInterval, true, func(ctx context.Context) (bool, error) {
-				return s.KcpSharedInformerFactory.Core().V1alpha1().LogicalClusters().Informer().HasSynced(), nil
+				return s.KcpSharedInformerFactory.Core().V1alpha1().LogicalClusters().Informer().HasSynced() &&
+					s.KcpSharedInformerFactory.Core().V1alpha1().LogicalClusterLimits().Informer().HasSynced() &&
+					workspaceTypeInformer.Informer().HasSynced() &&
+					globalWorkspaceTypeInformer.Informer().HasSynced() &&
+					workspaceQuotaInformer.Informer().HasSynced() &&
+					globalWorkspaceQuotaInformer.Informer().HasSynced(), nil
 			})
 		},
 		Runner: func(ctx context.Context) {
@@ -1671,6 +2114,42 @@ func (s *Server) installPartitionSetController(ctx context.Context, config *rest
 	})
 }
 
+func (s *Server) installPartitionController(ctx context.Context, config *rest.Config) error {
+	config = rest.CopyConfig(config)
+	config = rest.AddUserAgent(config, partition.ControllerName)
+
+	kcpClusterClient, err := kcpclientset.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	c, err := partition.NewController(
+		s.KcpSharedInformerFactory.Topology().V1alpha1().Partitions(),
+		s.KcpSharedInformerFactory.Topology().V1alpha1().PartitionSets(),
+		s.KcpSharedInformerFactory.Apis().V1alpha1().APIExportEndpointSlices(),
+		s.CacheKcpSharedInformerFactory.Core().V1alpha1().Shards(),
+		kcpClusterClient,
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.registerController(&controllerWrapper{
+		Name: partition.ControllerName,
+		Wait: func(ctx context.Context, s *Server) error {
+			return wait.PollUntilContextCancel(ctx, waitPollInterval, true, func(ctx context.Context) (bool, error) {
+				return s.KcpSharedInformerFactory.Topology().V1alpha1().Partitions().Informer().HasSynced() &&
+					s.KcpSharedInformerFactory.Topology().V1alpha1().PartitionSets().Informer().HasSynced() &&
+					s.KcpSharedInformerFactory.Apis().V1alpha1().APIExportEndpointSlices().Informer().HasSynced() &&
+					s.CacheKcpSharedInformerFactory.Core().V1alpha1().Shards().Informer().HasSynced(), nil
+			})
+		},
+		Runner: func(ctx context.Context) {
+			c.Start(ctx, 2)
+		},
+	})
+}
+
 func (s *Server) installExtraAnnotationSyncController(ctx context.Context, config *rest.Config) error {
 	config = rest.CopyConfig(config)
 	config = rest.AddUserAgent(config, extraannotationsync.ControllerName)
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filters
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	corev1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/core/v1alpha1"
+
+	"github.com/kcp-dev/kcp/pkg/hibernation"
+)
+
+// WithWakeHibernatedLogicalClusters records the requests of users to logical
+// clusters in the tracker, and wakes up hibernated logical clusters on the
+// first such request.
+//
+// A hibernated logical cluster keeps serving requests, so the request is
+// never failed because of hibernation. Requests of kcp system components
+// neither count as activity nor wake up a logical cluster, see
+// hibernation.IsActivity. The filter must run after authorization, so that
+// unauthorized requests do not keep a logical cluster awake.
+func WithWakeHibernatedLogicalClusters(handler http.Handler, logicalClusterInformer corev1alpha1informers.LogicalClusterClusterInformer, tracker *hibernation.Tracker, wake func(ctx context.Context, cluster logicalcluster.Name) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		cluster := request.ClusterFrom(req.Context())
+		if cluster == nil || cluster.Wildcard || cluster.Name.Empty() || strings.HasPrefix(cluster.Name.String(), "system:") {
+			handler.ServeHTTP(w, req)
+			return
+		}
+
+		userInfo, ok := request.UserFrom(req.Context())
+		if !ok || !hibernation.IsActivity(userInfo) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+
+		done := tracker.Begin(cluster.Name)
+		defer done()
+
+		logicalCluster, err := logicalClusterInformer.Cluster(cluster.Name).Lister().Get(corev1alpha1.LogicalClusterName)
+		if err == nil && corev1alpha1.IsLogicalClusterHibernated(logicalCluster.Annotations) {
+			if err := wake(req.Context(), cluster.Name); err != nil {
+				// The logical cluster is served nevertheless, the next
+				// request tries again.
+				klog.FromContext(req.Context()).Error(err, "failed to wake up hibernated logical cluster", "cluster", cluster.Name)
+			}
+		}
+
+		handler.ServeHTTP(w, req)
+	}
+}
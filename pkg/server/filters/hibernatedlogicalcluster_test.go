@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filters
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	kcpinformers "github.com/kcp-dev/sdk/client/informers/externalversions"
+
+	"github.com/kcp-dev/kcp/pkg/hibernation"
+)
+
+func TestWithWakeHibernatedLogicalClusters(t *testing.T) {
+	t.Parallel()
+
+	const (
+		hibernated = logicalcluster.Name("hibernated-lc")
+		awake      = logicalcluster.Name("awake-lc")
+	)
+
+	testCases := []struct {
+		name string
+
+		cluster *request.Cluster
+		user    user.Info
+		wakeErr error
+
+		wantWoken   bool
+		wantTracked logicalcluster.Name
+	}{
+		{
+			name:    "no cluster passes through",
+			cluster: nil,
+			user:    &user.DefaultInfo{Name: "alice", Groups: []string{user.AllAuthenticated}},
+		},
+		{
+			name:    "wildcard request is not tracked",
+			cluster: &request.Cluster{Wildcard: true},
+			user:    &user.DefaultInfo{Name: "alice", Groups: []string{user.AllAuthenticated}},
+		},
+		{
+			name:        "user request to an awake cluster is tracked",
+			cluster:     &request.Cluster{Name: awake},
+			user:        &user.DefaultInfo{Name: "alice", Groups: []string{user.AllAuthenticated}},
+			wantTracked: awake,
+		},
+		{
+			name:        "user request wakes a hibernated cluster",
+			cluster:     &request.Cluster{Name: hibernated},
+			user:        &user.DefaultInfo{Name: "alice", Groups: []string{user.AllAuthenticated}},
+			wantWoken:   true,
+			wantTracked: hibernated,
+		},
+		{
+			name:        "failing to wake still serves the request",
+			cluster:     &request.Cluster{Name: hibernated},
+			user:        &user.DefaultInfo{Name: "alice", Groups: []string{user.AllAuthenticated}},
+			wakeErr:     errors.New("boom"),
+			wantWoken:   true,
+			wantTracked: hibernated,
+		},
+		{
+			name:    "privileged system request does not wake a hibernated cluster",
+			cluster: &request.Cluster{Name: hibernated},
+			user:    &user.DefaultInfo{Name: "system:apiserver", Groups: []string{user.SystemPrivilegedGroup}},
+		},
+		{
+			name:    "unauthenticated request does not wake a hibernated cluster",
+			cluster: &request.Cluster{Name: hibernated},
+			user:    &user.DefaultInfo{Name: user.Anonymous, Groups: []string{user.AllUnauthenticated}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			informer := kcpinformers.NewSharedInformerFactory(nil, 0).Core().V1alpha1().LogicalClusters()
+			for name, annotations := range map[logicalcluster.Name]map[string]string{
+				hibernated: {corev1alpha1.LogicalClusterHibernatedAnnotationKey: "2026-01-01T00:00:00Z"},
+				awake:      {},
+			} {
+				annotations[logicalcluster.AnnotationKey] = name.String()
+				require.NoError(t, informer.Informer().GetIndexer().Add(&corev1alpha1.LogicalCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: corev1alpha1.LogicalClusterName, Annotations: annotations},
+				}))
+			}
+
+			tracker := hibernation.NewTracker()
+			var woken logicalcluster.Name
+			var inFlight bool
+			handler := WithWakeHibernatedLogicalClusters(
+				http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+					if tc.wantTracked != "" {
+						_, idle := tracker.IdleSince(tc.wantTracked)
+						inFlight = !idle
+					}
+					w.WriteHeader(http.StatusOK)
+				}),
+				informer,
+				tracker,
+				func(_ context.Context, cluster logicalcluster.Name) error {
+					woken = cluster
+					return tc.wakeErr
+				},
+			)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/api/v1/configmaps", http.NoBody)
+			ctx := req.Context()
+			if tc.cluster != nil {
+				ctx = request.WithCluster(ctx, *tc.cluster)
+			}
+			ctx = request.WithUser(ctx, tc.user)
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req.WithContext(ctx))
+
+			require.Equal(t, http.StatusOK, rec.Code)
+			if tc.wantWoken {
+				require.Equal(t, hibernated, woken)
+			} else {
+				require.Empty(t, woken)
+			}
+			if tc.wantTracked != "" {
+				require.True(t, inFlight, "expected the request to be in flight while served")
+				_, idle := tracker.IdleSince(tc.wantTracked)
+				require.True(t, idle, "expected the cluster to be idle after the request")
+			}
+		})
+	}
+}
@@ -22,15 +22,20 @@ import (
 	"github.com/kcp-dev/kcp/pkg/virtual/migratingworkspaces"
 )
 
-// WithMigrationDumpHandler intercepts POSTs to the LogicalClusterDump path
-// before the kube REST chain rejects the unknown migration.kcp.io API group
-// with a 503. All other requests fall through to next.
-func WithMigrationDumpHandler(next http.Handler, dump http.Handler) http.HandlerFunc {
+// WithMigrationDumpHandler intercepts POSTs to the LogicalClusterDump and
+// LogicalClusterRestore paths before the kube REST chain rejects the
+// unknown migration.kcp.io API group with a 503. All other requests fall
+// through to next.
+func WithMigrationDumpHandler(next http.Handler, dump, restore http.Handler) http.HandlerFunc {
 	return func(w http.ResponseWriter, req *http.Request) {
 		if req.Method == http.MethodPost && req.URL.Path == migratingworkspaces.HandlerPath {
 			dump.ServeHTTP(w, req)
 			return
 		}
+		if req.Method == http.MethodPost && req.URL.Path == migratingworkspaces.RestoreHandlerPath {
+			restore.ServeHTTP(w, req)
+			return
+		}
 		next.ServeHTTP(w, req)
 	}
 }
@@ -0,0 +1,268 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationdump
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	"github.com/kcp-dev/sdk/apis/core"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	migrationv1alpha1 "github.com/kcp-dev/sdk/apis/migration/v1alpha1"
+
+	kcpetcd "github.com/kcp-dev/kcp/pkg/etcd"
+)
+
+var (
+	// errInvalidEntry is returned by restoreEntries for entries that cannot
+	// be restored at all.
+	errInvalidEntry = errors.New("invalid entry")
+	// errConflict is returned by restoreEntries if restoring would
+	// overwrite existing data.
+	errConflict = errors.New("conflict")
+)
+
+// ServeRestore serves POST requests to RestoreHandlerPath by writing the
+// LogicalClusterRestore entries into the cluster carried in the request
+// context.
+func (h *Handler) ServeRestore(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := klog.FromContext(ctx)
+	resource := migrationv1alpha1.Resource("logicalclusterrestores")
+
+	cluster, ok := authorize(w, r, resource)
+	if !ok {
+		return
+	}
+
+	if h.migratingLogicalClusters.IsMigrating(cluster) {
+		writeError(w, r, apierrors.NewConflict(resource, cluster.String(), errors.New("logical cluster is being migrated")))
+		return
+	}
+
+	var restore migrationv1alpha1.LogicalClusterRestore
+	if err := json.NewDecoder(r.Body).Decode(&restore); err != nil {
+		writeError(w, r, apierrors.NewBadRequest(fmt.Sprintf("failed to decode request body: %v", err)))
+		return
+	}
+	if restore.Spec.SourceLogicalCluster == "" {
+		writeError(w, r, apierrors.NewBadRequest("spec.sourceLogicalCluster is required"))
+		return
+	}
+
+	logger.V(2).Info("restoring logical cluster page to etcd", "cluster", cluster, "source", restore.Spec.SourceLogicalCluster, "entries", len(restore.Spec.Entries))
+
+	restored, err := restoreEntries(ctx, h.etcdClient, h.etcdStoragePrefix, logicalcluster.Name(restore.Spec.SourceLogicalCluster), cluster, restore.Spec.Entries)
+	switch {
+	case errors.Is(err, errInvalidEntry):
+		writeError(w, r, apierrors.NewBadRequest(err.Error()))
+		return
+	case errors.Is(err, errConflict):
+		writeError(w, r, apierrors.NewConflict(resource, cluster.String(), err))
+		return
+	case err != nil:
+		writeError(w, r, apierrors.NewInternalError(fmt.Errorf("failed to restore logical cluster: %w", err)))
+		return
+	}
+
+	resp := &migrationv1alpha1.LogicalClusterRestore{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: migrationv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "LogicalClusterRestore",
+		},
+		ObjectMeta: restore.ObjectMeta,
+		Status: migrationv1alpha1.LogicalClusterRestoreStatus{
+			Restored: restored,
+		},
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		logger.Error(err, "failed to write restore response")
+	}
+}
+
+// restoreEntries writes entries dumped from the source logical cluster
+// into the target logical cluster, rewriting the cluster segment of every
+// key. It returns the number of entries written.
+//
+// Restoring into another logical cluster also drops the path annotations
+// and the owner of the LogicalCluster object, see restoreValue, as the
+// target is not the workspace the entries were dumped from.
+//
+// Entries that already exist with the same value are skipped, so a page
+// can be retried after a partial failure. An entry that exists with a
+// different value fails with errConflict. So does restoring into a target
+// that already has a LogicalCluster object, unless the page restores that
+// very object, so that existing logical clusters are never merged into.
+// All entries are validated before the first one is written.
+func restoreEntries(ctx context.Context, kv clientv3.KV, storagePrefix string, source, target logicalcluster.Name, entries []migrationv1alpha1.EtcdEntry) (int64, error) {
+	prefix := storagePrefix
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	keys := make([]string, len(entries))
+	values := make([][]byte, len(entries))
+	for i, entry := range entries {
+		split, ok := kcpetcd.SplitKey("", strings.TrimPrefix(entry.Key, "/"), source)
+		if !ok || split.Cluster != source {
+			return 0, fmt.Errorf("%w: key %q does not belong to logical cluster %s", errInvalidEntry, entry.Key, source)
+		}
+		if len(entry.Value) == 0 {
+			return 0, fmt.Errorf("%w: key %q has no value", errInvalidEntry, entry.Key)
+		}
+		value, err := restoreValue(split, entry.Value, source, target)
+		if err != nil {
+			return 0, fmt.Errorf("%w: key %q cannot be decoded: %v", errInvalidEntry, entry.Key, err)
+		}
+		values[i] = value
+		split.Cluster = target
+		keys[i] = split.Key(prefix)
+	}
+
+	for _, segment := range []string{"customresources", ""} {
+		lcKey := kcpetcd.KeyParts{
+			Group:    core.GroupName,
+			Resource: "logicalclusters",
+			Segment:  segment,
+			Cluster:  target,
+			Rest:     corev1alpha1.LogicalClusterName,
+		}.Key(prefix)
+
+		resp, err := kv.Get(ctx, lcKey)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get %s: %w", lcKey, err)
+		}
+		if len(resp.Kvs) == 0 {
+			continue
+		}
+		restoresLogicalCluster := false
+		for i, key := range keys {
+			if key == lcKey && bytes.Equal(values[i], resp.Kvs[0].Value) {
+				restoresLogicalCluster = true
+			}
+		}
+		if !restoresLogicalCluster {
+			return 0, fmt.Errorf("%w: logical cluster %s already exists", errConflict, target)
+		}
+	}
+
+	var restored int64
+	for i, key := range keys {
+		if err := ctx.Err(); err != nil {
+			return restored, err
+		}
+
+		resp, err := kv.Txn(ctx).
+			If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).
+			Then(clientv3.OpPut(key, string(values[i]))).
+			Else(clientv3.OpGet(key)).
+			Commit()
+		if err != nil {
+			return restored, fmt.Errorf("failed to write %s: %w", key, err)
+		}
+		if resp.Succeeded {
+			restored++
+			continue
+		}
+
+		existing := resp.Responses[0].GetResponseRange().GetKvs()
+		if len(existing) == 0 || !bytes.Equal(existing[0].Value, values[i]) {
+			return restored, fmt.Errorf("%w: key %s already exists with a different value", errConflict, strings.TrimPrefix(key, prefix))
+		}
+	}
+
+	return restored, nil
+}
+
+// restoreValue returns the value to store in the target logical cluster for
+// the object stored under split in the source. Restored into another logical
+// cluster, objects lose their path annotation, which points to the source's
+// workspace and would make objects resolved by path and name, e.g.
+// WorkspaceTypes, ambiguous next to a live source. The annotation is set
+// again by the next update through the API. The LogicalCluster object also
+// loses its owner, as the workspace of the source does not own the target.
+func restoreValue(split kcpetcd.KeyParts, value []byte, source, target logicalcluster.Name) ([]byte, error) {
+	if source == target {
+		return value, nil
+	}
+
+	if kcpetcd.IsProtobuf(value) {
+		restored, _, err := kcpetcd.RewriteProtobufMeta(value, func(meta *metav1.ObjectMeta) bool {
+			delete(meta.Annotations, core.LogicalClusterPathAnnotationKey)
+			return true
+		})
+		return restored, err
+	}
+
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(value, &obj); err != nil {
+		return nil, err
+	}
+	changed := false
+
+	var meta metav1.ObjectMeta
+	if err := json.Unmarshal(obj["metadata"], &meta); err != nil {
+		return nil, fmt.Errorf("failed to decode metadata: %w", err)
+	}
+	if _, ok := meta.Annotations[core.LogicalClusterPathAnnotationKey]; ok {
+		delete(meta.Annotations, core.LogicalClusterPathAnnotationKey)
+		data, err := json.Marshal(meta)
+		if err != nil {
+			return nil, err
+		}
+		obj["metadata"] = data
+		changed = true
+	}
+
+	if split.Group == core.GroupName && split.Resource == "logicalclusters" {
+		var spec map[string]json.RawMessage
+		if raw, ok := obj["spec"]; ok {
+			if err := json.Unmarshal(raw, &spec); err != nil {
+				return nil, fmt.Errorf("failed to decode spec: %w", err)
+			}
+		}
+		if _, ok := spec["owner"]; ok {
+			delete(spec, "owner")
+			data, err := json.Marshal(spec)
+			if err != nil {
+				return nil, err
+			}
+			obj["spec"] = data
+			changed = true
+		}
+	}
+
+	if !changed {
+		return value, nil
+	}
+	return json.Marshal(obj)
+}
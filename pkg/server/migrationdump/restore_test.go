@@ -0,0 +1,234 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationdump
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer/protobuf"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	"github.com/kcp-dev/sdk/apis/core"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	migrationv1alpha1 "github.com/kcp-dev/sdk/apis/migration/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+)
+
+func TestRestoreEntries_rewritesKeysToTheTargetCluster(t *testing.T) {
+	t.Parallel()
+
+	kv := newFakeKV(map[string]string{
+		"/registry/apps/deployments/other/default/foo": "other",
+	})
+
+	restored, err := restoreEntries(context.Background(), kv, "/registry", "source", "target", []migrationv1alpha1.EtcdEntry{
+		{Key: "apps/deployments/source/default/foo", Value: []byte(`{"metadata":{"name":"deployment"}}`)},
+		{Key: "rbac.authorization.k8s.io/clusterroles/source/admin", Value: []byte(`{"metadata":{"name":"clusterrole"}}`)},
+		{Key: "mygroup.io/widgets/customresources/source/default/w", Value: []byte(`{"metadata":{"name":"widget"}}`)},
+		{Key: "mygroup.io/widgets/abc123def/source/w", Value: []byte(`{"metadata":{"name":"bound"}}`)},
+		{Key: "core.kcp.io/logicalclusters/customresources/source/cluster", Value: []byte(`{"metadata":{"name":"lc"}}`)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), restored)
+	require.Equal(t, map[string]string{
+		"/registry/apps/deployments/other/default/foo":                         "other",
+		"/registry/apps/deployments/target/default/foo":                        `{"metadata":{"name":"deployment"}}`,
+		"/registry/rbac.authorization.k8s.io/clusterroles/target/admin":        `{"metadata":{"name":"clusterrole"}}`,
+		"/registry/mygroup.io/widgets/customresources/target/default/w":        `{"metadata":{"name":"widget"}}`,
+		"/registry/mygroup.io/widgets/abc123def/target/w":                      `{"metadata":{"name":"bound"}}`,
+		"/registry/core.kcp.io/logicalclusters/customresources/target/cluster": `{"metadata":{"name":"lc"}}`,
+	}, kv.kvs)
+}
+
+func TestRestoreEntries_retryingAPageIsIdempotent(t *testing.T) {
+	t.Parallel()
+
+	kv := newFakeKV(map[string]string{})
+	entries := []migrationv1alpha1.EtcdEntry{
+		{Key: "apps/deployments/source/default/foo", Value: []byte(`{"metadata":{"name":"deployment"}}`)},
+		{Key: "core.kcp.io/logicalclusters/customresources/source/cluster", Value: []byte(`{"metadata":{"name":"lc"}}`)},
+	}
+
+	restored, err := restoreEntries(context.Background(), kv, "/registry/", "source", "target", entries)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), restored)
+
+	restored, err = restoreEntries(context.Background(), kv, "/registry/", "source", "target", entries)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), restored, "entries that already exist with the same value must be skipped")
+}
+
+func TestRestoreEntries_rejects(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		existing    map[string]string
+		entries     []migrationv1alpha1.EtcdEntry
+		wantErr     error
+		wantWritten bool
+	}{
+		"keys of other logical clusters": {
+			entries: []migrationv1alpha1.EtcdEntry{
+				{Key: "apps/deployments/source/default/foo", Value: []byte(`{"metadata":{"name":"deployment"}}`)},
+				{Key: "apps/deployments/other/default/foo", Value: []byte(`{"metadata":{"name":"deployment"}}`)},
+			},
+			wantErr: errInvalidEntry,
+		},
+		"entries without value": {
+			entries: []migrationv1alpha1.EtcdEntry{
+				{Key: "apps/deployments/source/default/foo"},
+			},
+			wantErr: errInvalidEntry,
+		},
+		"restoring into an existing logical cluster": {
+			existing: map[string]string{
+				"/registry/core.kcp.io/logicalclusters/customresources/target/cluster": "existing",
+			},
+			entries: []migrationv1alpha1.EtcdEntry{
+				{Key: "apps/deployments/source/default/foo", Value: []byte(`{"metadata":{"name":"deployment"}}`)},
+			},
+			wantErr: errConflict,
+		},
+		"overwriting a different value": {
+			existing: map[string]string{
+				"/registry/apps/deployments/target/default/bar": "existing",
+			},
+			entries: []migrationv1alpha1.EtcdEntry{
+				{Key: "apps/deployments/source/default/foo", Value: []byte(`{"metadata":{"name":"deployment"}}`)},
+				{Key: "apps/deployments/source/default/bar", Value: []byte(`{"metadata":{"name":"deployment"}}`)},
+			},
+			wantErr:     errConflict,
+			wantWritten: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			existing := map[string]string{}
+			for k, v := range tc.existing {
+				existing[k] = v
+			}
+			kv := newFakeKV(existing)
+
+			_, err := restoreEntries(context.Background(), kv, "/registry/", logicalcluster.Name("source"), logicalcluster.Name("target"), tc.entries)
+			require.ErrorIs(t, err, tc.wantErr)
+
+			_, written := kv.kvs["/registry/apps/deployments/target/default/foo"]
+			require.Equal(t, tc.wantWritten, written, "entries must only be written once the whole page validated")
+		})
+	}
+}
+
+func TestRestoreEntries_nextToTheSource(t *testing.T) {
+	t.Parallel()
+
+	// The source is still live, with its objects annotated with its path.
+	source := map[string]string{
+		"/registry/core.kcp.io/logicalclusters/customresources/source/cluster":  `{"metadata":{"name":"cluster","annotations":{"kcp.io/path":"root:team"}},"spec":{"owner":{"apiVersion":"tenancy.kcp.io/v1alpha1","resource":"workspaces","name":"team","cluster":"root","uid":"team-uid"}}}`,
+		"/registry/tenancy.kcp.io/workspacetypes/customresources/source/team":   `{"metadata":{"name":"team","annotations":{"kcp.io/path":"root:team"}}}`,
+		"/registry/apis.kcp.io/apiexports/customresources/source/widgets":       `{"metadata":{"name":"widgets","annotations":{"kcp.io/path":"root:team"}}}`,
+		"/registry/tenancy.kcp.io/workspacequotas/customresources/source/quota": `{"metadata":{"name":"quota","annotations":{"kcp.io/path":"root:team","foo":"bar"}}}`,
+		"/registry/apps/deployments/source/default/foo":                         `{"metadata":{"name":"foo"}}`,
+		"/registry/apis.kcp.io/apibindings/customresources/source/tenancy":      `{"metadata":{"name":"tenancy","annotations":{"kcp.io/path":"root:team"}},"spec":{"reference":{"export":{"path":"root","name":"tenancy"}}}}`,
+	}
+	existing := map[string]string{}
+	var entries []migrationv1alpha1.EtcdEntry
+	for key, value := range source {
+		existing[key] = value
+		entries = append(entries, migrationv1alpha1.EtcdEntry{Key: key[len("/registry/"):], Value: []byte(value)})
+	}
+	entries = append(entries, migrationv1alpha1.EtcdEntry{Key: "core/configmaps/source/default/cm", Value: encodeProtobuf(t, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "default", Annotations: map[string]string{core.LogicalClusterPathAnnotationKey: "root:team"}},
+		Data:       map[string]string{"foo": "bar"},
+	})})
+	kv := newFakeKV(existing)
+
+	restored, err := restoreEntries(context.Background(), kv, "/registry", "source", "target", entries)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(entries)), restored)
+
+	for key, value := range source {
+		require.Equal(t, value, kv.kvs[key], "the source must not be touched")
+	}
+
+	var lc corev1alpha1.LogicalCluster
+	require.NoError(t, json.Unmarshal([]byte(kv.kvs["/registry/core.kcp.io/logicalclusters/customresources/target/cluster"]), &lc))
+	require.NotContains(t, lc.Annotations, core.LogicalClusterPathAnnotationKey)
+	require.Nil(t, lc.Spec.Owner, "the workspace of the source does not own the target")
+
+	for _, key := range []string{
+		"/registry/tenancy.kcp.io/workspacetypes/customresources/target/team",
+		"/registry/apis.kcp.io/apiexports/customresources/target/widgets",
+		"/registry/apis.kcp.io/apibindings/customresources/target/tenancy",
+	} {
+		var meta struct {
+			Metadata metav1.ObjectMeta `json:"metadata"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(kv.kvs[key]), &meta))
+		require.NotContains(t, meta.Metadata.Annotations, core.LogicalClusterPathAnnotationKey, key)
+	}
+
+	var quota tenancyv1alpha1.WorkspaceQuota
+	require.NoError(t, json.Unmarshal([]byte(kv.kvs["/registry/tenancy.kcp.io/workspacequotas/customresources/target/quota"]), &quota))
+	require.Equal(t, map[string]string{"foo": "bar"}, quota.Annotations)
+
+	var binding map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(kv.kvs["/registry/apis.kcp.io/apibindings/customresources/target/tenancy"]), &binding))
+	require.Equal(t, map[string]interface{}{"reference": map[string]interface{}{"export": map[string]interface{}{"path": "root", "name": "tenancy"}}}, binding["spec"])
+	require.Equal(t, `{"metadata":{"name":"foo"}}`, kv.kvs["/registry/apps/deployments/target/default/foo"], "values without path annotation are restored verbatim")
+
+	obj, _, err := scheme.Codecs.UniversalDeserializer().Decode([]byte(kv.kvs["/registry/core/configmaps/target/default/cm"]), nil, nil)
+	require.NoError(t, err)
+	cm := obj.(*corev1.ConfigMap)
+	require.NotContains(t, cm.Annotations, core.LogicalClusterPathAnnotationKey)
+	require.Equal(t, map[string]string{"foo": "bar"}, cm.Data)
+}
+
+func TestRestoreEntries_intoTheSourceKeepsValues(t *testing.T) {
+	t.Parallel()
+
+	value := `{"metadata":{"name":"cluster","annotations":{"kcp.io/path":"root:team"}},"spec":{"owner":{"name":"team"}}}`
+	kv := newFakeKV(map[string]string{})
+	_, err := restoreEntries(context.Background(), kv, "/registry", "source", "source", []migrationv1alpha1.EtcdEntry{
+		{Key: "core.kcp.io/logicalclusters/customresources/source/cluster", Value: []byte(value)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, value, kv.kvs["/registry/core.kcp.io/logicalclusters/customresources/source/cluster"])
+}
+
+func encodeProtobuf(t *testing.T, obj runtime.Object) []byte {
+	t.Helper()
+
+	gvks, _, err := scheme.Scheme.ObjectKinds(obj)
+	require.NoError(t, err)
+	obj.GetObjectKind().SetGroupVersionKind(gvks[0])
+
+	var buf bytes.Buffer
+	require.NoError(t, protobuf.NewSerializer(scheme.Scheme, scheme.Scheme).Encode(obj, &buf))
+	return buf.Bytes()
+}
@@ -19,11 +19,13 @@ package migrationdump
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"slices"
 	"strings"
 
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	clientv3 "go.etcd.io/etcd/client/v3"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -51,13 +53,19 @@ func init() {
 	errorScheme.AddUnversionedTypes(metav1.Unversioned, &metav1.Status{})
 }
 
-// migratingClusters is a local interface for checking if a cluster is migrating.
+// migratingClusters is a local interface for checking if a cluster may be
+// dumped or restored.
 type migratingClusters interface {
+	IsDumpable(name logicalcluster.Name) bool
 	IsMigrating(name logicalcluster.Name) bool
 }
 
 // Handler serves POST requests to HandlerPath by dumping every etcd entry
-// that belongs to the cluster carried in the request context.
+// that belongs to the cluster carried in the request context, and POST
+// requests to RestoreHandlerPath through ServeRestore.
+//
+// Besides migrations, dumps and restores are used to back up and restore
+// single logical clusters.
 type Handler struct {
 	etcdClient               *clientv3.Client
 	etcdStoragePrefix        string
@@ -84,45 +92,11 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	logger := klog.FromContext(ctx)
 
-	if r.Method != http.MethodPost {
-		writeError(w, r, apierrors.NewMethodNotSupported(
-			migrationv1alpha1.Resource("logicalclusterdumps"),
-			r.Method,
-		))
-		return
-	}
-
-	cluster := genericapirequest.ClusterFrom(ctx)
-	if cluster == nil || cluster.Name.Empty() {
-		writeError(w, r, apierrors.NewBadRequest("no cluster in context"))
-		return
-	}
-
-	user, ok := genericapirequest.UserFrom(ctx)
-	if !ok || user == nil {
-		writeError(w, r, apierrors.NewUnauthorized("no user info"))
-		return
-	}
-	if !slices.Contains(user.GetGroups(), bootstrappolicy.SystemExternalLogicalClusterAdmin) {
-		writeError(w, r, apierrors.NewForbidden(
-			migrationv1alpha1.Resource("logicalclusterdumps"),
-			"",
-			fmt.Errorf("user is not in group %s", bootstrappolicy.SystemExternalLogicalClusterAdmin),
-		))
-		return
-	}
-
-	if !h.migratingLogicalClusters.IsMigrating(cluster.Name) {
-		writeError(w, r, apierrors.NewNotFound(
-			migrationv1alpha1.Resource("logicalclusterdumps"),
-			string(cluster.Name),
-		))
+	cluster, ok := authorize(w, r, migrationv1alpha1.Resource("logicalclusterdumps"))
+	if !ok {
 		return
 	}
 
-	// Decode the request body. Spec is empty today so we don't actually
-	// need anything from it, but parsing it ensures the client sent
-	// something resembling the expected type.
 	var dump migrationv1alpha1.LogicalClusterDump
 	if r.ContentLength != 0 {
 		if err := json.NewDecoder(r.Body).Decode(&dump); err != nil {
@@ -131,9 +105,20 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	logger.V(2).Info("dumping logical cluster page from etcd", "cluster", cluster.Name, "continue", dump.Spec.Continue)
+	// Logical clusters that are not being migrated keep being written
+	// to, paging through them is only consistent at a pinned revision.
+	if dump.Spec.Continue != "" && dump.Spec.Revision <= 0 && !h.migratingLogicalClusters.IsDumpable(cluster) {
+		writeError(w, r, apierrors.NewBadRequest("spec.revision is required to continue dumping a logical cluster that is not being migrated"))
+		return
+	}
+
+	logger.V(2).Info("dumping logical cluster page from etcd", "cluster", cluster, "continue", dump.Spec.Continue, "sinceRevision", dump.Spec.SinceRevision, "revision", dump.Spec.Revision)
 
-	entries, nextContinue, err := scanEtcdEntries(ctx, h.etcdClient, h.etcdStoragePrefix, cluster.Name, dump.Spec.Continue, dump.Spec.Limit, dump.Spec.MaxBytes)
+	entries, nextContinue, revision, err := scanEtcdEntries(ctx, h.etcdClient, h.etcdStoragePrefix, cluster, dump.Spec.Continue, dump.Spec.Limit, dump.Spec.MaxBytes, dump.Spec.SinceRevision, dump.Spec.Revision)
+	if errors.Is(err, rpctypes.ErrCompacted) {
+		writeError(w, r, apierrors.NewResourceExpired(fmt.Sprintf("revision %d has been compacted", dump.Spec.Revision)))
+		return
+	}
 	if err != nil {
 		writeError(w, r, apierrors.NewInternalError(fmt.Errorf("failed to dump logical cluster: %w", err)))
 		return
@@ -148,6 +133,7 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		Status: migrationv1alpha1.LogicalClusterDumpStatus{
 			Entries:  entries,
 			Continue: nextContinue,
+			Revision: revision,
 		},
 	}
 
@@ -167,10 +153,18 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 // alone, so the scan always makes progress). If limit or maxBytes is zero
 // or negative, a default is used.
 //
+// If sinceRevision is set, entries not modified after that etcd revision
+// are still returned, but without their value, and don't count against
+// maxBytes. This lets the caller both pick up changed entries and detect
+// deleted ones.
+//
+// If revision is set, all range reads are served at that etcd revision.
+//
 // The second return value is the continue token for the next page, or the
 // empty string if the scan reached the end of the logical cluster's
-// keyspace.
-func scanEtcdEntries(ctx context.Context, kv clientv3.KV, storagePrefix string, target logicalcluster.Name, continueToken string, limit, maxBytes int64) ([]migrationv1alpha1.EtcdEntry, string, error) {
+// keyspace. The third is the etcd revision the first range read of the page
+// was served at.
+func scanEtcdEntries(ctx context.Context, kv clientv3.KV, storagePrefix string, target logicalcluster.Name, continueToken string, limit, maxBytes, sinceRevision, revision int64) ([]migrationv1alpha1.EtcdEntry, string, int64, error) {
 	prefix := storagePrefix
 	if !strings.HasSuffix(prefix, "/") {
 		prefix += "/"
@@ -196,43 +190,88 @@ func scanEtcdEntries(ctx context.Context, kv clientv3.KV, storagePrefix string,
 		etcdScanLimit = kcpetcd.ScanPageSize
 	}
 
+	opts := []clientv3.OpOption{
+		clientv3.WithRange(clientv3.GetPrefixRangeEnd(prefix)),
+		clientv3.WithLimit(etcdScanLimit),
+	}
+	if revision > 0 {
+		opts = append(opts, clientv3.WithRev(revision))
+	}
+
 	var entries []migrationv1alpha1.EtcdEntry
 	var totalBytes int64
 	for {
-		resp, err := kv.Get(ctx, key,
-			clientv3.WithRange(clientv3.GetPrefixRangeEnd(prefix)),
-			clientv3.WithLimit(etcdScanLimit),
-		)
+		resp, err := kv.Get(ctx, key, opts...)
 		if err != nil {
-			return nil, "", fmt.Errorf("failed to list etcd keys: %w", err)
+			return nil, "", 0, fmt.Errorf("failed to list etcd keys: %w", err)
+		}
+		if revision <= 0 {
+			revision = resp.Header.GetRevision()
 		}
 
 		for _, entry := range resp.Kvs {
 			if err := ctx.Err(); err != nil {
-				return nil, "", err
+				return nil, "", 0, err
 			}
 			if !kcpetcd.BelongsToCluster(prefix, string(entry.Key), target) {
 				continue
 			}
 
-			if int64(len(entries)) >= limit || (len(entries) > 0 && totalBytes+int64(len(entry.Value)) > maxBytes) {
-				return entries, strings.TrimPrefix(string(entry.Key), prefix), nil
+			var value []byte
+			if sinceRevision <= 0 || entry.ModRevision > sinceRevision {
+				value = entry.Value
+			}
+
+			if int64(len(entries)) >= limit || (len(entries) > 0 && len(value) > 0 && totalBytes+int64(len(value)) > maxBytes) {
+				return entries, strings.TrimPrefix(string(entry.Key), prefix), revision, nil
 			}
 
 			entries = append(entries, migrationv1alpha1.EtcdEntry{
 				Key:   strings.TrimPrefix(string(entry.Key), prefix),
-				Value: append([]byte(nil), entry.Value...),
+				Value: append([]byte(nil), value...),
 			})
-			totalBytes += int64(len(entry.Value))
+			totalBytes += int64(len(value))
 		}
 
 		if !resp.More {
-			return entries, "", nil
+			return entries, "", revision, nil
 		}
 		key = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
 	}
 }
 
+// authorize writes an error response and returns false unless r is a POST
+// to a logical cluster by a member of the
+// system:kcp:external-logical-cluster-admin group.
+func authorize(w http.ResponseWriter, r *http.Request, resource schema.GroupResource) (logicalcluster.Name, bool) {
+	if r.Method != http.MethodPost {
+		writeError(w, r, apierrors.NewMethodNotSupported(resource, r.Method))
+		return "", false
+	}
+
+	cluster := genericapirequest.ClusterFrom(r.Context())
+	if cluster == nil || cluster.Name.Empty() {
+		writeError(w, r, apierrors.NewBadRequest("no cluster in context"))
+		return "", false
+	}
+
+	user, ok := genericapirequest.UserFrom(r.Context())
+	if !ok || user == nil {
+		writeError(w, r, apierrors.NewUnauthorized("no user info"))
+		return "", false
+	}
+	if !slices.Contains(user.GetGroups(), bootstrappolicy.SystemExternalLogicalClusterAdmin) {
+		writeError(w, r, apierrors.NewForbidden(
+			resource,
+			"",
+			fmt.Errorf("user is not in group %s", bootstrappolicy.SystemExternalLogicalClusterAdmin),
+		))
+		return "", false
+	}
+
+	return cluster.Name, true
+}
+
 func writeError(w http.ResponseWriter, r *http.Request, err error) {
 	responsewriters.ErrorNegotiated(err, errorCodecs, schema.GroupVersion{}, w, r)
 }
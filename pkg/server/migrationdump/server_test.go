@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
 
@@ -43,7 +44,7 @@ func TestScanEtcdEntries_singlePageReturnsEverythingWhenItFits(t *testing.T) {
 		"/registry/apps/deployments/root:other/default/x": "v3",
 	})
 
-	entries, next, err := scanEtcdEntries(context.Background(), kv, prefix, target, "", 0, 0)
+	entries, next, _, err := scanEtcdEntries(context.Background(), kv, prefix, target, "", 0, 0, 0, 0)
 	require.NoError(t, err)
 	require.Empty(t, next)
 
@@ -77,7 +78,7 @@ func TestScanEtcdEntries_limitPaginatesAndCoversAllEntriesAcrossPages(t *testing
 		pages++
 		require.LessOrEqual(t, pages, len(kvs)+1, "too many pages, pagination is likely stuck")
 
-		entries, next, err := scanEtcdEntries(context.Background(), kv, prefix, target, continueToken, 2, 0)
+		entries, next, _, err := scanEtcdEntries(context.Background(), kv, prefix, target, continueToken, 2, 0, 0, 0)
 		require.NoError(t, err)
 		require.LessOrEqual(t, len(entries), 2)
 
@@ -114,7 +115,7 @@ func TestScanEtcdEntries_maxBytesStopsPageBeforeLimit(t *testing.T) {
 		"/registry/apps/deployments/root:ws/default/c": "0123456789",
 	})
 
-	entries, next, err := scanEtcdEntries(context.Background(), kv, prefix, target, "", 1000, 25)
+	entries, next, _, err := scanEtcdEntries(context.Background(), kv, prefix, target, "", 1000, 25, 0, 0)
 	require.NoError(t, err)
 	require.Len(t, entries, 2)
 	require.NotEmpty(t, next)
@@ -132,7 +133,7 @@ func TestScanEtcdEntries_singleEntryLargerThanMaxBytesIsStillReturnedAlone(t *te
 		"/registry/apps/deployments/root:ws/default/small2": "v",
 	})
 
-	entries, next, err := scanEtcdEntries(context.Background(), kv, prefix, target, "", 1000, 10)
+	entries, next, _, err := scanEtcdEntries(context.Background(), kv, prefix, target, "", 1000, 10, 0, 0)
 	require.NoError(t, err)
 	require.Len(t, entries, 1, "the oversized entry must be returned alone, not dropped")
 	require.NotEmpty(t, next, "pagination must continue after the oversized entry")
@@ -151,12 +152,12 @@ func TestScanEtcdEntries_resumesFromContinueTokenWithoutDuplicatesOrGaps(t *test
 		"/registry/apps/deployments/root:ws/default/d": "v",
 	})
 
-	first, next, err := scanEtcdEntries(context.Background(), kv, prefix, target, "", 2, 0)
+	first, next, _, err := scanEtcdEntries(context.Background(), kv, prefix, target, "", 2, 0, 0, 0)
 	require.NoError(t, err)
 	require.Len(t, first, 2)
 	require.NotEmpty(t, next)
 
-	second, next2, err := scanEtcdEntries(context.Background(), kv, prefix, target, next, 1000, 0)
+	second, next2, _, err := scanEtcdEntries(context.Background(), kv, prefix, target, next, 1000, 0, 0, 0)
 	require.NoError(t, err)
 	require.Empty(t, next2)
 
@@ -181,12 +182,67 @@ func TestScanEtcdEntries_ignoresOtherClusters(t *testing.T) {
 		"/registry/core/configmaps/root:other/default/b":  "v",
 	})
 
-	entries, next, err := scanEtcdEntries(context.Background(), kv, prefix, target, "", 0, 0)
+	entries, next, _, err := scanEtcdEntries(context.Background(), kv, prefix, target, "", 0, 0, 0, 0)
 	require.NoError(t, err)
 	require.Empty(t, next)
 	require.Empty(t, entries)
 }
 
+func TestScanEtcdEntries_sinceRevisionOnlyCarriesValuesOfChangedEntries(t *testing.T) {
+	t.Parallel()
+
+	prefix := "/registry/"
+	target := logicalcluster.Name("root:ws")
+
+	kv := newFakeKV(map[string]string{
+		"/registry/apps/deployments/root:ws/default/old":     "0123456789",
+		"/registry/apps/deployments/root:ws/default/changed": "0123456789",
+	})
+	kv.revision = 20
+	kv.modRevisions = map[string]int64{
+		"/registry/apps/deployments/root:ws/default/old":     5,
+		"/registry/apps/deployments/root:ws/default/changed": 15,
+	}
+
+	// The byte budget only fits one value, unchanged entries must not
+	// count against it.
+	entries, next, revision, err := scanEtcdEntries(context.Background(), kv, prefix, target, "", 0, 10, 10, 0)
+	require.NoError(t, err)
+	require.Empty(t, next)
+	require.Equal(t, int64(20), revision)
+
+	values := map[string]string{}
+	for _, e := range entries {
+		values[e.Key] = string(e.Value)
+	}
+	require.Equal(t, map[string]string{
+		"apps/deployments/root:ws/default/old":     "",
+		"apps/deployments/root:ws/default/changed": "0123456789",
+	}, values, "unchanged entries must still be listed, without their value")
+}
+
+func TestScanEtcdEntries_pinnedRevisionIsUsedForAllReads(t *testing.T) {
+	t.Parallel()
+
+	prefix := "/registry/"
+	target := logicalcluster.Name("root:ws")
+
+	kv := newFakeKV(map[string]string{
+		"/registry/apps/deployments/root:ws/default/a": "v1",
+		"/registry/apps/deployments/root:ws/default/b": "v2",
+		"/registry/apps/deployments/root:ws/default/c": "v3",
+	})
+	kv.revision = 42
+
+	_, _, revision, err := scanEtcdEntries(context.Background(), kv, prefix, target, "", 0, 0, 0, 7)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), revision, "the pinned revision must be returned rather than the current one")
+	require.NotEmpty(t, kv.requestedRevisions)
+	for _, rev := range kv.requestedRevisions {
+		require.Equal(t, int64(7), rev)
+	}
+}
+
 func entryKeys(entries []migrationv1alpha1.EtcdEntry) []string {
 	keys := make([]string, len(entries))
 	for i, e := range entries {
@@ -198,11 +254,19 @@ func entryKeys(entries []migrationv1alpha1.EtcdEntry) []string {
 // fakeKV is a minimal in-memory fake of clientv3.KV for unit-testing range
 // scans, mirroring the one used in
 // pkg/reconciler/migration/logicalclustermigration/datacleanup_test.go. It
-// supports only the operations scanEtcdEntries uses: Get with a range end
-// and an optional limit. Other methods are not implemented and panic if
-// called.
+// supports only the operations scanEtcdEntries and restoreEntries use: Get
+// with an optional range end, limit and revision, Put, and put-if-absent
+// transactions. Other methods are not implemented and panic if called.
+//
+// revision is reported as the header revision of every response, and
+// modRevisions as the mod revision of the respective keys. The revisions
+// requested by Get are recorded in requestedRevisions.
 type fakeKV struct {
-	kvs map[string]string
+	kvs          map[string]string
+	revision     int64
+	modRevisions map[string]int64
+
+	requestedRevisions []int64
 }
 
 func newFakeKV(kvs map[string]string) *fakeKV { return &fakeKV{kvs: kvs} }
@@ -211,10 +275,11 @@ func (f *fakeKV) Get(_ context.Context, key string, opts ...clientv3.OpOption) (
 	op := clientv3.OpGet(key, opts...)
 	rangeEnd := string(op.RangeBytes())
 	limit := op.Limit()
+	f.requestedRevisions = append(f.requestedRevisions, op.Rev())
 
 	keys := make([]string, 0, len(f.kvs))
 	for k := range f.kvs {
-		if k >= key && (rangeEnd == "" || k < rangeEnd) {
+		if (rangeEnd == "" && k == key) || (rangeEnd != "" && k >= key && k < rangeEnd) {
 			keys = append(keys, k)
 		}
 	}
@@ -226,15 +291,16 @@ func (f *fakeKV) Get(_ context.Context, key string, opts ...clientv3.OpOption) (
 		more = true
 	}
 
-	resp := &clientv3.GetResponse{More: more}
+	resp := &clientv3.GetResponse{Header: &etcdserverpb.ResponseHeader{Revision: f.revision}, More: more}
 	for _, k := range keys {
-		resp.Kvs = append(resp.Kvs, &mvccpb.KeyValue{Key: []byte(k), Value: []byte(f.kvs[k])})
+		resp.Kvs = append(resp.Kvs, &mvccpb.KeyValue{Key: []byte(k), Value: []byte(f.kvs[k]), ModRevision: f.modRevisions[k]})
 	}
 	return resp, nil
 }
 
-func (f *fakeKV) Put(context.Context, string, string, ...clientv3.OpOption) (*clientv3.PutResponse, error) {
-	panic("not implemented")
+func (f *fakeKV) Put(_ context.Context, key, val string, _ ...clientv3.OpOption) (*clientv3.PutResponse, error) {
+	f.kvs[key] = val
+	return &clientv3.PutResponse{Header: &etcdserverpb.ResponseHeader{Revision: f.revision}}, nil
 }
 func (f *fakeKV) Delete(context.Context, string, ...clientv3.OpOption) (*clientv3.DeleteResponse, error) {
 	panic("not implemented")
@@ -245,4 +311,50 @@ func (f *fakeKV) Compact(context.Context, int64, ...clientv3.CompactOption) (*cl
 func (f *fakeKV) Do(context.Context, clientv3.Op) (clientv3.OpResponse, error) {
 	panic("not implemented")
 }
-func (f *fakeKV) Txn(context.Context) clientv3.Txn { panic("not implemented") }
+func (f *fakeKV) Txn(ctx context.Context) clientv3.Txn { return &fakeTxn{ctx: ctx, kv: f} }
+
+// fakeTxn only supports transactions comparing a key's create revision to 0
+// that put the key if it is absent and get it otherwise.
+type fakeTxn struct {
+	ctx       context.Context
+	kv        *fakeKV
+	cmps      []clientv3.Cmp
+	then, els []clientv3.Op
+}
+
+func (t *fakeTxn) If(cs ...clientv3.Cmp) clientv3.Txn   { t.cmps = cs; return t }
+func (t *fakeTxn) Then(ops ...clientv3.Op) clientv3.Txn { t.then = ops; return t }
+func (t *fakeTxn) Else(ops ...clientv3.Op) clientv3.Txn { t.els = ops; return t }
+
+func (t *fakeTxn) Commit() (*clientv3.TxnResponse, error) {
+	succeeded := true
+	for _, cmp := range t.cmps {
+		if _, ok := t.kv.kvs[string(cmp.Key)]; ok {
+			succeeded = false
+		}
+	}
+
+	ops := t.els
+	if succeeded {
+		ops = t.then
+	}
+	resp := &clientv3.TxnResponse{Succeeded: succeeded}
+	for _, op := range ops {
+		switch {
+		case op.IsPut():
+			if _, err := t.kv.Put(t.ctx, string(op.KeyBytes()), string(op.ValueBytes())); err != nil {
+				return nil, err
+			}
+			resp.Responses = append(resp.Responses, &etcdserverpb.ResponseOp{Response: &etcdserverpb.ResponseOp_ResponsePut{ResponsePut: &etcdserverpb.PutResponse{}}})
+		case op.IsGet():
+			get, err := t.kv.Get(t.ctx, string(op.KeyBytes()))
+			if err != nil {
+				return nil, err
+			}
+			resp.Responses = append(resp.Responses, &etcdserverpb.ResponseOp{Response: &etcdserverpb.ResponseOp_ResponseRange{ResponseRange: (*etcdserverpb.RangeResponse)(get)}})
+		default:
+			panic("not implemented")
+		}
+	}
+	return resp, nil
+}
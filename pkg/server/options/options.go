@@ -38,6 +38,7 @@ import (
 
 	kcpadmission "github.com/kcp-dev/kcp/pkg/admission"
 	kcpfeatures "github.com/kcp-dev/kcp/pkg/features"
+	"github.com/kcp-dev/kcp/pkg/reconciler/tenancy/workspace"
 	"github.com/kcp-dev/kcp/pkg/server/options/batteries"
 	kcpserviceaccount "github.com/kcp-dev/kcp/pkg/server/serviceaccount"
 )
@@ -76,6 +77,11 @@ type ExtraOptions struct {
 	ExperimentalBindFreePort              bool
 	LogicalClusterTotalObjectLimit        int64
 	LogicalClusterObjectCountScanInterval time.Duration
+	ShardCapacityLogicalClusters          int64
+	ShardCapacityObjects                  int64
+	ShardUsageReportInterval              time.Duration
+	WorkspaceSchedulingPolicy             string
+	WorkspaceSchedulingSpreadLabel        string
 	LogicalClusterAdminKubeconfig         string
 	ExternalLogicalClusterAdminKubeconfig string
 	ConversionCELTransformationTimeout    time.Duration
@@ -134,6 +140,10 @@ func NewOptions(rootDir string) *Options {
 			LogicalClusterTotalObjectLimit:        0,
 			LogicalClusterObjectCountScanInterval: 60 * time.Second,
 
+			ShardUsageReportInterval:       30 * time.Second,
+			WorkspaceSchedulingPolicy:      workspace.ShardSchedulingPolicyRandom,
+			WorkspaceSchedulingSpreadLabel: "region",
+
 			BatteriesIncluded:   sets.List[string](batteries.Defaults),
 			ServiceAccountCache: saCache,
 		},
@@ -204,6 +214,12 @@ func (o *Options) AddFlags(fss *cliflag.NamedFlagSets) {
 	fs.Int64Var(&o.Extra.LogicalClusterTotalObjectLimit, "logical-cluster-total-object-limit", o.Extra.LogicalClusterTotalObjectLimit, "Maximum total number of objects allowed in a logical cluster on this shard. 0 disables the default limit. The "+corev1alpha1.LogicalClusterMaxTotalObjectsAnnotationKey+" annotation on a LogicalCluster overrides this value for that logical cluster.")
 	fs.DurationVar(&o.Extra.LogicalClusterObjectCountScanInterval, "logical-cluster-object-count-scan-interval", o.Extra.LogicalClusterObjectCountScanInterval, "Interval at which etcd is scanned to count objects per logical cluster for total object count limit enforcement.")
 
+	fs.Int64Var(&o.Extra.ShardCapacityLogicalClusters, "shard-capacity-logical-clusters", o.Extra.ShardCapacityLogicalClusters, "Number of logical clusters this shard can hold, reported in the status of its Shard. Full shards are not scheduled to. 0 means the capacity is not reported.")
+	fs.Int64Var(&o.Extra.ShardCapacityObjects, "shard-capacity-objects", o.Extra.ShardCapacityObjects, "Total number of objects this shard can hold, reported in the status of its Shard. Full shards are not scheduled to. Enables the periodic object count scan. 0 means the capacity is not reported.")
+	fs.DurationVar(&o.Extra.ShardUsageReportInterval, "shard-usage-report-interval", o.Extra.ShardUsageReportInterval, "Interval at which this shard reports its capacity and usage in the status of its Shard. 0 disables reporting.")
+	fs.StringVar(&o.Extra.WorkspaceSchedulingPolicy, "workspace-scheduling-policy", o.Extra.WorkspaceSchedulingPolicy, fmt.Sprintf("Policy used to pick the shard for new workspaces among the shards matching their location. One of: %s.", strings.Join(workspace.ShardSchedulingPolicies, ", ")))
+	fs.StringVar(&o.Extra.WorkspaceSchedulingSpreadLabel, "workspace-scheduling-spread-label", o.Extra.WorkspaceSchedulingSpreadLabel, "Shard label to spread new workspaces across with --workspace-scheduling-policy=spread.")
+
 	fs.StringSliceVar(&o.Extra.BatteriesIncluded, "batteries-included", o.Extra.BatteriesIncluded, fmt.Sprintf(
 		`A list of batteries included (= default objects that might be unwanted in production, but are very helpful in trying out kcp or for development). These are the possible values: %s.
 
@@ -240,6 +256,13 @@ func (o *CompletedOptions) Validate() []error {
 	errs = append(errs, o.HomeWorkspaces.Validate()...)
 	errs = append(errs, o.Cache.Validate()...)
 
+	if _, err := workspace.NewShardScorer(o.Extra.WorkspaceSchedulingPolicy, o.Extra.WorkspaceSchedulingSpreadLabel); err != nil {
+		errs = append(errs, fmt.Errorf("--workspace-scheduling-policy: %w", err))
+	}
+	if o.Extra.ShardCapacityLogicalClusters < 0 || o.Extra.ShardCapacityObjects < 0 {
+		errs = append(errs, fmt.Errorf("--shard-capacity-logical-clusters and --shard-capacity-objects must not be negative"))
+	}
+
 	if o.Extra.ShardName != corev1alpha1.RootShard && len(o.Cache.Client.KubeconfigFile) == 0 {
 		errs = append(errs, fmt.Errorf("--cache-kubeconfig is required for non-root shards"))
 	}
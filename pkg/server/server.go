@@ -312,10 +312,49 @@ func (s *Server) installControllers(ctx context.Context, controllerConfig *rest.
 		}
 	}
 
+	if (s.Options.Controllers.EnableAll || enabled.Has("shard-usage-reporter")) && s.Options.Extra.ShardUsageReportInterval > 0 {
+		if err := s.installShardUsageReporter(ctx); err != nil {
+			return err
+		}
+	}
+
+	if s.Options.Controllers.EnableAll || enabled.Has("logicalclusterlimits-status-reporter") {
+		if err := s.installLogicalClusterLimitsStatusReporter(ctx, controllerConfig); err != nil {
+			return err
+		}
+	}
+
+	if s.Options.Controllers.EnableAll || enabled.Has("logicalcluster-hibernation") {
+		if err := s.installLogicalClusterHibernationController(ctx, controllerConfig); err != nil {
+			return err
+		}
+	}
+
+	if s.Options.Controllers.EnableAll || enabled.Has("logicalcluster-path") {
+		if err := s.installLogicalClusterPathController(ctx, controllerConfig); err != nil {
+			return err
+		}
+	}
+
+	if s.Options.Controllers.EnableAll || enabled.Has("logicalcluster-purge") {
+		if err := s.installLogicalClusterPurgeController(ctx, controllerConfig); err != nil {
+			return err
+		}
+	}
+
+	if s.Options.Controllers.EnableAll || enabled.Has("workspacequota-usage-reporter") {
+		if err := s.installWorkspaceQuotaUsageReporter(ctx, controllerConfig); err != nil {
+			return err
+		}
+	}
+
 	if kcpfeatures.DefaultFeatureGate.Enabled(kcpfeatures.LogicalClusterMigration) {
 		if err := s.installLogicalClusterMigrationController(ctx, controllerConfig); err != nil {
 			return err
 		}
+		if err := s.installShardDrainController(ctx); err != nil {
+			return err
+		}
 	}
 
 	if s.Options.Controllers.EnableAll || enabled.Has("apibinding") {
@@ -325,6 +364,9 @@ func (s *Server) installControllers(ctx context.Context, controllerConfig *rest.
 		if err := s.installCRDCleanupController(ctx, controllerConfig); err != nil {
 			return err
 		}
+		if err := s.installStorageVersionMigrationController(ctx, controllerConfig); err != nil {
+			return err
+		}
 		if err := s.installLogicalClusterCleanupController(ctx, controllerConfig); err != nil {
 			return err
 		}
@@ -401,6 +443,12 @@ func (s *Server) installControllers(ctx context.Context, controllerConfig *rest.
 		}
 	}
 
+	if s.Options.Controllers.EnableAll || enabled.Has("clone") {
+		if err := s.installCloneController(ctx, controllerConfig); err != nil {
+			return err
+		}
+	}
+
 	if s.Options.Controllers.EnableAll || enabled.Has("defaultapibindinglifecycle") {
 		if err := s.installDefaultAPIBindingController(ctx, controllerConfig); err != nil {
 			return err
@@ -411,6 +459,9 @@ func (s *Server) installControllers(ctx context.Context, controllerConfig *rest.
 		if err := s.installPartitionSetController(ctx, controllerConfig); err != nil {
 			return err
 		}
+		if err := s.installPartitionController(ctx, controllerConfig); err != nil {
+			return err
+		}
 	}
 
 	if s.Options.Controllers.EnableAll || enabled.Has("quota") {
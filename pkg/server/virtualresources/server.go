@@ -257,7 +257,7 @@ func (s *Server) handleResource(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var virtualStorage *apisv1alpha2.ResourceSchemaStorageVirtual
-	for _, resource := range apiExport.Spec.Resources {
+	for _, resource := range apiExport.BindableResources() {
 		if resource.Storage.Virtual != nil &&
 			resource.Group == gr.Group &&
 			resource.Name == gr.Resource {
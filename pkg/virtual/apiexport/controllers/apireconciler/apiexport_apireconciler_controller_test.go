@@ -17,18 +17,24 @@ limitations under the License.
 package apireconciler
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2/ktesting"
 
+	kcpcache "github.com/kcp-dev/apimachinery/v2/pkg/cache"
 	"github.com/kcp-dev/logicalcluster/v3"
 	apisv1alpha1 "github.com/kcp-dev/sdk/apis/apis/v1alpha1"
 	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+	apisv1alpha1listers "github.com/kcp-dev/sdk/client/listers/apis/v1alpha1"
 )
 
 func TestEnqueueAPIResourceSchema(t *testing.T) {
@@ -80,3 +86,59 @@ func TestEnqueueAPIResourceSchema(t *testing.T) {
 	expected := sets.New[string]("export1", "export2")
 	require.True(t, expected.Equal(actual))
 }
+
+func TestGetSchemasFromAPIExportDuringRollout(t *testing.T) {
+	t.Parallel()
+
+	newSchema := func(name, group, plural string) *apisv1alpha1.APIResourceSchema {
+		return &apisv1alpha1.APIResourceSchema{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Annotations: map[string]string{logicalcluster.AnnotationKey: "provider"},
+			},
+			Spec: apisv1alpha1.APIResourceSchemaSpec{
+				Group: group,
+				Names: apiextensionsv1.CustomResourceDefinitionNames{Plural: plural},
+			},
+		}
+	}
+
+	indexer := cache.NewIndexer(kcpcache.MetaClusterNamespaceKeyFunc, cache.Indexers{kcpcache.ClusterIndexName: kcpcache.ClusterIndexFunc})
+	for _, sch := range []*apisv1alpha1.APIResourceSchema{
+		newSchema("v2.widgets.example.io", "example.io", "widgets"),
+		newSchema("v1.widgets.example.io", "example.io", "widgets"),
+		newSchema("v1.gadgets.example.io", "example.io", "gadgets"),
+	} {
+		require.NoError(t, indexer.Add(sch))
+	}
+	c := &APIReconciler{
+		apiResourceSchemaLister: apisv1alpha1listers.NewAPIResourceSchemaClusterLister(indexer),
+	}
+
+	// Half rolled out: the APIBindings not selected yet are still bound to the
+	// previous resources, including gadgets which the new resources dropped.
+	apiExport := &apisv1alpha2.APIExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "export",
+			Annotations: map[string]string{logicalcluster.AnnotationKey: "provider"},
+		},
+		Spec: apisv1alpha2.APIExportSpec{
+			Resources: []apisv1alpha2.ResourceSchema{
+				{Group: "example.io", Name: "widgets", Schema: "v2.widgets.example.io"},
+			},
+			Rollout: &apisv1alpha2.APIExportRollout{
+				Percentage: 50,
+				PreviousResources: []apisv1alpha2.ResourceSchema{
+					{Group: "example.io", Name: "widgets", Schema: "v1.widgets.example.io"},
+					{Group: "example.io", Name: "gadgets", Schema: "v1.gadgets.example.io"},
+				},
+			},
+		},
+	}
+
+	schemas, err := c.getSchemasFromAPIExport(context.Background(), apiExport)
+	require.NoError(t, err)
+	require.Len(t, schemas, 2)
+	require.Equal(t, "v2.widgets.example.io", schemas[schema.GroupResource{Group: "example.io", Resource: "widgets"}].Name)
+	require.Equal(t, "v1.gadgets.example.io", schemas[schema.GroupResource{Group: "example.io", Resource: "gadgets"}].Name)
+}
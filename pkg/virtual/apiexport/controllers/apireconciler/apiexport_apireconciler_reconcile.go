@@ -299,7 +299,7 @@ func gvrString(gvr schema.GroupVersionResource) string {
 func (c *APIReconciler) getSchemasFromAPIExport(ctx context.Context, apiExport *apisv1alpha2.APIExport) (map[schema.GroupResource]*apisv1alpha1.APIResourceSchema, error) {
 	logger := klog.FromContext(ctx)
 	apiResourceSchemas := map[schema.GroupResource]*apisv1alpha1.APIResourceSchema{}
-	for _, resourceSchema := range apiExport.Spec.Resources {
+	for _, resourceSchema := range apiExport.BindableResources() {
 		apiExportClusterName := logicalcluster.From(apiExport)
 		apiResourceSchema, err := c.apiResourceSchemaLister.Cluster(apiExportClusterName).Get(resourceSchema.Schema)
 		if err != nil && !apierrors.IsNotFound(err) {
@@ -313,7 +313,12 @@ func (c *APIReconciler) getSchemasFromAPIExport(ctx context.Context, apiExport *
 			).V(3).Info("APIResourceSchema for APIExport not found")
 			continue
 		}
-		apiResourceSchemas[schema.GroupResource{Group: apiResourceSchema.Spec.Group, Resource: apiResourceSchema.Spec.Names.Plural}] = apiResourceSchema
+		gr := schema.GroupResource{Group: apiResourceSchema.Spec.Group, Resource: apiResourceSchema.Spec.Names.Plural}
+		if _, found := apiResourceSchemas[gr]; found {
+			// The resources come first and take precedence over the previous resources of a rollout.
+			continue
+		}
+		apiResourceSchemas[gr] = apiResourceSchema
 	}
 
 	return apiResourceSchemas, nil
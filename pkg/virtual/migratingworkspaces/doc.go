@@ -28,6 +28,10 @@ const VirtualWorkspaceName string = "migratingworkspaces"
 // HandlerPath is the URL path that the dump handler responds to.
 const HandlerPath = "/apis/migration.kcp.io/v1alpha1/logicalclusterdumps"
 
+// RestoreHandlerPath is the URL path that the restore handler responds to.
+// It is served by the shards only, not by the virtual workspace.
+const RestoreHandlerPath = "/apis/migration.kcp.io/v1alpha1/logicalclusterrestores"
+
 // URLFor returns the absolute path prefix for the migrating workspaces VW.
 func URLFor() string {
 	return path.Join("/services", VirtualWorkspaceName)
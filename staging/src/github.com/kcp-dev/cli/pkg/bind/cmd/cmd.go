@@ -36,6 +36,14 @@ var (
 
 # Create an APIBinding named "my-binding" that binds to the APIExport "my-export" in the "root:my-service" workspace with rejected permission claims.
 %[1]s bind apiexport root:my-service:my-export --name my-binding --reject-permission-claim secrets.core,configmaps.core
+`
+
+	upgradeExampleUses = `
+# Move all pinned schemas of the APIBinding "my-binding" to the ones offered by its APIExport.
+%[1]s bind upgrade my-binding
+
+# Move only the pinned schema of the "widgets.example.io" resource of the APIBinding "my-binding".
+%[1]s bind upgrade my-binding --resource widgets.example.io
 `
 )
 
@@ -70,6 +78,27 @@ func New(streams genericclioptions.IOStreams) *cobra.Command {
 	}
 	bindOpts.BindFlags(bindCmd)
 
+	upgradeOpts := plugin.NewUpgradeOptions(streams)
+	upgradeCmd := &cobra.Command{
+		Use:          "upgrade <apibinding-name>",
+		Short:        "Move the pinned schemas of an APIBinding to the ones offered by its APIExport",
+		Example:      fmt.Sprintf(upgradeExampleUses, "kubectl kcp"),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := upgradeOpts.Complete(args); err != nil {
+				return err
+			}
+
+			if err := upgradeOpts.Validate(); err != nil {
+				return err
+			}
+
+			return upgradeOpts.Run(cmd.Context())
+		},
+	}
+	upgradeOpts.BindFlags(upgradeCmd)
+
 	cmd.AddCommand(bindCmd)
+	cmd.AddCommand(upgradeCmd)
 	return cmd
 }
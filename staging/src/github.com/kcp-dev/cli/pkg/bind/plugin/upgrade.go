@@ -0,0 +1,197 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/kcp-dev/cli/pkg/base"
+	pluginhelpers "github.com/kcp-dev/cli/pkg/helpers"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+)
+
+// UpgradeOptions contains the options for upgrading the pinned schemas of an APIBinding.
+type UpgradeOptions struct {
+	*base.Options
+	// APIBindingName is the name of the APIBinding to upgrade.
+	APIBindingName string
+	// Resources restricts the upgrade to the given resources, in the format resource.group.
+	// All pinned resources with an available upgrade are upgraded if empty.
+	Resources []string
+	// UpgradeWaitTimeout is how long to wait for the APIBinding to bind the upgraded schemas.
+	UpgradeWaitTimeout time.Duration
+
+	// resources is the parsed list of group resources from Resources.
+	resources []schema.GroupResource
+}
+
+// NewUpgradeOptions returns new UpgradeOptions.
+func NewUpgradeOptions(streams genericclioptions.IOStreams) *UpgradeOptions {
+	return &UpgradeOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields to cmd's flagset.
+func (u *UpgradeOptions) BindFlags(cmd *cobra.Command) {
+	u.Options.BindFlags(cmd)
+
+	cmd.Flags().StringSliceVar(&u.Resources, "resource", nil, "List of pinned resources to upgrade. All are upgraded if not set. Format:  --resource resource.group")
+	cmd.Flags().DurationVar(&u.UpgradeWaitTimeout, "timeout", time.Second*30, "Duration to wait for the APIBinding to bind the upgraded schemas.")
+}
+
+// Complete ensures all fields are initialized.
+func (u *UpgradeOptions) Complete(args []string) error {
+	if err := u.Options.Complete(); err != nil {
+		return err
+	}
+
+	if len(args) > 0 {
+		u.APIBindingName = args[0]
+	}
+	return nil
+}
+
+// Validate validates the UpgradeOptions are complete and usable.
+func (u *UpgradeOptions) Validate() error {
+	if u.APIBindingName == "" {
+		return errors.New("APIBinding name is required as an argument")
+	}
+
+	u.resources = nil
+	for _, resource := range u.Resources {
+		parts := strings.SplitN(resource, ".", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return fmt.Errorf("invalid resource %q, expected format resource.group", resource)
+		}
+		group := parts[1]
+		if group == "core" {
+			group = ""
+		}
+		u.resources = append(u.resources, schema.GroupResource{Group: group, Resource: parts[0]})
+	}
+
+	return u.Options.Validate()
+}
+
+// Run moves the pinned schemas of the APIBinding to the ones offered by its APIExport.
+func (u *UpgradeOptions) Run(ctx context.Context) error {
+	config, err := u.ClientConfig.ClientConfig()
+	if err != nil {
+		return err
+	}
+
+	_, currentClusterName, err := pluginhelpers.ParseClusterURL(config.Host)
+	if err != nil {
+		return fmt.Errorf("current URL %q does not point to workspace", config.Host)
+	}
+
+	kcpClusterClient, err := newKCPClusterClient(config)
+	if err != nil {
+		return err
+	}
+	client := kcpClusterClient.Cluster(currentClusterName).ApisV1alpha2().APIBindings()
+
+	binding, err := client.Get(ctx, u.APIBindingName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get APIBinding %q: %w", u.APIBindingName, err)
+	}
+
+	pins, upgraded := upgradePinnedSchemas(binding, u.resources)
+	if len(upgraded) == 0 {
+		_, err := fmt.Fprintf(u.Out, "apibinding %s has no upgrades available.\n", binding.Name)
+		return err
+	}
+
+	binding.Spec.PinnedSchemas = pins
+	if binding, err = client.Update(ctx, binding, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to upgrade APIBinding %q: %w", u.APIBindingName, err)
+	}
+
+	if _, err := fmt.Fprintf(u.Out, "apibinding %s upgraded to %s. Waiting to successfully bind ...\n", binding.Name, strings.Join(upgraded, ", ")); err != nil {
+		return err
+	}
+
+	if err := wait.PollUntilContextTimeout(ctx, time.Millisecond*500, u.UpgradeWaitTimeout, true, func(ctx context.Context) (bool, error) {
+		binding, err = client.Get(ctx, u.APIBindingName, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return pinnedSchemasBound(binding), nil
+	}); err != nil {
+		return fmt.Errorf("could not bind upgraded schemas of %s: %w", u.APIBindingName, err)
+	}
+
+	if _, err := fmt.Fprintf(u.Out, "%s upgraded and bound.\n", binding.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// upgradePinnedSchemas returns the pinned schemas of the APIBinding with those of the
+// given resources, or of all resources if none are given, moved to the schema available
+// from the APIExport. It also returns the names of the schemas upgraded to.
+func upgradePinnedSchemas(binding *apisv1alpha2.APIBinding, resources []schema.GroupResource) ([]apisv1alpha2.PinnedSchema, []string) {
+	pins := slices.Clone(binding.Spec.PinnedSchemas)
+	var upgraded []string
+	for i, pin := range pins {
+		gr := schema.GroupResource{Group: pin.Group, Resource: pin.Resource}
+		if len(resources) > 0 && !slices.Contains(resources, gr) {
+			continue
+		}
+		j := slices.IndexFunc(binding.Status.BoundResources, func(br apisv1alpha2.BoundAPIResource) bool {
+			return br.Group == pin.Group && br.Resource == pin.Resource
+		})
+		if j < 0 || binding.Status.BoundResources[j].AvailableSchema == nil {
+			continue
+		}
+		available := binding.Status.BoundResources[j].AvailableSchema
+		pins[i].Schema = available.Name
+		pins[i].SchemaUID = available.UID
+		upgraded = append(upgraded, available.Name)
+	}
+	return pins, upgraded
+}
+
+// pinnedSchemasBound returns whether the APIBinding observed and bound all its pinned
+// schemas.
+func pinnedSchemasBound(binding *apisv1alpha2.APIBinding) bool {
+	for _, pin := range binding.Spec.PinnedSchemas {
+		bound := slices.ContainsFunc(binding.Status.BoundResources, func(br apisv1alpha2.BoundAPIResource) bool {
+			return br.Group == pin.Group && br.Resource == pin.Resource &&
+				(pin.Schema == "" || br.Schema.Name == pin.Schema) &&
+				(pin.SchemaUID == "" || br.Schema.UID == pin.SchemaUID)
+		})
+		if !bound {
+			return false
+		}
+	}
+	return true
+}
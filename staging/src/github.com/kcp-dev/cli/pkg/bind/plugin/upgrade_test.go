@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+)
+
+func TestUpgradePinnedSchemas(t *testing.T) {
+	t.Parallel()
+
+	binding := &apisv1alpha2.APIBinding{
+		Spec: apisv1alpha2.APIBindingSpec{
+			PinnedSchemas: []apisv1alpha2.PinnedSchema{
+				{Group: "example.io", Resource: "widgets", Schema: "v1.widgets.example.io"},
+				{Group: "example.io", Resource: "gadgets", SchemaUID: "uid-gadgets-v1"},
+				{Group: "example.io", Resource: "gizmos", Schema: "v1.gizmos.example.io"},
+			},
+		},
+		Status: apisv1alpha2.APIBindingStatus{
+			BoundResources: []apisv1alpha2.BoundAPIResource{
+				{
+					Group:           "example.io",
+					Resource:        "widgets",
+					Schema:          apisv1alpha2.BoundAPIResourceSchema{Name: "v1.widgets.example.io", UID: "uid-widgets-v1"},
+					AvailableSchema: &apisv1alpha2.BoundAPIResourceSchema{Name: "v2.widgets.example.io", UID: "uid-widgets-v2"},
+				},
+				{
+					Group:           "example.io",
+					Resource:        "gadgets",
+					Schema:          apisv1alpha2.BoundAPIResourceSchema{Name: "v1.gadgets.example.io", UID: "uid-gadgets-v1"},
+					AvailableSchema: &apisv1alpha2.BoundAPIResourceSchema{Name: "v2.gadgets.example.io", UID: "uid-gadgets-v2"},
+				},
+				{
+					Group:    "example.io",
+					Resource: "gizmos",
+					Schema:   apisv1alpha2.BoundAPIResourceSchema{Name: "v1.gizmos.example.io", UID: "uid-gizmos-v1"},
+				},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		resources    []schema.GroupResource
+		wantPins     []apisv1alpha2.PinnedSchema
+		wantUpgraded []string
+	}{
+		"all resources": {
+			wantPins: []apisv1alpha2.PinnedSchema{
+				{Group: "example.io", Resource: "widgets", Schema: "v2.widgets.example.io", SchemaUID: "uid-widgets-v2"},
+				{Group: "example.io", Resource: "gadgets", Schema: "v2.gadgets.example.io", SchemaUID: "uid-gadgets-v2"},
+				{Group: "example.io", Resource: "gizmos", Schema: "v1.gizmos.example.io"},
+			},
+			wantUpgraded: []string{"v2.widgets.example.io", "v2.gadgets.example.io"},
+		},
+		"selected resource": {
+			resources: []schema.GroupResource{{Group: "example.io", Resource: "gadgets"}},
+			wantPins: []apisv1alpha2.PinnedSchema{
+				{Group: "example.io", Resource: "widgets", Schema: "v1.widgets.example.io"},
+				{Group: "example.io", Resource: "gadgets", Schema: "v2.gadgets.example.io", SchemaUID: "uid-gadgets-v2"},
+				{Group: "example.io", Resource: "gizmos", Schema: "v1.gizmos.example.io"},
+			},
+			wantUpgraded: []string{"v2.gadgets.example.io"},
+		},
+		"selected resource without upgrade": {
+			resources:    []schema.GroupResource{{Group: "example.io", Resource: "gizmos"}},
+			wantPins:     binding.Spec.PinnedSchemas,
+			wantUpgraded: nil,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			pins, upgraded := upgradePinnedSchemas(binding, tc.resources)
+			require.Equal(t, tc.wantPins, pins)
+			require.Equal(t, tc.wantUpgraded, upgraded)
+		})
+	}
+}
+
+func TestPinnedSchemasBound(t *testing.T) {
+	t.Parallel()
+
+	binding := &apisv1alpha2.APIBinding{
+		Spec: apisv1alpha2.APIBindingSpec{
+			PinnedSchemas: []apisv1alpha2.PinnedSchema{
+				{Group: "example.io", Resource: "widgets", Schema: "v2.widgets.example.io", SchemaUID: "uid-widgets-v2"},
+			},
+		},
+		Status: apisv1alpha2.APIBindingStatus{
+			BoundResources: []apisv1alpha2.BoundAPIResource{
+				{Group: "example.io", Resource: "widgets", Schema: apisv1alpha2.BoundAPIResourceSchema{Name: "v1.widgets.example.io", UID: "uid-widgets-v1"}},
+			},
+		},
+	}
+	require.False(t, pinnedSchemasBound(binding))
+
+	binding.Status.BoundResources[0].Schema = apisv1alpha2.BoundAPIResourceSchema{Name: "v2.widgets.example.io", UID: "uid-widgets-v2"}
+	require.True(t, pinnedSchemasBound(binding))
+}
@@ -72,6 +72,12 @@ var (
 
 # create a context with the current workspace, named context-name
 %[1]s workspace create-context context-name
+
+# back up the current workspace to an archive
+%[1]s workspace backup my-workspace.tar.gz
+
+# restore an archive into a new logical cluster on the shard the kubeconfig points to
+%[1]s workspace restore my-workspace.tar.gz my-restored-cluster --server=https://shard.example.com:6443
 `
 )
 
@@ -89,7 +95,7 @@ func New(streams genericclioptions.IOStreams) (*cobra.Command, error) {
 
 	cmd := &cobra.Command{
 		Aliases:          []string{"ws", "workspaces"},
-		Use:              "workspace [create|create-context|use|current|<workspace>|..|.|-|~|<root:absolute:workspace>] [-i|--interactive]",
+		Use:              "workspace [create|create-context|use|current|backup|restore|<workspace>|..|.|-|~|<root:absolute:workspace>] [-i|--interactive]",
 		Short:            "Manages kcp workspaces",
 		Example:          fmt.Sprintf(workspaceExample, cliName),
 		SilenceUsage:     true,
@@ -222,11 +228,134 @@ func New(streams genericclioptions.IOStreams) (*cobra.Command, error) {
 	}
 	treeCmdOpts.BindFlags(treeCmd)
 
+	backupOpts := plugin.NewBackupOptions(streams)
+	backupCmd := &cobra.Command{
+		Use:   "backup <archive-file>",
+		Short: "Back up the current workspace to an archive",
+		Long: `Back up the current workspace to an archive.
+
+The raw etcd contents of the workspace's logical cluster are written to a
+gzipped tar archive together with a manifest holding checksums of its pages.
+All pages are read at the same etcd revision, so the archive is a consistent
+snapshot even if the workspace is written to during the backup. Child
+workspaces are not included.
+
+Requires credentials in the system:kcp:external-logical-cluster-admin group.`,
+		Example:      "kcp workspace backup my-workspace.tar.gz",
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return c.Help()
+			}
+			if err := backupOpts.Complete(args); err != nil {
+				return err
+			}
+			if err := backupOpts.Validate(); err != nil {
+				return err
+			}
+			return backupOpts.Run(c.Context())
+		},
+	}
+	backupOpts.BindFlags(backupCmd)
+
+	restoreOpts := plugin.NewRestoreOptions(streams)
+	restoreCmd := &cobra.Command{
+		Use:   "restore <archive-file> <logical-cluster-name>",
+		Short: "Restore a workspace archive into a new logical cluster",
+		Long: `Restore a workspace archive into a new logical cluster.
+
+The archive written by 'workspace backup' is verified against its checksums
+and imported into a logical cluster of the given name, rewriting all etcd
+keys from the backed up logical cluster. The import fails if a logical
+cluster of that name already exists and can be retried if interrupted.
+
+The new logical cluster cannot be routed to by the front-proxy yet, so the
+kubeconfig or --server must point to the shard to restore onto. The restored
+logical cluster is not attached to a workspace.
+
+Requires credentials in the system:kcp:external-logical-cluster-admin group.`,
+		Example:      "kcp workspace restore my-workspace.tar.gz my-restored-cluster --server=https://shard.example.com:6443",
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return c.Help()
+			}
+			if err := restoreOpts.Complete(args); err != nil {
+				return err
+			}
+			if err := restoreOpts.Validate(); err != nil {
+				return err
+			}
+			return restoreOpts.Run(c.Context())
+		},
+	}
+	restoreOpts.BindFlags(restoreCmd)
+
+	undeleteOpts := plugin.NewUndeleteWorkspaceOptions(streams)
+	undeleteCmd := &cobra.Command{
+		Use:   "undelete <workspace-name>",
+		Short: "Undelete a deleted workspace",
+		Long: `Undelete a deleted workspace.
+
+A workspace whose type sets a deletionRetention is kept in the Deleted phase
+after deletion, with its content retained. Undeleting releases the deleted
+workspace and recreates it with the same name and type, attached to the
+retained logical cluster. Once the retention has passed, the content is purged
+and the workspace cannot be undeleted anymore.`,
+		Example:      "kcp workspace undelete my-workspace",
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return c.Help()
+			}
+			if err := undeleteOpts.Complete(args); err != nil {
+				return err
+			}
+			if err := undeleteOpts.Validate(); err != nil {
+				return err
+			}
+			return undeleteOpts.Run(c.Context())
+		},
+	}
+	undeleteOpts.BindFlags(undeleteCmd)
+
+	moveOpts := plugin.NewMoveWorkspaceOptions(streams)
+	moveCmd := &cobra.Command{
+		Use:   "move <workspace-name> <new-name|path>",
+		Short: "Rename a workspace or move it to another parent",
+		Long: `Rename a workspace or move it to another parent.
+
+The logical cluster of the workspace, and with it all its content, is kept
+and only its path changes. The new path is relative to the current workspace,
+or absolute when prefixed with ':'. References to the old path, e.g. in
+APIBindings, keep working if they were resolved before. The workspace history
+used by 'kubectl ws -' is updated to the new path.`,
+		Example:      "kcp workspace move my-workspace :root:other-org:my-workspace",
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return c.Help()
+			}
+			if err := moveOpts.Complete(args); err != nil {
+				return err
+			}
+			if err := moveOpts.Validate(); err != nil {
+				return err
+			}
+			return moveOpts.Run(c.Context())
+		},
+	}
+	moveOpts.BindFlags(moveCmd)
+
 	cmd.AddCommand(useCmd)
 	cmd.AddCommand(treeCmd)
 	cmd.AddCommand(currentCmd)
 	cmd.AddCommand(createCmd)
 	cmd.AddCommand(createContextCmd)
+	cmd.AddCommand(backupCmd)
+	cmd.AddCommand(restoreCmd)
+	cmd.AddCommand(undeleteCmd)
+	cmd.AddCommand(moveCmd)
 	return cmd, nil
 }
 
@@ -0,0 +1,248 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	migrationv1alpha1 "github.com/kcp-dev/sdk/apis/migration/v1alpha1"
+)
+
+// A backup archive is a gzipped tar file holding one JSON file per
+// LogicalClusterDump page, in dump order, followed by backupManifestFile.
+const (
+	backupManifestFile    = "manifest.json"
+	backupManifestVersion = 1
+)
+
+// backupManifest describes the contents of a backup archive.
+type backupManifest struct {
+	// Version is the version of the archive format.
+	Version int `json:"version"`
+	// LogicalCluster is the name of the backed up logical cluster.
+	LogicalCluster string `json:"logicalCluster"`
+	// Workspace is the path of the workspace the logical cluster was
+	// backed up through.
+	Workspace string `json:"workspace,omitempty"`
+	// Revision is the etcd revision of the shard the backup was taken at.
+	Revision int64 `json:"revision"`
+	// Created is the time the backup was taken.
+	Created time.Time `json:"created"`
+	// Entries is the total number of etcd entries in the archive.
+	Entries int64 `json:"entries"`
+	// Pages lists the page files of the archive, in dump order.
+	Pages []backupPage `json:"pages"`
+}
+
+// backupPage describes a single page file of a backup archive.
+type backupPage struct {
+	// File is the name of the page file in the archive.
+	File string `json:"file"`
+	// Entries is the number of etcd entries in the page.
+	Entries int `json:"entries"`
+	// SHA256 is the hex encoded checksum of the page file.
+	SHA256 string `json:"sha256"`
+}
+
+// backupWriter writes a backup archive page by page. The manifest is
+// written on Close.
+type backupWriter struct {
+	gz       *gzip.Writer
+	tw       *tar.Writer
+	manifest backupManifest
+}
+
+func newBackupWriter(w io.Writer, manifest backupManifest) *backupWriter {
+	gz := gzip.NewWriter(w)
+	manifest.Version = backupManifestVersion
+	return &backupWriter{gz: gz, tw: tar.NewWriter(gz), manifest: manifest}
+}
+
+// WritePage adds a page of dumped entries to the archive.
+func (b *backupWriter) WritePage(entries []migrationv1alpha1.EtcdEntry) error {
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+
+	page := backupPage{
+		File:    fmt.Sprintf("pages/%06d.json", len(b.manifest.Pages)),
+		Entries: len(entries),
+		SHA256:  checksum(data),
+	}
+	if err := b.writeFile(page.File, data); err != nil {
+		return err
+	}
+
+	b.manifest.Pages = append(b.manifest.Pages, page)
+	b.manifest.Entries += int64(len(entries))
+	return nil
+}
+
+// Close writes the manifest and finishes the archive. It does not close
+// the underlying writer.
+func (b *backupWriter) Close(revision int64) error {
+	b.manifest.Revision = revision
+	data, err := json.MarshalIndent(b.manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := b.writeFile(backupManifestFile, data); err != nil {
+		return err
+	}
+	if err := b.tw.Close(); err != nil {
+		return err
+	}
+	return b.gz.Close()
+}
+
+func (b *backupWriter) writeFile(name string, data []byte) error {
+	if err := b.tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o600,
+		Size:    int64(len(data)),
+		ModTime: b.manifest.Created,
+	}); err != nil {
+		return err
+	}
+	_, err := b.tw.Write(data)
+	return err
+}
+
+// readBackupManifest reads the manifest of a backup archive and verifies
+// the checksums of all pages against it.
+func readBackupManifest(r io.Reader) (*backupManifest, error) {
+	var manifest *backupManifest
+	checksums := map[string]string{}
+
+	err := walkBackup(r, func(name string, data []byte) error {
+		if name != backupManifestFile {
+			checksums[name] = checksum(data)
+			return nil
+		}
+		manifest = &backupManifest{}
+		if err := json.Unmarshal(data, manifest); err != nil {
+			return fmt.Errorf("failed to decode %s: %w", backupManifestFile, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if manifest == nil {
+		return nil, fmt.Errorf("archive has no %s", backupManifestFile)
+	}
+	if manifest.Version != backupManifestVersion {
+		return nil, fmt.Errorf("unsupported archive version %d", manifest.Version)
+	}
+	if manifest.LogicalCluster == "" {
+		return nil, errors.New("archive manifest has no logical cluster")
+	}
+	for _, page := range manifest.Pages {
+		sum, ok := checksums[page.File]
+		if !ok {
+			return nil, fmt.Errorf("archive is missing page %s", page.File)
+		}
+		if sum != page.SHA256 {
+			return nil, fmt.Errorf("checksum mismatch for page %s", page.File)
+		}
+		delete(checksums, page.File)
+	}
+	for name := range checksums {
+		return nil, fmt.Errorf("archive contains unexpected file %s", name)
+	}
+
+	return manifest, nil
+}
+
+// readBackupPages calls fn with the entries of every page of the archive
+// in dump order, verifying each page against the manifest read by
+// readBackupManifest before.
+func readBackupPages(r io.Reader, manifest *backupManifest, fn func([]migrationv1alpha1.EtcdEntry) error) error {
+	next := 0
+	err := walkBackup(r, func(name string, data []byte) error {
+		if name == backupManifestFile {
+			return nil
+		}
+		if next >= len(manifest.Pages) || manifest.Pages[next].File != name {
+			return fmt.Errorf("unexpected page %s", name)
+		}
+		if checksum(data) != manifest.Pages[next].SHA256 {
+			return fmt.Errorf("checksum mismatch for page %s", name)
+		}
+		next++
+
+		var entries []migrationv1alpha1.EtcdEntry
+		if err := json.Unmarshal(data, &entries); err != nil {
+			return fmt.Errorf("failed to decode page %s: %w", name, err)
+		}
+		return fn(entries)
+	})
+	if err != nil {
+		return err
+	}
+	if next != len(manifest.Pages) {
+		return fmt.Errorf("archive is missing page %s", manifest.Pages[next].File)
+	}
+	return nil
+}
+
+// walkBackup calls fn with the name and content of every file in the
+// archive.
+func walkBackup(r io.Reader, fn func(name string, data []byte) error) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("failed to read archive: %w", err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read archive: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", header.Name, err)
+		}
+		if err := fn(header.Name, data); err != nil {
+			return err
+		}
+	}
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/kcp-dev/cli/pkg/base"
+	pluginhelpers "github.com/kcp-dev/cli/pkg/helpers"
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	migrationv1alpha1 "github.com/kcp-dev/sdk/apis/migration/v1alpha1"
+	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
+)
+
+// BackupOptions contains options for backing up a workspace.
+type BackupOptions struct {
+	*base.Options
+
+	// File is the path of the archive to write.
+	File string
+	// PageSize is the maximum number of etcd entries requested per page.
+	PageSize int64
+
+	kcpClusterClient kcpclientset.ClusterInterface
+}
+
+// NewBackupOptions returns a new BackupOptions.
+func NewBackupOptions(streams genericclioptions.IOStreams) *BackupOptions {
+	return &BackupOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields to cmd's flagset.
+func (o *BackupOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+	cmd.Flags().Int64Var(&o.PageSize, "page-size", o.PageSize, "Maximum number of etcd entries fetched per request. The server default is used if zero.")
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *BackupOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if len(args) > 0 {
+		o.File = args[0]
+	}
+
+	kcpClusterClient, err := newKCPClusterClient(o.ClientConfig)
+	if err != nil {
+		return err
+	}
+	o.kcpClusterClient = kcpClusterClient
+
+	return nil
+}
+
+// Validate validates the BackupOptions are complete and usable.
+func (o *BackupOptions) Validate() error {
+	if o.File == "" {
+		return errors.New("an archive file is required")
+	}
+	if o.PageSize < 0 {
+		return errors.New("--page-size must not be negative")
+	}
+	return o.Options.Validate()
+}
+
+// Run backs up the current workspace.
+func (o *BackupOptions) Run(ctx context.Context) error {
+	config, err := o.ClientConfig.ClientConfig()
+	if err != nil {
+		return err
+	}
+	_, current, err := pluginhelpers.ParseClusterURL(config.Host)
+	if err != nil {
+		return fmt.Errorf("current URL %q does not point to a workspace", config.Host)
+	}
+
+	lc, err := o.kcpClusterClient.Cluster(current).CoreV1alpha1().LogicalClusters().Get(ctx, corev1alpha1.LogicalClusterName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get logical cluster of workspace %s: %w", current, err)
+	}
+
+	f, err := os.OpenFile(o.File, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	manifest, err := o.backup(ctx, current, logicalcluster.From(lc), f)
+	if err != nil {
+		// Don't leave a partial archive behind.
+		_ = os.Remove(o.File)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "Backed up %d entries of workspace %s (logical cluster %s) at revision %d to %s.\n", manifest.Entries, current, manifest.LogicalCluster, manifest.Revision, o.File)
+	return nil
+}
+
+// backup pages through the LogicalClusterDump of the workspace at path and
+// writes the pages to out. All pages are read at the revision of the first
+// one, so the archive is a consistent snapshot.
+func (o *BackupOptions) backup(ctx context.Context, path logicalcluster.Path, lcName logicalcluster.Name, out io.Writer) (*backupManifest, error) {
+	w := newBackupWriter(out, backupManifest{
+		LogicalCluster: lcName.String(),
+		Workspace:      path.String(),
+		Created:        time.Now().UTC().Truncate(time.Second),
+	})
+
+	var revision int64
+	var continueToken string
+	for {
+		dump, err := o.kcpClusterClient.Cluster(path).MigrationV1alpha1().LogicalClusterDumps().Create(ctx, &migrationv1alpha1.LogicalClusterDump{
+			Spec: migrationv1alpha1.LogicalClusterDumpSpec{
+				Continue: continueToken,
+				Limit:    o.PageSize,
+				Revision: revision,
+			},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump workspace %s: %w", path, err)
+		}
+		if revision == 0 {
+			revision = dump.Status.Revision
+		}
+
+		if err := w.WritePage(dump.Status.Entries); err != nil {
+			return nil, fmt.Errorf("failed to write archive: %w", err)
+		}
+
+		if dump.Status.Continue == "" {
+			break
+		}
+		continueToken = dump.Status.Continue
+	}
+
+	if err := w.Close(revision); err != nil {
+		return nil, fmt.Errorf("failed to write archive: %w", err)
+	}
+	return &w.manifest, nil
+}
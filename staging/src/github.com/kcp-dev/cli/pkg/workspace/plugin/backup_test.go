@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	kcptesting "github.com/kcp-dev/client-go/third_party/k8s.io/client-go/testing"
+	"github.com/kcp-dev/logicalcluster/v3"
+	migrationv1alpha1 "github.com/kcp-dev/sdk/apis/migration/v1alpha1"
+	kcpfakeclient "github.com/kcp-dev/sdk/client/clientset/versioned/cluster/fake"
+)
+
+func TestBackupAndRestore(t *testing.T) {
+	t.Parallel()
+
+	pages := []migrationv1alpha1.LogicalClusterDumpStatus{
+		{
+			Entries: []migrationv1alpha1.EtcdEntry{
+				{Key: "core/configmaps/source/default/cm", Value: []byte("configmap")},
+				{Key: "core.kcp.io/logicalclusters/customresources/source/cluster", Value: []byte("logicalcluster")},
+			},
+			Continue: "core.kcp.io/logicalclusters/customresources/source/cluster",
+			Revision: 42,
+		},
+		{
+			Entries: []migrationv1alpha1.EtcdEntry{
+				{Key: "rbac.authorization.k8s.io/clusterroles/source/admin", Value: []byte("clusterrole")},
+			},
+			Revision: 42,
+		},
+	}
+
+	client := kcpfakeclient.NewClientset()
+	var dumpRequests []migrationv1alpha1.LogicalClusterDumpSpec
+	client.PrependReactor("create", "logicalclusterdumps", func(action kcptesting.Action) (bool, runtime.Object, error) {
+		require.Equal(t, "root:ws", action.GetCluster().String())
+		dump := action.(kcptesting.CreateAction).GetObject().(*migrationv1alpha1.LogicalClusterDump)
+		dumpRequests = append(dumpRequests, dump.Spec)
+		dump.Status = pages[len(dumpRequests)-1]
+		return true, dump, nil
+	})
+
+	backup := NewBackupOptions(genericclioptions.NewTestIOStreamsDiscard())
+	backup.kcpClusterClient = client
+
+	var archive bytes.Buffer
+	manifest, err := backup.backup(context.Background(), logicalcluster.NewPath("root:ws"), "source", &archive)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), manifest.Entries)
+	require.Equal(t, int64(42), manifest.Revision)
+	require.Equal(t, []migrationv1alpha1.LogicalClusterDumpSpec{
+		{},
+		{Continue: "core.kcp.io/logicalclusters/customresources/source/cluster", Revision: 42},
+	}, dumpRequests, "all pages after the first one must be pinned to its revision")
+
+	read, err := readBackupManifest(bytes.NewReader(archive.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, manifest.Pages, read.Pages)
+	require.Equal(t, "source", read.LogicalCluster)
+
+	var restoreRequests [][]string
+	client.PrependReactor("create", "logicalclusterrestores", func(action kcptesting.Action) (bool, runtime.Object, error) {
+		require.Equal(t, "restored", action.GetCluster().String())
+		restore := action.(kcptesting.CreateAction).GetObject().(*migrationv1alpha1.LogicalClusterRestore)
+		require.Equal(t, "source", restore.Spec.SourceLogicalCluster)
+		var keys []string
+		for _, entry := range restore.Spec.Entries {
+			keys = append(keys, entry.Key)
+		}
+		restoreRequests = append(restoreRequests, keys)
+		restore.Status.Restored = int64(len(keys))
+		return true, restore, nil
+	})
+
+	restore := NewRestoreOptions(genericclioptions.NewTestIOStreamsDiscard())
+	restore.kcpClusterClient = client
+	restore.LogicalCluster = "restored"
+
+	restored, err := restore.restore(context.Background(), bytes.NewReader(archive.Bytes()), read)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), restored)
+	require.Equal(t, [][]string{
+		{"core/configmaps/source/default/cm"},
+		{"rbac.authorization.k8s.io/clusterroles/source/admin"},
+		{"core.kcp.io/logicalclusters/customresources/source/cluster"},
+	}, restoreRequests, "the logical cluster must be restored last")
+}
+
+func TestReadBackupManifest_detectsTampering(t *testing.T) {
+	t.Parallel()
+
+	w := newBackupWriter(io.Discard, backupManifest{})
+	require.NoError(t, w.WritePage(nil))
+
+	tests := map[string]struct {
+		files   map[string]string
+		wantErr string
+	}{
+		"no manifest": {
+			files:   map[string]string{"pages/000000.json": "[]"},
+			wantErr: "archive has no manifest.json",
+		},
+		"modified page": {
+			files: map[string]string{
+				"pages/000000.json": `[{"key":"core/configmaps/source/default/cm"}]`,
+				"manifest.json":     `{"version":1,"logicalCluster":"source","pages":[{"file":"pages/000000.json","sha256":"` + w.manifest.Pages[0].SHA256 + `"}]}`,
+			},
+			wantErr: "checksum mismatch for page pages/000000.json",
+		},
+		"missing page": {
+			files: map[string]string{
+				"manifest.json": `{"version":1,"logicalCluster":"source","pages":[{"file":"pages/000000.json","sha256":"` + w.manifest.Pages[0].SHA256 + `"}]}`,
+			},
+			wantErr: "archive is missing page pages/000000.json",
+		},
+		"unexpected file": {
+			files: map[string]string{
+				"pages/000000.json": "null",
+				"pages/000001.json": "null",
+				"manifest.json":     `{"version":1,"logicalCluster":"source","pages":[{"file":"pages/000000.json","sha256":"` + w.manifest.Pages[0].SHA256 + `"}]}`,
+			},
+			wantErr: "archive contains unexpected file pages/000001.json",
+		},
+		"unsupported version": {
+			files: map[string]string{
+				"manifest.json": `{"version":2,"logicalCluster":"source"}`,
+			},
+			wantErr: "unsupported archive version 2",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := readBackupManifest(bytes.NewReader(newTarGz(t, tc.files)))
+			require.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func newTarGz(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(content)), ModTime: time.Now()}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
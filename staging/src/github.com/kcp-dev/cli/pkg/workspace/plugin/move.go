@@ -0,0 +1,234 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/kcp-dev/cli/pkg/base"
+	pluginhelpers "github.com/kcp-dev/cli/pkg/helpers"
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
+)
+
+// MoveWorkspaceOptions contains options for moving or renaming a workspace.
+type MoveWorkspaceOptions struct {
+	*base.Options
+
+	// Name is the name of the workspace to move.
+	Name string
+	// To is the new name of the workspace, or its new path.
+	To string
+	// ReadyWaitTimeout is how long to wait for the workspace to be ready before returning control to the user.
+	ReadyWaitTimeout time.Duration
+
+	kcpClusterClient kcpclientset.ClusterInterface
+	startingConfig   *clientcmdapi.Config
+
+	// for testing
+	modifyConfig func(configAccess clientcmd.ConfigAccess, newConfig *clientcmdapi.Config) error
+}
+
+// NewMoveWorkspaceOptions returns a new MoveWorkspaceOptions.
+func NewMoveWorkspaceOptions(streams genericclioptions.IOStreams) *MoveWorkspaceOptions {
+	return &MoveWorkspaceOptions{
+		Options: base.NewOptions(streams),
+
+		ReadyWaitTimeout: time.Minute,
+		modifyConfig: func(configAccess clientcmd.ConfigAccess, newConfig *clientcmdapi.Config) error {
+			return clientcmd.ModifyConfig(configAccess, *newConfig, true)
+		},
+	}
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *MoveWorkspaceOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if len(args) > 0 {
+		o.Name = args[0]
+	}
+	if len(args) > 1 {
+		o.To = args[1]
+	}
+
+	if o.startingConfig == nil {
+		var err error
+		o.startingConfig, err = o.ClientConfig.ConfigAccess().GetStartingConfig()
+		if err != nil {
+			return err
+		}
+	}
+
+	kcpClusterClient, err := newKCPClusterClient(o.ClientConfig)
+	if err != nil {
+		return err
+	}
+	o.kcpClusterClient = kcpClusterClient
+
+	return nil
+}
+
+// Validate validates the MoveWorkspaceOptions are complete and usable.
+func (o *MoveWorkspaceOptions) Validate() error {
+	if o.Name == "" {
+		return fmt.Errorf("workspace name is required")
+	}
+	if o.To == "" {
+		return fmt.Errorf("new workspace name or path is required")
+	}
+
+	return o.Options.Validate()
+}
+
+// BindFlags binds fields to cmd's flagset.
+func (o *MoveWorkspaceOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+	cmd.Flags().DurationVar(&o.ReadyWaitTimeout, "timeout", o.ReadyWaitTimeout, "How long to wait for the moved workspace to be ready.")
+}
+
+// Run moves a workspace. The workspace is annotated with its new path, and a
+// workspace with the same type is created there, taking over the logical
+// cluster. The old workspace is then deleted by the workspace controller,
+// without deleting the logical cluster.
+func (o *MoveWorkspaceOptions) Run(ctx context.Context) error {
+	config, err := o.ClientConfig.ClientConfig()
+	if err != nil {
+		return err
+	}
+	_, currentClusterName, err := pluginhelpers.ParseClusterURL(config.Host)
+	if err != nil {
+		return fmt.Errorf("current URL %q does not point to a workspace", config.Host)
+	}
+
+	ws, err := o.kcpClusterClient.Cluster(currentClusterName).TenancyV1alpha1().Workspaces().Get(ctx, o.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if ws.Status.Phase != corev1alpha1.LogicalClusterPhaseReady {
+		return fmt.Errorf("workspace %q is in phase %q, only %q workspaces can be moved", o.Name, ws.Status.Phase, corev1alpha1.LogicalClusterPhaseReady)
+	}
+	_, from, err := pluginhelpers.ParseClusterURL(ws.Spec.URL)
+	if err != nil {
+		from = currentClusterName.Join(o.Name)
+	}
+
+	// like for "use", a leading colon makes the path absolute, otherwise it is
+	// relative to the current workspace, i.e. a plain name renames.
+	to := logicalcluster.NewPath(strings.TrimPrefix(o.To, ":"))
+	if !strings.HasPrefix(o.To, ":") {
+		to = currentClusterName.Join(o.To)
+	}
+	parent, name := to.Split()
+	if !to.IsValid() || parent.Empty() {
+		return fmt.Errorf("invalid workspace path: %s", o.To)
+	}
+	if to == from {
+		return fmt.Errorf("workspace %q is already at %s", o.Name, to)
+	}
+	if strings.HasPrefix(to.String(), from.String()+":") {
+		return fmt.Errorf("workspace %q cannot be moved into itself", o.Name)
+	}
+
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, tenancyv1alpha1.WorkspaceMoveToAnnotationKey, to.String())
+	if _, err := o.kcpClusterClient.Cluster(currentClusterName).TenancyV1alpha1().Workspaces().Patch(ctx, o.Name, types.MergePatchType, []byte(patch), metav1.PatchOptions{}); err != nil {
+		return err
+	}
+
+	moved := &tenancyv1alpha1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Labels:      ws.Labels,
+			Annotations: map[string]string{tenancyv1alpha1.WorkspaceMoveFromAnnotationKey: from.String()},
+		},
+		Spec: tenancyv1alpha1.WorkspaceSpec{
+			Type:     ws.Spec.Type,
+			Location: ws.Spec.Location,
+		},
+	}
+	workspaces := o.kcpClusterClient.Cluster(parent).TenancyV1alpha1().Workspaces()
+	moved, err = workspaces.Create(ctx, moved, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create workspace %s, retry by creating it with annotation %s=%s: %w", to, tenancyv1alpha1.WorkspaceMoveFromAnnotationKey, from, err)
+	}
+
+	if err := wait.PollUntilContextTimeout(ctx, time.Millisecond*500, o.ReadyWaitTimeout, true, func(ctx context.Context) (bool, error) {
+		if moved.Status.Phase == corev1alpha1.LogicalClusterPhaseReady {
+			return true, nil
+		}
+		moved, err = workspaces.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return moved.Status.Phase == corev1alpha1.LogicalClusterPhaseReady, nil
+	}); err != nil {
+		return fmt.Errorf("workspace %s was created but is not ready: %w", to, err)
+	}
+
+	if err := o.rewriteHistory(from, to); err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(o.Out, "Workspace %q moved to %s.\n", o.Name, to)
+	return err
+}
+
+// rewriteHistory points the current and previous workspaces in the kubeconfig,
+// as maintained by "use", to the new path if they are within the moved
+// workspace, so that "kubectl ws -" keeps working.
+func (o *MoveWorkspaceOptions) rewriteHistory(from, to logicalcluster.Path) error {
+	newKubeConfig := o.startingConfig.DeepCopy()
+	changed := false
+	for _, key := range []string{kcpCurrentWorkspaceContextKey, kcpPreviousWorkspaceContextKey} {
+		cluster, found := newKubeConfig.Clusters[key]
+		if !found {
+			continue
+		}
+		u, current, err := pluginhelpers.ParseClusterURL(cluster.Server)
+		if err != nil {
+			continue
+		}
+		if current != from && !strings.HasPrefix(current.String(), from.String()+":") {
+			continue
+		}
+		current = logicalcluster.NewPath(to.String() + strings.TrimPrefix(current.String(), from.String()))
+		u.Path = path.Join(u.Path, current.RequestPath())
+		cluster.Server = u.String()
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return o.modifyConfig(o.ClientConfig.ConfigAccess(), newKubeConfig)
+}
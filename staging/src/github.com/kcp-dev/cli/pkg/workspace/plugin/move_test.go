@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	kcptesting "github.com/kcp-dev/client-go/third_party/k8s.io/client-go/testing"
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+	kcpfakeclient "github.com/kcp-dev/sdk/client/clientset/versioned/cluster/fake"
+)
+
+func TestMove(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		to    string
+		phase corev1alpha1.LogicalClusterPhaseType
+
+		wantErr     bool
+		wantParent  string
+		wantName    string
+		wantCurrent string
+		wantPrev    string
+	}{
+		{
+			name:        "rename within the current workspace",
+			to:          "baz",
+			phase:       corev1alpha1.LogicalClusterPhaseReady,
+			wantParent:  "root:foo",
+			wantName:    "baz",
+			wantCurrent: "https://test/clusters/root:foo:baz:sub",
+			wantPrev:    "https://test/clusters/root:foo",
+		},
+		{
+			name:        "move to another parent",
+			to:          ":root:other:bar",
+			phase:       corev1alpha1.LogicalClusterPhaseReady,
+			wantParent:  "root:other",
+			wantName:    "bar",
+			wantCurrent: "https://test/clusters/root:other:bar:sub",
+			wantPrev:    "https://test/clusters/root:foo",
+		},
+		{
+			name:    "move into itself",
+			to:      "bar:sub",
+			phase:   corev1alpha1.LogicalClusterPhaseReady,
+			wantErr: true,
+		},
+		{
+			name:    "workspace not ready",
+			to:      "baz",
+			phase:   corev1alpha1.LogicalClusterPhaseInitializing,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			parent := logicalcluster.NewPath("root:foo")
+			client := kcpfakeclient.NewSimpleClientset(&tenancyv1alpha1.Workspace{ //nolint:staticcheck
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "bar",
+					Annotations: map[string]string{logicalcluster.AnnotationKey: parent.String()},
+					Labels:      map[string]string{"team": "a"},
+				},
+				Spec: tenancyv1alpha1.WorkspaceSpec{
+					Cluster: "abc",
+					URL:     "https://shard/clusters/root:foo:bar",
+					Type:    &tenancyv1alpha1.WorkspaceTypeReference{Name: "universal", Path: "root"},
+				},
+				Status: tenancyv1alpha1.WorkspaceStatus{Phase: tt.phase},
+			})
+
+			var patch []byte
+			client.PrependReactor("patch", "workspaces", func(action kcptesting.Action) (bool, runtime.Object, error) {
+				patch = action.(kcptesting.PatchAction).GetPatch()
+				return true, nil, nil
+			})
+			var created *tenancyv1alpha1.Workspace
+			var createdIn logicalcluster.Path
+			client.PrependReactor("create", "workspaces", func(action kcptesting.Action) (bool, runtime.Object, error) {
+				created = action.(kcptesting.CreateAction).GetObject().(*tenancyv1alpha1.Workspace).DeepCopy()
+				createdIn = action.GetCluster()
+				created.Status.Phase = corev1alpha1.LogicalClusterPhaseReady
+				return true, created, nil
+			})
+
+			startingConfig := &clientcmdapi.Config{CurrentContext: "workspace.kcp.io/current",
+				Contexts: map[string]*clientcmdapi.Context{
+					"workspace.kcp.io/current":  {Cluster: "workspace.kcp.io/current", AuthInfo: "test"},
+					"workspace.kcp.io/previous": {Cluster: "workspace.kcp.io/previous", AuthInfo: "test"},
+				},
+				Clusters: map[string]*clientcmdapi.Cluster{
+					"workspace.kcp.io/current":  {Server: "https://test/clusters/root:foo:bar:sub"},
+					"workspace.kcp.io/previous": {Server: "https://test/clusters/root:foo"},
+				},
+				AuthInfos: map[string]*clientcmdapi.AuthInfo{"test": {Token: "test"}},
+			}
+			opts := NewMoveWorkspaceOptions(genericclioptions.NewTestIOStreamsDiscard())
+			opts.Name = "bar"
+			opts.To = tt.to
+			opts.ReadyWaitTimeout = time.Second
+			opts.kcpClusterClient = client
+			opts.startingConfig = startingConfig
+			// the command is run from the parent of the moved workspace
+			opts.ClientConfig = clientcmd.NewDefaultClientConfig(clientcmdapi.Config{CurrentContext: "test",
+				Contexts:  map[string]*clientcmdapi.Context{"test": {Cluster: "test", AuthInfo: "test"}},
+				Clusters:  map[string]*clientcmdapi.Cluster{"test": {Server: "https://test/clusters/root:foo"}},
+				AuthInfos: map[string]*clientcmdapi.AuthInfo{"test": {Token: "test"}},
+			}, nil)
+			var newConfig *clientcmdapi.Config
+			opts.modifyConfig = func(configAccess clientcmd.ConfigAccess, config *clientcmdapi.Config) error {
+				newConfig = config
+				return nil
+			}
+
+			err := opts.Run(context.Background())
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Nil(t, patch)
+				require.Nil(t, created)
+				return
+			}
+			require.NoError(t, err)
+			require.JSONEq(t, `{"metadata":{"annotations":{"tenancy.kcp.io/move-to":"`+tt.wantParent+":"+tt.wantName+`"}}}`, string(patch))
+			require.Equal(t, tt.wantParent, createdIn.String())
+			require.Equal(t, tt.wantName, created.Name)
+			require.Equal(t, "root:foo:bar", created.Annotations[tenancyv1alpha1.WorkspaceMoveFromAnnotationKey])
+			require.Equal(t, map[string]string{"team": "a"}, created.Labels)
+			require.Equal(t, &tenancyv1alpha1.WorkspaceTypeReference{Name: "universal", Path: "root"}, created.Spec.Type)
+
+			require.NotNil(t, newConfig)
+			require.Equal(t, tt.wantCurrent, newConfig.Clusters["workspace.kcp.io/current"].Server)
+			require.Equal(t, tt.wantPrev, newConfig.Clusters["workspace.kcp.io/previous"].Server)
+			require.Equal(t, "https://test/clusters/root:foo:bar:sub", startingConfig.Clusters["workspace.kcp.io/current"].Server, "starting config must not be modified")
+		})
+	}
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/kcp-dev/cli/pkg/base"
+	"github.com/kcp-dev/logicalcluster/v3"
+	"github.com/kcp-dev/sdk/apis/core"
+	migrationv1alpha1 "github.com/kcp-dev/sdk/apis/migration/v1alpha1"
+	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
+)
+
+// RestoreOptions contains options for restoring a workspace backup.
+type RestoreOptions struct {
+	*base.Options
+
+	// File is the path of the archive to restore.
+	File string
+	// LogicalCluster is the name of the logical cluster to restore into.
+	LogicalCluster string
+
+	kcpClusterClient kcpclientset.ClusterInterface
+}
+
+// NewRestoreOptions returns a new RestoreOptions.
+func NewRestoreOptions(streams genericclioptions.IOStreams) *RestoreOptions {
+	o := &RestoreOptions{
+		Options: base.NewOptions(streams),
+	}
+	// The logical cluster restored into does not exist yet, hence it
+	// cannot be addressed through a workspace path.
+	o.OptOutOfWorkspaceFlag = true
+	return o
+}
+
+// BindFlags binds fields to cmd's flagset.
+func (o *RestoreOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *RestoreOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if len(args) > 0 {
+		o.File = args[0]
+	}
+	if len(args) > 1 {
+		o.LogicalCluster = args[1]
+	}
+
+	kcpClusterClient, err := newKCPClusterClient(o.ClientConfig)
+	if err != nil {
+		return err
+	}
+	o.kcpClusterClient = kcpClusterClient
+
+	return nil
+}
+
+// Validate validates the RestoreOptions are complete and usable.
+func (o *RestoreOptions) Validate() error {
+	if o.File == "" {
+		return errors.New("an archive file is required")
+	}
+	if o.LogicalCluster == "" {
+		return errors.New("a logical cluster name is required")
+	}
+	if strings.Contains(o.LogicalCluster, ":") || !logicalcluster.NewPath(o.LogicalCluster).IsValid() {
+		return fmt.Errorf("invalid logical cluster name %q", o.LogicalCluster)
+	}
+	return o.Options.Validate()
+}
+
+// Run restores the archive into the logical cluster.
+func (o *RestoreOptions) Run(ctx context.Context) error {
+	f, err := os.Open(o.File)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	manifest, err := readBackupManifest(f)
+	if err != nil {
+		return fmt.Errorf("failed to verify %s: %w", o.File, err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	restored, err := o.restore(ctx, f, manifest)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "Restored %d entries of logical cluster %s into logical cluster %s.\n", restored, manifest.LogicalCluster, o.LogicalCluster)
+	return nil
+}
+
+// restore sends the pages of the archive to the shard. The LogicalCluster
+// object is restored last, so the logical cluster only shows up once all
+// of its contents are in place.
+func (o *RestoreOptions) restore(ctx context.Context, r io.Reader, manifest *backupManifest) (int64, error) {
+	var restored int64
+	send := func(entries []migrationv1alpha1.EtcdEntry) error {
+		if len(entries) == 0 {
+			return nil
+		}
+		resp, err := o.kcpClusterClient.Cluster(logicalcluster.NewPath(o.LogicalCluster)).MigrationV1alpha1().LogicalClusterRestores().Create(ctx, &migrationv1alpha1.LogicalClusterRestore{
+			Spec: migrationv1alpha1.LogicalClusterRestoreSpec{
+				SourceLogicalCluster: manifest.LogicalCluster,
+				Entries:              entries,
+			},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to restore into logical cluster %s: %w", o.LogicalCluster, err)
+		}
+		restored += resp.Status.Restored
+		return nil
+	}
+
+	var logicalClusterEntries []migrationv1alpha1.EtcdEntry
+	err := readBackupPages(r, manifest, func(entries []migrationv1alpha1.EtcdEntry) error {
+		page := make([]migrationv1alpha1.EtcdEntry, 0, len(entries))
+		for _, entry := range entries {
+			if strings.HasPrefix(entry.Key, core.GroupName+"/logicalclusters/") {
+				logicalClusterEntries = append(logicalClusterEntries, entry)
+				continue
+			}
+			page = append(page, entry)
+		}
+		return send(page)
+	})
+	if err != nil {
+		return restored, err
+	}
+
+	return restored, send(logicalClusterEntries)
+}
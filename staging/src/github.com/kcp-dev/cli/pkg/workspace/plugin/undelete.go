@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/kcp-dev/cli/pkg/base"
+	pluginhelpers "github.com/kcp-dev/cli/pkg/helpers"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
+)
+
+// UndeleteWorkspaceOptions contains options for undeleting a workspace.
+type UndeleteWorkspaceOptions struct {
+	*base.Options
+
+	// Name is the name of the workspace to undelete.
+	Name string
+	// ReadyWaitTimeout is how long to wait for the workspace to be ready before returning control to the user.
+	ReadyWaitTimeout time.Duration
+
+	kcpClusterClient kcpclientset.ClusterInterface
+}
+
+// NewUndeleteWorkspaceOptions returns a new UndeleteWorkspaceOptions.
+func NewUndeleteWorkspaceOptions(streams genericclioptions.IOStreams) *UndeleteWorkspaceOptions {
+	return &UndeleteWorkspaceOptions{
+		Options: base.NewOptions(streams),
+
+		ReadyWaitTimeout: time.Minute,
+	}
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *UndeleteWorkspaceOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if len(args) > 0 {
+		o.Name = args[0]
+	}
+
+	kcpClusterClient, err := newKCPClusterClient(o.ClientConfig)
+	if err != nil {
+		return err
+	}
+	o.kcpClusterClient = kcpClusterClient
+
+	return nil
+}
+
+// Validate validates the UndeleteWorkspaceOptions are complete and usable.
+func (o *UndeleteWorkspaceOptions) Validate() error {
+	if o.Name == "" {
+		return fmt.Errorf("workspace name is required")
+	}
+
+	return o.Options.Validate()
+}
+
+// BindFlags binds fields to cmd's flagset.
+func (o *UndeleteWorkspaceOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+	cmd.Flags().DurationVar(&o.ReadyWaitTimeout, "timeout", o.ReadyWaitTimeout, "How long to wait for the workspace to be released and ready again.")
+}
+
+// Run undeletes a workspace. The deleted workspace is released by annotating
+// it with the logical cluster to undelete, and then recreated with the same
+// name and type, taking over the retained logical cluster.
+func (o *UndeleteWorkspaceOptions) Run(ctx context.Context) error {
+	config, err := o.ClientConfig.ClientConfig()
+	if err != nil {
+		return err
+	}
+	_, currentClusterName, err := pluginhelpers.ParseClusterURL(config.Host)
+	if err != nil {
+		return fmt.Errorf("current URL %q does not point to a workspace", config.Host)
+	}
+	workspaces := o.kcpClusterClient.Cluster(currentClusterName).TenancyV1alpha1().Workspaces()
+
+	ws, err := workspaces.Get(ctx, o.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if ws.Status.Phase != corev1alpha1.LogicalClusterPhaseDeleted {
+		return fmt.Errorf("workspace %q is in phase %q, only %q workspaces can be undeleted", o.Name, ws.Status.Phase, corev1alpha1.LogicalClusterPhaseDeleted)
+	}
+	if ws.Spec.Cluster == "" {
+		return fmt.Errorf("workspace %q has no logical cluster", o.Name)
+	}
+
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, tenancyv1alpha1.WorkspaceUndeleteAnnotationKey, ws.Spec.Cluster)
+	if _, err := workspaces.Patch(ctx, o.Name, types.MergePatchType, []byte(patch), metav1.PatchOptions{}); err != nil {
+		return err
+	}
+
+	// wait for the deleted workspace to be released
+	if err := wait.PollUntilContextTimeout(ctx, time.Millisecond*500, o.ReadyWaitTimeout, true, func(ctx context.Context) (bool, error) {
+		_, err := workspaces.Get(ctx, o.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}); err != nil {
+		return fmt.Errorf("workspace %q was not released: %w", o.Name, err)
+	}
+
+	undeleted := &tenancyv1alpha1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        o.Name,
+			Labels:      ws.Labels,
+			Annotations: map[string]string{tenancyv1alpha1.WorkspaceUndeleteAnnotationKey: ws.Spec.Cluster},
+		},
+		Spec: tenancyv1alpha1.WorkspaceSpec{
+			Type:     ws.Spec.Type,
+			Location: ws.Spec.Location,
+		},
+	}
+	undeleted, err = workspaces.Create(ctx, undeleted, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to recreate workspace %q, retry by creating it with annotation %s=%s: %w", o.Name, tenancyv1alpha1.WorkspaceUndeleteAnnotationKey, ws.Spec.Cluster, err)
+	}
+
+	if err := wait.PollUntilContextTimeout(ctx, time.Millisecond*500, o.ReadyWaitTimeout, true, func(ctx context.Context) (bool, error) {
+		if undeleted.Status.Phase == corev1alpha1.LogicalClusterPhaseReady {
+			return true, nil
+		}
+		undeleted, err = workspaces.Get(ctx, o.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return undeleted.Status.Phase == corev1alpha1.LogicalClusterPhaseReady, nil
+	}); err != nil {
+		return fmt.Errorf("workspace %q was recreated but is not ready: %w", o.Name, err)
+	}
+
+	_, err = fmt.Fprintf(o.Out, "Workspace %q undeleted.\n", o.Name)
+	return err
+}
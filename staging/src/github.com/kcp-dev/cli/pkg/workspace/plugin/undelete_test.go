@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	kcptesting "github.com/kcp-dev/client-go/third_party/k8s.io/client-go/testing"
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+	kcpfakeclient "github.com/kcp-dev/sdk/client/clientset/versioned/cluster/fake"
+)
+
+func TestUndelete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		phase corev1alpha1.LogicalClusterPhaseType
+
+		wantErr bool
+	}{
+		{
+			name:  "deleted workspace is recreated",
+			phase: corev1alpha1.LogicalClusterPhaseDeleted,
+		},
+		{
+			name:    "ready workspace cannot be undeleted",
+			phase:   corev1alpha1.LogicalClusterPhaseReady,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			parent := logicalcluster.NewPath("root:foo")
+			workspacesResource := tenancyv1alpha1.SchemeGroupVersion.WithResource("workspaces")
+			client := kcpfakeclient.NewSimpleClientset(&tenancyv1alpha1.Workspace{ //nolint:staticcheck
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "bar",
+					Annotations: map[string]string{logicalcluster.AnnotationKey: parent.String()},
+					Labels:      map[string]string{"team": "a"},
+				},
+				Spec: tenancyv1alpha1.WorkspaceSpec{
+					Cluster: "abc",
+					Type:    &tenancyv1alpha1.WorkspaceTypeReference{Name: "universal", Path: "root"},
+				},
+				Status: tenancyv1alpha1.WorkspaceStatus{Phase: tt.phase},
+			})
+
+			// the workspace controller releases the deleted workspace once annotated
+			var patch []byte
+			client.PrependReactor("patch", "workspaces", func(action kcptesting.Action) (bool, runtime.Object, error) {
+				patch = action.(kcptesting.PatchAction).GetPatch()
+				return true, nil, client.Tracker().Cluster(parent).Delete(workspacesResource, "", "bar")
+			})
+			var created *tenancyv1alpha1.Workspace
+			client.PrependReactor("create", "workspaces", func(action kcptesting.Action) (bool, runtime.Object, error) {
+				created = action.(kcptesting.CreateAction).GetObject().(*tenancyv1alpha1.Workspace).DeepCopy()
+				created.Status.Phase = corev1alpha1.LogicalClusterPhaseReady
+				return true, created, client.Tracker().Cluster(parent).Create(workspacesResource, created, "")
+			})
+
+			opts := NewUndeleteWorkspaceOptions(genericclioptions.NewTestIOStreamsDiscard())
+			opts.Name = "bar"
+			opts.ReadyWaitTimeout = time.Second
+			opts.kcpClusterClient = client
+			opts.ClientConfig = clientcmd.NewDefaultClientConfig(clientcmdapi.Config{CurrentContext: "test",
+				Contexts:  map[string]*clientcmdapi.Context{"test": {Cluster: "test", AuthInfo: "test"}},
+				Clusters:  map[string]*clientcmdapi.Cluster{"test": {Server: "https://test/clusters/root:foo"}},
+				AuthInfos: map[string]*clientcmdapi.AuthInfo{"test": {Token: "test"}},
+			}, nil)
+
+			err := opts.Run(context.Background())
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Nil(t, patch)
+				require.Nil(t, created)
+				return
+			}
+			require.NoError(t, err)
+			require.JSONEq(t, `{"metadata":{"annotations":{"tenancy.kcp.io/undelete":"abc"}}}`, string(patch))
+			require.Equal(t, "abc", created.Annotations[tenancyv1alpha1.WorkspaceUndeleteAnnotationKey])
+			require.Equal(t, map[string]string{"team": "a"}, created.Labels)
+			require.Equal(t, &tenancyv1alpha1.WorkspaceTypeReference{Name: "universal", Path: "root"}, created.Spec.Type)
+		})
+	}
+}
@@ -80,6 +80,44 @@ type APIBindingSpec struct {
 	//
 	// +optional
 	PermissionClaims []AcceptablePermissionClaim `json:"permissionClaims,omitempty"`
+
+	// pinnedSchemas pins resources of the APIExport to specific APIResourceSchemas in the
+	// workspace of the APIExport. A pinned resource stays bound to its schema when the
+	// APIExport moves ahead, until the pin is updated or removed.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=group
+	// +listMapKey=resource
+	PinnedSchemas []PinnedSchema `json:"pinnedSchemas,omitempty"`
+}
+
+// PinnedSchema pins a resource of an APIExport to an APIResourceSchema, by name or by UID.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.schema) || has(self.schemaUID)",message="either schema or schemaUID must be set"
+type PinnedSchema struct {
+	// group is the API group of the resource. Empty string for the core API group.
+	//
+	// +kubebuilder:default:=""
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// resource is the name of the resource.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Resource string `json:"resource"`
+
+	// schema is the name of the APIResourceSchema.
+	//
+	// +optional
+	Schema string `json:"schema,omitempty"`
+
+	// schemaUID is the UID of the APIResourceSchema. If schema is set too, both
+	// must refer to the same APIResourceSchema.
+	//
+	// +optional
+	SchemaUID string `json:"schemaUID,omitempty"`
 }
 
 // AcceptablePermissionClaim is a PermissionClaim that records if the user accepts or rejects it.
@@ -177,6 +215,13 @@ type APIBindingStatus struct {
 	// the binding to grant.
 	// +optional
 	ExportPermissionClaims []PermissionClaim `json:"exportPermissionClaims,omitempty"`
+
+	// schemaRevision identifies the resource schemas of the APIExport the APIBinding
+	// is bound to. It matches the schemaRevision or previousSchemaRevision in the
+	// APIExport status.
+	//
+	// +optional
+	SchemaRevision string `json:"schemaRevision,omitempty"`
 }
 
 // These are valid conditions of APIBinding.
@@ -279,6 +324,12 @@ type BoundAPIResource struct {
 	// +optional
 	// +listType=set
 	StorageVersions []string `json:"storageVersions,omitempty"`
+
+	// availableSchema references the APIResourceSchema the APIExport offers for this API,
+	// if the API is pinned to another one in spec.pinnedSchemas.
+	//
+	// +optional
+	AvailableSchema *BoundAPIResourceSchema `json:"availableSchema,omitempty"`
 }
 
 // BoundAPIResourceSchema is a reference to an APIResourceSchema.
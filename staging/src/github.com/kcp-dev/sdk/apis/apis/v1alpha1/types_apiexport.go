@@ -325,6 +325,17 @@ type APIExportStatus struct {
 	//
 	// +optional
 	VirtualWorkspaces []VirtualWorkspace `json:"virtualWorkspaces,omitempty"`
+
+	// schemaRevision identifies the resource schemas the APIExport binds to.
+	//
+	// +optional
+	SchemaRevision string `json:"schemaRevision,omitempty"`
+
+	// previousSchemaRevision identifies the resource schemas of the APIExport
+	// before a staged rollout.
+	//
+	// +optional
+	PreviousSchemaRevision string `json:"previousSchemaRevision,omitempty"`
 }
 
 type VirtualWorkspace struct {
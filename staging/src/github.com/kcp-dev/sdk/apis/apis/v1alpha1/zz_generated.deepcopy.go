@@ -102,6 +102,11 @@ func (in *APIBindingSpec) DeepCopyInto(out *APIBindingSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PinnedSchemas != nil {
+		in, out := &in.PinnedSchemas, &out.PinnedSchemas
+		*out = make([]PinnedSchema, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -688,6 +693,11 @@ func (in *BoundAPIResource) DeepCopyInto(out *BoundAPIResource) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AvailableSchema != nil {
+		in, out := &in.AvailableSchema, &out.AvailableSchema
+		*out = new(BoundAPIResourceSchema)
+		**out = **in
+	}
 	return
 }
 
@@ -850,6 +860,22 @@ func (in *PermissionClaim) DeepCopy() *PermissionClaim {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PinnedSchema) DeepCopyInto(out *PinnedSchema) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PinnedSchema.
+func (in *PinnedSchema) DeepCopy() *PinnedSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(PinnedSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceSelector) DeepCopyInto(out *ResourceSelector) {
 	*out = *in
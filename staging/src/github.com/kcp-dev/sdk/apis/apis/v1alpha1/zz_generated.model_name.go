@@ -181,6 +181,11 @@ func (in PermissionClaim) OpenAPIModelName() string {
 	return "com.github.kcp-dev.sdk.apis.apis.v1alpha1.PermissionClaim"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in PinnedSchema) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.apis.v1alpha1.PinnedSchema"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ResourceSelector) OpenAPIModelName() string {
 	return "com.github.kcp-dev.sdk.apis.apis.v1alpha1.ResourceSelector"
@@ -79,6 +79,44 @@ type APIBindingSpec struct {
 	// +listMapKey=resource
 	// +listMapKey=identityHash
 	PermissionClaims []AcceptablePermissionClaim `json:"permissionClaims,omitempty"`
+
+	// pinnedSchemas pins resources of the APIExport to specific APIResourceSchemas in the
+	// workspace of the APIExport. A pinned resource stays bound to its schema when the
+	// APIExport moves ahead, until the pin is updated or removed.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=group
+	// +listMapKey=resource
+	PinnedSchemas []PinnedSchema `json:"pinnedSchemas,omitempty"`
+}
+
+// PinnedSchema pins a resource of an APIExport to an APIResourceSchema, by name or by UID.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.schema) || has(self.schemaUID)",message="either schema or schemaUID must be set"
+type PinnedSchema struct {
+	// group is the API group of the resource. Empty string for the core API group.
+	//
+	// +kubebuilder:default:=""
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// resource is the name of the resource.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Resource string `json:"resource"`
+
+	// schema is the name of the APIResourceSchema.
+	//
+	// +optional
+	Schema string `json:"schema,omitempty"`
+
+	// schemaUID is the UID of the APIResourceSchema. If schema is set too, both
+	// must refer to the same APIResourceSchema.
+	//
+	// +optional
+	SchemaUID string `json:"schemaUID,omitempty"`
 }
 
 // ScopedPermissionClaim embeds a PermissionClaim and adds a selector to
@@ -204,6 +242,13 @@ type APIBindingStatus struct {
 	// the binding to grant.
 	// +optional
 	ExportPermissionClaims []PermissionClaim `json:"exportPermissionClaims,omitempty"`
+
+	// schemaRevision identifies the resource schemas of the APIExport the APIBinding
+	// is bound to. It matches the schemaRevision or previousSchemaRevision in the
+	// APIExport status.
+	//
+	// +optional
+	SchemaRevision string `json:"schemaRevision,omitempty"`
 }
 
 // These are valid conditions of APIBinding.
@@ -242,6 +287,14 @@ const (
 	// has a naming conflict with other APIs.
 	NamingConflictsReason = "NamingConflicts"
 
+	// UpgradeAvailableReason is a reason for the BindingUpToDate condition that the APIExport offers
+	// newer schemas than those pinned in the APIBinding.
+	UpgradeAvailableReason = "UpgradeAvailable"
+
+	// PinnedSchemaInvalidReason is a reason for the BindingUpToDate and InitialBindingCompleted conditions
+	// that a schema pinned in the APIBinding cannot be bound.
+	PinnedSchemaInvalidReason = "PinnedSchemaInvalid"
+
 	// BindingResourceDeleteSuccess is a condition for APIBinding that indicates the resources relating this binding are deleted
 	// successfully when the APIBinding is deleting.
 	BindingResourceDeleteSuccess conditionsv1alpha1.ConditionType = "BindingResourceDeleteSuccess"
@@ -260,6 +313,19 @@ const (
 	// PermissionClaimsApplied is a condition for APIBinding that indicates that all the accepted permission claims
 	// have been applied.
 	PermissionClaimsApplied conditionsv1alpha1.ConditionType = "PermissionClaimsApplied"
+
+	// StorageVersionsMigrated is a condition for APIBinding that indicates that all objects of the bound resources
+	// are stored in the storage versions of the currently bound schemas. It is only set once a bound resource has
+	// recorded other storage versions.
+	StorageVersionsMigrated conditionsv1alpha1.ConditionType = "StorageVersionsMigrated"
+
+	// StorageVersionMigrationInProgressReason is a reason for the StorageVersionsMigrated condition that objects of
+	// some bound resources still have to be rewritten.
+	StorageVersionMigrationInProgressReason = "StorageVersionMigrationInProgress"
+
+	// StorageVersionMigrationFailedReason is a reason for the StorageVersionsMigrated condition that rewriting the
+	// objects of a bound resource failed.
+	StorageVersionMigrationFailedReason = "StorageVersionMigrationFailed"
 )
 
 // BoundAPIResource describes a bound GroupVersionResource through an APIResourceSchema of an APIExport..
@@ -291,6 +357,12 @@ type BoundAPIResource struct {
 	// +optional
 	// +listType=set
 	StorageVersions []string `json:"storageVersions,omitempty"`
+
+	// availableSchema references the APIResourceSchema the APIExport offers for this API,
+	// if the API is pinned to another one in spec.pinnedSchemas.
+	//
+	// +optional
+	AvailableSchema *BoundAPIResourceSchema `json:"availableSchema,omitempty"`
 }
 
 // BoundAPIResourceSchema is a reference to an APIResourceSchema.
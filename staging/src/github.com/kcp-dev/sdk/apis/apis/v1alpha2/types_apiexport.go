@@ -17,7 +17,10 @@ limitations under the License.
 package v1alpha2
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"sort"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -88,8 +91,8 @@ type APIExportSpec struct {
 	// The schemas can be changed in the life-cycle of the APIExport. These changes
 	// have no effect on existing APIBindings, but only on newly bound ones.
 	//
-	// For updating existing APIBindings, use an APIDeployment keeping bound
-	// workspaces up-to-date.
+	// Changes are picked up by all APIBindings at once, unless rollout is set
+	// to stage them.
 	//
 	// +optional
 	// +listType=map
@@ -153,6 +156,48 @@ type APIExportSpec struct {
 	// +listMapKey=group
 	// +listMapKey=resource
 	PermissionClaims []PermissionClaim `json:"permissionClaims,omitempty"`
+
+	// rollout stages a change of resources. While set, only the APIBindings selected
+	// by the rollout are bound to resources; all others stay on rollout.previousResources.
+	// The change is promoted to all APIBindings by removing rollout.
+	//
+	// +optional
+	Rollout *APIExportRollout `json:"rollout,omitempty"`
+}
+
+// APIExportRollout selects the APIBindings that are bound to the resources of an
+// APIExport during a staged rollout.
+type APIExportRollout struct {
+	// previousResources are the resources that APIBindings not selected for the
+	// rollout are bound to.
+	//
+	// +required
+	// +listType=map
+	// +listMapKey=name
+	// +listMapKey=group
+	PreviousResources []ResourceSchema `json:"previousResources"`
+
+	// percentage is the share of APIBindings that are bound to resources. An APIBinding
+	// is selected by a stable hash of its logical cluster and name, i.e. raising the
+	// percentage keeps the previously selected APIBindings selected.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage int32 `json:"percentage,omitempty"`
+
+	// selector selects APIBindings by label that are bound to resources, in addition
+	// to those selected by percentage. An empty selector selects no APIBinding.
+	//
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// halted stops the rollout. APIBindings that are not bound to resources yet stay
+	// on previousResources, independently of percentage and selector. APIBindings
+	// already bound to resources stay there.
+	//
+	// +optional
+	Halted bool `json:"halted,omitempty"`
 }
 
 // ResourceSchema defines the resource schemas that are exposed with this APIExport.
@@ -171,6 +216,48 @@ type ResourceSchema struct {
 	Storage ResourceSchemaStorage `json:"storage"`
 }
 
+// SchemaRevision returns a short identifier of the given resource schemas, independent
+// of their order. It is empty if there are no resource schemas.
+func SchemaRevision(resources []ResourceSchema) string {
+	if len(resources) == 0 {
+		return ""
+	}
+
+	keys := make([]string, 0, len(resources))
+	for _, r := range resources {
+		keys = append(keys, r.Group+"/"+r.Name+"="+r.Schema)
+	}
+	sort.Strings(keys)
+
+	hash := sha256.New()
+	for _, key := range keys {
+		hash.Write([]byte(key + "\n"))
+	}
+	return hex.EncodeToString(hash.Sum(nil))[:10]
+}
+
+// BindableResources returns the resources of the APIExport any APIBinding can be
+// bound to: the resources, and during a rollout also the previous resources that
+// are not among them. The resources come first.
+func (e *APIExport) BindableResources() []ResourceSchema {
+	if e.Spec.Rollout == nil {
+		return e.Spec.Resources
+	}
+
+	resources := make([]ResourceSchema, 0, len(e.Spec.Resources)+len(e.Spec.Rollout.PreviousResources))
+	seen := make(map[string]bool, len(e.Spec.Resources))
+	for _, r := range e.Spec.Resources {
+		resources = append(resources, r)
+		seen[r.Group+"/"+r.Name+"="+r.Schema] = true
+	}
+	for _, r := range e.Spec.Rollout.PreviousResources {
+		if !seen[r.Group+"/"+r.Name+"="+r.Schema] {
+			resources = append(resources, r)
+		}
+	}
+	return resources
+}
+
 // ResourceSchemaStorage defines how the resource is stored.
 //
 // +kubebuilder:validation:XValidation:rule="has(self.crd) != has(self.virtual)",message="Exactly one of crd or virtual must be set"
@@ -361,6 +448,17 @@ type APIExportStatus struct {
 	//
 	// +optional
 	VirtualWorkspaces []VirtualWorkspace `json:"virtualWorkspaces,omitempty"`
+
+	// schemaRevision identifies the resource schemas in spec.resources.
+	//
+	// +optional
+	SchemaRevision string `json:"schemaRevision,omitempty"`
+
+	// previousSchemaRevision identifies the resource schemas in spec.rollout.previousResources
+	// during a rollout.
+	//
+	// +optional
+	PreviousSchemaRevision string `json:"previousSchemaRevision,omitempty"`
 }
 
 type VirtualWorkspace struct {
@@ -30,6 +30,7 @@ const (
 	ResourceSchemasAnnotation          = "apis.v1alpha2.kcp.io/resource-schemas"
 	PermissionClaimsAnnotation         = "apis.v1alpha2.kcp.io/permission-claims"
 	PermissionClaimsV1Alpha1Annotation = "apis.v1alpha2.kcp.io/v1alpha1-permission-claims"
+	RolloutAnnotation                  = "apis.v1alpha2.kcp.io/rollout"
 )
 
 // v1alpha2 -> v1alpha1 conversions.
@@ -69,6 +70,19 @@ func Convert_v1alpha2_APIExport_To_v1alpha1_APIExport(in *APIExport, out *apisv1
 		out.Annotations[PermissionClaimsAnnotation] = string(encoded)
 	}
 
+	// The rollout cannot be represented in v1alpha1 at all, retain it via an annotation.
+	if in.Spec.Rollout != nil {
+		encoded, err := json.Marshal(in.Spec.Rollout)
+		if err != nil {
+			return fmt.Errorf("failed to encode rollout as JSON: %w", err)
+		}
+
+		if out.Annotations == nil {
+			out.Annotations = map[string]string{}
+		}
+		out.Annotations[RolloutAnnotation] = string(encoded)
+	}
+
 	if err := Convert_v1alpha2_APIExportSpec_To_v1alpha1_APIExportSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
@@ -142,7 +156,7 @@ func Convert_v1alpha2_PermissionClaims_To_v1alpha1_PermissionClaims(in []Permiss
 }
 
 // Convert_v1alpha2_APIExportSpec_To_v1alpha1_APIExportSpec is *not* lossless, as it will drop all non-CRD
-// resource schemas and all non-wildcard PermissionClaims present in the APIExport's spec.
+// resource schemas, all non-wildcard PermissionClaims and the rollout present in the APIExport's spec.
 // To have a full, lossless conversion, use Convert_v1alpha2_APIExport_To_v1alpha1_APIExport instead.
 func Convert_v1alpha2_APIExportSpec_To_v1alpha1_APIExportSpec(in *APIExportSpec, out *apisv1alpha1.APIExportSpec, s kubeconversion.Scope) error {
 	if in.Identity != nil {
@@ -269,6 +283,20 @@ func Convert_v1alpha1_APIExport_To_v1alpha2_APIExport(in *apisv1alpha1.APIExport
 		}
 	}
 
+	if rollout, ok := in.Annotations[RolloutAnnotation]; ok {
+		out.Spec.Rollout = &APIExportRollout{}
+		if err := json.Unmarshal([]byte(rollout), out.Spec.Rollout); err != nil {
+			return fmt.Errorf("failed to decode rollout from JSON: %w", err)
+		}
+
+		delete(out.Annotations, RolloutAnnotation)
+
+		// Make tests for equality easier to write by turning []string into nil.
+		if len(out.Annotations) == 0 {
+			out.Annotations = nil
+		}
+	}
+
 	return nil
 }
 
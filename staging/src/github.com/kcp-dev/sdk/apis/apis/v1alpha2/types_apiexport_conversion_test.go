@@ -147,6 +147,39 @@ func TestConvertV1Alpha2APIExports(t *testing.T) {
 				}},
 			},
 		},
+		// Test case with a rollout, which does not exist in v1alpha1
+		{
+			Spec: APIExportSpec{
+				Resources: []ResourceSchema{{
+					Group:  "bar",
+					Name:   "foo",
+					Schema: "v2.foo.bar",
+					Storage: ResourceSchemaStorage{
+						CRD: &ResourceSchemaStorageCRD{},
+					},
+				}},
+				Rollout: &APIExportRollout{
+					PreviousResources: []ResourceSchema{{
+						Group:  "bar",
+						Name:   "foo",
+						Schema: "v1.foo.bar",
+						Storage: ResourceSchemaStorage{
+							CRD: &ResourceSchemaStorageCRD{},
+						},
+					}},
+					Percentage: 10,
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"canary": "true",
+						},
+					},
+				},
+			},
+			Status: APIExportStatus{
+				SchemaRevision:         "abc",
+				PreviousSchemaRevision: "def",
+			},
+		},
 	}
 
 	scheme := runtime.NewScheme()
@@ -15,3 +15,50 @@ limitations under the License.
 */
 
 package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBindableResources(t *testing.T) {
+	widgetsV1 := ResourceSchema{Group: "example.io", Name: "widgets", Schema: "v1.widgets.example.io"}
+	widgetsV2 := ResourceSchema{Group: "example.io", Name: "widgets", Schema: "v2.widgets.example.io"}
+	gadgetsV1 := ResourceSchema{Group: "example.io", Name: "gadgets", Schema: "v1.gadgets.example.io"}
+
+	tests := map[string]struct {
+		spec APIExportSpec
+		want []ResourceSchema
+	}{
+		"no rollout": {
+			spec: APIExportSpec{Resources: []ResourceSchema{widgetsV2}},
+			want: []ResourceSchema{widgetsV2},
+		},
+		"half rolled out": {
+			spec: APIExportSpec{
+				Resources: []ResourceSchema{widgetsV2},
+				Rollout: &APIExportRollout{
+					Percentage:        50,
+					PreviousResources: []ResourceSchema{widgetsV1, gadgetsV1},
+				},
+			},
+			want: []ResourceSchema{widgetsV2, widgetsV1, gadgetsV1},
+		},
+		"unchanged resources are listed once": {
+			spec: APIExportSpec{
+				Resources: []ResourceSchema{widgetsV2, gadgetsV1},
+				Rollout: &APIExportRollout{
+					PreviousResources: []ResourceSchema{widgetsV1, gadgetsV1},
+				},
+			},
+			want: []ResourceSchema{widgetsV2, gadgetsV1, widgetsV1},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			export := &APIExport{Spec: tc.spec}
+			require.Equal(t, tc.want, export.BindableResources())
+		})
+	}
+}
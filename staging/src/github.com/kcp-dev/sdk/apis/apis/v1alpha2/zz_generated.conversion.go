@@ -169,6 +169,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PinnedSchema)(nil), (*v1alpha1.PinnedSchema)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_PinnedSchema_To_v1alpha1_PinnedSchema(a.(*PinnedSchema), b.(*v1alpha1.PinnedSchema), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PinnedSchema)(nil), (*PinnedSchema)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PinnedSchema_To_v1alpha2_PinnedSchema(a.(*v1alpha1.PinnedSchema), b.(*PinnedSchema), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ResourceSelector)(nil), (*v1alpha1.ResourceSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_ResourceSelector_To_v1alpha1_ResourceSelector(a.(*ResourceSelector), b.(*v1alpha1.ResourceSelector), scope)
 	}); err != nil {
@@ -331,6 +341,7 @@ func autoConvert_v1alpha2_APIBindingSpec_To_v1alpha1_APIBindingSpec(in *APIBindi
 	} else {
 		out.PermissionClaims = nil
 	}
+	out.PinnedSchemas = *(*[]v1alpha1.PinnedSchema)(unsafe.Pointer(&in.PinnedSchemas))
 	return nil
 }
 
@@ -354,6 +365,7 @@ func autoConvert_v1alpha1_APIBindingSpec_To_v1alpha2_APIBindingSpec(in *v1alpha1
 	} else {
 		out.PermissionClaims = nil
 	}
+	out.PinnedSchemas = *(*[]PinnedSchema)(unsafe.Pointer(&in.PinnedSchemas))
 	return nil
 }
 
@@ -389,6 +401,7 @@ func autoConvert_v1alpha2_APIBindingStatus_To_v1alpha1_APIBindingStatus(in *APIB
 	} else {
 		out.ExportPermissionClaims = nil
 	}
+	out.SchemaRevision = in.SchemaRevision
 	return nil
 }
 
@@ -424,6 +437,7 @@ func autoConvert_v1alpha1_APIBindingStatus_To_v1alpha2_APIBindingStatus(in *v1al
 	} else {
 		out.ExportPermissionClaims = nil
 	}
+	out.SchemaRevision = in.SchemaRevision
 	return nil
 }
 
@@ -511,6 +525,7 @@ func autoConvert_v1alpha2_APIExportSpec_To_v1alpha1_APIExportSpec(in *APIExportS
 	} else {
 		out.PermissionClaims = nil
 	}
+	// WARNING: in.Rollout requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -536,6 +551,8 @@ func autoConvert_v1alpha2_APIExportStatus_To_v1alpha1_APIExportStatus(in *APIExp
 	out.IdentityHash = in.IdentityHash
 	out.Conditions = *(*conditionsv1alpha1.Conditions)(unsafe.Pointer(&in.Conditions))
 	out.VirtualWorkspaces = *(*[]v1alpha1.VirtualWorkspace)(unsafe.Pointer(&in.VirtualWorkspaces))
+	out.SchemaRevision = in.SchemaRevision
+	out.PreviousSchemaRevision = in.PreviousSchemaRevision
 	return nil
 }
 
@@ -548,6 +565,8 @@ func autoConvert_v1alpha1_APIExportStatus_To_v1alpha2_APIExportStatus(in *v1alph
 	out.IdentityHash = in.IdentityHash
 	out.Conditions = *(*conditionsv1alpha1.Conditions)(unsafe.Pointer(&in.Conditions))
 	out.VirtualWorkspaces = *(*[]VirtualWorkspace)(unsafe.Pointer(&in.VirtualWorkspaces))
+	out.SchemaRevision = in.SchemaRevision
+	out.PreviousSchemaRevision = in.PreviousSchemaRevision
 	return nil
 }
 
@@ -595,6 +614,7 @@ func autoConvert_v1alpha2_BoundAPIResource_To_v1alpha1_BoundAPIResource(in *Boun
 		return err
 	}
 	out.StorageVersions = *(*[]string)(unsafe.Pointer(&in.StorageVersions))
+	out.AvailableSchema = (*v1alpha1.BoundAPIResourceSchema)(unsafe.Pointer(in.AvailableSchema))
 	return nil
 }
 
@@ -610,6 +630,7 @@ func autoConvert_v1alpha1_BoundAPIResource_To_v1alpha2_BoundAPIResource(in *v1al
 		return err
 	}
 	out.StorageVersions = *(*[]string)(unsafe.Pointer(&in.StorageVersions))
+	out.AvailableSchema = (*BoundAPIResourceSchema)(unsafe.Pointer(in.AvailableSchema))
 	return nil
 }
 
@@ -764,6 +785,32 @@ func autoConvert_v1alpha1_PermissionClaim_To_v1alpha2_PermissionClaim(in *v1alph
 	return nil
 }
 
+func autoConvert_v1alpha2_PinnedSchema_To_v1alpha1_PinnedSchema(in *PinnedSchema, out *v1alpha1.PinnedSchema, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Resource = in.Resource
+	out.Schema = in.Schema
+	out.SchemaUID = in.SchemaUID
+	return nil
+}
+
+// Convert_v1alpha2_PinnedSchema_To_v1alpha1_PinnedSchema is an autogenerated conversion function.
+func Convert_v1alpha2_PinnedSchema_To_v1alpha1_PinnedSchema(in *PinnedSchema, out *v1alpha1.PinnedSchema, s conversion.Scope) error {
+	return autoConvert_v1alpha2_PinnedSchema_To_v1alpha1_PinnedSchema(in, out, s)
+}
+
+func autoConvert_v1alpha1_PinnedSchema_To_v1alpha2_PinnedSchema(in *v1alpha1.PinnedSchema, out *PinnedSchema, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Resource = in.Resource
+	out.Schema = in.Schema
+	out.SchemaUID = in.SchemaUID
+	return nil
+}
+
+// Convert_v1alpha1_PinnedSchema_To_v1alpha2_PinnedSchema is an autogenerated conversion function.
+func Convert_v1alpha1_PinnedSchema_To_v1alpha2_PinnedSchema(in *v1alpha1.PinnedSchema, out *PinnedSchema, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PinnedSchema_To_v1alpha2_PinnedSchema(in, out, s)
+}
+
 func autoConvert_v1alpha2_ResourceSelector_To_v1alpha1_ResourceSelector(in *ResourceSelector, out *v1alpha1.ResourceSelector, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Namespace = in.Namespace
@@ -22,7 +22,8 @@ limitations under the License.
 package v1alpha2
 
 import (
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
 	v1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
@@ -100,6 +101,11 @@ func (in *APIBindingSpec) DeepCopyInto(out *APIBindingSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PinnedSchemas != nil {
+		in, out := &in.PinnedSchemas, &out.PinnedSchemas
+		*out = make([]PinnedSchema, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -218,6 +224,34 @@ func (in *APIExportList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIExportRollout) DeepCopyInto(out *APIExportRollout) {
+	*out = *in
+	if in.PreviousResources != nil {
+		in, out := &in.PreviousResources, &out.PreviousResources
+		*out = make([]ResourceSchema, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIExportRollout.
+func (in *APIExportRollout) DeepCopy() *APIExportRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(APIExportRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIExportSpec) DeepCopyInto(out *APIExportSpec) {
 	*out = *in
@@ -245,6 +279,11 @@ func (in *APIExportSpec) DeepCopyInto(out *APIExportSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(APIExportRollout)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -333,6 +372,11 @@ func (in *BoundAPIResource) DeepCopyInto(out *BoundAPIResource) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AvailableSchema != nil {
+		in, out := &in.AvailableSchema, &out.AvailableSchema
+		*out = new(BoundAPIResourceSchema)
+		**out = **in
+	}
 	return
 }
 
@@ -399,7 +443,7 @@ func (in *Identity) DeepCopyInto(out *Identity) {
 	*out = *in
 	if in.SecretRef != nil {
 		in, out := &in.SecretRef, &out.SecretRef
-		*out = new(v1.SecretReference)
+		*out = new(corev1.SecretReference)
 		**out = **in
 	}
 	return
@@ -496,6 +540,22 @@ func (in *PermissionClaimSelector) DeepCopy() *PermissionClaimSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PinnedSchema) DeepCopyInto(out *PinnedSchema) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PinnedSchema.
+func (in *PinnedSchema) DeepCopy() *PinnedSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(PinnedSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceSchema) DeepCopyInto(out *ResourceSchema) {
 	*out = *in
@@ -51,6 +51,11 @@ func (in APIExportList) OpenAPIModelName() string {
 	return "com.github.kcp-dev.sdk.apis.apis.v1alpha2.APIExportList"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in APIExportRollout) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.apis.v1alpha2.APIExportRollout"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in APIExportSpec) OpenAPIModelName() string {
 	return "com.github.kcp-dev.sdk.apis.apis.v1alpha2.APIExportSpec"
@@ -116,6 +121,11 @@ func (in PermissionClaimSelector) OpenAPIModelName() string {
 	return "com.github.kcp-dev.sdk.apis.apis.v1alpha2.PermissionClaimSelector"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in PinnedSchema) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.apis.v1alpha2.PinnedSchema"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ResourceSchema) OpenAPIModelName() string {
 	return "com.github.kcp-dev.sdk.apis.apis.v1alpha2.ResourceSchema"
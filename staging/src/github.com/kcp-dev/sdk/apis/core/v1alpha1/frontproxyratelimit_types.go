@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FrontProxyRateLimit limits the rate of requests the front-proxy forwards to
+// the shards, counted per user, per logical cluster or per workspace type.
+// Every distinct key gets its own token bucket, such that a noisy tenant does
+// not consume the budget of others. Requests exceeding the limit are queued
+// for up to maxQueueWait and rejected with 429 afterwards.
+//
+// Rate limits live in the root workspace and are picked up by all front-proxy
+// replicas without a restart. A request has to pass all limits it matches.
+// Every front-proxy replica enforces the limits on its own.
+//
+// +crd
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Cluster,categories=kcp
+// +kubebuilder:printcolumn:name="Key",type=string,JSONPath=`.spec.key`,description="What requests are counted by"
+// +kubebuilder:printcolumn:name="QPS",type=integer,JSONPath=`.spec.qps`,description="The sustained requests per second per key"
+// +kubebuilder:printcolumn:name="Burst",type=integer,JSONPath=`.spec.burst`,description="The requests allowed in a burst per key"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type FrontProxyRateLimit struct {
+	v1.TypeMeta `json:",inline"`
+	// +optional
+	v1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +required
+	// +kubebuilder:validation:Required
+	Spec FrontProxyRateLimitSpec `json:"spec"`
+}
+
+// FrontProxyRateLimitKey is what requests are counted by.
+//
+// +kubebuilder:validation:Enum=User;LogicalCluster;WorkspaceType
+type FrontProxyRateLimitKey string
+
+const (
+	// FrontProxyRateLimitKeyUser counts requests per authenticated user name.
+	// Unauthenticated requests share the bucket of the anonymous user.
+	FrontProxyRateLimitKeyUser FrontProxyRateLimitKey = "User"
+	// FrontProxyRateLimitKeyLogicalCluster counts requests per logical cluster
+	// the request is resolved to. Requests not resolved to a logical cluster
+	// are not limited.
+	FrontProxyRateLimitKeyLogicalCluster FrontProxyRateLimitKey = "LogicalCluster"
+	// FrontProxyRateLimitKeyWorkspaceType counts requests per type of the
+	// workspace the request is resolved to, i.e. all workspaces of a type share
+	// the bucket. Requests not resolved to a typed workspace are not limited.
+	FrontProxyRateLimitKeyWorkspaceType FrontProxyRateLimitKey = "WorkspaceType"
+)
+
+// FrontProxyRateLimitSpec holds the desired state of the FrontProxyRateLimit.
+type FrontProxyRateLimitSpec struct {
+	// key is what requests are counted by.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	Key FrontProxyRateLimitKey `json:"key"`
+
+	// qps is the sustained number of requests per second allowed per key.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	QPS int32 `json:"qps"`
+
+	// burst is the number of requests allowed at once per key. It defaults to
+	// qps.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Burst int32 `json:"burst,omitempty"`
+
+	// maxQueueWait is how long a request exceeding the limit waits for its
+	// turn before it is rejected. Waiting requests are served in the order
+	// they arrived. By default, such requests are rejected immediately.
+	//
+	// +optional
+	MaxQueueWait *v1.Duration `json:"maxQueueWait,omitempty"`
+
+	// workspaceTypes restricts the limit to requests to workspaces of the
+	// given types, by fully qualified name, e.g. "root:universal". By
+	// default, the limit applies to all requests.
+	//
+	// +optional
+	// +listType=set
+	WorkspaceTypes []string `json:"workspaceTypes,omitempty"`
+
+	// exemptGroups lists user groups whose requests are not limited.
+	//
+	// +optional
+	// +listType=set
+	ExemptGroups []string `json:"exemptGroups,omitempty"`
+}
+
+// FrontProxyRateLimitList is a list of FrontProxyRateLimits.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type FrontProxyRateLimitList struct {
+	v1.TypeMeta `json:",inline"`
+	v1.ListMeta `json:"metadata"`
+
+	Items []FrontProxyRateLimit `json:"items"`
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FrontProxyRoute routes requests matching a path prefix, headers and user
+// groups from the front-proxy to one of a set of weighted backends. Routes live
+// in the root workspace and are picked up by all front-proxy replicas without a
+// restart. They take precedence over the static path mappings of the
+// front-proxy.
+//
+// +crd
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Cluster,categories=kcp
+// +kubebuilder:printcolumn:name="Path Prefix",type=string,JSONPath=`.spec.match.pathPrefix`,description="The path prefix of the requests routed"
+// +kubebuilder:printcolumn:name="Priority",type=integer,JSONPath=`.spec.priority`,description="The priority of the route among matching routes"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type FrontProxyRoute struct {
+	v1.TypeMeta `json:",inline"`
+	// +optional
+	v1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +required
+	// +kubebuilder:validation:Required
+	Spec FrontProxyRouteSpec `json:"spec"`
+}
+
+// FrontProxyRouteSpec holds the desired state of the FrontProxyRoute.
+type FrontProxyRouteSpec struct {
+	// match selects the requests routed. A request must match all of the given
+	// criteria.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	Match FrontProxyRouteMatch `json:"match"`
+
+	// backends receive the matched requests. Every request is sent to one of
+	// them, chosen at random in proportion to their weight.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=url
+	Backends []FrontProxyRouteBackend `json:"backends"`
+
+	// priority orders routes matching the same request, higher first. Routes
+	// of the same priority are ordered by the length of their path prefix,
+	// longest first, and then by name.
+	//
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+}
+
+// FrontProxyRouteMatch selects requests by path, headers and user groups.
+type FrontProxyRouteMatch struct {
+	// pathPrefix is the prefix of the request path, e.g. "/services/my-workspace/".
+	// A prefix ending in "/" matches the path without the trailing slash too.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^/`
+	PathPrefix string `json:"pathPrefix"`
+
+	// headers the request must carry.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Headers []FrontProxyRouteHeaderMatch `json:"headers,omitempty"`
+
+	// groups restricts the route to users being a member of at least one of
+	// the given groups. It requires the front-proxy to authenticate the user.
+	//
+	// +optional
+	// +listType=set
+	Groups []string `json:"groups,omitempty"`
+}
+
+// FrontProxyRouteHeaderMatch matches a request header.
+type FrontProxyRouteHeaderMatch struct {
+	// name is the name of the header, case-insensitive.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// value is the exact value the header must have. If empty, the header must
+	// be present with any value.
+	//
+	// +optional
+	Value string `json:"value,omitempty"`
+}
+
+// FrontProxyRouteBackend is a backend receiving requests of a route.
+type FrontProxyRouteBackend struct {
+	// url is the base URL of the backend. The request path is appended to it.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Format=uri
+	// +kubebuilder:validation:MinLength=1
+	URL string `json:"url"`
+
+	// weight is the relative share of requests sent to this backend. A weight
+	// of 0 takes the backend out of rotation.
+	//
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=0
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// FrontProxyRouteList is a list of FrontProxyRoutes.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type FrontProxyRouteList struct {
+	v1.TypeMeta `json:",inline"`
+	v1.ListMeta `json:"metadata"`
+
+	Items []FrontProxyRoute `json:"items"`
+}
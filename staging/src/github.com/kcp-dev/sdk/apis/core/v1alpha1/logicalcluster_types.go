@@ -71,6 +71,22 @@ const (
 	// Deprecated: use LogicalClusterInactiveAnnotationKey.
 	LogicalClusterInactiveAnnotationKeyLegacy = "internal.kcp.io/inactive"
 
+	// LogicalClusterHibernatedAnnotationKey is the annotation denoting a
+	// logical cluster that has been hibernated after being idle, with the
+	// time of hibernation in RFC3339 format as value. Unlike an inactive
+	// logical cluster, a hibernated one keeps serving requests, and the
+	// first request by a user removes the annotation again.
+	// The phase of a logical cluster with this annotation is set to
+	// LogicalClusterPhaseInactive.
+	LogicalClusterHibernatedAnnotationKey = "core.kcp.io/hibernated"
+
+	// LogicalClusterDeletedAnnotationKey is the annotation denoting a logical
+	// cluster whose workspace has been deleted, but which is retained so that
+	// the workspace can be undeleted. Its value is the time in RFC3339 format
+	// after which the logical cluster is deleted for real. The phase of a
+	// logical cluster with this annotation is set to LogicalClusterPhaseDeleted.
+	LogicalClusterDeletedAnnotationKey = "core.kcp.io/deleted"
+
 	// LogicalClusterShardAnnotationKey is the shard name the LogicalCluster is scheduled on.
 	// This annotation is set on both the LogicalCluster and its owner, if the owner is set.
 	LogicalClusterShardAnnotationKey = "core.kcp.io/shard"
@@ -78,13 +94,28 @@ const (
 	// LogicalClusterMaxTotalObjectsAnnotationKey overrides the shard-wide default
 	// limit on the total number of objects in this logical cluster. A value <= 0
 	// disables the limit for this logical cluster. The annotation is protected by
-	// the ReservedMetadata admission plugin, i.e. only system users may set it.
+	// the LogicalCluster admission plugin, i.e. only system users may set it.
 	LogicalClusterMaxTotalObjectsAnnotationKey = "core.kcp.io/max-total-objects"
+
+	// LogicalClusterMaxObjectsPerResourceAnnotationKey limits the number of
+	// objects of individual resources in this logical cluster, as a comma
+	// separated list of <resource>[.<group>]=<max> pairs, e.g.
+	// "secrets=500,configmaps=10000". Entries of the LogicalClusterLimits
+	// object for the same resource take precedence. Only system users may set
+	// it.
+	LogicalClusterMaxObjectsPerResourceAnnotationKey = "core.kcp.io/max-objects-per-resource"
+
+	// LogicalClusterMaxStorageBytesAnnotationKey limits the bytes the objects of
+	// this logical cluster may occupy in storage, as a quantity, e.g. "1Gi". It
+	// takes precedence over the maxStorageBytes of the WorkspaceType. A value
+	// <= 0 disables the limit for this logical cluster. Only system users may
+	// set it.
+	LogicalClusterMaxStorageBytesAnnotationKey = "core.kcp.io/max-storage-bytes"
 )
 
 // LogicalClusterPhaseType is the type of the current phase of the logical cluster.
 //
-// +kubebuilder:validation:Enum=Scheduling;Initializing;Ready;Unavailable;Inactive;Terminating;Deleting
+// +kubebuilder:validation:Enum=Scheduling;Initializing;Ready;Unavailable;Inactive;Deleted;Terminating;Deleting
 type LogicalClusterPhaseType string
 
 const (
@@ -99,10 +130,17 @@ const (
 	LogicalClusterPhaseUnavailable LogicalClusterPhaseType = "Unavailable"
 	// LogicalClusterPhaseInactive phase indicates that the logical cluster has been
 	// intentionally taken offline (for example, during maintenance).
-	// This phase is driven by the LogicalClusterInactiveAnnotationKey annotation.
+	// This phase is driven by the LogicalClusterInactiveAnnotationKey annotation,
+	// or by the LogicalClusterHibernatedAnnotationKey annotation for a logical
+	// cluster that is hibernated while idle.
 	// This is distinct from Unavailable in so far that Inactive is an
 	// intentional admin decision, while Unavailable is caused by an error.
 	LogicalClusterPhaseInactive LogicalClusterPhaseType = "Inactive"
+	// LogicalClusterPhaseDeleted phase indicates that the workspace of the logical
+	// cluster has been deleted, but the logical cluster and its content are retained
+	// until the time in the LogicalClusterDeletedAnnotationKey annotation so that
+	// the workspace can be undeleted. No content access is permitted in this phase.
+	LogicalClusterPhaseDeleted LogicalClusterPhaseType = "Deleted"
 	// LogicalClusterPhaseTerminating phase is used to indicate that the logical cluster has a
 	// DeletionTimestamp set and is waiting on terminator controllers to clean up workspace
 	// content. The cluster is still served (the workspace content authorizer permits access)
@@ -281,6 +319,36 @@ func IsLogicalClusterInactive(annotations map[string]string) bool {
 		annotations[LogicalClusterInactiveAnnotationKeyLegacy] == "true"
 }
 
+// IsLogicalClusterHibernated reports whether the given annotations mark a
+// LogicalCluster as hibernated.
+func IsLogicalClusterHibernated(annotations map[string]string) bool {
+	_, found := annotations[LogicalClusterHibernatedAnnotationKey]
+	return found
+}
+
+// IsLogicalClusterPhaseInitialized reports whether a LogicalCluster in the
+// given phase has been initialized, i.e. is Ready or Inactive. A hibernated
+// LogicalCluster is Inactive until a request wakes it up.
+func IsLogicalClusterPhaseInitialized(phase LogicalClusterPhaseType) bool {
+	return phase == LogicalClusterPhaseReady || phase == LogicalClusterPhaseInactive
+}
+
+// IsLogicalClusterLimitEnforced reports whether the object count, storage and
+// workspace quota limits are enforced for a LogicalCluster in the given phase.
+// They are not enforced before the LogicalCluster is fully bootstrapped. They
+// are enforced while it is hibernated, as the request waking it up is admitted
+// before it is Ready again.
+func IsLogicalClusterLimitEnforced(phase LogicalClusterPhaseType) bool {
+	return IsLogicalClusterPhaseInitialized(phase)
+}
+
+// IsLogicalClusterDeleted reports whether the given annotations mark a
+// LogicalCluster as deleted and retained.
+func IsLogicalClusterDeleted(annotations map[string]string) bool {
+	_, found := annotations[LogicalClusterDeletedAnnotationKey]
+	return found
+}
+
 // LogicalClusterList is a list of LogicalCluster
 //
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
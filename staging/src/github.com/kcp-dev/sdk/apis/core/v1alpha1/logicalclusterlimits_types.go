@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LogicalClusterLimits limits the number of objects per resource in the
+// logical cluster it lives in. The limits are enforced by the
+// core.kcp.io/LogicalClusterObjectCountLimit admission plugin, together with
+// the limits of the core.kcp.io/max-objects-per-resource annotation on the
+// LogicalCluster.
+//
+// A LogicalClusterLimits is always named "cluster". Only privileged system
+// users may change it.
+//
+// +crd
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories=kcp,path=logicalclusterlimits
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type LogicalClusterLimits struct {
+	v1.TypeMeta `json:",inline"`
+	// +optional
+	v1.ObjectMeta `json:"metadata,omitempty"`
+	// +optional
+	Spec LogicalClusterLimitsSpec `json:"spec,omitempty"`
+	// +optional
+	Status LogicalClusterLimitsStatus `json:"status,omitempty"`
+}
+
+// LogicalClusterLimitsName is the name of the LogicalClusterLimits singleton.
+const LogicalClusterLimitsName = "cluster"
+
+// LogicalClusterLimitsSpec holds the desired state of the LogicalClusterLimits.
+type LogicalClusterLimitsSpec struct {
+	// objectCounts limits the number of objects of individual resources. It
+	// takes precedence over the core.kcp.io/max-objects-per-resource annotation
+	// of the LogicalCluster for the same resource.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=resource
+	ObjectCounts []ResourceObjectCountLimit `json:"objectCounts,omitempty"`
+}
+
+// ResourceObjectCountLimit limits the number of objects of a resource.
+type ResourceObjectCountLimit struct {
+	// resource is the resource to limit, in the format <resource>.<group>,
+	// or just <resource> for the core group, e.g. "secrets" or
+	// "deployments.apps".
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Resource string `json:"resource"`
+
+	// max is the maximum number of objects of the resource. 0 forbids
+	// creating any object of the resource.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=0
+	Max int64 `json:"max"`
+}
+
+// LogicalClusterLimitsStatus communicates the observed state of the LogicalClusterLimits.
+type LogicalClusterLimitsStatus struct {
+	// objectCounts reports the number of objects of every limited resource,
+	// no matter whether the limit comes from the spec or from the
+	// core.kcp.io/max-objects-per-resource annotation. The counts are
+	// refreshed periodically.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=resource
+	ObjectCounts []ResourceObjectCount `json:"objectCounts,omitempty"`
+}
+
+// ResourceObjectCount is the number of objects of a limited resource.
+type ResourceObjectCount struct {
+	// resource is the limited resource, in the format <resource>.<group>,
+	// or just <resource> for the core group.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	Resource string `json:"resource"`
+
+	// max is the effective limit of the resource.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	Max int64 `json:"max"`
+
+	// count is the number of objects of the resource.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	Count int64 `json:"count"`
+}
+
+// LogicalClusterLimitsList is a list of LogicalClusterLimits.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type LogicalClusterLimitsList struct {
+	v1.TypeMeta `json:",inline"`
+	v1.ListMeta `json:"metadata"`
+
+	Items []LogicalClusterLimits `json:"items"`
+}
@@ -47,8 +47,14 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&LogicalCluster{},
 		&LogicalClusterList{},
+		&LogicalClusterLimits{},
+		&LogicalClusterLimitsList{},
 		&Shard{},
 		&ShardList{},
+		&FrontProxyRoute{},
+		&FrontProxyRouteList{},
+		&FrontProxyRateLimit{},
+		&FrontProxyRateLimitList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -104,12 +104,35 @@ type ShardSpec struct {
 	VirtualWorkspaceURL string `json:"virtualWorkspaceURL,omitempty"`
 }
 
+const (
+	// ShardCapacityLogicalClusters is the key in ShardStatus.Capacity and
+	// ShardStatus.Usage holding the number of logical clusters on a shard.
+	ShardCapacityLogicalClusters corev1.ResourceName = "logicalclusters"
+
+	// ShardCapacityObjects is the key in ShardStatus.Capacity and
+	// ShardStatus.Usage holding the total number of objects on a shard.
+	ShardCapacityObjects corev1.ResourceName = "objects"
+
+	// ShardUsageLogicalClustersOfTypePrefix prefixes the keys in
+	// ShardStatus.Usage holding the number of logical clusters of a
+	// WorkspaceType on a shard. It is followed by the type in the format
+	// "root:org:name". Only types with topology spread constraints of scope
+	// "Type" are reported.
+	ShardUsageLogicalClustersOfTypePrefix = "logicalclusters.tenancy.kcp.io/"
+)
+
 // ShardStatus communicates the observed state of the Shard.
 type ShardStatus struct {
 	// Set of integer resources that logical clusters can be scheduled into
 	// +optional
 	Capacity corev1.ResourceList `json:"capacity,omitempty"`
 
+	// usage is the amount of the capacity resources in use, as periodically
+	// reported by the shard itself. Used by the workspace scheduler to place
+	// new workspaces.
+	// +optional
+	Usage corev1.ResourceList `json:"usage,omitempty"`
+
 	// Current processing state of the Shard.
 	// +optional
 	Conditions v1alpha1.Conditions `json:"conditions,omitempty"`
@@ -68,6 +68,244 @@ func (in *EndpointSelector) DeepCopy() *EndpointSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FrontProxyRateLimit) DeepCopyInto(out *FrontProxyRateLimit) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrontProxyRateLimit.
+func (in *FrontProxyRateLimit) DeepCopy() *FrontProxyRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(FrontProxyRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FrontProxyRateLimit) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FrontProxyRateLimitList) DeepCopyInto(out *FrontProxyRateLimitList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FrontProxyRateLimit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrontProxyRateLimitList.
+func (in *FrontProxyRateLimitList) DeepCopy() *FrontProxyRateLimitList {
+	if in == nil {
+		return nil
+	}
+	out := new(FrontProxyRateLimitList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FrontProxyRateLimitList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FrontProxyRateLimitSpec) DeepCopyInto(out *FrontProxyRateLimitSpec) {
+	*out = *in
+	if in.MaxQueueWait != nil {
+		in, out := &in.MaxQueueWait, &out.MaxQueueWait
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.WorkspaceTypes != nil {
+		in, out := &in.WorkspaceTypes, &out.WorkspaceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExemptGroups != nil {
+		in, out := &in.ExemptGroups, &out.ExemptGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrontProxyRateLimitSpec.
+func (in *FrontProxyRateLimitSpec) DeepCopy() *FrontProxyRateLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FrontProxyRateLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FrontProxyRoute) DeepCopyInto(out *FrontProxyRoute) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrontProxyRoute.
+func (in *FrontProxyRoute) DeepCopy() *FrontProxyRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(FrontProxyRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FrontProxyRoute) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FrontProxyRouteBackend) DeepCopyInto(out *FrontProxyRouteBackend) {
+	*out = *in
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrontProxyRouteBackend.
+func (in *FrontProxyRouteBackend) DeepCopy() *FrontProxyRouteBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(FrontProxyRouteBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FrontProxyRouteHeaderMatch) DeepCopyInto(out *FrontProxyRouteHeaderMatch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrontProxyRouteHeaderMatch.
+func (in *FrontProxyRouteHeaderMatch) DeepCopy() *FrontProxyRouteHeaderMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(FrontProxyRouteHeaderMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FrontProxyRouteList) DeepCopyInto(out *FrontProxyRouteList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FrontProxyRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrontProxyRouteList.
+func (in *FrontProxyRouteList) DeepCopy() *FrontProxyRouteList {
+	if in == nil {
+		return nil
+	}
+	out := new(FrontProxyRouteList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FrontProxyRouteList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FrontProxyRouteMatch) DeepCopyInto(out *FrontProxyRouteMatch) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]FrontProxyRouteHeaderMatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrontProxyRouteMatch.
+func (in *FrontProxyRouteMatch) DeepCopy() *FrontProxyRouteMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(FrontProxyRouteMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FrontProxyRouteSpec) DeepCopyInto(out *FrontProxyRouteSpec) {
+	*out = *in
+	in.Match.DeepCopyInto(&out.Match)
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make([]FrontProxyRouteBackend, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrontProxyRouteSpec.
+func (in *FrontProxyRouteSpec) DeepCopy() *FrontProxyRouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FrontProxyRouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogicalCluster) DeepCopyInto(out *LogicalCluster) {
 	*out = *in
@@ -96,6 +334,109 @@ func (in *LogicalCluster) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogicalClusterLimits) DeepCopyInto(out *LogicalClusterLimits) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogicalClusterLimits.
+func (in *LogicalClusterLimits) DeepCopy() *LogicalClusterLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(LogicalClusterLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogicalClusterLimits) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogicalClusterLimitsList) DeepCopyInto(out *LogicalClusterLimitsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LogicalClusterLimits, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogicalClusterLimitsList.
+func (in *LogicalClusterLimitsList) DeepCopy() *LogicalClusterLimitsList {
+	if in == nil {
+		return nil
+	}
+	out := new(LogicalClusterLimitsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogicalClusterLimitsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogicalClusterLimitsSpec) DeepCopyInto(out *LogicalClusterLimitsSpec) {
+	*out = *in
+	if in.ObjectCounts != nil {
+		in, out := &in.ObjectCounts, &out.ObjectCounts
+		*out = make([]ResourceObjectCountLimit, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogicalClusterLimitsSpec.
+func (in *LogicalClusterLimitsSpec) DeepCopy() *LogicalClusterLimitsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LogicalClusterLimitsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogicalClusterLimitsStatus) DeepCopyInto(out *LogicalClusterLimitsStatus) {
+	*out = *in
+	if in.ObjectCounts != nil {
+		in, out := &in.ObjectCounts, &out.ObjectCounts
+		*out = make([]ResourceObjectCount, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogicalClusterLimitsStatus.
+func (in *LogicalClusterLimitsStatus) DeepCopy() *LogicalClusterLimitsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LogicalClusterLimitsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogicalClusterList) DeepCopyInto(out *LogicalClusterList) {
 	*out = *in
@@ -250,6 +591,38 @@ func (in *OwnerUserInfo) DeepCopy() *OwnerUserInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceObjectCount) DeepCopyInto(out *ResourceObjectCount) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceObjectCount.
+func (in *ResourceObjectCount) DeepCopy() *ResourceObjectCount {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceObjectCount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceObjectCountLimit) DeepCopyInto(out *ResourceObjectCountLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceObjectCountLimit.
+func (in *ResourceObjectCountLimit) DeepCopy() *ResourceObjectCountLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceObjectCountLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Shard) DeepCopyInto(out *Shard) {
 	*out = *in
@@ -337,6 +710,13 @@ func (in *ShardStatus) DeepCopyInto(out *ShardStatus) {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(conditionsv1alpha1.Conditions, len(*in))
@@ -31,11 +31,76 @@ func (in EndpointSelector) OpenAPIModelName() string {
 	return "com.github.kcp-dev.sdk.apis.core.v1alpha1.EndpointSelector"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in FrontProxyRateLimit) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.core.v1alpha1.FrontProxyRateLimit"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in FrontProxyRateLimitList) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.core.v1alpha1.FrontProxyRateLimitList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in FrontProxyRateLimitSpec) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.core.v1alpha1.FrontProxyRateLimitSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in FrontProxyRoute) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.core.v1alpha1.FrontProxyRoute"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in FrontProxyRouteBackend) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.core.v1alpha1.FrontProxyRouteBackend"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in FrontProxyRouteHeaderMatch) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.core.v1alpha1.FrontProxyRouteHeaderMatch"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in FrontProxyRouteList) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.core.v1alpha1.FrontProxyRouteList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in FrontProxyRouteMatch) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.core.v1alpha1.FrontProxyRouteMatch"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in FrontProxyRouteSpec) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.core.v1alpha1.FrontProxyRouteSpec"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in LogicalCluster) OpenAPIModelName() string {
 	return "com.github.kcp-dev.sdk.apis.core.v1alpha1.LogicalCluster"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in LogicalClusterLimits) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.core.v1alpha1.LogicalClusterLimits"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in LogicalClusterLimitsList) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.core.v1alpha1.LogicalClusterLimitsList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in LogicalClusterLimitsSpec) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.core.v1alpha1.LogicalClusterLimitsSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in LogicalClusterLimitsStatus) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.core.v1alpha1.LogicalClusterLimitsStatus"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in LogicalClusterList) OpenAPIModelName() string {
 	return "com.github.kcp-dev.sdk.apis.core.v1alpha1.LogicalClusterList"
@@ -61,6 +126,16 @@ func (in OwnerUserInfo) OpenAPIModelName() string {
 	return "com.github.kcp-dev.sdk.apis.core.v1alpha1.OwnerUserInfo"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ResourceObjectCount) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.core.v1alpha1.ResourceObjectCount"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ResourceObjectCountLimit) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.core.v1alpha1.ResourceObjectCountLimit"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in Shard) OpenAPIModelName() string {
 	return "com.github.kcp-dev.sdk.apis.core.v1alpha1.Shard"
@@ -47,6 +47,9 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&LogicalClusterDump{},
 		&LogicalClusterMigration{},
 		&LogicalClusterMigrationList{},
+		&LogicalClusterRestore{},
+		&ShardDrain{},
+		&ShardDrainList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -68,6 +68,25 @@ type LogicalClusterDumpSpec struct {
 	//
 	// +optional
 	MaxBytes int64 `json:"maxBytes,omitempty"`
+
+	// sinceRevision turns the page into a delta against an earlier copy.
+	// If set, every key of the logical cluster is still returned, but only
+	// entries modified after this etcd revision carry a value. Keys missing
+	// from the page were deleted on the origin shard, if they fall between
+	// spec.continue and status.continue.
+	//
+	// +optional
+	SinceRevision int64 `json:"sinceRevision,omitempty"`
+
+	// revision pins the page to an etcd revision of the origin shard. Pass
+	// the status.revision of the first page on every following page to get
+	// a consistent snapshot of a logical cluster that keeps being written
+	// to. It is required to paginate through a logical cluster that is not
+	// being migrated. The request fails with 410 Gone once the revision has
+	// been compacted.
+	//
+	// +optional
+	Revision int64 `json:"revision,omitempty"`
 }
 
 // LogicalClusterDumpStatus carries the dump payload populated by the server.
@@ -85,6 +104,13 @@ type LogicalClusterDumpStatus struct {
 	//
 	// +optional
 	Continue string `json:"continue,omitempty"`
+
+	// revision is the origin shard's etcd revision at the time the page
+	// was read, or spec.revision if set. Every change made after it will
+	// be seen by a later delta page that passes it as spec.sinceRevision.
+	//
+	// +optional
+	Revision int64 `json:"revision,omitempty"`
 }
 
 // EtcdEntry is a single etcd key/value pair from the origin shard.
@@ -94,5 +120,8 @@ type EtcdEntry struct {
 	Key string `json:"key"`
 
 	// value is the raw etcd value bytes. JSON-encoded as base64 on the wire.
-	Value []byte `json:"value"`
+	// Empty if the entry was not modified after spec.sinceRevision.
+	//
+	// +optional
+	Value []byte `json:"value,omitempty"`
 }
@@ -46,6 +46,8 @@ type LogicalClusterMigration struct {
 }
 
 // LogicalClusterMigrationSpec holds the desired state of the migration.
+//
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.abort) || !oldSelf.abort || (has(self.abort) && self.abort)",message="abort cannot be unset"
 type LogicalClusterMigrationSpec struct {
 	// logicalCluster is the name of the logical cluster to migrate.
 	//
@@ -60,20 +62,87 @@ type LogicalClusterMigrationSpec struct {
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinLength=1
 	DestinationShard string `json:"destinationShard"`
+
+	// strategy selects how the data is moved to the destination shard.
+	//
+	// Freeze blocks all access to the logical cluster for the whole copy.
+	//
+	// PreCopy copies the data in rounds while the logical cluster stays
+	// writable, each round only transferring what changed on the origin
+	// shard since the previous one. The logical cluster is only blocked
+	// for the final round, which copies the remaining delta.
+	//
+	// +optional
+	// +kubebuilder:default=Freeze
+	Strategy LogicalClusterMigrationStrategyType `json:"strategy,omitempty"`
+
+	// preCopy tunes the PreCopy strategy. Ignored for other strategies.
+	//
+	// +optional
+	PreCopy *LogicalClusterMigrationPreCopy `json:"preCopy,omitempty"`
+
+	// abort requests the migration to be rolled back. The data copied to
+	// the destination shard is deleted and the logical cluster is made
+	// available on the origin shard again.
+	//
+	// A migration can only be aborted until the origin shard starts
+	// deleting its data, i.e. before the OriginCleanup phase. Once set,
+	// abort cannot be unset.
+	//
+	// +optional
+	Abort bool `json:"abort,omitempty"`
+}
+
+// LogicalClusterMigrationStrategyType is the strategy used to copy the data of
+// the logical cluster.
+//
+// +kubebuilder:validation:Enum=Freeze;PreCopy
+type LogicalClusterMigrationStrategyType string
+
+const (
+	LogicalClusterMigrationStrategyFreeze  LogicalClusterMigrationStrategyType = "Freeze"
+	LogicalClusterMigrationStrategyPreCopy LogicalClusterMigrationStrategyType = "PreCopy"
+)
+
+// LogicalClusterMigrationPreCopy configures when the PreCopy strategy stops
+// copying in the background and freezes the logical cluster for the final
+// round.
+type LogicalClusterMigrationPreCopy struct {
+	// maxRounds is the maximum number of copy rounds, including the initial
+	// bulk copy, run while the logical cluster is writable. Once reached the
+	// logical cluster is frozen for the final round, even if the last round
+	// still copied more than freezeThreshold entries. Defaults to 5 if
+	// unset.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxRounds int32 `json:"maxRounds,omitempty"`
+
+	// freezeThreshold is the number of changed entries at or below which a
+	// completed copy round is considered small enough to freeze the logical
+	// cluster and copy the final delta. Defaults to 100 if unset.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	FreezeThreshold int64 `json:"freezeThreshold,omitempty"`
 }
 
 // LogicalClusterMigrationPhaseType is the type of the current phase of the migration.
 //
-// +kubebuilder:validation:Enum=Preparing;Migrating;OriginCleanup;DestinationFinalize;Completed;Failed
+// +kubebuilder:validation:Enum=Preparing;PreCopying;Freezing;Migrating;OriginCleanup;DestinationFinalize;Completed;Failed;Aborting;RolledBack
 type LogicalClusterMigrationPhaseType string
 
 const (
 	LogicalClusterMigrationPhasePreparing           LogicalClusterMigrationPhaseType = "Preparing"
+	LogicalClusterMigrationPhasePreCopying          LogicalClusterMigrationPhaseType = "PreCopying"
+	LogicalClusterMigrationPhaseFreezing            LogicalClusterMigrationPhaseType = "Freezing"
 	LogicalClusterMigrationPhaseMigrating           LogicalClusterMigrationPhaseType = "Migrating"
 	LogicalClusterMigrationPhaseOriginCleanup       LogicalClusterMigrationPhaseType = "OriginCleanup"
 	LogicalClusterMigrationPhaseDestinationFinalize LogicalClusterMigrationPhaseType = "DestinationFinalize"
 	LogicalClusterMigrationPhaseCompleted           LogicalClusterMigrationPhaseType = "Completed"
 	LogicalClusterMigrationPhaseFailed              LogicalClusterMigrationPhaseType = "Failed"
+	LogicalClusterMigrationPhaseAborting            LogicalClusterMigrationPhaseType = "Aborting"
+	LogicalClusterMigrationPhaseRolledBack          LogicalClusterMigrationPhaseType = "RolledBack"
 )
 
 // LogicalClusterMigrationStatus communicates the observed state of the migration.
@@ -105,6 +174,33 @@ type LogicalClusterMigrationStatus struct {
 	// +optional
 	DumpContinue string `json:"dumpContinue,omitempty"`
 
+	// copyRounds is the number of copy rounds completed so far. Only used
+	// by the PreCopy strategy.
+	//
+	// +optional
+	CopyRounds int32 `json:"copyRounds,omitempty"`
+
+	// roundRevision is the origin shard's etcd revision at the start of the
+	// copy round in progress. Only used by the PreCopy strategy.
+	//
+	// +optional
+	RoundRevision int64 `json:"roundRevision,omitempty"`
+
+	// roundEntriesCopied is the number of changed etcd entries copied in the
+	// copy round in progress, or in the last completed one if no round is in
+	// progress. Only used by the PreCopy strategy.
+	//
+	// +optional
+	RoundEntriesCopied int64 `json:"roundEntriesCopied,omitempty"`
+
+	// syncedRevision is the origin shard's etcd revision up to which all
+	// changes have been copied to the destination shard. The next copy
+	// round only transfers entries modified after it. Only used by the
+	// PreCopy strategy.
+	//
+	// +optional
+	SyncedRevision int64 `json:"syncedRevision,omitempty"`
+
 	// Current processing state of the migration.
 	// +optional
 	Conditions conditionsv1alpha1.Conditions `json:"conditions,omitempty"`
@@ -122,6 +218,11 @@ const (
 	// from the origin.
 	LCMigrationStarted conditionsv1alpha1.ConditionType = "MigrationStarted"
 
+	// LCMigrationPreCopied indicates the destination shard has finished
+	// copying data while the logical cluster was writable, and the origin
+	// shard can freeze it for the final round.
+	LCMigrationPreCopied conditionsv1alpha1.ConditionType = "PreCopied"
+
 	// LCMigrationDataCopied indicates the destination shard has finished copying
 	// and verifying all data.
 	LCMigrationDataCopied conditionsv1alpha1.ConditionType = "DataCopied"
@@ -133,6 +234,14 @@ const (
 	// LCMigrationCompleted indicates the migration has fully completed and the
 	// logical cluster is available on the destination shard.
 	LCMigrationCompleted conditionsv1alpha1.ConditionType = "Completed"
+
+	// LCMigrationDestinationCleaned indicates the destination shard has deleted
+	// all data copied for an aborted migration.
+	LCMigrationDestinationCleaned conditionsv1alpha1.ConditionType = "DestinationCleaned"
+
+	// LCMigrationRolledBack indicates an aborted migration has been rolled back
+	// and the logical cluster is available on the origin shard again.
+	LCMigrationRolledBack conditionsv1alpha1.ConditionType = "RolledBack"
 )
 
 func (in *LogicalClusterMigration) SetConditions(c conditionsv1alpha1.Conditions) {
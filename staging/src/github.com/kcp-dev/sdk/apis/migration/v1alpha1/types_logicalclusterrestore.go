@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LogicalClusterRestore is an ephemeral request/response type used to
+// import a page of LogicalClusterDump entries, e.g. from a backup, into a
+// logical cluster on the shard receiving the request.
+//
+// The server writes Spec.Entries on Create and populates Status. The
+// object is not persisted.
+//
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=create
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type LogicalClusterRestore struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec LogicalClusterRestoreSpec `json:"spec,omitempty"`
+	// +optional
+	Status LogicalClusterRestoreStatus `json:"status,omitempty"`
+}
+
+// LogicalClusterRestoreSpec is the desired state for a restore request.
+//
+// The logical cluster to restore into is taken from the request's cluster
+// context (i.e. the URL the request arrived on).
+type LogicalClusterRestoreSpec struct {
+	// sourceLogicalCluster is the name of the logical cluster the entries
+	// were dumped from. Their keys are rewritten from it to the target
+	// logical cluster.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	SourceLogicalCluster string `json:"sourceLogicalCluster"`
+
+	// entries are the etcd key/value pairs to write, as returned in the
+	// status.entries of a LogicalClusterDump without spec.sinceRevision.
+	//
+	// An entry whose key already exists with the same value is skipped, so
+	// a page can be retried. A key that exists with a different value fails
+	// the request with 409 Conflict.
+	//
+	// +optional
+	Entries []EtcdEntry `json:"entries,omitempty"`
+}
+
+// LogicalClusterRestoreStatus carries the result of the restore request.
+type LogicalClusterRestoreStatus struct {
+	// restored is the number of entries written. Entries skipped because
+	// they already existed with the same value are not counted.
+	//
+	// +optional
+	Restored int64 `json:"restored,omitempty"`
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	conditionsv1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+)
+
+// ShardDrain moves all logical clusters off a shard by creating one
+// LogicalClusterMigration per logical cluster.
+//
+// +crd
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories=kcp
+// +kubebuilder:printcolumn:name="Source",type=string,JSONPath=`.spec.sourceShard`,description="The shard being drained"
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`,description="The current phase of the drain"
+// +kubebuilder:printcolumn:name="Migrated",type=integer,JSONPath=`.status.migrated`,description="Number of logical clusters migrated"
+// +kubebuilder:printcolumn:name="Total",type=integer,JSONPath=`.status.logicalClusters`,description="Number of logical clusters to migrate"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type ShardDrain struct {
+	v1.TypeMeta `json:",inline"`
+	// +optional
+	v1.ObjectMeta `json:"metadata,omitempty"`
+	// +optional
+	Spec ShardDrainSpec `json:"spec,omitempty"`
+	// +optional
+	Status ShardDrainStatus `json:"status,omitempty"`
+}
+
+// ShardDrainSpec holds the desired state of the drain.
+type ShardDrainSpec struct {
+	// sourceShard is the name of the shard to move all logical clusters off.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="sourceShard is immutable"
+	SourceShard string `json:"sourceShard"`
+
+	// destinationShardSelector selects the shards the logical clusters are
+	// migrated to. The source shard is never selected. All other shards are
+	// selected if unset.
+	//
+	// Among the selected shards the least used one with capacity left is
+	// picked for every logical cluster, see ShardCapacityLogicalClusters.
+	//
+	// +optional
+	DestinationShardSelector *v1.LabelSelector `json:"destinationShardSelector,omitempty"`
+
+	// concurrency is the maximum number of logical clusters migrated at the
+	// same time.
+	//
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	Concurrency int32 `json:"concurrency,omitempty"`
+
+	// strategy is passed on to the created LogicalClusterMigrations.
+	//
+	// +optional
+	Strategy LogicalClusterMigrationStrategyType `json:"strategy,omitempty"`
+}
+
+// ShardDrainPhaseType is the type of the current phase of the drain.
+//
+// +kubebuilder:validation:Enum=Draining;Completed;Failed
+type ShardDrainPhaseType string
+
+const (
+	ShardDrainPhaseDraining  ShardDrainPhaseType = "Draining"
+	ShardDrainPhaseCompleted ShardDrainPhaseType = "Completed"
+	ShardDrainPhaseFailed    ShardDrainPhaseType = "Failed"
+)
+
+// ShardDrainStatus communicates the observed state of the drain.
+type ShardDrainStatus struct {
+	// phase is the current phase of the drain. It is Failed once all
+	// migrations have finished and at least one of them failed.
+	//
+	// +optional
+	Phase ShardDrainPhaseType `json:"phase,omitempty"`
+
+	// logicalClusters is the number of logical clusters found on the source
+	// shard, including those already migrated by this drain.
+	//
+	// +optional
+	LogicalClusters int32 `json:"logicalClusters,omitempty"`
+
+	// pending is the number of logical clusters a migration hasn't been
+	// started for yet.
+	//
+	// +optional
+	Pending int32 `json:"pending,omitempty"`
+
+	// migrating is the number of logical clusters currently being migrated.
+	//
+	// +optional
+	Migrating int32 `json:"migrating,omitempty"`
+
+	// migrated is the number of logical clusters migrated successfully.
+	//
+	// +optional
+	Migrated int32 `json:"migrated,omitempty"`
+
+	// failed is the number of logical clusters whose migration failed or was
+	// rolled back. These are not retried.
+	//
+	// +optional
+	Failed int32 `json:"failed,omitempty"`
+
+	// Current processing state of the drain.
+	// +optional
+	Conditions conditionsv1alpha1.Conditions `json:"conditions,omitempty"`
+}
+
+const (
+	// ShardDrainDestinationsAvailable indicates whether a destination shard with
+	// capacity left could be found for the next logical cluster.
+	ShardDrainDestinationsAvailable conditionsv1alpha1.ConditionType = "DestinationsAvailable"
+
+	// ShardDrainDrained indicates all logical clusters have been migrated off the
+	// source shard.
+	ShardDrainDrained conditionsv1alpha1.ConditionType = "Drained"
+
+	// ShardDrainInvalidSelectorReason indicates the destinationShardSelector
+	// could not be converted into a selector.
+	ShardDrainInvalidSelectorReason = "InvalidSelector"
+	// ShardDrainNoDestinationShardReason indicates no shard matching the
+	// destinationShardSelector has capacity left.
+	ShardDrainNoDestinationShardReason = "NoDestinationShard"
+	// ShardDrainMigrationsFailedReason indicates some migrations failed.
+	ShardDrainMigrationsFailedReason = "MigrationsFailed"
+)
+
+func (in *ShardDrain) SetConditions(c conditionsv1alpha1.Conditions) {
+	in.Status.Conditions = c
+}
+
+func (in *ShardDrain) GetConditions() conditionsv1alpha1.Conditions {
+	return in.Status.Conditions
+}
+
+var _ conditions.Getter = &ShardDrain{}
+var _ conditions.Setter = &ShardDrain{}
+
+// ShardDrainList is a list of ShardDrain resources.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ShardDrainList struct {
+	v1.TypeMeta `json:",inline"`
+	v1.ListMeta `json:"metadata"`
+
+	Items []ShardDrain `json:"items"`
+}
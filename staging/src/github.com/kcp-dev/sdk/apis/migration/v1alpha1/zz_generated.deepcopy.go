@@ -22,6 +22,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
 	conditionsv1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
@@ -120,7 +121,7 @@ func (in *LogicalClusterMigration) DeepCopyInto(out *LogicalClusterMigration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -176,9 +177,30 @@ func (in *LogicalClusterMigrationList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogicalClusterMigrationPreCopy) DeepCopyInto(out *LogicalClusterMigrationPreCopy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogicalClusterMigrationPreCopy.
+func (in *LogicalClusterMigrationPreCopy) DeepCopy() *LogicalClusterMigrationPreCopy {
+	if in == nil {
+		return nil
+	}
+	out := new(LogicalClusterMigrationPreCopy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogicalClusterMigrationSpec) DeepCopyInto(out *LogicalClusterMigrationSpec) {
 	*out = *in
+	if in.PreCopy != nil {
+		in, out := &in.PreCopy, &out.PreCopy
+		*out = new(LogicalClusterMigrationPreCopy)
+		**out = **in
+	}
 	return
 }
 
@@ -214,3 +236,175 @@ func (in *LogicalClusterMigrationStatus) DeepCopy() *LogicalClusterMigrationStat
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogicalClusterRestore) DeepCopyInto(out *LogicalClusterRestore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogicalClusterRestore.
+func (in *LogicalClusterRestore) DeepCopy() *LogicalClusterRestore {
+	if in == nil {
+		return nil
+	}
+	out := new(LogicalClusterRestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogicalClusterRestore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogicalClusterRestoreSpec) DeepCopyInto(out *LogicalClusterRestoreSpec) {
+	*out = *in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]EtcdEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogicalClusterRestoreSpec.
+func (in *LogicalClusterRestoreSpec) DeepCopy() *LogicalClusterRestoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LogicalClusterRestoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogicalClusterRestoreStatus) DeepCopyInto(out *LogicalClusterRestoreStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogicalClusterRestoreStatus.
+func (in *LogicalClusterRestoreStatus) DeepCopy() *LogicalClusterRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LogicalClusterRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShardDrain) DeepCopyInto(out *ShardDrain) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShardDrain.
+func (in *ShardDrain) DeepCopy() *ShardDrain {
+	if in == nil {
+		return nil
+	}
+	out := new(ShardDrain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShardDrain) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShardDrainList) DeepCopyInto(out *ShardDrainList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ShardDrain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShardDrainList.
+func (in *ShardDrainList) DeepCopy() *ShardDrainList {
+	if in == nil {
+		return nil
+	}
+	out := new(ShardDrainList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShardDrainList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShardDrainSpec) DeepCopyInto(out *ShardDrainSpec) {
+	*out = *in
+	if in.DestinationShardSelector != nil {
+		in, out := &in.DestinationShardSelector, &out.DestinationShardSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShardDrainSpec.
+func (in *ShardDrainSpec) DeepCopy() *ShardDrainSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ShardDrainSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShardDrainStatus) DeepCopyInto(out *ShardDrainStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(conditionsv1alpha1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShardDrainStatus.
+func (in *ShardDrainStatus) DeepCopy() *ShardDrainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShardDrainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
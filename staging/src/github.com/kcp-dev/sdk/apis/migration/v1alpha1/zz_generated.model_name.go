@@ -51,6 +51,11 @@ func (in LogicalClusterMigrationList) OpenAPIModelName() string {
 	return "com.github.kcp-dev.sdk.apis.migration.v1alpha1.LogicalClusterMigrationList"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in LogicalClusterMigrationPreCopy) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.migration.v1alpha1.LogicalClusterMigrationPreCopy"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in LogicalClusterMigrationSpec) OpenAPIModelName() string {
 	return "com.github.kcp-dev.sdk.apis.migration.v1alpha1.LogicalClusterMigrationSpec"
@@ -60,3 +65,38 @@ func (in LogicalClusterMigrationSpec) OpenAPIModelName() string {
 func (in LogicalClusterMigrationStatus) OpenAPIModelName() string {
 	return "com.github.kcp-dev.sdk.apis.migration.v1alpha1.LogicalClusterMigrationStatus"
 }
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in LogicalClusterRestore) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.migration.v1alpha1.LogicalClusterRestore"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in LogicalClusterRestoreSpec) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.migration.v1alpha1.LogicalClusterRestoreSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in LogicalClusterRestoreStatus) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.migration.v1alpha1.LogicalClusterRestoreStatus"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShardDrain) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.migration.v1alpha1.ShardDrain"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShardDrainList) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.migration.v1alpha1.ShardDrainList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShardDrainSpec) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.migration.v1alpha1.ShardDrainSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShardDrainStatus) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.migration.v1alpha1.ShardDrainStatus"
+}
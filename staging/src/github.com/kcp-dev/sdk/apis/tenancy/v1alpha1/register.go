@@ -51,6 +51,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&WorkspaceTypeList{},
 		&WorkspaceAuthenticationConfiguration{},
 		&WorkspaceAuthenticationConfigurationList{},
+		&WorkspaceQuota{},
+		&WorkspaceQuotaList{},
+		&WorkspaceQuotaUsage{},
+		&WorkspaceQuotaUsageList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -68,12 +68,24 @@ const (
 	// WorkspaceReasonUnschedulable reason in WorkspaceScheduled WorkspaceCondition means that the scheduler
 	// can't schedule the workspace right now, for example due to insufficient resources in the cluster.
 	WorkspaceReasonUnschedulable = "Unschedulable"
+	// WorkspaceReasonTopologySpreadUnsatisfiable reason in WorkspaceScheduled WorkspaceCondition means that
+	// no shard satisfies the topology spread constraints of the workspace type.
+	WorkspaceReasonTopologySpreadUnsatisfiable = "TopologySpreadUnsatisfiable"
 	// WorkspaceReasonReasonUnknown reason in WorkspaceScheduled means that scheduler has failed for
 	// some unexpected reason.
 	WorkspaceReasonReasonUnknown = "Unknown"
+	// WorkspaceReasonUndeleteFailed reason in WorkspaceScheduled means that the deleted workspace
+	// requested by the tenancy.kcp.io/undelete annotation cannot be restored.
+	WorkspaceReasonUndeleteFailed = "UndeleteFailed"
+	// WorkspaceReasonMoveFailed reason in WorkspaceScheduled means that the workspace requested
+	// by the tenancy.kcp.io/move-from annotation cannot be moved.
+	WorkspaceReasonMoveFailed = "MoveFailed"
 
 	// WorkspaceContentDeleted represents the status that all resources in the workspace are deleted.
 	WorkspaceContentDeleted conditionsv1alpha1.ConditionType = "WorkspaceContentDeleted"
+	// WorkspaceContentDeletedRetained reason in WorkspaceContentDeleted condition means that the workspace
+	// has been deleted, but its content is retained until it is purged, and that it can be undeleted.
+	WorkspaceContentDeletedRetained = "Retained"
 
 	// WorkspaceInitialized represents the status that initialization has finished.
 	WorkspaceInitialized conditionsv1alpha1.ConditionType = "WorkspaceInitialized"
@@ -109,12 +121,75 @@ const (
 	// WorkspaceInitializedAPIBindingErrors is a reason for the APIBindingsInitialized condition that indicates there
 	// were errors trying to initialize APIBindings for the workspace.
 	WorkspaceReconciledAPIBindingErrors = WorkspaceInitializedAPIBindingErrors
+
+	// WorkspaceCloned represents the status of copying the contents of the
+	// workspace referenced by spec.cloneFrom.
+	WorkspaceCloned conditionsv1alpha1.ConditionType = "WorkspaceCloned"
+	// WorkspaceClonedWaitingOnAPIBindings is a reason for the WorkspaceCloned condition that indicates
+	// the initial APIBindings have to be initialized before the contents are copied.
+	WorkspaceClonedWaitingOnAPIBindings = WorkspaceInitializedWaitingOnAPIBindings
+	// WorkspaceClonedSourceInvalid is a reason for the WorkspaceCloned condition that indicates
+	// the workspace to clone could not be resolved.
+	WorkspaceClonedSourceInvalid = "CloneSourceInvalid"
+	// WorkspaceClonedErrors is a reason for the WorkspaceCloned condition that indicates there
+	// were errors copying the contents.
+	WorkspaceClonedErrors = "CloneErrors"
+
+	// WorkspaceExpiring represents that the workspace is about to be, or is
+	// being deleted because spec.expiresAt has passed. It is absent while the
+	// workspace does not expire soon.
+	WorkspaceExpiring conditionsv1alpha1.ConditionType = "WorkspaceExpiring"
+	// WorkspaceExpiringExpiresSoon is a reason for the WorkspaceExpiring condition that indicates
+	// the workspace expires within the warning period.
+	WorkspaceExpiringExpiresSoon = "ExpiresSoon"
+	// WorkspaceExpiringExpired is a reason for the WorkspaceExpiring condition that indicates
+	// the workspace has expired and is deleted.
+	WorkspaceExpiringExpired = "Expired"
 )
 
+// WorkspaceExtendLeaseAnnotationKey is the annotation key used to extend the
+// lease of a workspace. Its value is a duration, e.g. "24h". On admission,
+// spec.expiresAt is set to that duration from now, unless it is later already,
+// and the annotation is removed. Workspaces without spec.expiresAt do not
+// expire and are not changed, except on creation.
+const WorkspaceExtendLeaseAnnotationKey = "tenancy.kcp.io/extend-lease"
+
+// WorkspaceUndeleteAnnotationKey is the annotation key used to undelete a
+// workspace whose logical cluster is retained after deletion. Its value is
+// the name of the logical cluster. Set on the deleted workspace, it releases
+// the workspace object. Set on a new workspace of the same name and type in
+// the same parent, it restores the logical cluster as that workspace.
+const WorkspaceUndeleteAnnotationKey = "tenancy.kcp.io/undelete"
+
+// WorkspaceMoveToAnnotationKey is the annotation key used to allow moving a
+// workspace. Its value is the canonical path the workspace is moved to, e.g.
+// "root:org:team". A workspace created at that path with the
+// tenancy.kcp.io/move-from annotation takes over the logical cluster, and the
+// annotated workspace is deleted without deleting the logical cluster.
+const WorkspaceMoveToAnnotationKey = "tenancy.kcp.io/move-to"
+
+// WorkspaceMoveFromAnnotationKey is the annotation key used to move a
+// workspace on creation. Its value is the canonical path of the workspace to
+// move, which must be of the same type and carry the tenancy.kcp.io/move-to
+// annotation pointing to the new workspace.
+const WorkspaceMoveFromAnnotationKey = "tenancy.kcp.io/move-from"
+
 // LogicalClusterTypeAnnotationKey is the annotation key used to indicate
 // the type of the workspace on the corresponding LogicalCluster object. Its format is "root:ws:name".
 const LogicalClusterTypeAnnotationKey = "internal.tenancy.kcp.io/type"
 
+// LogicalClusterMaxDepthAnnotationKey is the annotation key used to record the
+// number of workspace levels the maxDepth of the WorkspaceTypes of the
+// ancestors still allows below a workspace, on the Workspace and on the
+// corresponding LogicalCluster object. It is absent if the depth is not limited.
+// Only system users may change it.
+const LogicalClusterMaxDepthAnnotationKey = "internal.tenancy.kcp.io/max-depth"
+
+// LogicalClusterCloneFromAnnotationKey is the annotation key used to indicate
+// the path of the workspace to clone, i.e. the workspace's spec.cloneFrom.path,
+// on the corresponding LogicalCluster object.
+const LogicalClusterCloneFromAnnotationKey = "internal.tenancy.kcp.io/clone-from"
+
 // Workspace defines a generic Kubernetes-cluster-like endpoint, with standard Kubernetes
 // discovery APIs, OpenAPI and resource API endpoints.
 //
@@ -153,6 +228,8 @@ type Workspace struct {
 // WorkspaceSpec holds the desired state of the Workspace.
 // +kubebuilder:validation:XValidation:rule="!has(oldSelf.URL) || has(self.URL)",message="URL cannot be unset"
 // +kubebuilder:validation:XValidation:rule="!has(oldSelf.cluster) || has(self.cluster)",message="cluster cannot be unset"
+// +kubebuilder:validation:XValidation:rule="has(oldSelf.cloneFrom) == has(self.cloneFrom)",message="cloneFrom is immutable"
+// +kubebuilder:validation:XValidation:rule="!has(self.cloneFrom) || !has(self.mount)",message="cloneFrom cannot be combined with mount"
 type WorkspaceSpec struct {
 	// type defines properties of the workspace both on creation (e.g. initial
 	// resources and initially installed APIs) and during runtime (e.g. permissions).
@@ -202,6 +279,35 @@ type WorkspaceSpec struct {
 	// +optional
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="mount is immutable"
 	Mount *Mount `json:"mount,omitempty"`
+
+	// cloneFrom references a workspace whose contents are copied into this
+	// workspace while it is initializing. Copied objects get new UIDs, and
+	// owner references are updated to point to the copied owners. The owner
+	// of the workspace needs the verb 'admin' on the workspaces/content
+	// subresource of the source workspace.
+	//
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="cloneFrom is immutable"
+	CloneFrom *WorkspaceCloneSource `json:"cloneFrom,omitempty"`
+
+	// expiresAt is the time after which the workspace is deleted. If unset on
+	// creation, it is defaulted from the defaultTTL of the workspace type. The
+	// lease can be extended by updating it, or with the
+	// tenancy.kcp.io/extend-lease annotation. The WorkspaceExpiring condition
+	// warns an hour before the workspace expires.
+	//
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+// WorkspaceCloneSource references the workspace a workspace is cloned from.
+type WorkspaceCloneSource struct {
+	// path is an absolute reference to the workspace to clone, e.g. root:org:golden.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:="^[a-z0-9]([-a-z0-9]*[a-z0-9])?(:[a-z0-9]([-a-z0-9]*[a-z0-9])?)+$"
+	Path string `json:"path"`
 }
 
 // Mount is a reference to an object implementing a mounting feature. It is used to orchestrate
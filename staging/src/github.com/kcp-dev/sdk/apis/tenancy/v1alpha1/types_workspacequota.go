@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WorkspaceQuota limits the aggregate usage of all workspaces below the
+// workspace it lives in, across shards. The usage is reported by every shard
+// through the cache server and summed up in the status. The limits are
+// enforced by the tenancy.kcp.io/WorkspaceQuota admission plugin.
+//
+// +crd
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories=kcp
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type WorkspaceQuota struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +optional
+	Spec WorkspaceQuotaSpec `json:"spec,omitempty"`
+	// +optional
+	Status WorkspaceQuotaStatus `json:"status,omitempty"`
+}
+
+const (
+	// ResourceWorkspaces is the number of workspaces below the workspace of a
+	// WorkspaceQuota, at any depth.
+	ResourceWorkspaces corev1.ResourceName = "workspaces"
+
+	// ResourceCountPrefix is the prefix of the object count resources of a
+	// WorkspaceQuota, in the format count/<resource>.<group>, or
+	// count/<resource> for the core group.
+	ResourceCountPrefix = "count/"
+)
+
+// WorkspaceQuotaSpec holds the desired state of the WorkspaceQuota.
+type WorkspaceQuotaSpec struct {
+	// hard is the set of limits on the aggregate usage of all workspaces
+	// below the workspace of the WorkspaceQuota. Supported are "workspaces",
+	// the number of workspaces at any depth, object counts of resources,
+	// e.g. "count/secrets" or "count/deployments.apps", and the compute
+	// resources of pods as in a ResourceQuota, e.g. "pods", "requests.cpu"
+	// or "limits.memory".
+	//
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self.all(k, k in ['workspaces', 'pods', 'cpu', 'memory', 'ephemeral-storage'] || k.startsWith('count/') || k.startsWith('requests.') || k.startsWith('limits.') || k.startsWith('hugepages-'))",message="only workspaces, count/<resource> and pod compute resources are supported"
+	Hard corev1.ResourceList `json:"hard,omitempty"`
+}
+
+// WorkspaceQuotaStatus communicates the observed state of the WorkspaceQuota.
+type WorkspaceQuotaStatus struct {
+	// hard is the set of enforced limits.
+	//
+	// +optional
+	Hard corev1.ResourceList `json:"hard,omitempty"`
+
+	// used is the aggregate usage of all workspaces below the workspace of
+	// the WorkspaceQuota, summed up over all shards.
+	//
+	// +optional
+	Used corev1.ResourceList `json:"used,omitempty"`
+}
+
+// WorkspaceQuotaList is a list of WorkspaceQuota resources.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type WorkspaceQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []WorkspaceQuota `json:"items"`
+}
+
+// WorkspaceQuotaUsage is the usage of a WorkspaceQuota on a single shard. It
+// only exists in the cache server, under the shard reporting it and the
+// logical cluster of the WorkspaceQuota.
+//
+// +crd
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Cluster,categories=kcp
+type WorkspaceQuotaUsage struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +optional
+	Spec WorkspaceQuotaUsageSpec `json:"spec,omitempty"`
+}
+
+// WorkspaceQuotaUsageSpec holds the usage of a WorkspaceQuota on a shard.
+type WorkspaceQuotaUsageSpec struct {
+	// quota is the name of the WorkspaceQuota.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	Quota string `json:"quota"`
+
+	// shard is the name of the shard reporting the usage.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	Shard string `json:"shard"`
+
+	// used is the usage of the workspaces on the shard.
+	//
+	// +optional
+	Used corev1.ResourceList `json:"used,omitempty"`
+}
+
+// WorkspaceQuotaUsageList is a list of WorkspaceQuotaUsage resources.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type WorkspaceQuotaUsageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []WorkspaceQuotaUsage `json:"items"`
+}
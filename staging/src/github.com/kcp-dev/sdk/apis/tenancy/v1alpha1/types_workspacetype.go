@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
@@ -177,8 +178,117 @@ type WorkspaceTypeSpec struct {
 	//
 	// +optional
 	TerminatorPermissions []rbacv1.PolicyRule `json:"terminatorPermissions,omitempty"`
+
+	// topologySpreadConstraints describe how workspaces of this type are spread
+	// across shard failure domains, e.g. regions. A new workspace of this type
+	// is only scheduled to a shard satisfying all constraints. Constraints are
+	// not inherited from extended types.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=spreadBy
+	// +listMapKey=scope
+	TopologySpreadConstraints []WorkspaceTopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// maxStorageBytes limits the bytes the objects of each workspace of this
+	// type may occupy in storage. Writes that would exceed the limit are
+	// rejected. The core.kcp.io/max-storage-bytes annotation on the
+	// LogicalCluster of a workspace takes precedence. The limit is not
+	// inherited from extended types.
+	//
+	// +optional
+	MaxStorageBytes *resource.Quantity `json:"maxStorageBytes,omitempty"`
+
+	// maxChildren limits the number of child workspaces created directly in
+	// each workspace of this type. 0 allows no child workspaces. The limit is
+	// not inherited from extended types.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxChildren *int32 `json:"maxChildren,omitempty"`
+
+	// maxDepth limits the nesting of workspaces below each workspace of this
+	// type, whatever the types of the workspaces below: 1 allows child
+	// workspaces but no grandchildren, 0 allows no child workspaces. The
+	// limit is evaluated when a workspace is created, and changes do not
+	// affect existing workspaces. It is not inherited from extended types.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxDepth *int32 `json:"maxDepth,omitempty"`
+
+	// hibernateAfter is the time a workspace of this type may go without
+	// requests from users before it is hibernated, e.g. "24h". A hibernated
+	// workspace is marked Inactive and its per-cluster caches are dropped on
+	// its shard. The next request by a user wakes it up transparently. Requests
+	// by kcp system components neither count as activity nor wake a workspace.
+	// If unset, workspaces of this type are never hibernated. It is not
+	// inherited from extended types.
+	//
+	// +optional
+	HibernateAfter *metav1.Duration `json:"hibernateAfter,omitempty"`
+
+	// defaultTTL is the lifetime of new workspaces of this type which do not
+	// set spec.expiresAt on creation, e.g. "72h". Expired workspaces are
+	// deleted. If unset, workspaces of this type do not expire by default. It
+	// is not inherited from extended types.
+	//
+	// +optional
+	DefaultTTL *metav1.Duration `json:"defaultTTL,omitempty"`
+
+	// deletionRetention is the time the logical cluster and the content of a
+	// deleted workspace of this type are retained, e.g. "168h". Meanwhile, the
+	// workspace is in phase Deleted, its content cannot be accessed, and it can
+	// be undeleted. Terminators run when the retention has passed. If unset,
+	// deleted workspaces are purged right away. It is not inherited from
+	// extended types.
+	//
+	// +optional
+	DeletionRetention *metav1.Duration `json:"deletionRetention,omitempty"`
 }
 
+// WorkspaceTopologySpreadConstraint spreads workspaces across the failure
+// domains given by a shard label.
+type WorkspaceTopologySpreadConstraint struct {
+	// spreadBy is the shard label whose values are the failure domains, e.g.
+	// topology.kcp.io/region. Shards without this label are not scheduled to.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	SpreadBy string `json:"spreadBy"`
+
+	// maxSkew is the maximum difference between the number of workspaces in any
+	// failure domain and the lowest number of workspaces in a failure domain.
+	//
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	MaxSkew int32 `json:"maxSkew,omitempty"`
+
+	// scope selects the workspaces being spread. With "Siblings" the workspaces
+	// of this type with the same parent workspace are spread. With "Type" all
+	// workspaces of this type are spread, based on the usage periodically
+	// reported by the shards.
+	//
+	// +optional
+	// +kubebuilder:default=Siblings
+	// +kubebuilder:validation:Enum=Siblings;Type
+	Scope WorkspaceTopologySpreadScope `json:"scope,omitempty"`
+}
+
+// WorkspaceTopologySpreadScope selects the workspaces spread by a
+// WorkspaceTopologySpreadConstraint.
+type WorkspaceTopologySpreadScope string
+
+const (
+	// WorkspaceTopologySpreadScopeSiblings spreads the workspaces of a type
+	// with the same parent workspace.
+	WorkspaceTopologySpreadScopeSiblings WorkspaceTopologySpreadScope = "Siblings"
+	// WorkspaceTopologySpreadScopeType spreads all workspaces of a type.
+	WorkspaceTopologySpreadScopeType WorkspaceTopologySpreadScope = "Type"
+)
+
 // APIExportReference provides the fields necessary to resolve an APIExport.
 type APIExportReference struct {
 	// path is the fully-qualified path to the workspace containing the APIExport. If it is
@@ -314,6 +424,10 @@ type WorkspaceTypeList struct {
 // on a WorkspaceType to be created.
 const WorkspaceAPIBindingsInitializer corev1alpha1.LogicalClusterInitializer = "system:apibindings"
 
+// WorkspaceCloneInitializer is a special-case initializer that copies the contents of the workspace
+// referenced by spec.cloneFrom of a Workspace.
+const WorkspaceCloneInitializer corev1alpha1.LogicalClusterInitializer = "system:clone"
+
 const (
 	// WorkspacePhaseLabel holds the Workspace.Status.Phase value, and is enforced to match
 	// by a mutating admission webhook.
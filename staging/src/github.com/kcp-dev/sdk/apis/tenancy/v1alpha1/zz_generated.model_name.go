@@ -106,6 +106,11 @@ func (in WorkspaceAuthenticationConfigurationSpec) OpenAPIModelName() string {
 	return "com.github.kcp-dev.sdk.apis.tenancy.v1alpha1.WorkspaceAuthenticationConfigurationSpec"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in WorkspaceCloneSource) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.tenancy.v1alpha1.WorkspaceCloneSource"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in WorkspaceList) OpenAPIModelName() string {
 	return "com.github.kcp-dev.sdk.apis.tenancy.v1alpha1.WorkspaceList"
@@ -116,6 +121,41 @@ func (in WorkspaceLocation) OpenAPIModelName() string {
 	return "com.github.kcp-dev.sdk.apis.tenancy.v1alpha1.WorkspaceLocation"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in WorkspaceQuota) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.tenancy.v1alpha1.WorkspaceQuota"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in WorkspaceQuotaList) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.tenancy.v1alpha1.WorkspaceQuotaList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in WorkspaceQuotaSpec) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.tenancy.v1alpha1.WorkspaceQuotaSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in WorkspaceQuotaStatus) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.tenancy.v1alpha1.WorkspaceQuotaStatus"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in WorkspaceQuotaUsage) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.tenancy.v1alpha1.WorkspaceQuotaUsage"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in WorkspaceQuotaUsageList) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.tenancy.v1alpha1.WorkspaceQuotaUsageList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in WorkspaceQuotaUsageSpec) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.tenancy.v1alpha1.WorkspaceQuotaUsageSpec"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in WorkspaceSpec) OpenAPIModelName() string {
 	return "com.github.kcp-dev.sdk.apis.tenancy.v1alpha1.WorkspaceSpec"
@@ -126,6 +166,11 @@ func (in WorkspaceStatus) OpenAPIModelName() string {
 	return "com.github.kcp-dev.sdk.apis.tenancy.v1alpha1.WorkspaceStatus"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in WorkspaceTopologySpreadConstraint) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.tenancy.v1alpha1.WorkspaceTopologySpreadConstraint"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in WorkspaceType) OpenAPIModelName() string {
 	return "com.github.kcp-dev.sdk.apis.tenancy.v1alpha1.WorkspaceType"
@@ -17,15 +17,21 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	conditionsv1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
 )
 
 // +crd
 // +genclient
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories=kcp
 // +kubebuilder:printcolumn:name="Owner",type="string",JSONPath=".metadata.ownerReferences[*].name"
+// +kubebuilder:printcolumn:name="Shards",type="integer",JSONPath=".status.shardCount",description="Number of shards matching the partition"
+// +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.readyShardCount",description="Number of ready shards matching the partition"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Partition defines the selection of a set of shards along multiple dimensions.
@@ -42,6 +48,11 @@ type Partition struct {
 
 	// spec holds the desired state.
 	Spec PartitionSpec `json:"spec,omitempty"`
+
+	// +optional
+
+	// status holds information about the current status
+	Status PartitionStatus `json:"status,omitempty"`
 }
 
 // PartitionSpec records the values defining the partition along multiple dimensions.
@@ -52,6 +63,92 @@ type PartitionSpec struct {
 	Selector *metav1.LabelSelector `json:"selector,omitempty"`
 }
 
+// PartitionStatus records the shards currently selected by the partition.
+type PartitionStatus struct {
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+
+	// shards are the shards matching the selector, sorted by name.
+	Shards []PartitionShard `json:"shards,omitempty"`
+
+	// +optional
+
+	// shardCount is the number of shards matching the selector.
+	ShardCount int32 `json:"shardCount,omitempty"`
+
+	// +optional
+
+	// readyShardCount is the number of ready shards matching the selector.
+	ReadyShardCount int32 `json:"readyShardCount,omitempty"`
+
+	// +optional
+
+	// capacity is the sum of the capacities reported by the ready shards
+	// matching the selector.
+	Capacity corev1.ResourceList `json:"capacity,omitempty"`
+
+	// +optional
+
+	// apiExportEndpointSlice is the name of the APIExportEndpointSlice filtered
+	// by this partition, if the owning PartitionSet asks for one.
+	APIExportEndpointSlice string `json:"apiExportEndpointSlice,omitempty"`
+
+	// +optional
+
+	// conditions is a list of conditions that apply to the Partition.
+	Conditions conditionsv1alpha1.Conditions `json:"conditions,omitempty"`
+}
+
+// PartitionShard is a shard matching a partition.
+type PartitionShard struct {
+	// +required
+	// +kubebuilder:validation:Required
+
+	// name is the name of the shard.
+	Name string `json:"name"`
+
+	// +optional
+
+	// ready is true if the shard is ready, i.e. its Ready condition is not
+	// false.
+	Ready bool `json:"ready,omitempty"`
+}
+
+func (in *Partition) GetConditions() conditionsv1alpha1.Conditions {
+	return in.Status.Conditions
+}
+
+func (in *Partition) SetConditions(conditions conditionsv1alpha1.Conditions) {
+	in.Status.Conditions = conditions
+}
+
+// These are valid conditions of Partition.
+const (
+	// PartitionShardsReady indicates whether the partition selects at least one
+	// shard and all of them are ready.
+	PartitionShardsReady conditionsv1alpha1.ConditionType = "ShardsReady"
+	// APIExportEndpointSliceReady indicates whether the APIExportEndpointSlice
+	// requested by the owning PartitionSet exists.
+	APIExportEndpointSliceReady conditionsv1alpha1.ConditionType = "APIExportEndpointSliceReady"
+
+	// NoShardsReason indicates that no shard matches the selector of the partition.
+	NoShardsReason = "NoShards"
+	// ShardsNotReadyReason indicates that some shards matching the selector are not ready.
+	ShardsNotReadyReason = "ShardsNotReady"
+	// InvalidSelectorReason indicates that the selector of the partition is invalid.
+	InvalidSelectorReason = "InvalidSelector"
+	// APIExportEndpointSliceConflictReason indicates that an APIExportEndpointSlice
+	// of the name of the partition exists, but is not filtered by it.
+	APIExportEndpointSliceConflictReason = "APIExportEndpointSliceConflict"
+	// ErrorCreatingAPIExportEndpointSliceReason indicates that the APIExportEndpointSlice
+	// could not be created.
+	ErrorCreatingAPIExportEndpointSliceReason = "ErrorCreatingAPIExportEndpointSlice"
+	// ReplacingAPIExportEndpointSliceReason indicates that the APIExportEndpointSlice
+	// is recreated because the owning PartitionSet asks for a different APIExport.
+	ReplacingAPIExportEndpointSliceReason = "ReplacingAPIExportEndpointSlice"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // PartitionList is a list of Partition resources.
@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	apisv1alpha1 "github.com/kcp-dev/sdk/apis/apis/v1alpha1"
 	conditionsv1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
 )
 
@@ -62,6 +63,23 @@ type PartitionSetSpec struct {
 
 	// shardSelector (optional) specifies filtering for shard targets.
 	ShardSelector *metav1.LabelSelector `json:"shardSelector,omitempty"`
+
+	// +optional
+
+	// apiExportEndpointSlice (optional) makes every partition of the set get an
+	// APIExportEndpointSlice of the same name, filtered by the partition. Its
+	// endpoints are kept up to date as shards join or leave the partition.
+	APIExportEndpointSlice *PartitionSetAPIExportEndpointSlice `json:"apiExportEndpointSlice,omitempty"`
+}
+
+// PartitionSetAPIExportEndpointSlice configures the APIExportEndpointSlices
+// created for the partitions of a PartitionSet.
+type PartitionSetAPIExportEndpointSlice struct {
+	// +required
+	// +kubebuilder:validation:Required
+
+	// export points to the APIExport whose endpoints are sliced.
+	APIExport apisv1alpha1.ExportBindingReference `json:"export"`
 }
 
 // PartitionSetStatus records the status of the PartitionSet.
@@ -22,6 +22,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
@@ -34,6 +35,7 @@ func (in *Partition) DeepCopyInto(out *Partition) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -116,6 +118,23 @@ func (in *PartitionSet) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PartitionSetAPIExportEndpointSlice) DeepCopyInto(out *PartitionSetAPIExportEndpointSlice) {
+	*out = *in
+	out.APIExport = in.APIExport
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PartitionSetAPIExportEndpointSlice.
+func (in *PartitionSetAPIExportEndpointSlice) DeepCopy() *PartitionSetAPIExportEndpointSlice {
+	if in == nil {
+		return nil
+	}
+	out := new(PartitionSetAPIExportEndpointSlice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PartitionSetList) DeepCopyInto(out *PartitionSetList) {
 	*out = *in
@@ -162,6 +181,11 @@ func (in *PartitionSetSpec) DeepCopyInto(out *PartitionSetSpec) {
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.APIExportEndpointSlice != nil {
+		in, out := &in.APIExportEndpointSlice, &out.APIExportEndpointSlice
+		*out = new(PartitionSetAPIExportEndpointSlice)
+		**out = **in
+	}
 	return
 }
 
@@ -198,6 +222,22 @@ func (in *PartitionSetStatus) DeepCopy() *PartitionSetStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PartitionShard) DeepCopyInto(out *PartitionShard) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PartitionShard.
+func (in *PartitionShard) DeepCopy() *PartitionShard {
+	if in == nil {
+		return nil
+	}
+	out := new(PartitionShard)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PartitionSpec) DeepCopyInto(out *PartitionSpec) {
 	*out = *in
@@ -218,3 +258,38 @@ func (in *PartitionSpec) DeepCopy() *PartitionSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PartitionStatus) DeepCopyInto(out *PartitionStatus) {
+	*out = *in
+	if in.Shards != nil {
+		in, out := &in.Shards, &out.Shards
+		*out = make([]PartitionShard, len(*in))
+		copy(*out, *in)
+	}
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(conditionsv1alpha1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PartitionStatus.
+func (in *PartitionStatus) DeepCopy() *PartitionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PartitionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -36,6 +36,11 @@ func (in PartitionSet) OpenAPIModelName() string {
 	return "com.github.kcp-dev.sdk.apis.topology.v1alpha1.PartitionSet"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in PartitionSetAPIExportEndpointSlice) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.topology.v1alpha1.PartitionSetAPIExportEndpointSlice"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in PartitionSetList) OpenAPIModelName() string {
 	return "com.github.kcp-dev.sdk.apis.topology.v1alpha1.PartitionSetList"
@@ -51,7 +56,17 @@ func (in PartitionSetStatus) OpenAPIModelName() string {
 	return "com.github.kcp-dev.sdk.apis.topology.v1alpha1.PartitionSetStatus"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in PartitionShard) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.topology.v1alpha1.PartitionShard"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in PartitionSpec) OpenAPIModelName() string {
 	return "com.github.kcp-dev.sdk.apis.topology.v1alpha1.PartitionSpec"
 }
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in PartitionStatus) OpenAPIModelName() string {
+	return "com.github.kcp-dev.sdk.apis.topology.v1alpha1.PartitionStatus"
+}
@@ -46,6 +46,10 @@ type APIBindingStatusApplyConfiguration struct {
 	// exportPermissionClaims records the permissions that the export provider is asking for
 	// the binding to grant.
 	ExportPermissionClaims []PermissionClaimApplyConfiguration `json:"exportPermissionClaims,omitempty"`
+	// schemaRevision identifies the resource schemas of the APIExport the APIBinding
+	// is bound to. It matches the schemaRevision or previousSchemaRevision in the
+	// APIExport status.
+	SchemaRevision *string `json:"schemaRevision,omitempty"`
 }
 
 // APIBindingStatusApplyConfiguration constructs a declarative configuration of the APIBindingStatus type for use with
@@ -116,3 +120,11 @@ func (b *APIBindingStatusApplyConfiguration) WithExportPermissionClaims(values .
 	}
 	return b
 }
+
+// WithSchemaRevision sets the SchemaRevision field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SchemaRevision field is set to the value of the last call.
+func (b *APIBindingStatusApplyConfiguration) WithSchemaRevision(value string) *APIBindingStatusApplyConfiguration {
+	b.SchemaRevision = &value
+	return b
+}
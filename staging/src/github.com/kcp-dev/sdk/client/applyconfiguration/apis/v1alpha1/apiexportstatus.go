@@ -36,6 +36,11 @@ type APIExportStatusApplyConfiguration struct {
 	//
 	// Deprecated: use APIExportEndpointSlice.status.endpoints instead
 	VirtualWorkspaces []VirtualWorkspaceApplyConfiguration `json:"virtualWorkspaces,omitempty"`
+	// schemaRevision identifies the resource schemas the APIExport binds to.
+	SchemaRevision *string `json:"schemaRevision,omitempty"`
+	// previousSchemaRevision identifies the resource schemas of the APIExport
+	// before a staged rollout.
+	PreviousSchemaRevision *string `json:"previousSchemaRevision,omitempty"`
 }
 
 // APIExportStatusApplyConfiguration constructs a declarative configuration of the APIExportStatus type for use with
@@ -72,3 +77,19 @@ func (b *APIExportStatusApplyConfiguration) WithVirtualWorkspaces(values ...*Vir
 	}
 	return b
 }
+
+// WithSchemaRevision sets the SchemaRevision field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SchemaRevision field is set to the value of the last call.
+func (b *APIExportStatusApplyConfiguration) WithSchemaRevision(value string) *APIExportStatusApplyConfiguration {
+	b.SchemaRevision = &value
+	return b
+}
+
+// WithPreviousSchemaRevision sets the PreviousSchemaRevision field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PreviousSchemaRevision field is set to the value of the last call.
+func (b *APIExportStatusApplyConfiguration) WithPreviousSchemaRevision(value string) *APIExportStatusApplyConfiguration {
+	b.PreviousSchemaRevision = &value
+	return b
+}
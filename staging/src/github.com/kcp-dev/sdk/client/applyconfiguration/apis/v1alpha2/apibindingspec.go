@@ -30,6 +30,10 @@ type APIBindingSpecApplyConfiguration struct {
 	// requested access to the specified resources in this workspace. Access is granted per
 	// GroupResource, identity, and other properties.
 	PermissionClaims []AcceptablePermissionClaimApplyConfiguration `json:"permissionClaims,omitempty"`
+	// pinnedSchemas pins resources of the APIExport to specific APIResourceSchemas in the
+	// workspace of the APIExport. A pinned resource stays bound to its schema when the
+	// APIExport moves ahead, until the pin is updated or removed.
+	PinnedSchemas []PinnedSchemaApplyConfiguration `json:"pinnedSchemas,omitempty"`
 }
 
 // APIBindingSpecApplyConfiguration constructs a declarative configuration of the APIBindingSpec type for use with
@@ -58,3 +62,16 @@ func (b *APIBindingSpecApplyConfiguration) WithPermissionClaims(values ...*Accep
 	}
 	return b
 }
+
+// WithPinnedSchemas adds the given value to the PinnedSchemas field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PinnedSchemas field.
+func (b *APIBindingSpecApplyConfiguration) WithPinnedSchemas(values ...*PinnedSchemaApplyConfiguration) *APIBindingSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPinnedSchemas")
+		}
+		b.PinnedSchemas = append(b.PinnedSchemas, *values[i])
+	}
+	return b
+}
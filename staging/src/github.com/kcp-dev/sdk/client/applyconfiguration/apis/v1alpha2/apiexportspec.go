@@ -29,8 +29,8 @@ type APIExportSpecApplyConfiguration struct {
 	// The schemas can be changed in the life-cycle of the APIExport. These changes
 	// have no effect on existing APIBindings, but only on newly bound ones.
 	//
-	// For updating existing APIBindings, use an APIDeployment keeping bound
-	// workspaces up-to-date.
+	// Changes are picked up by all APIBindings at once, unless rollout is set
+	// to stage them.
 	Resources []ResourceSchemaApplyConfiguration `json:"resources,omitempty"`
 	// identity points to a secret that contains the API identity in the 'key' file.
 	// The API identity determines an unique etcd prefix for objects stored via this
@@ -75,6 +75,10 @@ type APIExportSpecApplyConfiguration struct {
 	//
 	// PermissionClaims overlapping with the APIExport resources are ignored.
 	PermissionClaims []PermissionClaimApplyConfiguration `json:"permissionClaims,omitempty"`
+	// rollout stages a change of resources. While set, only the APIBindings selected
+	// by the rollout are bound to resources; all others stay on rollout.previousResources.
+	// The change is promoted to all APIBindings by removing rollout.
+	Rollout *APIExportRolloutApplyConfiguration `json:"rollout,omitempty"`
 }
 
 // APIExportSpecApplyConfiguration constructs a declarative configuration of the APIExportSpec type for use with
@@ -124,3 +128,11 @@ func (b *APIExportSpecApplyConfiguration) WithPermissionClaims(values ...*Permis
 	}
 	return b
 }
+
+// WithRollout sets the Rollout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rollout field is set to the value of the last call.
+func (b *APIExportSpecApplyConfiguration) WithRollout(value *APIExportRolloutApplyConfiguration) *APIExportSpecApplyConfiguration {
+	b.Rollout = value
+	return b
+}
@@ -39,6 +39,9 @@ type BoundAPIResourceApplyConfiguration struct {
 	//
 	// Versions may not be removed while they exist in this list.
 	StorageVersions []string `json:"storageVersions,omitempty"`
+	// availableSchema references the APIResourceSchema the APIExport offers for this API,
+	// if the API is pinned to another one in spec.pinnedSchemas.
+	AvailableSchema *BoundAPIResourceSchemaApplyConfiguration `json:"availableSchema,omitempty"`
 }
 
 // BoundAPIResourceApplyConfiguration constructs a declarative configuration of the BoundAPIResource type for use with
@@ -80,3 +83,11 @@ func (b *BoundAPIResourceApplyConfiguration) WithStorageVersions(values ...strin
 	}
 	return b
 }
+
+// WithAvailableSchema sets the AvailableSchema field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AvailableSchema field is set to the value of the last call.
+func (b *BoundAPIResourceApplyConfiguration) WithAvailableSchema(value *BoundAPIResourceSchemaApplyConfiguration) *BoundAPIResourceApplyConfiguration {
+	b.AvailableSchema = value
+	return b
+}
@@ -31,6 +31,10 @@ import (
 type ShardStatusApplyConfiguration struct {
 	// Set of integer resources that logical clusters can be scheduled into
 	Capacity *v1.ResourceList `json:"capacity,omitempty"`
+	// usage is the amount of the capacity resources in use, as periodically
+	// reported by the shard itself. Used by the workspace scheduler to place
+	// new workspaces.
+	Usage *v1.ResourceList `json:"usage,omitempty"`
 	// Current processing state of the Shard.
 	Conditions *conditionsv1alpha1.Conditions `json:"conditions,omitempty"`
 }
@@ -49,6 +53,14 @@ func (b *ShardStatusApplyConfiguration) WithCapacity(value v1.ResourceList) *Sha
 	return b
 }
 
+// WithUsage sets the Usage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Usage field is set to the value of the last call.
+func (b *ShardStatusApplyConfiguration) WithUsage(value v1.ResourceList) *ShardStatusApplyConfiguration {
+	b.Usage = &value
+	return b
+}
+
 // WithConditions sets the Conditions field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Conditions field is set to the value of the last call.
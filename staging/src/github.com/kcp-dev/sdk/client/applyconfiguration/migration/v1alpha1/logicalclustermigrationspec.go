@@ -18,6 +18,10 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	migrationv1alpha1 "github.com/kcp-dev/sdk/apis/migration/v1alpha1"
+)
+
 // LogicalClusterMigrationSpecApplyConfiguration represents a declarative configuration of the LogicalClusterMigrationSpec type for use
 // with apply.
 //
@@ -27,6 +31,25 @@ type LogicalClusterMigrationSpecApplyConfiguration struct {
 	LogicalCluster *string `json:"logicalCluster,omitempty"`
 	// destinationShard is the name of the shard to migrate the logical cluster to.
 	DestinationShard *string `json:"destinationShard,omitempty"`
+	// strategy selects how the data is moved to the destination shard.
+	//
+	// Freeze blocks all access to the logical cluster for the whole copy.
+	//
+	// PreCopy copies the data in rounds while the logical cluster stays
+	// writable, each round only transferring what changed on the origin
+	// shard since the previous one. The logical cluster is only blocked
+	// for the final round, which copies the remaining delta.
+	Strategy *migrationv1alpha1.LogicalClusterMigrationStrategyType `json:"strategy,omitempty"`
+	// preCopy tunes the PreCopy strategy. Ignored for other strategies.
+	PreCopy *LogicalClusterMigrationPreCopyApplyConfiguration `json:"preCopy,omitempty"`
+	// abort requests the migration to be rolled back. The data copied to
+	// the destination shard is deleted and the logical cluster is made
+	// available on the origin shard again.
+	//
+	// A migration can only be aborted until the origin shard starts
+	// deleting its data, i.e. before the OriginCleanup phase. Once set,
+	// abort cannot be unset.
+	Abort *bool `json:"abort,omitempty"`
 }
 
 // LogicalClusterMigrationSpecApplyConfiguration constructs a declarative configuration of the LogicalClusterMigrationSpec type for use with
@@ -50,3 +73,27 @@ func (b *LogicalClusterMigrationSpecApplyConfiguration) WithDestinationShard(val
 	b.DestinationShard = &value
 	return b
 }
+
+// WithStrategy sets the Strategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Strategy field is set to the value of the last call.
+func (b *LogicalClusterMigrationSpecApplyConfiguration) WithStrategy(value migrationv1alpha1.LogicalClusterMigrationStrategyType) *LogicalClusterMigrationSpecApplyConfiguration {
+	b.Strategy = &value
+	return b
+}
+
+// WithPreCopy sets the PreCopy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PreCopy field is set to the value of the last call.
+func (b *LogicalClusterMigrationSpecApplyConfiguration) WithPreCopy(value *LogicalClusterMigrationPreCopyApplyConfiguration) *LogicalClusterMigrationSpecApplyConfiguration {
+	b.PreCopy = value
+	return b
+}
+
+// WithAbort sets the Abort field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Abort field is set to the value of the last call.
+func (b *LogicalClusterMigrationSpecApplyConfiguration) WithAbort(value bool) *LogicalClusterMigrationSpecApplyConfiguration {
+	b.Abort = &value
+	return b
+}
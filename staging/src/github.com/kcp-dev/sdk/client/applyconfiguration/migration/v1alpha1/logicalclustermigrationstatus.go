@@ -42,6 +42,21 @@ type LogicalClusterMigrationStatusApplyConfiguration struct {
 	// copy is complete. Used to resume the copy from where it left off
 	// after a destination shard restart, instead of starting over.
 	DumpContinue *string `json:"dumpContinue,omitempty"`
+	// copyRounds is the number of copy rounds completed so far. Only used
+	// by the PreCopy strategy.
+	CopyRounds *int32 `json:"copyRounds,omitempty"`
+	// roundRevision is the origin shard's etcd revision at the start of the
+	// copy round in progress. Only used by the PreCopy strategy.
+	RoundRevision *int64 `json:"roundRevision,omitempty"`
+	// roundEntriesCopied is the number of changed etcd entries copied in the
+	// copy round in progress, or in the last completed one if no round is in
+	// progress. Only used by the PreCopy strategy.
+	RoundEntriesCopied *int64 `json:"roundEntriesCopied,omitempty"`
+	// syncedRevision is the origin shard's etcd revision up to which all
+	// changes have been copied to the destination shard. The next copy
+	// round only transfers entries modified after it. Only used by the
+	// PreCopy strategy.
+	SyncedRevision *int64 `json:"syncedRevision,omitempty"`
 	// Current processing state of the migration.
 	Conditions *conditionsv1alpha1.Conditions `json:"conditions,omitempty"`
 }
@@ -84,6 +99,38 @@ func (b *LogicalClusterMigrationStatusApplyConfiguration) WithDumpContinue(value
 	return b
 }
 
+// WithCopyRounds sets the CopyRounds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CopyRounds field is set to the value of the last call.
+func (b *LogicalClusterMigrationStatusApplyConfiguration) WithCopyRounds(value int32) *LogicalClusterMigrationStatusApplyConfiguration {
+	b.CopyRounds = &value
+	return b
+}
+
+// WithRoundRevision sets the RoundRevision field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RoundRevision field is set to the value of the last call.
+func (b *LogicalClusterMigrationStatusApplyConfiguration) WithRoundRevision(value int64) *LogicalClusterMigrationStatusApplyConfiguration {
+	b.RoundRevision = &value
+	return b
+}
+
+// WithRoundEntriesCopied sets the RoundEntriesCopied field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RoundEntriesCopied field is set to the value of the last call.
+func (b *LogicalClusterMigrationStatusApplyConfiguration) WithRoundEntriesCopied(value int64) *LogicalClusterMigrationStatusApplyConfiguration {
+	b.RoundEntriesCopied = &value
+	return b
+}
+
+// WithSyncedRevision sets the SyncedRevision field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SyncedRevision field is set to the value of the last call.
+func (b *LogicalClusterMigrationStatusApplyConfiguration) WithSyncedRevision(value int64) *LogicalClusterMigrationStatusApplyConfiguration {
+	b.SyncedRevision = &value
+	return b
+}
+
 // WithConditions sets the Conditions field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Conditions field is set to the value of the last call.
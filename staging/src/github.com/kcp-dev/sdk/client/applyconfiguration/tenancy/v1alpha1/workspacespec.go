@@ -18,6 +18,10 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // WorkspaceSpecApplyConfiguration represents a declarative configuration of the WorkspaceSpec type for use
 // with apply.
 //
@@ -52,6 +56,18 @@ type WorkspaceSpecApplyConfiguration struct {
 	// If specified, logicalcluster will not be created and the workspace will be mounted
 	// using reference mount object.
 	Mount *MountApplyConfiguration `json:"mount,omitempty"`
+	// cloneFrom references a workspace whose contents are copied into this
+	// workspace while it is initializing. Copied objects get new UIDs, and
+	// owner references are updated to point to the copied owners. The owner
+	// of the workspace needs the verb 'admin' on the workspaces/content
+	// subresource of the source workspace.
+	CloneFrom *WorkspaceCloneSourceApplyConfiguration `json:"cloneFrom,omitempty"`
+	// expiresAt is the time after which the workspace is deleted. If unset on
+	// creation, it is defaulted from the defaultTTL of the workspace type. The
+	// lease can be extended by updating it, or with the
+	// tenancy.kcp.io/extend-lease annotation. The WorkspaceExpiring condition
+	// warns an hour before the workspace expires.
+	ExpiresAt *v1.Time `json:"expiresAt,omitempty"`
 }
 
 // WorkspaceSpecApplyConfiguration constructs a declarative configuration of the WorkspaceSpec type for use with
@@ -99,3 +115,19 @@ func (b *WorkspaceSpecApplyConfiguration) WithMount(value *MountApplyConfigurati
 	b.Mount = value
 	return b
 }
+
+// WithCloneFrom sets the CloneFrom field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CloneFrom field is set to the value of the last call.
+func (b *WorkspaceSpecApplyConfiguration) WithCloneFrom(value *WorkspaceCloneSourceApplyConfiguration) *WorkspaceSpecApplyConfiguration {
+	b.CloneFrom = value
+	return b
+}
+
+// WithExpiresAt sets the ExpiresAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExpiresAt field is set to the value of the last call.
+func (b *WorkspaceSpecApplyConfiguration) WithExpiresAt(value v1.Time) *WorkspaceSpecApplyConfiguration {
+	b.ExpiresAt = &value
+	return b
+}
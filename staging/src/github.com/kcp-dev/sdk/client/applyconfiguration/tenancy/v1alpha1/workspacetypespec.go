@@ -20,6 +20,8 @@ package v1alpha1
 
 import (
 	v1 "k8s.io/api/rbac/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
 )
@@ -107,6 +109,47 @@ type WorkspaceTypeSpecApplyConfiguration struct {
 	//
 	// Changes take effect immediately for all workspaces of this type.
 	TerminatorPermissions []v1.PolicyRule `json:"terminatorPermissions,omitempty"`
+	// topologySpreadConstraints describe how workspaces of this type are spread
+	// across shard failure domains, e.g. regions. A new workspace of this type
+	// is only scheduled to a shard satisfying all constraints. Constraints are
+	// not inherited from extended types.
+	TopologySpreadConstraints []WorkspaceTopologySpreadConstraintApplyConfiguration `json:"topologySpreadConstraints,omitempty"`
+	// maxStorageBytes limits the bytes the objects of each workspace of this
+	// type may occupy in storage. Writes that would exceed the limit are
+	// rejected. The core.kcp.io/max-storage-bytes annotation on the
+	// LogicalCluster of a workspace takes precedence. The limit is not
+	// inherited from extended types.
+	MaxStorageBytes *resource.Quantity `json:"maxStorageBytes,omitempty"`
+	// maxChildren limits the number of child workspaces created directly in
+	// each workspace of this type. 0 allows no child workspaces. The limit is
+	// not inherited from extended types.
+	MaxChildren *int32 `json:"maxChildren,omitempty"`
+	// maxDepth limits the nesting of workspaces below each workspace of this
+	// type, whatever the types of the workspaces below: 1 allows child
+	// workspaces but no grandchildren, 0 allows no child workspaces. The
+	// limit is evaluated when a workspace is created, and changes do not
+	// affect existing workspaces. It is not inherited from extended types.
+	MaxDepth *int32 `json:"maxDepth,omitempty"`
+	// hibernateAfter is the time a workspace of this type may go without
+	// requests from users before it is hibernated, e.g. "24h". A hibernated
+	// workspace is marked Inactive and its per-cluster caches are dropped on
+	// its shard. The next request by a user wakes it up transparently. Requests
+	// by kcp system components neither count as activity nor wake a workspace.
+	// If unset, workspaces of this type are never hibernated. It is not
+	// inherited from extended types.
+	HibernateAfter *metav1.Duration `json:"hibernateAfter,omitempty"`
+	// defaultTTL is the lifetime of new workspaces of this type which do not
+	// set spec.expiresAt on creation, e.g. "72h". Expired workspaces are
+	// deleted. If unset, workspaces of this type do not expire by default. It
+	// is not inherited from extended types.
+	DefaultTTL *metav1.Duration `json:"defaultTTL,omitempty"`
+	// deletionRetention is the time the logical cluster and the content of a
+	// deleted workspace of this type are retained, e.g. "168h". Meanwhile, the
+	// workspace is in phase Deleted, its content cannot be accessed, and it can
+	// be undeleted. Terminators run when the retention has passed. If unset,
+	// deleted workspaces are purged right away. It is not inherited from
+	// extended types.
+	DeletionRetention *metav1.Duration `json:"deletionRetention,omitempty"`
 }
 
 // WorkspaceTypeSpecApplyConfiguration constructs a declarative configuration of the WorkspaceTypeSpec type for use with
@@ -230,3 +273,64 @@ func (b *WorkspaceTypeSpecApplyConfiguration) WithTerminatorPermissions(values .
 	}
 	return b
 }
+
+// WithTopologySpreadConstraints adds the given value to the TopologySpreadConstraints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TopologySpreadConstraints field.
+func (b *WorkspaceTypeSpecApplyConfiguration) WithTopologySpreadConstraints(values ...*WorkspaceTopologySpreadConstraintApplyConfiguration) *WorkspaceTypeSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTopologySpreadConstraints")
+		}
+		b.TopologySpreadConstraints = append(b.TopologySpreadConstraints, *values[i])
+	}
+	return b
+}
+
+// WithMaxStorageBytes sets the MaxStorageBytes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxStorageBytes field is set to the value of the last call.
+func (b *WorkspaceTypeSpecApplyConfiguration) WithMaxStorageBytes(value resource.Quantity) *WorkspaceTypeSpecApplyConfiguration {
+	b.MaxStorageBytes = &value
+	return b
+}
+
+// WithMaxChildren sets the MaxChildren field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxChildren field is set to the value of the last call.
+func (b *WorkspaceTypeSpecApplyConfiguration) WithMaxChildren(value int32) *WorkspaceTypeSpecApplyConfiguration {
+	b.MaxChildren = &value
+	return b
+}
+
+// WithMaxDepth sets the MaxDepth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxDepth field is set to the value of the last call.
+func (b *WorkspaceTypeSpecApplyConfiguration) WithMaxDepth(value int32) *WorkspaceTypeSpecApplyConfiguration {
+	b.MaxDepth = &value
+	return b
+}
+
+// WithHibernateAfter sets the HibernateAfter field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HibernateAfter field is set to the value of the last call.
+func (b *WorkspaceTypeSpecApplyConfiguration) WithHibernateAfter(value metav1.Duration) *WorkspaceTypeSpecApplyConfiguration {
+	b.HibernateAfter = &value
+	return b
+}
+
+// WithDefaultTTL sets the DefaultTTL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DefaultTTL field is set to the value of the last call.
+func (b *WorkspaceTypeSpecApplyConfiguration) WithDefaultTTL(value metav1.Duration) *WorkspaceTypeSpecApplyConfiguration {
+	b.DefaultTTL = &value
+	return b
+}
+
+// WithDeletionRetention sets the DeletionRetention field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionRetention field is set to the value of the last call.
+func (b *WorkspaceTypeSpecApplyConfiguration) WithDeletionRetention(value metav1.Duration) *WorkspaceTypeSpecApplyConfiguration {
+	b.DeletionRetention = &value
+	return b
+}
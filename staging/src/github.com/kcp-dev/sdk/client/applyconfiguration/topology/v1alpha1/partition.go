@@ -35,6 +35,8 @@ type PartitionApplyConfiguration struct {
 	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
 	// spec holds the desired state.
 	Spec *PartitionSpecApplyConfiguration `json:"spec,omitempty"`
+	// status holds information about the current status
+	Status *PartitionStatusApplyConfiguration `json:"status,omitempty"`
 }
 
 // Partition constructs a declarative configuration of the Partition type for use with
@@ -215,6 +217,14 @@ func (b *PartitionApplyConfiguration) WithSpec(value *PartitionSpecApplyConfigur
 	return b
 }
 
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *PartitionApplyConfiguration) WithStatus(value *PartitionStatusApplyConfiguration) *PartitionApplyConfiguration {
+	b.Status = value
+	return b
+}
+
 // GetKind retrieves the value of the Kind field in the declarative configuration.
 func (b *PartitionApplyConfiguration) GetKind() *string {
 	return b.TypeMetaApplyConfiguration.Kind
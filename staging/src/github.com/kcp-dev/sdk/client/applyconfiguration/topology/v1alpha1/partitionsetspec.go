@@ -31,6 +31,10 @@ type PartitionSetSpecApplyConfiguration struct {
 	Dimensions []string `json:"dimensions,omitempty"`
 	// shardSelector (optional) specifies filtering for shard targets.
 	ShardSelector *v1.LabelSelectorApplyConfiguration `json:"shardSelector,omitempty"`
+	// apiExportEndpointSlice (optional) makes every partition of the set get an
+	// APIExportEndpointSlice of the same name, filtered by the partition. Its
+	// endpoints are kept up to date as shards join or leave the partition.
+	APIExportEndpointSlice *PartitionSetAPIExportEndpointSliceApplyConfiguration `json:"apiExportEndpointSlice,omitempty"`
 }
 
 // PartitionSetSpecApplyConfiguration constructs a declarative configuration of the PartitionSetSpec type for use with
@@ -56,3 +60,11 @@ func (b *PartitionSetSpecApplyConfiguration) WithShardSelector(value *v1.LabelSe
 	b.ShardSelector = value
 	return b
 }
+
+// WithAPIExportEndpointSlice sets the APIExportEndpointSlice field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIExportEndpointSlice field is set to the value of the last call.
+func (b *PartitionSetSpecApplyConfiguration) WithAPIExportEndpointSlice(value *PartitionSetAPIExportEndpointSliceApplyConfiguration) *PartitionSetSpecApplyConfiguration {
+	b.APIExportEndpointSlice = value
+	return b
+}
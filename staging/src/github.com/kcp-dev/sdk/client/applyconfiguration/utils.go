@@ -102,6 +102,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apisv1alpha1.MaximalPermissionPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PermissionClaim"):
 		return &apisv1alpha1.PermissionClaimApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PinnedSchema"):
+		return &apisv1alpha1.PinnedSchemaApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ResourceSelector"):
 		return &apisv1alpha1.ResourceSelectorApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("VirtualWorkspace"):
@@ -122,6 +124,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apisv1alpha2.APIBindingStatusApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("APIExport"):
 		return &apisv1alpha2.APIExportApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("APIExportRollout"):
+		return &apisv1alpha2.APIExportRolloutApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("APIExportSpec"):
 		return &apisv1alpha2.APIExportSpecApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("APIExportStatus"):
@@ -144,6 +148,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apisv1alpha2.PermissionClaimApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("PermissionClaimSelector"):
 		return &apisv1alpha2.PermissionClaimSelectorApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("PinnedSchema"):
+		return &apisv1alpha2.PinnedSchemaApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("ResourceSchema"):
 		return &apisv1alpha2.ResourceSchemaApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("ResourceSchemaStorage"):
@@ -188,8 +194,28 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationcorev1alpha1.EndpointApplyConfiguration{}
 	case corev1alpha1.SchemeGroupVersion.WithKind("EndpointSelector"):
 		return &applyconfigurationcorev1alpha1.EndpointSelectorApplyConfiguration{}
+	case corev1alpha1.SchemeGroupVersion.WithKind("FrontProxyRateLimit"):
+		return &applyconfigurationcorev1alpha1.FrontProxyRateLimitApplyConfiguration{}
+	case corev1alpha1.SchemeGroupVersion.WithKind("FrontProxyRateLimitSpec"):
+		return &applyconfigurationcorev1alpha1.FrontProxyRateLimitSpecApplyConfiguration{}
+	case corev1alpha1.SchemeGroupVersion.WithKind("FrontProxyRoute"):
+		return &applyconfigurationcorev1alpha1.FrontProxyRouteApplyConfiguration{}
+	case corev1alpha1.SchemeGroupVersion.WithKind("FrontProxyRouteBackend"):
+		return &applyconfigurationcorev1alpha1.FrontProxyRouteBackendApplyConfiguration{}
+	case corev1alpha1.SchemeGroupVersion.WithKind("FrontProxyRouteHeaderMatch"):
+		return &applyconfigurationcorev1alpha1.FrontProxyRouteHeaderMatchApplyConfiguration{}
+	case corev1alpha1.SchemeGroupVersion.WithKind("FrontProxyRouteMatch"):
+		return &applyconfigurationcorev1alpha1.FrontProxyRouteMatchApplyConfiguration{}
+	case corev1alpha1.SchemeGroupVersion.WithKind("FrontProxyRouteSpec"):
+		return &applyconfigurationcorev1alpha1.FrontProxyRouteSpecApplyConfiguration{}
 	case corev1alpha1.SchemeGroupVersion.WithKind("LogicalCluster"):
 		return &applyconfigurationcorev1alpha1.LogicalClusterApplyConfiguration{}
+	case corev1alpha1.SchemeGroupVersion.WithKind("LogicalClusterLimits"):
+		return &applyconfigurationcorev1alpha1.LogicalClusterLimitsApplyConfiguration{}
+	case corev1alpha1.SchemeGroupVersion.WithKind("LogicalClusterLimitsSpec"):
+		return &applyconfigurationcorev1alpha1.LogicalClusterLimitsSpecApplyConfiguration{}
+	case corev1alpha1.SchemeGroupVersion.WithKind("LogicalClusterLimitsStatus"):
+		return &applyconfigurationcorev1alpha1.LogicalClusterLimitsStatusApplyConfiguration{}
 	case corev1alpha1.SchemeGroupVersion.WithKind("LogicalClusterOwner"):
 		return &applyconfigurationcorev1alpha1.LogicalClusterOwnerApplyConfiguration{}
 	case corev1alpha1.SchemeGroupVersion.WithKind("LogicalClusterSpec"):
@@ -198,6 +224,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationcorev1alpha1.LogicalClusterStatusApplyConfiguration{}
 	case corev1alpha1.SchemeGroupVersion.WithKind("OwnerUserInfo"):
 		return &applyconfigurationcorev1alpha1.OwnerUserInfoApplyConfiguration{}
+	case corev1alpha1.SchemeGroupVersion.WithKind("ResourceObjectCount"):
+		return &applyconfigurationcorev1alpha1.ResourceObjectCountApplyConfiguration{}
+	case corev1alpha1.SchemeGroupVersion.WithKind("ResourceObjectCountLimit"):
+		return &applyconfigurationcorev1alpha1.ResourceObjectCountLimitApplyConfiguration{}
 	case corev1alpha1.SchemeGroupVersion.WithKind("Shard"):
 		return &applyconfigurationcorev1alpha1.ShardApplyConfiguration{}
 	case corev1alpha1.SchemeGroupVersion.WithKind("ShardSpec"):
@@ -226,10 +256,18 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		// Group=migration.kcp.io, Version=v1alpha1
 	case migrationv1alpha1.SchemeGroupVersion.WithKind("LogicalClusterMigration"):
 		return &applyconfigurationmigrationv1alpha1.LogicalClusterMigrationApplyConfiguration{}
+	case migrationv1alpha1.SchemeGroupVersion.WithKind("LogicalClusterMigrationPreCopy"):
+		return &applyconfigurationmigrationv1alpha1.LogicalClusterMigrationPreCopyApplyConfiguration{}
 	case migrationv1alpha1.SchemeGroupVersion.WithKind("LogicalClusterMigrationSpec"):
 		return &applyconfigurationmigrationv1alpha1.LogicalClusterMigrationSpecApplyConfiguration{}
 	case migrationv1alpha1.SchemeGroupVersion.WithKind("LogicalClusterMigrationStatus"):
 		return &applyconfigurationmigrationv1alpha1.LogicalClusterMigrationStatusApplyConfiguration{}
+	case migrationv1alpha1.SchemeGroupVersion.WithKind("ShardDrain"):
+		return &applyconfigurationmigrationv1alpha1.ShardDrainApplyConfiguration{}
+	case migrationv1alpha1.SchemeGroupVersion.WithKind("ShardDrainSpec"):
+		return &applyconfigurationmigrationv1alpha1.ShardDrainSpecApplyConfiguration{}
+	case migrationv1alpha1.SchemeGroupVersion.WithKind("ShardDrainStatus"):
+		return &applyconfigurationmigrationv1alpha1.ShardDrainStatusApplyConfiguration{}
 
 		// Group=tenancy.kcp.io, Version=v1alpha1
 	case tenancyv1alpha1.SchemeGroupVersion.WithKind("APIExportReference"):
@@ -264,12 +302,26 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationtenancyv1alpha1.WorkspaceAuthenticationConfigurationApplyConfiguration{}
 	case tenancyv1alpha1.SchemeGroupVersion.WithKind("WorkspaceAuthenticationConfigurationSpec"):
 		return &applyconfigurationtenancyv1alpha1.WorkspaceAuthenticationConfigurationSpecApplyConfiguration{}
+	case tenancyv1alpha1.SchemeGroupVersion.WithKind("WorkspaceCloneSource"):
+		return &applyconfigurationtenancyv1alpha1.WorkspaceCloneSourceApplyConfiguration{}
 	case tenancyv1alpha1.SchemeGroupVersion.WithKind("WorkspaceLocation"):
 		return &applyconfigurationtenancyv1alpha1.WorkspaceLocationApplyConfiguration{}
+	case tenancyv1alpha1.SchemeGroupVersion.WithKind("WorkspaceQuota"):
+		return &applyconfigurationtenancyv1alpha1.WorkspaceQuotaApplyConfiguration{}
+	case tenancyv1alpha1.SchemeGroupVersion.WithKind("WorkspaceQuotaSpec"):
+		return &applyconfigurationtenancyv1alpha1.WorkspaceQuotaSpecApplyConfiguration{}
+	case tenancyv1alpha1.SchemeGroupVersion.WithKind("WorkspaceQuotaStatus"):
+		return &applyconfigurationtenancyv1alpha1.WorkspaceQuotaStatusApplyConfiguration{}
+	case tenancyv1alpha1.SchemeGroupVersion.WithKind("WorkspaceQuotaUsage"):
+		return &applyconfigurationtenancyv1alpha1.WorkspaceQuotaUsageApplyConfiguration{}
+	case tenancyv1alpha1.SchemeGroupVersion.WithKind("WorkspaceQuotaUsageSpec"):
+		return &applyconfigurationtenancyv1alpha1.WorkspaceQuotaUsageSpecApplyConfiguration{}
 	case tenancyv1alpha1.SchemeGroupVersion.WithKind("WorkspaceSpec"):
 		return &applyconfigurationtenancyv1alpha1.WorkspaceSpecApplyConfiguration{}
 	case tenancyv1alpha1.SchemeGroupVersion.WithKind("WorkspaceStatus"):
 		return &applyconfigurationtenancyv1alpha1.WorkspaceStatusApplyConfiguration{}
+	case tenancyv1alpha1.SchemeGroupVersion.WithKind("WorkspaceTopologySpreadConstraint"):
+		return &applyconfigurationtenancyv1alpha1.WorkspaceTopologySpreadConstraintApplyConfiguration{}
 	case tenancyv1alpha1.SchemeGroupVersion.WithKind("WorkspaceType"):
 		return &applyconfigurationtenancyv1alpha1.WorkspaceTypeApplyConfiguration{}
 	case tenancyv1alpha1.SchemeGroupVersion.WithKind("WorkspaceTypeExtension"):
@@ -288,12 +340,18 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationtopologyv1alpha1.PartitionApplyConfiguration{}
 	case topologyv1alpha1.SchemeGroupVersion.WithKind("PartitionSet"):
 		return &applyconfigurationtopologyv1alpha1.PartitionSetApplyConfiguration{}
+	case topologyv1alpha1.SchemeGroupVersion.WithKind("PartitionSetAPIExportEndpointSlice"):
+		return &applyconfigurationtopologyv1alpha1.PartitionSetAPIExportEndpointSliceApplyConfiguration{}
 	case topologyv1alpha1.SchemeGroupVersion.WithKind("PartitionSetSpec"):
 		return &applyconfigurationtopologyv1alpha1.PartitionSetSpecApplyConfiguration{}
 	case topologyv1alpha1.SchemeGroupVersion.WithKind("PartitionSetStatus"):
 		return &applyconfigurationtopologyv1alpha1.PartitionSetStatusApplyConfiguration{}
+	case topologyv1alpha1.SchemeGroupVersion.WithKind("PartitionShard"):
+		return &applyconfigurationtopologyv1alpha1.PartitionShardApplyConfiguration{}
 	case topologyv1alpha1.SchemeGroupVersion.WithKind("PartitionSpec"):
 		return &applyconfigurationtopologyv1alpha1.PartitionSpecApplyConfiguration{}
+	case topologyv1alpha1.SchemeGroupVersion.WithKind("PartitionStatus"):
+		return &applyconfigurationtopologyv1alpha1.PartitionStatusApplyConfiguration{}
 
 	}
 	return nil
@@ -32,7 +32,10 @@ import (
 
 type CoreV1alpha1ClusterInterface interface {
 	CoreV1alpha1ClusterScoper
+	FrontProxyRateLimitsClusterGetter
+	FrontProxyRoutesClusterGetter
 	LogicalClustersClusterGetter
+	LogicalClusterLimitsClusterGetter
 	ShardsClusterGetter
 }
 
@@ -52,10 +55,22 @@ func (c *CoreV1alpha1ClusterClient) Cluster(clusterPath logicalcluster.Path) kcp
 	return c.clientCache.ClusterOrDie(clusterPath)
 }
 
+func (c *CoreV1alpha1ClusterClient) FrontProxyRateLimits() FrontProxyRateLimitClusterInterface {
+	return &frontProxyRateLimitsClusterInterface{clientCache: c.clientCache}
+}
+
+func (c *CoreV1alpha1ClusterClient) FrontProxyRoutes() FrontProxyRouteClusterInterface {
+	return &frontProxyRoutesClusterInterface{clientCache: c.clientCache}
+}
+
 func (c *CoreV1alpha1ClusterClient) LogicalClusters() LogicalClusterClusterInterface {
 	return &logicalClustersClusterInterface{clientCache: c.clientCache}
 }
 
+func (c *CoreV1alpha1ClusterClient) LogicalClusterLimits() LogicalClusterLimitsClusterInterface {
+	return &logicalClusterLimitsClusterInterface{clientCache: c.clientCache}
+}
+
 func (c *CoreV1alpha1ClusterClient) Shards() ShardClusterInterface {
 	return &shardsClusterInterface{clientCache: c.clientCache}
 }
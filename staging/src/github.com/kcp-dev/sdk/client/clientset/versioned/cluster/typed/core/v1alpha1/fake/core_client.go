@@ -40,10 +40,22 @@ func (c *CoreV1alpha1ClusterClient) Cluster(clusterPath logicalcluster.Path) cor
 	return &CoreV1alpha1Client{Fake: c.Fake, ClusterPath: clusterPath}
 }
 
+func (c *CoreV1alpha1ClusterClient) FrontProxyRateLimits() kcpcorev1alpha1.FrontProxyRateLimitClusterInterface {
+	return newFakeFrontProxyRateLimitClusterClient(c)
+}
+
+func (c *CoreV1alpha1ClusterClient) FrontProxyRoutes() kcpcorev1alpha1.FrontProxyRouteClusterInterface {
+	return newFakeFrontProxyRouteClusterClient(c)
+}
+
 func (c *CoreV1alpha1ClusterClient) LogicalClusters() kcpcorev1alpha1.LogicalClusterClusterInterface {
 	return newFakeLogicalClusterClusterClient(c)
 }
 
+func (c *CoreV1alpha1ClusterClient) LogicalClusterLimits() kcpcorev1alpha1.LogicalClusterLimitsClusterInterface {
+	return newFakeLogicalClusterLimitsClusterClient(c)
+}
+
 func (c *CoreV1alpha1ClusterClient) Shards() kcpcorev1alpha1.ShardClusterInterface {
 	return newFakeShardClusterClient(c)
 }
@@ -53,10 +65,22 @@ type CoreV1alpha1Client struct {
 	ClusterPath logicalcluster.Path
 }
 
+func (c *CoreV1alpha1Client) FrontProxyRateLimits() corev1alpha1.FrontProxyRateLimitInterface {
+	return newFakeFrontProxyRateLimitClient(c.Fake, c.ClusterPath)
+}
+
+func (c *CoreV1alpha1Client) FrontProxyRoutes() corev1alpha1.FrontProxyRouteInterface {
+	return newFakeFrontProxyRouteClient(c.Fake, c.ClusterPath)
+}
+
 func (c *CoreV1alpha1Client) LogicalClusters() corev1alpha1.LogicalClusterInterface {
 	return newFakeLogicalClusterClient(c.Fake, c.ClusterPath)
 }
 
+func (c *CoreV1alpha1Client) LogicalClusterLimits() corev1alpha1.LogicalClusterLimitsInterface {
+	return newFakeLogicalClusterLimitsClient(c.Fake, c.ClusterPath)
+}
+
 func (c *CoreV1alpha1Client) Shards() corev1alpha1.ShardInterface {
 	return newFakeShardClient(c.Fake, c.ClusterPath)
 }
@@ -18,6 +18,12 @@ limitations under the License.
 
 package v1alpha1
 
+type FrontProxyRateLimitClusterExpansion interface{}
+
+type FrontProxyRouteClusterExpansion interface{}
+
 type LogicalClusterClusterExpansion interface{}
 
+type LogicalClusterLimitsClusterExpansion interface{}
+
 type ShardClusterExpansion interface{}
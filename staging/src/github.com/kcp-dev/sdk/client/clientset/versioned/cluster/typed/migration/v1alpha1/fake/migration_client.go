@@ -48,6 +48,14 @@ func (c *MigrationV1alpha1ClusterClient) LogicalClusterMigrations() kcpmigration
 	return newFakeLogicalClusterMigrationClusterClient(c)
 }
 
+func (c *MigrationV1alpha1ClusterClient) LogicalClusterRestores() kcpmigrationv1alpha1.LogicalClusterRestoreClusterInterface {
+	return newFakeLogicalClusterRestoreClusterClient(c)
+}
+
+func (c *MigrationV1alpha1ClusterClient) ShardDrains() kcpmigrationv1alpha1.ShardDrainClusterInterface {
+	return newFakeShardDrainClusterClient(c)
+}
+
 type MigrationV1alpha1Client struct {
 	*kcptesting.Fake
 	ClusterPath logicalcluster.Path
@@ -61,6 +69,14 @@ func (c *MigrationV1alpha1Client) LogicalClusterMigrations() migrationv1alpha1.L
 	return newFakeLogicalClusterMigrationClient(c.Fake, c.ClusterPath)
 }
 
+func (c *MigrationV1alpha1Client) LogicalClusterRestores() migrationv1alpha1.LogicalClusterRestoreInterface {
+	return newFakeLogicalClusterRestoreClient(c.Fake, c.ClusterPath)
+}
+
+func (c *MigrationV1alpha1Client) ShardDrains() migrationv1alpha1.ShardDrainInterface {
+	return newFakeShardDrainClient(c.Fake, c.ClusterPath)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *MigrationV1alpha1Client) RESTClient() rest.Interface {
@@ -21,3 +21,7 @@ package v1alpha1
 type LogicalClusterDumpClusterExpansion interface{}
 
 type LogicalClusterMigrationClusterExpansion interface{}
+
+type LogicalClusterRestoreClusterExpansion interface{}
+
+type ShardDrainClusterExpansion interface{}
@@ -34,6 +34,8 @@ type MigrationV1alpha1ClusterInterface interface {
 	MigrationV1alpha1ClusterScoper
 	LogicalClusterDumpsClusterGetter
 	LogicalClusterMigrationsClusterGetter
+	LogicalClusterRestoresClusterGetter
+	ShardDrainsClusterGetter
 }
 
 type MigrationV1alpha1ClusterScoper interface {
@@ -60,6 +62,14 @@ func (c *MigrationV1alpha1ClusterClient) LogicalClusterMigrations() LogicalClust
 	return &logicalClusterMigrationsClusterInterface{clientCache: c.clientCache}
 }
 
+func (c *MigrationV1alpha1ClusterClient) LogicalClusterRestores() LogicalClusterRestoreClusterInterface {
+	return &logicalClusterRestoresClusterInterface{clientCache: c.clientCache}
+}
+
+func (c *MigrationV1alpha1ClusterClient) ShardDrains() ShardDrainClusterInterface {
+	return &shardDrainsClusterInterface{clientCache: c.clientCache}
+}
+
 // NewForConfig creates a new MigrationV1alpha1ClusterClient for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -48,6 +48,14 @@ func (c *TenancyV1alpha1ClusterClient) WorkspaceAuthenticationConfigurations() k
 	return newFakeWorkspaceAuthenticationConfigurationClusterClient(c)
 }
 
+func (c *TenancyV1alpha1ClusterClient) WorkspaceQuotas() kcptenancyv1alpha1.WorkspaceQuotaClusterInterface {
+	return newFakeWorkspaceQuotaClusterClient(c)
+}
+
+func (c *TenancyV1alpha1ClusterClient) WorkspaceQuotaUsages() kcptenancyv1alpha1.WorkspaceQuotaUsageClusterInterface {
+	return newFakeWorkspaceQuotaUsageClusterClient(c)
+}
+
 func (c *TenancyV1alpha1ClusterClient) WorkspaceTypes() kcptenancyv1alpha1.WorkspaceTypeClusterInterface {
 	return newFakeWorkspaceTypeClusterClient(c)
 }
@@ -65,6 +73,14 @@ func (c *TenancyV1alpha1Client) WorkspaceAuthenticationConfigurations() tenancyv
 	return newFakeWorkspaceAuthenticationConfigurationClient(c.Fake, c.ClusterPath)
 }
 
+func (c *TenancyV1alpha1Client) WorkspaceQuotas() tenancyv1alpha1.WorkspaceQuotaInterface {
+	return newFakeWorkspaceQuotaClient(c.Fake, c.ClusterPath)
+}
+
+func (c *TenancyV1alpha1Client) WorkspaceQuotaUsages() tenancyv1alpha1.WorkspaceQuotaUsageInterface {
+	return newFakeWorkspaceQuotaUsageClient(c.Fake, c.ClusterPath)
+}
+
 func (c *TenancyV1alpha1Client) WorkspaceTypes() tenancyv1alpha1.WorkspaceTypeInterface {
 	return newFakeWorkspaceTypeClient(c.Fake, c.ClusterPath)
 }
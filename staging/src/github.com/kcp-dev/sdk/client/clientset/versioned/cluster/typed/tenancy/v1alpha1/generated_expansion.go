@@ -22,4 +22,8 @@ type WorkspaceClusterExpansion interface{}
 
 type WorkspaceAuthenticationConfigurationClusterExpansion interface{}
 
+type WorkspaceQuotaClusterExpansion interface{}
+
+type WorkspaceQuotaUsageClusterExpansion interface{}
+
 type WorkspaceTypeClusterExpansion interface{}
@@ -34,6 +34,8 @@ type TenancyV1alpha1ClusterInterface interface {
 	TenancyV1alpha1ClusterScoper
 	WorkspacesClusterGetter
 	WorkspaceAuthenticationConfigurationsClusterGetter
+	WorkspaceQuotasClusterGetter
+	WorkspaceQuotaUsagesClusterGetter
 	WorkspaceTypesClusterGetter
 }
 
@@ -61,6 +63,14 @@ func (c *TenancyV1alpha1ClusterClient) WorkspaceAuthenticationConfigurations() W
 	return &workspaceAuthenticationConfigurationsClusterInterface{clientCache: c.clientCache}
 }
 
+func (c *TenancyV1alpha1ClusterClient) WorkspaceQuotas() WorkspaceQuotaClusterInterface {
+	return &workspaceQuotasClusterInterface{clientCache: c.clientCache}
+}
+
+func (c *TenancyV1alpha1ClusterClient) WorkspaceQuotaUsages() WorkspaceQuotaUsageClusterInterface {
+	return &workspaceQuotaUsagesClusterInterface{clientCache: c.clientCache}
+}
+
 func (c *TenancyV1alpha1ClusterClient) WorkspaceTypes() WorkspaceTypeClusterInterface {
 	return &workspaceTypesClusterInterface{clientCache: c.clientCache}
 }
@@ -29,7 +29,10 @@ import (
 
 type CoreV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	FrontProxyRateLimitsGetter
+	FrontProxyRoutesGetter
 	LogicalClustersGetter
+	LogicalClusterLimitsGetter
 	ShardsGetter
 }
 
@@ -38,10 +41,22 @@ type CoreV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *CoreV1alpha1Client) FrontProxyRateLimits() FrontProxyRateLimitInterface {
+	return newFrontProxyRateLimits(c)
+}
+
+func (c *CoreV1alpha1Client) FrontProxyRoutes() FrontProxyRouteInterface {
+	return newFrontProxyRoutes(c)
+}
+
 func (c *CoreV1alpha1Client) LogicalClusters() LogicalClusterInterface {
 	return newLogicalClusters(c)
 }
 
+func (c *CoreV1alpha1Client) LogicalClusterLimits() LogicalClusterLimitsInterface {
+	return newLogicalClusterLimits(c)
+}
+
 func (c *CoreV1alpha1Client) Shards() ShardInterface {
 	return newShards(c)
 }
@@ -29,10 +29,22 @@ type FakeCoreV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeCoreV1alpha1) FrontProxyRateLimits() v1alpha1.FrontProxyRateLimitInterface {
+	return newFakeFrontProxyRateLimits(c)
+}
+
+func (c *FakeCoreV1alpha1) FrontProxyRoutes() v1alpha1.FrontProxyRouteInterface {
+	return newFakeFrontProxyRoutes(c)
+}
+
 func (c *FakeCoreV1alpha1) LogicalClusters() v1alpha1.LogicalClusterInterface {
 	return newFakeLogicalClusters(c)
 }
 
+func (c *FakeCoreV1alpha1) LogicalClusterLimits() v1alpha1.LogicalClusterLimitsInterface {
+	return newFakeLogicalClusterLimits(c)
+}
+
 func (c *FakeCoreV1alpha1) Shards() v1alpha1.ShardInterface {
 	return newFakeShards(c)
 }
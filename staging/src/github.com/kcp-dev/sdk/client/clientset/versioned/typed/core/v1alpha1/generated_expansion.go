@@ -18,6 +18,12 @@ limitations under the License.
 
 package v1alpha1
 
+type FrontProxyRateLimitExpansion interface{}
+
+type FrontProxyRouteExpansion interface{}
+
 type LogicalClusterExpansion interface{}
 
+type LogicalClusterLimitsExpansion interface{}
+
 type ShardExpansion interface{}
@@ -37,6 +37,14 @@ func (c *FakeMigrationV1alpha1) LogicalClusterMigrations() v1alpha1.LogicalClust
 	return newFakeLogicalClusterMigrations(c)
 }
 
+func (c *FakeMigrationV1alpha1) LogicalClusterRestores() v1alpha1.LogicalClusterRestoreInterface {
+	return newFakeLogicalClusterRestores(c)
+}
+
+func (c *FakeMigrationV1alpha1) ShardDrains() v1alpha1.ShardDrainInterface {
+	return newFakeShardDrains(c)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeMigrationV1alpha1) RESTClient() rest.Interface {
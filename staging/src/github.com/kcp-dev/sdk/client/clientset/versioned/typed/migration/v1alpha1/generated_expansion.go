@@ -21,3 +21,7 @@ package v1alpha1
 type LogicalClusterDumpExpansion interface{}
 
 type LogicalClusterMigrationExpansion interface{}
+
+type LogicalClusterRestoreExpansion interface{}
+
+type ShardDrainExpansion interface{}
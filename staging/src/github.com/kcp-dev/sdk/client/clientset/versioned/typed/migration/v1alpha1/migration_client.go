@@ -31,6 +31,8 @@ type MigrationV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	LogicalClusterDumpsGetter
 	LogicalClusterMigrationsGetter
+	LogicalClusterRestoresGetter
+	ShardDrainsGetter
 }
 
 // MigrationV1alpha1Client is used to interact with features provided by the migration.kcp.io group.
@@ -46,6 +48,14 @@ func (c *MigrationV1alpha1Client) LogicalClusterMigrations() LogicalClusterMigra
 	return newLogicalClusterMigrations(c)
 }
 
+func (c *MigrationV1alpha1Client) LogicalClusterRestores() LogicalClusterRestoreInterface {
+	return newLogicalClusterRestores(c)
+}
+
+func (c *MigrationV1alpha1Client) ShardDrains() ShardDrainInterface {
+	return newShardDrains(c)
+}
+
 // NewForConfig creates a new MigrationV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -37,6 +37,14 @@ func (c *FakeTenancyV1alpha1) WorkspaceAuthenticationConfigurations() v1alpha1.W
 	return newFakeWorkspaceAuthenticationConfigurations(c)
 }
 
+func (c *FakeTenancyV1alpha1) WorkspaceQuotas() v1alpha1.WorkspaceQuotaInterface {
+	return newFakeWorkspaceQuotas(c)
+}
+
+func (c *FakeTenancyV1alpha1) WorkspaceQuotaUsages() v1alpha1.WorkspaceQuotaUsageInterface {
+	return newFakeWorkspaceQuotaUsages(c)
+}
+
 func (c *FakeTenancyV1alpha1) WorkspaceTypes() v1alpha1.WorkspaceTypeInterface {
 	return newFakeWorkspaceTypes(c)
 }
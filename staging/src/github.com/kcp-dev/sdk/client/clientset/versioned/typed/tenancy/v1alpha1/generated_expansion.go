@@ -22,4 +22,8 @@ type WorkspaceExpansion interface{}
 
 type WorkspaceAuthenticationConfigurationExpansion interface{}
 
+type WorkspaceQuotaExpansion interface{}
+
+type WorkspaceQuotaUsageExpansion interface{}
+
 type WorkspaceTypeExpansion interface{}
@@ -31,6 +31,8 @@ type TenancyV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	WorkspacesGetter
 	WorkspaceAuthenticationConfigurationsGetter
+	WorkspaceQuotasGetter
+	WorkspaceQuotaUsagesGetter
 	WorkspaceTypesGetter
 }
 
@@ -47,6 +49,14 @@ func (c *TenancyV1alpha1Client) WorkspaceAuthenticationConfigurations() Workspac
 	return newWorkspaceAuthenticationConfigurations(c)
 }
 
+func (c *TenancyV1alpha1Client) WorkspaceQuotas() WorkspaceQuotaInterface {
+	return newWorkspaceQuotas(c)
+}
+
+func (c *TenancyV1alpha1Client) WorkspaceQuotaUsages() WorkspaceQuotaUsageInterface {
+	return newWorkspaceQuotaUsages(c)
+}
+
 func (c *TenancyV1alpha1Client) WorkspaceTypes() WorkspaceTypeInterface {
 	return newWorkspaceTypes(c)
 }
@@ -41,6 +41,8 @@ type PartitionsGetter interface {
 type PartitionInterface interface {
 	Create(ctx context.Context, partition *topologyv1alpha1.Partition, opts v1.CreateOptions) (*topologyv1alpha1.Partition, error)
 	Update(ctx context.Context, partition *topologyv1alpha1.Partition, opts v1.UpdateOptions) (*topologyv1alpha1.Partition, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, partition *topologyv1alpha1.Partition, opts v1.UpdateOptions) (*topologyv1alpha1.Partition, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*topologyv1alpha1.Partition, error)
@@ -48,6 +50,8 @@ type PartitionInterface interface {
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *topologyv1alpha1.Partition, err error)
 	Apply(ctx context.Context, partition *applyconfigurationtopologyv1alpha1.PartitionApplyConfiguration, opts v1.ApplyOptions) (result *topologyv1alpha1.Partition, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, partition *applyconfigurationtopologyv1alpha1.PartitionApplyConfiguration, opts v1.ApplyOptions) (result *topologyv1alpha1.Partition, err error)
 	PartitionExpansion
 }
 
@@ -23,8 +23,14 @@ import (
 )
 
 type ClusterInterface interface {
+	// FrontProxyRateLimits returns a FrontProxyRateLimitClusterInformer.
+	FrontProxyRateLimits() FrontProxyRateLimitClusterInformer
+	// FrontProxyRoutes returns a FrontProxyRouteClusterInformer.
+	FrontProxyRoutes() FrontProxyRouteClusterInformer
 	// LogicalClusters returns a LogicalClusterClusterInformer.
 	LogicalClusters() LogicalClusterClusterInformer
+	// LogicalClusterLimits returns a LogicalClusterLimitsClusterInformer.
+	LogicalClusterLimits() LogicalClusterLimitsClusterInformer
 	// Shards returns a ShardClusterInformer.
 	Shards() ShardClusterInformer
 }
@@ -39,19 +45,40 @@ func New(f kcpinternalinterfaces.SharedInformerFactory, tweakListOptions kcpinte
 	return &version{factory: f, tweakListOptions: tweakListOptions}
 }
 
+// FrontProxyRateLimits returns a FrontProxyRateLimitClusterInformer.
+func (v *version) FrontProxyRateLimits() FrontProxyRateLimitClusterInformer {
+	return &frontProxyRateLimitClusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// FrontProxyRoutes returns a FrontProxyRouteClusterInformer.
+func (v *version) FrontProxyRoutes() FrontProxyRouteClusterInformer {
+	return &frontProxyRouteClusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // LogicalClusters returns a LogicalClusterClusterInformer.
 func (v *version) LogicalClusters() LogicalClusterClusterInformer {
 	return &logicalClusterClusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// LogicalClusterLimits returns a LogicalClusterLimitsClusterInformer.
+func (v *version) LogicalClusterLimits() LogicalClusterLimitsClusterInformer {
+	return &logicalClusterLimitsClusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Shards returns a ShardClusterInformer.
 func (v *version) Shards() ShardClusterInformer {
 	return &shardClusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
 type Interface interface {
+	// FrontProxyRateLimits returns a FrontProxyRateLimitInformer.
+	FrontProxyRateLimits() FrontProxyRateLimitInformer
+	// FrontProxyRoutes returns a FrontProxyRouteInformer.
+	FrontProxyRoutes() FrontProxyRouteInformer
 	// LogicalClusters returns a LogicalClusterInformer.
 	LogicalClusters() LogicalClusterInformer
+	// LogicalClusterLimits returns a LogicalClusterLimitsInformer.
+	LogicalClusterLimits() LogicalClusterLimitsInformer
 	// Shards returns a ShardInformer.
 	Shards() ShardInformer
 }
@@ -67,11 +94,26 @@ func NewScoped(f kcpinternalinterfaces.SharedScopedInformerFactory, namespace st
 	return &scopedVersion{factory: f, tweakListOptions: tweakListOptions}
 }
 
+// FrontProxyRateLimits returns a FrontProxyRateLimitInformer.
+func (v *scopedVersion) FrontProxyRateLimits() FrontProxyRateLimitInformer {
+	return &frontProxyRateLimitScopedInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// FrontProxyRoutes returns a FrontProxyRouteInformer.
+func (v *scopedVersion) FrontProxyRoutes() FrontProxyRouteInformer {
+	return &frontProxyRouteScopedInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // LogicalClusters returns a LogicalClusterInformer.
 func (v *scopedVersion) LogicalClusters() LogicalClusterInformer {
 	return &logicalClusterScopedInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// LogicalClusterLimits returns a LogicalClusterLimitsInformer.
+func (v *scopedVersion) LogicalClusterLimits() LogicalClusterLimitsInformer {
+	return &logicalClusterLimitsScopedInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Shards returns a ShardInformer.
 func (v *scopedVersion) Shards() ShardInformer {
 	return &shardScopedInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -124,20 +124,32 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericClusterInformer{resource: resource.GroupResource(), informer: f.Cache().V1alpha1().ClusterCachedResourceEndpointSlices().Informer()}, nil
 
 		// Group=core.kcp.io, Version=v1alpha1
+	case kcpcorev1alpha1.SchemeGroupVersion.WithResource("frontproxyratelimits"):
+		return &genericClusterInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().FrontProxyRateLimits().Informer()}, nil
+	case kcpcorev1alpha1.SchemeGroupVersion.WithResource("frontproxyroutes"):
+		return &genericClusterInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().FrontProxyRoutes().Informer()}, nil
 	case kcpcorev1alpha1.SchemeGroupVersion.WithResource("logicalclusters"):
 		return &genericClusterInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().LogicalClusters().Informer()}, nil
+	case kcpcorev1alpha1.SchemeGroupVersion.WithResource("logicalclusterlimits"):
+		return &genericClusterInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().LogicalClusterLimits().Informer()}, nil
 	case kcpcorev1alpha1.SchemeGroupVersion.WithResource("shards"):
 		return &genericClusterInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().Shards().Informer()}, nil
 
 		// Group=migration.kcp.io, Version=v1alpha1
 	case kcpmigrationv1alpha1.SchemeGroupVersion.WithResource("logicalclustermigrations"):
 		return &genericClusterInformer{resource: resource.GroupResource(), informer: f.Migration().V1alpha1().LogicalClusterMigrations().Informer()}, nil
+	case kcpmigrationv1alpha1.SchemeGroupVersion.WithResource("sharddrains"):
+		return &genericClusterInformer{resource: resource.GroupResource(), informer: f.Migration().V1alpha1().ShardDrains().Informer()}, nil
 
 		// Group=tenancy.kcp.io, Version=v1alpha1
 	case kcptenancyv1alpha1.SchemeGroupVersion.WithResource("workspaces"):
 		return &genericClusterInformer{resource: resource.GroupResource(), informer: f.Tenancy().V1alpha1().Workspaces().Informer()}, nil
 	case kcptenancyv1alpha1.SchemeGroupVersion.WithResource("workspaceauthenticationconfigurations"):
 		return &genericClusterInformer{resource: resource.GroupResource(), informer: f.Tenancy().V1alpha1().WorkspaceAuthenticationConfigurations().Informer()}, nil
+	case kcptenancyv1alpha1.SchemeGroupVersion.WithResource("workspacequotas"):
+		return &genericClusterInformer{resource: resource.GroupResource(), informer: f.Tenancy().V1alpha1().WorkspaceQuotas().Informer()}, nil
+	case kcptenancyv1alpha1.SchemeGroupVersion.WithResource("workspacequotausages"):
+		return &genericClusterInformer{resource: resource.GroupResource(), informer: f.Tenancy().V1alpha1().WorkspaceQuotaUsages().Informer()}, nil
 	case kcptenancyv1alpha1.SchemeGroupVersion.WithResource("workspacetypes"):
 		return &genericClusterInformer{resource: resource.GroupResource(), informer: f.Tenancy().V1alpha1().WorkspaceTypes().Informer()}, nil
 
@@ -190,9 +202,18 @@ func (f *sharedScopedInformerFactory) ForResource(resource schema.GroupVersionRe
 		return &genericInformer{lister: cache.NewGenericLister(informer.GetIndexer(), resource.GroupResource()), informer: informer}, nil
 
 		// Group=core.kcp.io, Version=v1alpha1
+	case kcpcorev1alpha1.SchemeGroupVersion.WithResource("frontproxyratelimits"):
+		informer := f.Core().V1alpha1().FrontProxyRateLimits().Informer()
+		return &genericInformer{lister: cache.NewGenericLister(informer.GetIndexer(), resource.GroupResource()), informer: informer}, nil
+	case kcpcorev1alpha1.SchemeGroupVersion.WithResource("frontproxyroutes"):
+		informer := f.Core().V1alpha1().FrontProxyRoutes().Informer()
+		return &genericInformer{lister: cache.NewGenericLister(informer.GetIndexer(), resource.GroupResource()), informer: informer}, nil
 	case kcpcorev1alpha1.SchemeGroupVersion.WithResource("logicalclusters"):
 		informer := f.Core().V1alpha1().LogicalClusters().Informer()
 		return &genericInformer{lister: cache.NewGenericLister(informer.GetIndexer(), resource.GroupResource()), informer: informer}, nil
+	case kcpcorev1alpha1.SchemeGroupVersion.WithResource("logicalclusterlimits"):
+		informer := f.Core().V1alpha1().LogicalClusterLimits().Informer()
+		return &genericInformer{lister: cache.NewGenericLister(informer.GetIndexer(), resource.GroupResource()), informer: informer}, nil
 	case kcpcorev1alpha1.SchemeGroupVersion.WithResource("shards"):
 		informer := f.Core().V1alpha1().Shards().Informer()
 		return &genericInformer{lister: cache.NewGenericLister(informer.GetIndexer(), resource.GroupResource()), informer: informer}, nil
@@ -201,6 +222,9 @@ func (f *sharedScopedInformerFactory) ForResource(resource schema.GroupVersionRe
 	case kcpmigrationv1alpha1.SchemeGroupVersion.WithResource("logicalclustermigrations"):
 		informer := f.Migration().V1alpha1().LogicalClusterMigrations().Informer()
 		return &genericInformer{lister: cache.NewGenericLister(informer.GetIndexer(), resource.GroupResource()), informer: informer}, nil
+	case kcpmigrationv1alpha1.SchemeGroupVersion.WithResource("sharddrains"):
+		informer := f.Migration().V1alpha1().ShardDrains().Informer()
+		return &genericInformer{lister: cache.NewGenericLister(informer.GetIndexer(), resource.GroupResource()), informer: informer}, nil
 
 		// Group=tenancy.kcp.io, Version=v1alpha1
 	case kcptenancyv1alpha1.SchemeGroupVersion.WithResource("workspaces"):
@@ -209,6 +233,12 @@ func (f *sharedScopedInformerFactory) ForResource(resource schema.GroupVersionRe
 	case kcptenancyv1alpha1.SchemeGroupVersion.WithResource("workspaceauthenticationconfigurations"):
 		informer := f.Tenancy().V1alpha1().WorkspaceAuthenticationConfigurations().Informer()
 		return &genericInformer{lister: cache.NewGenericLister(informer.GetIndexer(), resource.GroupResource()), informer: informer}, nil
+	case kcptenancyv1alpha1.SchemeGroupVersion.WithResource("workspacequotas"):
+		informer := f.Tenancy().V1alpha1().WorkspaceQuotas().Informer()
+		return &genericInformer{lister: cache.NewGenericLister(informer.GetIndexer(), resource.GroupResource()), informer: informer}, nil
+	case kcptenancyv1alpha1.SchemeGroupVersion.WithResource("workspacequotausages"):
+		informer := f.Tenancy().V1alpha1().WorkspaceQuotaUsages().Informer()
+		return &genericInformer{lister: cache.NewGenericLister(informer.GetIndexer(), resource.GroupResource()), informer: informer}, nil
 	case kcptenancyv1alpha1.SchemeGroupVersion.WithResource("workspacetypes"):
 		informer := f.Tenancy().V1alpha1().WorkspaceTypes().Informer()
 		return &genericInformer{lister: cache.NewGenericLister(informer.GetIndexer(), resource.GroupResource()), informer: informer}, nil
@@ -25,6 +25,8 @@ import (
 type ClusterInterface interface {
 	// LogicalClusterMigrations returns a LogicalClusterMigrationClusterInformer.
 	LogicalClusterMigrations() LogicalClusterMigrationClusterInformer
+	// ShardDrains returns a ShardDrainClusterInformer.
+	ShardDrains() ShardDrainClusterInformer
 }
 
 type version struct {
@@ -42,9 +44,16 @@ func (v *version) LogicalClusterMigrations() LogicalClusterMigrationClusterInfor
 	return &logicalClusterMigrationClusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// ShardDrains returns a ShardDrainClusterInformer.
+func (v *version) ShardDrains() ShardDrainClusterInformer {
+	return &shardDrainClusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 type Interface interface {
 	// LogicalClusterMigrations returns a LogicalClusterMigrationInformer.
 	LogicalClusterMigrations() LogicalClusterMigrationInformer
+	// ShardDrains returns a ShardDrainInformer.
+	ShardDrains() ShardDrainInformer
 }
 
 type scopedVersion struct {
@@ -62,3 +71,8 @@ func NewScoped(f kcpinternalinterfaces.SharedScopedInformerFactory, namespace st
 func (v *scopedVersion) LogicalClusterMigrations() LogicalClusterMigrationInformer {
 	return &logicalClusterMigrationScopedInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
+
+// ShardDrains returns a ShardDrainInformer.
+func (v *scopedVersion) ShardDrains() ShardDrainInformer {
+	return &shardDrainScopedInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
@@ -27,6 +27,10 @@ type ClusterInterface interface {
 	Workspaces() WorkspaceClusterInformer
 	// WorkspaceAuthenticationConfigurations returns a WorkspaceAuthenticationConfigurationClusterInformer.
 	WorkspaceAuthenticationConfigurations() WorkspaceAuthenticationConfigurationClusterInformer
+	// WorkspaceQuotas returns a WorkspaceQuotaClusterInformer.
+	WorkspaceQuotas() WorkspaceQuotaClusterInformer
+	// WorkspaceQuotaUsages returns a WorkspaceQuotaUsageClusterInformer.
+	WorkspaceQuotaUsages() WorkspaceQuotaUsageClusterInformer
 	// WorkspaceTypes returns a WorkspaceTypeClusterInformer.
 	WorkspaceTypes() WorkspaceTypeClusterInformer
 }
@@ -51,6 +55,16 @@ func (v *version) WorkspaceAuthenticationConfigurations() WorkspaceAuthenticatio
 	return &workspaceAuthenticationConfigurationClusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// WorkspaceQuotas returns a WorkspaceQuotaClusterInformer.
+func (v *version) WorkspaceQuotas() WorkspaceQuotaClusterInformer {
+	return &workspaceQuotaClusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// WorkspaceQuotaUsages returns a WorkspaceQuotaUsageClusterInformer.
+func (v *version) WorkspaceQuotaUsages() WorkspaceQuotaUsageClusterInformer {
+	return &workspaceQuotaUsageClusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // WorkspaceTypes returns a WorkspaceTypeClusterInformer.
 func (v *version) WorkspaceTypes() WorkspaceTypeClusterInformer {
 	return &workspaceTypeClusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -61,6 +75,10 @@ type Interface interface {
 	Workspaces() WorkspaceInformer
 	// WorkspaceAuthenticationConfigurations returns a WorkspaceAuthenticationConfigurationInformer.
 	WorkspaceAuthenticationConfigurations() WorkspaceAuthenticationConfigurationInformer
+	// WorkspaceQuotas returns a WorkspaceQuotaInformer.
+	WorkspaceQuotas() WorkspaceQuotaInformer
+	// WorkspaceQuotaUsages returns a WorkspaceQuotaUsageInformer.
+	WorkspaceQuotaUsages() WorkspaceQuotaUsageInformer
 	// WorkspaceTypes returns a WorkspaceTypeInformer.
 	WorkspaceTypes() WorkspaceTypeInformer
 }
@@ -86,6 +104,16 @@ func (v *scopedVersion) WorkspaceAuthenticationConfigurations() WorkspaceAuthent
 	return &workspaceAuthenticationConfigurationScopedInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// WorkspaceQuotas returns a WorkspaceQuotaInformer.
+func (v *scopedVersion) WorkspaceQuotas() WorkspaceQuotaInformer {
+	return &workspaceQuotaScopedInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// WorkspaceQuotaUsages returns a WorkspaceQuotaUsageInformer.
+func (v *scopedVersion) WorkspaceQuotaUsages() WorkspaceQuotaUsageInformer {
+	return &workspaceQuotaUsageScopedInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // WorkspaceTypes returns a WorkspaceTypeInformer.
 func (v *scopedVersion) WorkspaceTypes() WorkspaceTypeInformer {
 	return &workspaceTypeScopedInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -18,6 +18,22 @@ limitations under the License.
 
 package v1alpha1
 
+// FrontProxyRateLimitClusterListerExpansion allows custom methods to be added to
+// FrontProxyRateLimitClusterLister.
+type FrontProxyRateLimitClusterListerExpansion interface{}
+
+// FrontProxyRateLimitListerExpansion allows custom methods to be added to
+// FrontProxyRateLimitLister.
+type FrontProxyRateLimitListerExpansion interface{}
+
+// FrontProxyRouteClusterListerExpansion allows custom methods to be added to
+// FrontProxyRouteClusterLister.
+type FrontProxyRouteClusterListerExpansion interface{}
+
+// FrontProxyRouteListerExpansion allows custom methods to be added to
+// FrontProxyRouteLister.
+type FrontProxyRouteListerExpansion interface{}
+
 // LogicalClusterClusterListerExpansion allows custom methods to be added to
 // LogicalClusterClusterLister.
 type LogicalClusterClusterListerExpansion interface{}
@@ -26,6 +42,14 @@ type LogicalClusterClusterListerExpansion interface{}
 // LogicalClusterLister.
 type LogicalClusterListerExpansion interface{}
 
+// LogicalClusterLimitsClusterListerExpansion allows custom methods to be added to
+// LogicalClusterLimitsClusterLister.
+type LogicalClusterLimitsClusterListerExpansion interface{}
+
+// LogicalClusterLimitsListerExpansion allows custom methods to be added to
+// LogicalClusterLimitsLister.
+type LogicalClusterLimitsListerExpansion interface{}
+
 // ShardClusterListerExpansion allows custom methods to be added to
 // ShardClusterLister.
 type ShardClusterListerExpansion interface{}
@@ -25,3 +25,11 @@ type LogicalClusterMigrationClusterListerExpansion interface{}
 // LogicalClusterMigrationListerExpansion allows custom methods to be added to
 // LogicalClusterMigrationLister.
 type LogicalClusterMigrationListerExpansion interface{}
+
+// ShardDrainClusterListerExpansion allows custom methods to be added to
+// ShardDrainClusterLister.
+type ShardDrainClusterListerExpansion interface{}
+
+// ShardDrainListerExpansion allows custom methods to be added to
+// ShardDrainLister.
+type ShardDrainListerExpansion interface{}
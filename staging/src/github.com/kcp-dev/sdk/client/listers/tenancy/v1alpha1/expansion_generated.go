@@ -34,6 +34,22 @@ type WorkspaceAuthenticationConfigurationClusterListerExpansion interface{}
 // WorkspaceAuthenticationConfigurationLister.
 type WorkspaceAuthenticationConfigurationListerExpansion interface{}
 
+// WorkspaceQuotaClusterListerExpansion allows custom methods to be added to
+// WorkspaceQuotaClusterLister.
+type WorkspaceQuotaClusterListerExpansion interface{}
+
+// WorkspaceQuotaListerExpansion allows custom methods to be added to
+// WorkspaceQuotaLister.
+type WorkspaceQuotaListerExpansion interface{}
+
+// WorkspaceQuotaUsageClusterListerExpansion allows custom methods to be added to
+// WorkspaceQuotaUsageClusterLister.
+type WorkspaceQuotaUsageClusterListerExpansion interface{}
+
+// WorkspaceQuotaUsageListerExpansion allows custom methods to be added to
+// WorkspaceQuotaUsageLister.
+type WorkspaceQuotaUsageListerExpansion interface{}
+
 // WorkspaceTypeClusterListerExpansion allows custom methods to be added to
 // WorkspaceTypeClusterLister.
 type WorkspaceTypeClusterListerExpansion interface{}
@@ -369,7 +369,7 @@ func generateExports(outputDir string, ignoreExportSchemas []metav1.GroupResourc
 
 	byExport := map[string][]grs{}
 	for gr, apiResourceSchema := range allSchemas {
-		if gr.Group == core.GroupName && gr.Resource == "logicalclusters" {
+		if gr.Group == core.GroupName && (gr.Resource == "logicalclusters" || gr.Resource == "logicalclusterlimits") {
 			continue
 		}
 		var ignore bool
@@ -383,8 +383,9 @@ func generateExports(outputDir string, ignoreExportSchemas []metav1.GroupResourc
 		}
 
 		exportName := gr.Group
-		if gr.Group == core.GroupName && gr.Resource == "shards" {
-			// we export shards by themselves, not with the rest of the tenancy group
+		if gr.Group == core.GroupName && (gr.Resource == "shards" || gr.Resource == "frontproxyroutes" || gr.Resource == "frontproxyratelimits") {
+			// we export shards by themselves, not with the rest of the tenancy group.
+			// Front-proxy routes and rate limits are part of the same root-only topology.
 			exportName = "shards." + core.GroupName
 		}
 
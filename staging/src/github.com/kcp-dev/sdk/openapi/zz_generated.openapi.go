@@ -72,6 +72,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1alpha1.LocalAPIExportPolicy{}.OpenAPIModelName():                            schema_sdk_apis_apis_v1alpha1_LocalAPIExportPolicy(ref),
 		v1alpha1.MaximalPermissionPolicy{}.OpenAPIModelName():                         schema_sdk_apis_apis_v1alpha1_MaximalPermissionPolicy(ref),
 		v1alpha1.PermissionClaim{}.OpenAPIModelName():                                 schema_sdk_apis_apis_v1alpha1_PermissionClaim(ref),
+		v1alpha1.PinnedSchema{}.OpenAPIModelName():                                    schema_sdk_apis_apis_v1alpha1_PinnedSchema(ref),
 		v1alpha1.ResourceSelector{}.OpenAPIModelName():                                schema_sdk_apis_apis_v1alpha1_ResourceSelector(ref),
 		v1alpha1.VirtualWorkspace{}.OpenAPIModelName():                                schema_sdk_apis_apis_v1alpha1_VirtualWorkspace(ref),
 		v1alpha1.WebhookClientConfig{}.OpenAPIModelName():                             schema_sdk_apis_apis_v1alpha1_WebhookClientConfig(ref),
@@ -82,6 +83,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1alpha2.APIBindingStatus{}.OpenAPIModelName():                                schema_sdk_apis_apis_v1alpha2_APIBindingStatus(ref),
 		v1alpha2.APIExport{}.OpenAPIModelName():                                       schema_sdk_apis_apis_v1alpha2_APIExport(ref),
 		v1alpha2.APIExportList{}.OpenAPIModelName():                                   schema_sdk_apis_apis_v1alpha2_APIExportList(ref),
+		v1alpha2.APIExportRollout{}.OpenAPIModelName():                                schema_sdk_apis_apis_v1alpha2_APIExportRollout(ref),
 		v1alpha2.APIExportSpec{}.OpenAPIModelName():                                   schema_sdk_apis_apis_v1alpha2_APIExportSpec(ref),
 		v1alpha2.APIExportStatus{}.OpenAPIModelName():                                 schema_sdk_apis_apis_v1alpha2_APIExportStatus(ref),
 		v1alpha2.AcceptablePermissionClaim{}.OpenAPIModelName():                       schema_sdk_apis_apis_v1alpha2_AcceptablePermissionClaim(ref),
@@ -95,6 +97,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1alpha2.MaximalPermissionPolicy{}.OpenAPIModelName():                         schema_sdk_apis_apis_v1alpha2_MaximalPermissionPolicy(ref),
 		v1alpha2.PermissionClaim{}.OpenAPIModelName():                                 schema_sdk_apis_apis_v1alpha2_PermissionClaim(ref),
 		v1alpha2.PermissionClaimSelector{}.OpenAPIModelName():                         schema_sdk_apis_apis_v1alpha2_PermissionClaimSelector(ref),
+		v1alpha2.PinnedSchema{}.OpenAPIModelName():                                    schema_sdk_apis_apis_v1alpha2_PinnedSchema(ref),
 		v1alpha2.ResourceSchema{}.OpenAPIModelName():                                  schema_sdk_apis_apis_v1alpha2_ResourceSchema(ref),
 		v1alpha2.ResourceSchemaStorage{}.OpenAPIModelName():                           schema_sdk_apis_apis_v1alpha2_ResourceSchemaStorage(ref),
 		v1alpha2.ResourceSchemaStorageCRD{}.OpenAPIModelName():                        schema_sdk_apis_apis_v1alpha2_ResourceSchemaStorageCRD(ref),
@@ -117,12 +120,27 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		cachev1alpha1.ResourceCount{}.OpenAPIModelName():                              schema_sdk_apis_cache_v1alpha1_ResourceCount(ref),
 		corev1alpha1.Endpoint{}.OpenAPIModelName():                                    schema_sdk_apis_core_v1alpha1_Endpoint(ref),
 		corev1alpha1.EndpointSelector{}.OpenAPIModelName():                            schema_sdk_apis_core_v1alpha1_EndpointSelector(ref),
+		corev1alpha1.FrontProxyRateLimit{}.OpenAPIModelName():                         schema_sdk_apis_core_v1alpha1_FrontProxyRateLimit(ref),
+		corev1alpha1.FrontProxyRateLimitList{}.OpenAPIModelName():                     schema_sdk_apis_core_v1alpha1_FrontProxyRateLimitList(ref),
+		corev1alpha1.FrontProxyRateLimitSpec{}.OpenAPIModelName():                     schema_sdk_apis_core_v1alpha1_FrontProxyRateLimitSpec(ref),
+		corev1alpha1.FrontProxyRoute{}.OpenAPIModelName():                             schema_sdk_apis_core_v1alpha1_FrontProxyRoute(ref),
+		corev1alpha1.FrontProxyRouteBackend{}.OpenAPIModelName():                      schema_sdk_apis_core_v1alpha1_FrontProxyRouteBackend(ref),
+		corev1alpha1.FrontProxyRouteHeaderMatch{}.OpenAPIModelName():                  schema_sdk_apis_core_v1alpha1_FrontProxyRouteHeaderMatch(ref),
+		corev1alpha1.FrontProxyRouteList{}.OpenAPIModelName():                         schema_sdk_apis_core_v1alpha1_FrontProxyRouteList(ref),
+		corev1alpha1.FrontProxyRouteMatch{}.OpenAPIModelName():                        schema_sdk_apis_core_v1alpha1_FrontProxyRouteMatch(ref),
+		corev1alpha1.FrontProxyRouteSpec{}.OpenAPIModelName():                         schema_sdk_apis_core_v1alpha1_FrontProxyRouteSpec(ref),
 		corev1alpha1.LogicalCluster{}.OpenAPIModelName():                              schema_sdk_apis_core_v1alpha1_LogicalCluster(ref),
+		corev1alpha1.LogicalClusterLimits{}.OpenAPIModelName():                        schema_sdk_apis_core_v1alpha1_LogicalClusterLimits(ref),
+		corev1alpha1.LogicalClusterLimitsList{}.OpenAPIModelName():                    schema_sdk_apis_core_v1alpha1_LogicalClusterLimitsList(ref),
+		corev1alpha1.LogicalClusterLimitsSpec{}.OpenAPIModelName():                    schema_sdk_apis_core_v1alpha1_LogicalClusterLimitsSpec(ref),
+		corev1alpha1.LogicalClusterLimitsStatus{}.OpenAPIModelName():                  schema_sdk_apis_core_v1alpha1_LogicalClusterLimitsStatus(ref),
 		corev1alpha1.LogicalClusterList{}.OpenAPIModelName():                          schema_sdk_apis_core_v1alpha1_LogicalClusterList(ref),
 		corev1alpha1.LogicalClusterOwner{}.OpenAPIModelName():                         schema_sdk_apis_core_v1alpha1_LogicalClusterOwner(ref),
 		corev1alpha1.LogicalClusterSpec{}.OpenAPIModelName():                          schema_sdk_apis_core_v1alpha1_LogicalClusterSpec(ref),
 		corev1alpha1.LogicalClusterStatus{}.OpenAPIModelName():                        schema_sdk_apis_core_v1alpha1_LogicalClusterStatus(ref),
 		corev1alpha1.OwnerUserInfo{}.OpenAPIModelName():                               schema_sdk_apis_core_v1alpha1_OwnerUserInfo(ref),
+		corev1alpha1.ResourceObjectCount{}.OpenAPIModelName():                         schema_sdk_apis_core_v1alpha1_ResourceObjectCount(ref),
+		corev1alpha1.ResourceObjectCountLimit{}.OpenAPIModelName():                    schema_sdk_apis_core_v1alpha1_ResourceObjectCountLimit(ref),
 		corev1alpha1.Shard{}.OpenAPIModelName():                                       schema_sdk_apis_core_v1alpha1_Shard(ref),
 		corev1alpha1.ShardList{}.OpenAPIModelName():                                   schema_sdk_apis_core_v1alpha1_ShardList(ref),
 		corev1alpha1.ShardSpec{}.OpenAPIModelName():                                   schema_sdk_apis_core_v1alpha1_ShardSpec(ref),
@@ -133,8 +151,16 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		migrationv1alpha1.LogicalClusterDumpStatus{}.OpenAPIModelName():               schema_sdk_apis_migration_v1alpha1_LogicalClusterDumpStatus(ref),
 		migrationv1alpha1.LogicalClusterMigration{}.OpenAPIModelName():                schema_sdk_apis_migration_v1alpha1_LogicalClusterMigration(ref),
 		migrationv1alpha1.LogicalClusterMigrationList{}.OpenAPIModelName():            schema_sdk_apis_migration_v1alpha1_LogicalClusterMigrationList(ref),
+		migrationv1alpha1.LogicalClusterMigrationPreCopy{}.OpenAPIModelName():         schema_sdk_apis_migration_v1alpha1_LogicalClusterMigrationPreCopy(ref),
 		migrationv1alpha1.LogicalClusterMigrationSpec{}.OpenAPIModelName():            schema_sdk_apis_migration_v1alpha1_LogicalClusterMigrationSpec(ref),
 		migrationv1alpha1.LogicalClusterMigrationStatus{}.OpenAPIModelName():          schema_sdk_apis_migration_v1alpha1_LogicalClusterMigrationStatus(ref),
+		migrationv1alpha1.LogicalClusterRestore{}.OpenAPIModelName():                  schema_sdk_apis_migration_v1alpha1_LogicalClusterRestore(ref),
+		migrationv1alpha1.LogicalClusterRestoreSpec{}.OpenAPIModelName():              schema_sdk_apis_migration_v1alpha1_LogicalClusterRestoreSpec(ref),
+		migrationv1alpha1.LogicalClusterRestoreStatus{}.OpenAPIModelName():            schema_sdk_apis_migration_v1alpha1_LogicalClusterRestoreStatus(ref),
+		migrationv1alpha1.ShardDrain{}.OpenAPIModelName():                             schema_sdk_apis_migration_v1alpha1_ShardDrain(ref),
+		migrationv1alpha1.ShardDrainList{}.OpenAPIModelName():                         schema_sdk_apis_migration_v1alpha1_ShardDrainList(ref),
+		migrationv1alpha1.ShardDrainSpec{}.OpenAPIModelName():                         schema_sdk_apis_migration_v1alpha1_ShardDrainSpec(ref),
+		migrationv1alpha1.ShardDrainStatus{}.OpenAPIModelName():                       schema_sdk_apis_migration_v1alpha1_ShardDrainStatus(ref),
 		tenancyv1alpha1.APIExportReference{}.OpenAPIModelName():                       schema_sdk_apis_tenancy_v1alpha1_APIExportReference(ref),
 		tenancyv1alpha1.AuthenticationConfigurationReference{}.OpenAPIModelName():     schema_sdk_apis_tenancy_v1alpha1_AuthenticationConfigurationReference(ref),
 		tenancyv1alpha1.ClaimMappings{}.OpenAPIModelName():                            schema_sdk_apis_tenancy_v1alpha1_ClaimMappings(ref),
@@ -152,10 +178,19 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		tenancyv1alpha1.WorkspaceAuthenticationConfiguration{}.OpenAPIModelName():     schema_sdk_apis_tenancy_v1alpha1_WorkspaceAuthenticationConfiguration(ref),
 		tenancyv1alpha1.WorkspaceAuthenticationConfigurationList{}.OpenAPIModelName(): schema_sdk_apis_tenancy_v1alpha1_WorkspaceAuthenticationConfigurationList(ref),
 		tenancyv1alpha1.WorkspaceAuthenticationConfigurationSpec{}.OpenAPIModelName(): schema_sdk_apis_tenancy_v1alpha1_WorkspaceAuthenticationConfigurationSpec(ref),
+		tenancyv1alpha1.WorkspaceCloneSource{}.OpenAPIModelName():                     schema_sdk_apis_tenancy_v1alpha1_WorkspaceCloneSource(ref),
 		tenancyv1alpha1.WorkspaceList{}.OpenAPIModelName():                            schema_sdk_apis_tenancy_v1alpha1_WorkspaceList(ref),
 		tenancyv1alpha1.WorkspaceLocation{}.OpenAPIModelName():                        schema_sdk_apis_tenancy_v1alpha1_WorkspaceLocation(ref),
+		tenancyv1alpha1.WorkspaceQuota{}.OpenAPIModelName():                           schema_sdk_apis_tenancy_v1alpha1_WorkspaceQuota(ref),
+		tenancyv1alpha1.WorkspaceQuotaList{}.OpenAPIModelName():                       schema_sdk_apis_tenancy_v1alpha1_WorkspaceQuotaList(ref),
+		tenancyv1alpha1.WorkspaceQuotaSpec{}.OpenAPIModelName():                       schema_sdk_apis_tenancy_v1alpha1_WorkspaceQuotaSpec(ref),
+		tenancyv1alpha1.WorkspaceQuotaStatus{}.OpenAPIModelName():                     schema_sdk_apis_tenancy_v1alpha1_WorkspaceQuotaStatus(ref),
+		tenancyv1alpha1.WorkspaceQuotaUsage{}.OpenAPIModelName():                      schema_sdk_apis_tenancy_v1alpha1_WorkspaceQuotaUsage(ref),
+		tenancyv1alpha1.WorkspaceQuotaUsageList{}.OpenAPIModelName():                  schema_sdk_apis_tenancy_v1alpha1_WorkspaceQuotaUsageList(ref),
+		tenancyv1alpha1.WorkspaceQuotaUsageSpec{}.OpenAPIModelName():                  schema_sdk_apis_tenancy_v1alpha1_WorkspaceQuotaUsageSpec(ref),
 		tenancyv1alpha1.WorkspaceSpec{}.OpenAPIModelName():                            schema_sdk_apis_tenancy_v1alpha1_WorkspaceSpec(ref),
 		tenancyv1alpha1.WorkspaceStatus{}.OpenAPIModelName():                          schema_sdk_apis_tenancy_v1alpha1_WorkspaceStatus(ref),
+		tenancyv1alpha1.WorkspaceTopologySpreadConstraint{}.OpenAPIModelName():        schema_sdk_apis_tenancy_v1alpha1_WorkspaceTopologySpreadConstraint(ref),
 		tenancyv1alpha1.WorkspaceType{}.OpenAPIModelName():                            schema_sdk_apis_tenancy_v1alpha1_WorkspaceType(ref),
 		tenancyv1alpha1.WorkspaceTypeExtension{}.OpenAPIModelName():                   schema_sdk_apis_tenancy_v1alpha1_WorkspaceTypeExtension(ref),
 		tenancyv1alpha1.WorkspaceTypeList{}.OpenAPIModelName():                        schema_sdk_apis_tenancy_v1alpha1_WorkspaceTypeList(ref),
@@ -167,10 +202,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		topologyv1alpha1.Partition{}.OpenAPIModelName():                               schema_sdk_apis_topology_v1alpha1_Partition(ref),
 		topologyv1alpha1.PartitionList{}.OpenAPIModelName():                           schema_sdk_apis_topology_v1alpha1_PartitionList(ref),
 		topologyv1alpha1.PartitionSet{}.OpenAPIModelName():                            schema_sdk_apis_topology_v1alpha1_PartitionSet(ref),
+		topologyv1alpha1.PartitionSetAPIExportEndpointSlice{}.OpenAPIModelName():      schema_sdk_apis_topology_v1alpha1_PartitionSetAPIExportEndpointSlice(ref),
 		topologyv1alpha1.PartitionSetList{}.OpenAPIModelName():                        schema_sdk_apis_topology_v1alpha1_PartitionSetList(ref),
 		topologyv1alpha1.PartitionSetSpec{}.OpenAPIModelName():                        schema_sdk_apis_topology_v1alpha1_PartitionSetSpec(ref),
 		topologyv1alpha1.PartitionSetStatus{}.OpenAPIModelName():                      schema_sdk_apis_topology_v1alpha1_PartitionSetStatus(ref),
+		topologyv1alpha1.PartitionShard{}.OpenAPIModelName():                          schema_sdk_apis_topology_v1alpha1_PartitionShard(ref),
 		topologyv1alpha1.PartitionSpec{}.OpenAPIModelName():                           schema_sdk_apis_topology_v1alpha1_PartitionSpec(ref),
+		topologyv1alpha1.PartitionStatus{}.OpenAPIModelName():                         schema_sdk_apis_topology_v1alpha1_PartitionStatus(ref),
 		v1.APIGroup{}.OpenAPIModelName():                                              schema_pkg_apis_meta_v1_APIGroup(ref),
 		v1.APIGroupList{}.OpenAPIModelName():                                          schema_pkg_apis_meta_v1_APIGroupList(ref),
 		v1.APIResource{}.OpenAPIModelName():                                           schema_pkg_apis_meta_v1_APIResource(ref),
@@ -355,12 +393,35 @@ func schema_sdk_apis_apis_v1alpha1_APIBindingSpec(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"pinnedSchemas": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"group",
+									"resource",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "pinnedSchemas pins resources of the APIExport to specific APIResourceSchemas in the workspace of the APIExport. A pinned resource stays bound to its schema when the APIExport moves ahead, until the pin is updated or removed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.PinnedSchema{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"reference"},
 			},
 		},
 		Dependencies: []string{
-			v1alpha1.AcceptablePermissionClaim{}.OpenAPIModelName(), v1alpha1.BindingReference{}.OpenAPIModelName()},
+			v1alpha1.AcceptablePermissionClaim{}.OpenAPIModelName(), v1alpha1.BindingReference{}.OpenAPIModelName(), v1alpha1.PinnedSchema{}.OpenAPIModelName()},
 	}
 }
 
@@ -450,6 +511,13 @@ func schema_sdk_apis_apis_v1alpha1_APIBindingStatus(ref common.ReferenceCallback
 							},
 						},
 					},
+					"schemaRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "schemaRevision identifies the resource schemas of the APIExport the APIBinding is bound to. It matches the schemaRevision or previousSchemaRevision in the APIExport status.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1022,6 +1090,20 @@ func schema_sdk_apis_apis_v1alpha1_APIExportStatus(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"schemaRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "schemaRevision identifies the resource schemas the APIExport binds to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"previousSchemaRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "previousSchemaRevision identifies the resource schemas of the APIExport before a staged rollout.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1514,6 +1596,12 @@ func schema_sdk_apis_apis_v1alpha1_BoundAPIResource(ref common.ReferenceCallback
 							},
 						},
 					},
+					"availableSchema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "availableSchema references the APIResourceSchema the APIExport offers for this API, if the API is pinned to another one in spec.pinnedSchemas.",
+							Ref:         ref(v1alpha1.BoundAPIResourceSchema{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"group", "resource", "schema"},
 			},
@@ -1761,6 +1849,49 @@ func schema_sdk_apis_apis_v1alpha1_PermissionClaim(ref common.ReferenceCallback)
 	}
 }
 
+func schema_sdk_apis_apis_v1alpha1_PinnedSchema(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PinnedSchema pins a resource of an APIExport to an APIResourceSchema, by name or by UID.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "group is the API group of the resource. Empty string for the core API group.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "resource is the name of the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "schema is the name of the APIResourceSchema.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"schemaUID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "schemaUID is the UID of the APIResourceSchema. If schema is set too, both must refer to the same APIResourceSchema.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"resource"},
+			},
+		},
+	}
+}
+
 func schema_sdk_apis_apis_v1alpha1_ResourceSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2014,12 +2145,35 @@ func schema_sdk_apis_apis_v1alpha2_APIBindingSpec(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"pinnedSchemas": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"group",
+									"resource",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "pinnedSchemas pins resources of the APIExport to specific APIResourceSchemas in the workspace of the APIExport. A pinned resource stays bound to its schema when the APIExport moves ahead, until the pin is updated or removed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha2.PinnedSchema{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"reference"},
 			},
 		},
 		Dependencies: []string{
-			v1alpha2.AcceptablePermissionClaim{}.OpenAPIModelName(), v1alpha2.BindingReference{}.OpenAPIModelName()},
+			v1alpha2.AcceptablePermissionClaim{}.OpenAPIModelName(), v1alpha2.BindingReference{}.OpenAPIModelName(), v1alpha2.PinnedSchema{}.OpenAPIModelName()},
 	}
 }
 
@@ -2119,6 +2273,13 @@ func schema_sdk_apis_apis_v1alpha2_APIBindingStatus(ref common.ReferenceCallback
 							},
 						},
 					},
+					"schemaRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "schemaRevision identifies the resource schemas of the APIExport the APIBinding is bound to. It matches the schemaRevision or previousSchemaRevision in the APIExport status.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -2225,6 +2386,65 @@ func schema_sdk_apis_apis_v1alpha2_APIExportList(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_sdk_apis_apis_v1alpha2_APIExportRollout(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIExportRollout selects the APIBindings that are bound to the resources of an APIExport during a staged rollout.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"previousResources": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+									"group",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "previousResources are the resources that APIBindings not selected for the rollout are bound to.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha2.ResourceSchema{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"percentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "percentage is the share of APIBindings that are bound to resources. An APIBinding is selected by a stable hash of its logical cluster and name, i.e. raising the percentage keeps the previously selected APIBindings selected.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "selector selects APIBindings by label that are bound to resources, in addition to those selected by percentage. An empty selector selects no APIBinding.",
+							Ref:         ref(v1.LabelSelector{}.OpenAPIModelName()),
+						},
+					},
+					"halted": {
+						SchemaProps: spec.SchemaProps{
+							Description: "halted stops the rollout. APIBindings that are not bound to resources yet stay on previousResources, independently of percentage and selector. APIBindings already bound to resources stay there.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"previousResources"},
+			},
+		},
+		Dependencies: []string{
+			v1alpha2.ResourceSchema{}.OpenAPIModelName(), v1.LabelSelector{}.OpenAPIModelName()},
+	}
+}
+
 func schema_sdk_apis_apis_v1alpha2_APIExportSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2243,7 +2463,7 @@ func schema_sdk_apis_apis_v1alpha2_APIExportSpec(ref common.ReferenceCallback) c
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Resources records the APIResourceSchemas that are exposed with this APIExport.\n\nThe schemas can be changed in the life-cycle of the APIExport. These changes have no effect on existing APIBindings, but only on newly bound ones.\n\nFor updating existing APIBindings, use an APIDeployment keeping bound workspaces up-to-date.",
+							Description: "Resources records the APIResourceSchemas that are exposed with this APIExport.\n\nThe schemas can be changed in the life-cycle of the APIExport. These changes have no effect on existing APIBindings, but only on newly bound ones.\n\nChanges are picked up by all APIBindings at once, unless rollout is set to stage them.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -2290,11 +2510,17 @@ func schema_sdk_apis_apis_v1alpha2_APIExportSpec(ref common.ReferenceCallback) c
 							},
 						},
 					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "rollout stages a change of resources. While set, only the APIBindings selected by the rollout are bound to resources; all others stay on rollout.previousResources. The change is promoted to all APIBindings by removing rollout.",
+							Ref:         ref(v1alpha2.APIExportRollout{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1alpha2.Identity{}.OpenAPIModelName(), v1alpha2.MaximalPermissionPolicy{}.OpenAPIModelName(), v1alpha2.PermissionClaim{}.OpenAPIModelName(), v1alpha2.ResourceSchema{}.OpenAPIModelName()},
+			v1alpha2.APIExportRollout{}.OpenAPIModelName(), v1alpha2.Identity{}.OpenAPIModelName(), v1alpha2.MaximalPermissionPolicy{}.OpenAPIModelName(), v1alpha2.PermissionClaim{}.OpenAPIModelName(), v1alpha2.ResourceSchema{}.OpenAPIModelName()},
 	}
 }
 
@@ -2340,6 +2566,20 @@ func schema_sdk_apis_apis_v1alpha2_APIExportStatus(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"schemaRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "schemaRevision identifies the resource schemas in spec.resources.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"previousSchemaRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "previousSchemaRevision identifies the resource schemas in spec.rollout.previousResources during a rollout.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -2496,6 +2736,12 @@ func schema_sdk_apis_apis_v1alpha2_BoundAPIResource(ref common.ReferenceCallback
 							},
 						},
 					},
+					"availableSchema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "availableSchema references the APIResourceSchema the APIExport offers for this API, if the API is pinned to another one in spec.pinnedSchemas.",
+							Ref:         ref(v1alpha2.BoundAPIResourceSchema{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"group", "resource", "schema"},
 			},
@@ -2775,6 +3021,49 @@ func schema_sdk_apis_apis_v1alpha2_PermissionClaimSelector(ref common.ReferenceC
 	}
 }
 
+func schema_sdk_apis_apis_v1alpha2_PinnedSchema(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PinnedSchema pins a resource of an APIExport to an APIResourceSchema, by name or by UID.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "group is the API group of the resource. Empty string for the core API group.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "resource is the name of the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "schema is the name of the APIResourceSchema.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"schemaUID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "schemaUID is the UID of the APIResourceSchema. If schema is set too, both must refer to the same APIResourceSchema.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"resource"},
+			},
+		},
+	}
+}
+
 func schema_sdk_apis_apis_v1alpha2_ResourceSchema(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3598,11 +3887,11 @@ func schema_sdk_apis_core_v1alpha1_EndpointSelector(ref common.ReferenceCallback
 	}
 }
 
-func schema_sdk_apis_core_v1alpha1_LogicalCluster(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_core_v1alpha1_FrontProxyRateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LogicalCluster describes the current logical cluster. It is used to authorize requests to the logical cluster and to track state.\n\nA LogicalCluster is always named \"cluster\".",
+				Description: "FrontProxyRateLimit limits the rate of requests the front-proxy forwards to the shards, counted per user, per logical cluster or per workspace type. Every distinct key gets its own token bucket, such that a noisy tenant does not consume the budget of others. Requests exceeding the limit are queued for up to maxQueueWait and rejected with 429 afterwards.\n\nRate limits live in the root workspace and are picked up by all front-proxy replicas without a restart. A request has to pass all limits it matches. Every front-proxy replica enforces the limits on its own.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -3628,28 +3917,23 @@ func schema_sdk_apis_core_v1alpha1_LogicalCluster(ref common.ReferenceCallback)
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref(corev1alpha1.LogicalClusterSpec{}.OpenAPIModelName()),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref(corev1alpha1.LogicalClusterStatus{}.OpenAPIModelName()),
+							Ref:     ref(corev1alpha1.FrontProxyRateLimitSpec{}.OpenAPIModelName()),
 						},
 					},
 				},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			corev1alpha1.LogicalClusterSpec{}.OpenAPIModelName(), corev1alpha1.LogicalClusterStatus{}.OpenAPIModelName(), v1.ObjectMeta{}.OpenAPIModelName()},
+			corev1alpha1.FrontProxyRateLimitSpec{}.OpenAPIModelName(), v1.ObjectMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_sdk_apis_core_v1alpha1_LogicalClusterList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_core_v1alpha1_FrontProxyRateLimitList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LogicalClusterList is a list of LogicalCluster",
+				Description: "FrontProxyRateLimitList is a list of FrontProxyRateLimits.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -3679,7 +3963,7 @@ func schema_sdk_apis_core_v1alpha1_LogicalClusterList(ref common.ReferenceCallba
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref(corev1alpha1.LogicalCluster{}.OpenAPIModelName()),
+										Ref:     ref(corev1alpha1.FrontProxyRateLimit{}.OpenAPIModelName()),
 									},
 								},
 							},
@@ -3690,100 +3974,54 @@ func schema_sdk_apis_core_v1alpha1_LogicalClusterList(ref common.ReferenceCallba
 			},
 		},
 		Dependencies: []string{
-			corev1alpha1.LogicalCluster{}.OpenAPIModelName(), v1.ListMeta{}.OpenAPIModelName()},
+			corev1alpha1.FrontProxyRateLimit{}.OpenAPIModelName(), v1.ListMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_sdk_apis_core_v1alpha1_LogicalClusterOwner(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_core_v1alpha1_FrontProxyRateLimitSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LogicalClusterOwner is a reference to a resource controlling the life-cycle of a LogicalCluster.",
+				Description: "FrontProxyRateLimitSpec holds the desired state of the FrontProxyRateLimit.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"apiVersion": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "apiVersion is the group and API version of the owner.",
+							Description: "key is what requests are counted by.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"resource": {
+					"qps": {
 						SchemaProps: spec.SchemaProps{
-							Description: "resource is API resource to access the owner.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "qps is the sustained number of requests per second allowed per key.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"name": {
+					"burst": {
 						SchemaProps: spec.SchemaProps{
-							Description: "name is the name of the owner.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "burst is the number of requests allowed at once per key. It defaults to qps.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"namespace": {
+					"maxQueueWait": {
 						SchemaProps: spec.SchemaProps{
-							Description: "namespace is the optional namespace of the owner.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "maxQueueWait is how long a request exceeding the limit waits for its turn before it is rejected. Waiting requests are served in the order they arrived. By default, such requests are rejected immediately.",
+							Ref:         ref(v1.Duration{}.OpenAPIModelName()),
 						},
 					},
-					"cluster": {
-						SchemaProps: spec.SchemaProps{
-							Description: "cluster is the logical cluster in which the owner is located.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+					"workspaceTypes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
 						},
-					},
-					"uid": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UID is the UID of the owner.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
-				Required: []string{"apiVersion", "resource", "name", "cluster", "uid"},
-			},
-		},
-	}
-}
-
-func schema_sdk_apis_core_v1alpha1_LogicalClusterSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "LogicalClusterSpec is the specification of the LogicalCluster resource.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"directlyDeletable": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DirectlyDeletable indicates that this logical cluster can be directly deleted by the user from within by deleting the LogicalCluster object.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"owner": {
-						SchemaProps: spec.SchemaProps{
-							Description: "owner is a reference to a resource controlling the life-cycle of this logical cluster. On deletion of the LogicalCluster, the finalizer core.kcp.io/logicalcluster is removed from the owner.\n\nWhen this object is deleted, but the owner is not deleted, the owner is deleted too.",
-							Ref:         ref(corev1alpha1.LogicalClusterOwner{}.OpenAPIModelName()),
-						},
-					},
-					"createdBy": {
-						SchemaProps: spec.SchemaProps{
-							Description: "createdBy is the user who owns this logical cluster. This is the user who created the workspace.",
-							Ref:         ref(corev1alpha1.OwnerUserInfo{}.OpenAPIModelName()),
-						},
-					},
-					"initializers": {
-						SchemaProps: spec.SchemaProps{
-							Description: "initializers are set on creation by the system and copied to status when initialization starts.",
+							Description: "workspaceTypes restricts the limit to requests to workspaces of the given types, by fully qualified name, e.g. \"root:universal\". By default, the limit applies to all requests.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -3796,9 +4034,14 @@ func schema_sdk_apis_core_v1alpha1_LogicalClusterSpec(ref common.ReferenceCallba
 							},
 						},
 					},
-					"terminators": {
+					"exemptGroups": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Terminators are set on creation by the system and copied to status when termination starts.",
+							Description: "exemptGroups lists user groups whose requests are not limited.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -3812,203 +4055,119 @@ func schema_sdk_apis_core_v1alpha1_LogicalClusterSpec(ref common.ReferenceCallba
 						},
 					},
 				},
+				Required: []string{"key", "qps"},
 			},
 		},
 		Dependencies: []string{
-			corev1alpha1.LogicalClusterOwner{}.OpenAPIModelName(), corev1alpha1.OwnerUserInfo{}.OpenAPIModelName()},
+			v1.Duration{}.OpenAPIModelName()},
 	}
 }
 
-func schema_sdk_apis_core_v1alpha1_LogicalClusterStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_core_v1alpha1_FrontProxyRoute(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LogicalClusterStatus communicates the observed state of the Workspace.",
+				Description: "FrontProxyRoute routes requests matching a path prefix, headers and user groups from the front-proxy to one of a set of weighted backends. Routes live in the root workspace and are picked up by all front-proxy replicas without a restart. They take precedence over the static path mappings of the front-proxy.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"URL": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "url is the address under which the Kubernetes-cluster-like endpoint can be found. This URL can be used to access the logical cluster with standard Kubernetes client libraries and command line tools.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"phase": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase of the logical cluster (Initializing, Ready).",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"conditions": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Current processing state of the LogicalCluster.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref(conditionsv1alpha1.Condition{}.OpenAPIModelName()),
-									},
-								},
-							},
-						},
-					},
-					"initializers": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "initializers are set on creation by the system and must be cleared by a controller before the logical cluster can be used. The LogicalCluster object will stay in the phase \"Initializing\" state until all initializers are cleared.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref(v1.ObjectMeta{}.OpenAPIModelName()),
 						},
 					},
-					"terminators": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Terminators are set on creation by the system and must be cleared by a controller before the logical cluster can be deleted. The LogicalCluster object will stay in the phase \"Terminating\" until all terminator are cleared.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref(corev1alpha1.FrontProxyRouteSpec{}.OpenAPIModelName()),
 						},
 					},
 				},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			conditionsv1alpha1.Condition{}.OpenAPIModelName()},
+			corev1alpha1.FrontProxyRouteSpec{}.OpenAPIModelName(), v1.ObjectMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_sdk_apis_core_v1alpha1_OwnerUserInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_core_v1alpha1_FrontProxyRouteBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OwnerUserInfo is the user who owns a LogicalCluster.",
+				Description: "FrontProxyRouteBackend is a backend receiving requests of a route.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"username": {
-						SchemaProps: spec.SchemaProps{
-							Description: "username is the name of the user.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"groups": {
-						SchemaProps: spec.SchemaProps{
-							Description: "groups is the list of groups the user belongs to.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"uid": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "uid is a unique identifier for the user.",
+							Description: "url is the base URL of the backend. The request path is appended to it.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"extra": {
+					"weight": {
 						SchemaProps: spec.SchemaProps{
-							Description: "extra contains additional information provided by the authenticator.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type: []string{"array"},
-										Items: &spec.SchemaOrArray{
-											Schema: &spec.Schema{
-												SchemaProps: spec.SchemaProps{
-													Default: "",
-													Type:    []string{"string"},
-													Format:  "",
-												},
-											},
-										},
-									},
-								},
-							},
+							Description: "weight is the relative share of requests sent to this backend. A weight of 0 takes the backend out of rotation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
+				Required: []string{"url"},
 			},
 		},
 	}
 }
 
-func schema_sdk_apis_core_v1alpha1_Shard(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_core_v1alpha1_FrontProxyRouteHeaderMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Shard describes a kcp instance on which a number of logical clusters will live",
+				Description: "FrontProxyRouteHeaderMatch matches a request header.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "name is the name of the header, case-insensitive.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "value is the exact value the header must have. If empty, the header must be present with any value.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref(v1.ObjectMeta{}.OpenAPIModelName()),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref(corev1alpha1.ShardSpec{}.OpenAPIModelName()),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref(corev1alpha1.ShardStatus{}.OpenAPIModelName()),
-						},
-					},
 				},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			corev1alpha1.ShardSpec{}.OpenAPIModelName(), corev1alpha1.ShardStatus{}.OpenAPIModelName(), v1.ObjectMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_sdk_apis_core_v1alpha1_ShardList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_core_v1alpha1_FrontProxyRouteList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShardList is a list of shard instances",
+				Description: "FrontProxyRouteList is a list of FrontProxyRoutes.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -4038,7 +4197,7 @@ func schema_sdk_apis_core_v1alpha1_ShardList(ref common.ReferenceCallback) commo
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref(corev1alpha1.Shard{}.OpenAPIModelName()),
+										Ref:     ref(corev1alpha1.FrontProxyRoute{}.OpenAPIModelName()),
 									},
 								},
 							},
@@ -4049,123 +4208,133 @@ func schema_sdk_apis_core_v1alpha1_ShardList(ref common.ReferenceCallback) commo
 			},
 		},
 		Dependencies: []string{
-			corev1alpha1.Shard{}.OpenAPIModelName(), v1.ListMeta{}.OpenAPIModelName()},
+			corev1alpha1.FrontProxyRoute{}.OpenAPIModelName(), v1.ListMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_sdk_apis_core_v1alpha1_ShardSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_core_v1alpha1_FrontProxyRouteMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShardSpec holds the desired state of the Shard.",
+				Description: "FrontProxyRouteMatch selects requests by path, headers and user groups.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"baseURL": {
+					"pathPrefix": {
 						SchemaProps: spec.SchemaProps{
-							Description: "baseURL is the address of the kcp shard for direct connections, e.g. by some front-proxy doing the fan-out to the shards.",
+							Description: "pathPrefix is the prefix of the request path, e.g. \"/services/my-workspace/\". A prefix ending in \"/\" matches the path without the trailing slash too.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"externalURL": {
-						SchemaProps: spec.SchemaProps{
-							Description: "externalURL is the externally visible address presented to users in Workspace URLs. Changing this will break all existing logical clusters on that shard, i.e. existing kubeconfigs of clients will be invalid. Hence, when changing this value, the old URL used by clients must keep working.\n\nThe external address will not be unique if a front-proxy does a fan-out to shards, but all logical cluster clients will talk to the front-proxy. In that case, put the address of the front-proxy here.\n\nNote that movement of shards is only possible (in the future) between shards that share a common external URL.\n\nThis will be defaulted to the value of the baseURL.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"virtualWorkspaceURL": {
-						SchemaProps: spec.SchemaProps{
-							Description: "virtualWorkspaceURL is the address of the virtual workspace apiserver associated with this shard. It can be a direct address, an address of a front-proxy or even an address of an LB. As of today this address is assigned to APIExports.\n\nThis will be defaulted to the value of the baseURL.",
-							Type:        []string{"string"},
-							Format:      "",
+					"headers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
 						},
-					},
-				},
-				Required: []string{"baseURL"},
-			},
-		},
-	}
-}
-
-func schema_sdk_apis_core_v1alpha1_ShardStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ShardStatus communicates the observed state of the Shard.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"capacity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Set of integer resources that logical clusters can be scheduled into",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "headers the request must carry.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+										Default: map[string]interface{}{},
+										Ref:     ref(corev1alpha1.FrontProxyRouteHeaderMatch{}.OpenAPIModelName()),
 									},
 								},
 							},
 						},
 					},
-					"conditions": {
+					"groups": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Current processing state of the Shard.",
+							Description: "groups restricts the route to users being a member of at least one of the given groups. It requires the front-proxy to authenticate the user.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref(conditionsv1alpha1.Condition{}.OpenAPIModelName()),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"pathPrefix"},
 			},
 		},
 		Dependencies: []string{
-			conditionsv1alpha1.Condition{}.OpenAPIModelName(), "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			corev1alpha1.FrontProxyRouteHeaderMatch{}.OpenAPIModelName()},
 	}
 }
 
-func schema_sdk_apis_migration_v1alpha1_EtcdEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_core_v1alpha1_FrontProxyRouteSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EtcdEntry is a single etcd key/value pair from the origin shard.",
+				Description: "FrontProxyRouteSpec holds the desired state of the FrontProxyRoute.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"key": {
+					"match": {
 						SchemaProps: spec.SchemaProps{
-							Description: "key is the etcd key with the origin shard's storage prefix stripped. The destination shard prepends its own storage prefix before writing.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "match selects the requests routed. A request must match all of the given criteria.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(corev1alpha1.FrontProxyRouteMatch{}.OpenAPIModelName()),
 						},
 					},
-					"value": {
+					"backends": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"url",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "value is the raw etcd value bytes. JSON-encoded as base64 on the wire.",
-							Type:        []string{"string"},
-							Format:      "byte",
+							Description: "backends receive the matched requests. Every request is sent to one of them, chosen at random in proportion to their weight.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(corev1alpha1.FrontProxyRouteBackend{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "priority orders routes matching the same request, higher first. Routes of the same priority are ordered by the length of their path prefix, longest first, and then by name.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"key", "value"},
+				Required: []string{"match", "backends"},
 			},
 		},
+		Dependencies: []string{
+			corev1alpha1.FrontProxyRouteBackend{}.OpenAPIModelName(), corev1alpha1.FrontProxyRouteMatch{}.OpenAPIModelName()},
 	}
 }
 
-func schema_sdk_apis_migration_v1alpha1_LogicalClusterDump(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_core_v1alpha1_LogicalCluster(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LogicalClusterDump is an ephemeral request/response type used by the destination shard during a logical cluster migration to fetch the raw etcd contents of the migration logical cluster from the origin shard.\n\nThe server populates Status.Entries on Create. The object is not persisted.",
+				Description: "LogicalCluster describes the current logical cluster. It is used to authorize requests to the logical cluster and to track state.\n\nA LogicalCluster is always named \"cluster\".",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -4191,98 +4360,28 @@ func schema_sdk_apis_migration_v1alpha1_LogicalClusterDump(ref common.ReferenceC
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref(migrationv1alpha1.LogicalClusterDumpSpec{}.OpenAPIModelName()),
+							Ref:     ref(corev1alpha1.LogicalClusterSpec{}.OpenAPIModelName()),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref(migrationv1alpha1.LogicalClusterDumpStatus{}.OpenAPIModelName()),
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			migrationv1alpha1.LogicalClusterDumpSpec{}.OpenAPIModelName(), migrationv1alpha1.LogicalClusterDumpStatus{}.OpenAPIModelName(), v1.ObjectMeta{}.OpenAPIModelName()},
-	}
-}
-
-func schema_sdk_apis_migration_v1alpha1_LogicalClusterDumpSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "LogicalClusterDumpSpec is the desired state for a dump request.\n\nThe logical cluster to dump is taken from the request's cluster context (i.e. the URL the request arrived on).",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"continue": {
-						SchemaProps: spec.SchemaProps{
-							Description: "continue is the token returned by a previous LogicalClusterDump's status.continue. If set, the scan resumes right after the last key returned by that page. If empty, the scan starts from the beginning of the logical cluster's etcd keyspace.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"limit": {
-						SchemaProps: spec.SchemaProps{
-							Description: "limit caps the number of entries returned in a single page. If zero, the server picks a default page size.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"maxBytes": {
-						SchemaProps: spec.SchemaProps{
-							Description: "maxBytes caps the total size, in bytes, of entry values returned in a single page. The scan stops and returns a continue token as soon as this budget is exceeded, even if limit hasn't been reached yet. If zero, the server picks a default byte budget.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-				},
-			},
-		},
-	}
-}
-
-func schema_sdk_apis_migration_v1alpha1_LogicalClusterDumpStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "LogicalClusterDumpStatus carries the dump payload populated by the server.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"entries": {
-						SchemaProps: spec.SchemaProps{
-							Description: "entries is the page of etcd key/value pairs belonging to the logical cluster, in the origin shard's etcd encoding (proto for built-ins, JSON for CRs).",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref(migrationv1alpha1.EtcdEntry{}.OpenAPIModelName()),
-									},
-								},
-							},
-						},
-					},
-					"continue": {
-						SchemaProps: spec.SchemaProps{
-							Description: "continue is set when there are more entries to fetch. Pass it as spec.continue on the next LogicalClusterDump request to get the next page. Empty means this was the last page.",
-							Type:        []string{"string"},
-							Format:      "",
+							Ref:     ref(corev1alpha1.LogicalClusterStatus{}.OpenAPIModelName()),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			migrationv1alpha1.EtcdEntry{}.OpenAPIModelName()},
+			corev1alpha1.LogicalClusterSpec{}.OpenAPIModelName(), corev1alpha1.LogicalClusterStatus{}.OpenAPIModelName(), v1.ObjectMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_sdk_apis_migration_v1alpha1_LogicalClusterMigration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_core_v1alpha1_LogicalClusterLimits(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LogicalClusterMigration describes a migration of a logical cluster from one shard to another.",
+				Description: "LogicalClusterLimits limits the number of objects per resource in the logical cluster it lives in. The limits are enforced by the core.kcp.io/LogicalClusterObjectCountLimit admission plugin, together with the limits of the core.kcp.io/max-objects-per-resource annotation on the LogicalCluster.\n\nA LogicalClusterLimits is always named \"cluster\". Only privileged system users may change it.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -4308,28 +4407,28 @@ func schema_sdk_apis_migration_v1alpha1_LogicalClusterMigration(ref common.Refer
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref(migrationv1alpha1.LogicalClusterMigrationSpec{}.OpenAPIModelName()),
+							Ref:     ref(corev1alpha1.LogicalClusterLimitsSpec{}.OpenAPIModelName()),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref(migrationv1alpha1.LogicalClusterMigrationStatus{}.OpenAPIModelName()),
+							Ref:     ref(corev1alpha1.LogicalClusterLimitsStatus{}.OpenAPIModelName()),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			migrationv1alpha1.LogicalClusterMigrationSpec{}.OpenAPIModelName(), migrationv1alpha1.LogicalClusterMigrationStatus{}.OpenAPIModelName(), v1.ObjectMeta{}.OpenAPIModelName()},
+			corev1alpha1.LogicalClusterLimitsSpec{}.OpenAPIModelName(), corev1alpha1.LogicalClusterLimitsStatus{}.OpenAPIModelName(), v1.ObjectMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_sdk_apis_migration_v1alpha1_LogicalClusterMigrationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_core_v1alpha1_LogicalClusterLimitsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LogicalClusterMigrationList is a list of LogicalClusterMigration resources.",
+				Description: "LogicalClusterLimitsList is a list of LogicalClusterLimits.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -4359,7 +4458,7 @@ func schema_sdk_apis_migration_v1alpha1_LogicalClusterMigrationList(ref common.R
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref(migrationv1alpha1.LogicalClusterMigration{}.OpenAPIModelName()),
+										Ref:     ref(corev1alpha1.LogicalClusterLimits{}.OpenAPIModelName()),
 									},
 								},
 							},
@@ -4370,127 +4469,1426 @@ func schema_sdk_apis_migration_v1alpha1_LogicalClusterMigrationList(ref common.R
 			},
 		},
 		Dependencies: []string{
-			migrationv1alpha1.LogicalClusterMigration{}.OpenAPIModelName(), v1.ListMeta{}.OpenAPIModelName()},
+			corev1alpha1.LogicalClusterLimits{}.OpenAPIModelName(), v1.ListMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_sdk_apis_migration_v1alpha1_LogicalClusterMigrationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_core_v1alpha1_LogicalClusterLimitsSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LogicalClusterMigrationSpec holds the desired state of the migration.",
+				Description: "LogicalClusterLimitsSpec holds the desired state of the LogicalClusterLimits.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"logicalCluster": {
+					"objectCounts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"resource",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "logicalCluster is the name of the logical cluster to migrate.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "objectCounts limits the number of objects of individual resources. It takes precedence over the core.kcp.io/max-objects-per-resource annotation of the LogicalCluster for the same resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(corev1alpha1.ResourceObjectCountLimit{}.OpenAPIModelName()),
+									},
+								},
+							},
 						},
 					},
-					"destinationShard": {
+				},
+			},
+		},
+		Dependencies: []string{
+			corev1alpha1.ResourceObjectCountLimit{}.OpenAPIModelName()},
+	}
+}
+
+func schema_sdk_apis_core_v1alpha1_LogicalClusterLimitsStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LogicalClusterLimitsStatus communicates the observed state of the LogicalClusterLimits.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"objectCounts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"resource",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "destinationShard is the name of the shard to migrate the logical cluster to.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "objectCounts reports the number of objects of every limited resource, no matter whether the limit comes from the spec or from the core.kcp.io/max-objects-per-resource annotation. The counts are refreshed periodically.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(corev1alpha1.ResourceObjectCount{}.OpenAPIModelName()),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"logicalCluster", "destinationShard"},
 			},
 		},
+		Dependencies: []string{
+			corev1alpha1.ResourceObjectCount{}.OpenAPIModelName()},
 	}
 }
 
-func schema_sdk_apis_migration_v1alpha1_LogicalClusterMigrationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_core_v1alpha1_LogicalClusterList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LogicalClusterMigrationStatus communicates the observed state of the migration.",
+				Description: "LogicalClusterList is a list of LogicalCluster",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"phase": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "phase is the current phase of the migration.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"originShard": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "originShard is the name of the shard to migrate the logical cluster from. Set by the origin shard controller during preparation.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"entriesCopied": {
-						SchemaProps: spec.SchemaProps{
-							Description: "entriesCopied is the number of etcd entries copied from the origin shard to the destination shard so far during the Migrating phase.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"dumpContinue": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "dumpContinue is the continue token for the next LogicalClusterDump page to fetch from the origin shard. Set by the destination shard controller as it works through the data copy, and cleared once the copy is complete. Used to resume the copy from where it left off after a destination shard restart, instead of starting over.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref(v1.ListMeta{}.OpenAPIModelName()),
 						},
 					},
-					"conditions": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Current processing state of the migration.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref(conditionsv1alpha1.Condition{}.OpenAPIModelName()),
+										Ref:     ref(corev1alpha1.LogicalCluster{}.OpenAPIModelName()),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"metadata", "items"},
 			},
 		},
 		Dependencies: []string{
-			conditionsv1alpha1.Condition{}.OpenAPIModelName()},
+			corev1alpha1.LogicalCluster{}.OpenAPIModelName(), v1.ListMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_sdk_apis_tenancy_v1alpha1_APIExportReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_core_v1alpha1_LogicalClusterOwner(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "APIExportReference provides the fields necessary to resolve an APIExport.",
+				Description: "LogicalClusterOwner is a reference to a resource controlling the life-cycle of a LogicalCluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"path": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "path is the fully-qualified path to the workspace containing the APIExport. If it is empty, the current workspace is assumed.",
+							Description: "apiVersion is the group and API version of the owner.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"export": {
+					"resource": {
 						SchemaProps: spec.SchemaProps{
-							Description: "export is the name of the APIExport.",
+							Description: "resource is API resource to access the owner.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the name of the owner.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "namespace is the optional namespace of the owner.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cluster": {
+						SchemaProps: spec.SchemaProps{
+							Description: "cluster is the logical cluster in which the owner is located.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"uid": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UID is the UID of the owner.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"export"},
+				Required: []string{"apiVersion", "resource", "name", "cluster", "uid"},
 			},
 		},
 	}
 }
 
-func schema_sdk_apis_tenancy_v1alpha1_AuthenticationConfigurationReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_core_v1alpha1_LogicalClusterSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LogicalClusterSpec is the specification of the LogicalCluster resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"directlyDeletable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DirectlyDeletable indicates that this logical cluster can be directly deleted by the user from within by deleting the LogicalCluster object.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"owner": {
+						SchemaProps: spec.SchemaProps{
+							Description: "owner is a reference to a resource controlling the life-cycle of this logical cluster. On deletion of the LogicalCluster, the finalizer core.kcp.io/logicalcluster is removed from the owner.\n\nWhen this object is deleted, but the owner is not deleted, the owner is deleted too.",
+							Ref:         ref(corev1alpha1.LogicalClusterOwner{}.OpenAPIModelName()),
+						},
+					},
+					"createdBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "createdBy is the user who owns this logical cluster. This is the user who created the workspace.",
+							Ref:         ref(corev1alpha1.OwnerUserInfo{}.OpenAPIModelName()),
+						},
+					},
+					"initializers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "initializers are set on creation by the system and copied to status when initialization starts.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"terminators": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Terminators are set on creation by the system and copied to status when termination starts.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			corev1alpha1.LogicalClusterOwner{}.OpenAPIModelName(), corev1alpha1.OwnerUserInfo{}.OpenAPIModelName()},
+	}
+}
+
+func schema_sdk_apis_core_v1alpha1_LogicalClusterStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LogicalClusterStatus communicates the observed state of the Workspace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"URL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "url is the address under which the Kubernetes-cluster-like endpoint can be found. This URL can be used to access the logical cluster with standard Kubernetes client libraries and command line tools.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase of the logical cluster (Initializing, Ready).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Current processing state of the LogicalCluster.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(conditionsv1alpha1.Condition{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"initializers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "initializers are set on creation by the system and must be cleared by a controller before the logical cluster can be used. The LogicalCluster object will stay in the phase \"Initializing\" state until all initializers are cleared.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"terminators": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Terminators are set on creation by the system and must be cleared by a controller before the logical cluster can be deleted. The LogicalCluster object will stay in the phase \"Terminating\" until all terminator are cleared.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			conditionsv1alpha1.Condition{}.OpenAPIModelName()},
+	}
+}
+
+func schema_sdk_apis_core_v1alpha1_OwnerUserInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OwnerUserInfo is the user who owns a LogicalCluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Description: "username is the name of the user.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"groups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "groups is the list of groups the user belongs to.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"uid": {
+						SchemaProps: spec.SchemaProps{
+							Description: "uid is a unique identifier for the user.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"extra": {
+						SchemaProps: spec.SchemaProps{
+							Description: "extra contains additional information provided by the authenticator.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"array"},
+										Items: &spec.SchemaOrArray{
+											Schema: &spec.Schema{
+												SchemaProps: spec.SchemaProps{
+													Default: "",
+													Type:    []string{"string"},
+													Format:  "",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_sdk_apis_core_v1alpha1_ResourceObjectCount(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceObjectCount is the number of objects of a limited resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "resource is the limited resource, in the format <resource>.<group>, or just <resource> for the core group.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"max": {
+						SchemaProps: spec.SchemaProps{
+							Description: "max is the effective limit of the resource.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "count is the number of objects of the resource.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"resource", "max", "count"},
+			},
+		},
+	}
+}
+
+func schema_sdk_apis_core_v1alpha1_ResourceObjectCountLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceObjectCountLimit limits the number of objects of a resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "resource is the resource to limit, in the format <resource>.<group>, or just <resource> for the core group, e.g. \"secrets\" or \"deployments.apps\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"max": {
+						SchemaProps: spec.SchemaProps{
+							Description: "max is the maximum number of objects of the resource. 0 forbids creating any object of the resource.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"resource", "max"},
+			},
+		},
+	}
+}
+
+func schema_sdk_apis_core_v1alpha1_Shard(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Shard describes a kcp instance on which a number of logical clusters will live",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(corev1alpha1.ShardSpec{}.OpenAPIModelName()),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(corev1alpha1.ShardStatus{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			corev1alpha1.ShardSpec{}.OpenAPIModelName(), corev1alpha1.ShardStatus{}.OpenAPIModelName(), v1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_sdk_apis_core_v1alpha1_ShardList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShardList is a list of shard instances",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1.ListMeta{}.OpenAPIModelName()),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(corev1alpha1.Shard{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			corev1alpha1.Shard{}.OpenAPIModelName(), v1.ListMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_sdk_apis_core_v1alpha1_ShardSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShardSpec holds the desired state of the Shard.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"baseURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "baseURL is the address of the kcp shard for direct connections, e.g. by some front-proxy doing the fan-out to the shards.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"externalURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "externalURL is the externally visible address presented to users in Workspace URLs. Changing this will break all existing logical clusters on that shard, i.e. existing kubeconfigs of clients will be invalid. Hence, when changing this value, the old URL used by clients must keep working.\n\nThe external address will not be unique if a front-proxy does a fan-out to shards, but all logical cluster clients will talk to the front-proxy. In that case, put the address of the front-proxy here.\n\nNote that movement of shards is only possible (in the future) between shards that share a common external URL.\n\nThis will be defaulted to the value of the baseURL.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"virtualWorkspaceURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "virtualWorkspaceURL is the address of the virtual workspace apiserver associated with this shard. It can be a direct address, an address of a front-proxy or even an address of an LB. As of today this address is assigned to APIExports.\n\nThis will be defaulted to the value of the baseURL.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"baseURL"},
+			},
+		},
+	}
+}
+
+func schema_sdk_apis_core_v1alpha1_ShardStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShardStatus communicates the observed state of the Shard.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"capacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Set of integer resources that logical clusters can be scheduled into",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"usage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "usage is the amount of the capacity resources in use, as periodically reported by the shard itself. Used by the workspace scheduler to place new workspaces.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Current processing state of the Shard.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(conditionsv1alpha1.Condition{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			conditionsv1alpha1.Condition{}.OpenAPIModelName(), "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_sdk_apis_migration_v1alpha1_EtcdEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EtcdEntry is a single etcd key/value pair from the origin shard.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key is the etcd key with the origin shard's storage prefix stripped. The destination shard prepends its own storage prefix before writing.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "value is the raw etcd value bytes. JSON-encoded as base64 on the wire. Empty if the entry was not modified after spec.sinceRevision.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+				},
+				Required: []string{"key"},
+			},
+		},
+	}
+}
+
+func schema_sdk_apis_migration_v1alpha1_LogicalClusterDump(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LogicalClusterDump is an ephemeral request/response type used by the destination shard during a logical cluster migration to fetch the raw etcd contents of the migration logical cluster from the origin shard.\n\nThe server populates Status.Entries on Create. The object is not persisted.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(migrationv1alpha1.LogicalClusterDumpSpec{}.OpenAPIModelName()),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(migrationv1alpha1.LogicalClusterDumpStatus{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			migrationv1alpha1.LogicalClusterDumpSpec{}.OpenAPIModelName(), migrationv1alpha1.LogicalClusterDumpStatus{}.OpenAPIModelName(), v1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_sdk_apis_migration_v1alpha1_LogicalClusterDumpSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LogicalClusterDumpSpec is the desired state for a dump request.\n\nThe logical cluster to dump is taken from the request's cluster context (i.e. the URL the request arrived on).",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"continue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "continue is the token returned by a previous LogicalClusterDump's status.continue. If set, the scan resumes right after the last key returned by that page. If empty, the scan starts from the beginning of the logical cluster's etcd keyspace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"limit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "limit caps the number of entries returned in a single page. If zero, the server picks a default page size.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maxBytes caps the total size, in bytes, of entry values returned in a single page. The scan stops and returns a continue token as soon as this budget is exceeded, even if limit hasn't been reached yet. If zero, the server picks a default byte budget.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"sinceRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "sinceRevision turns the page into a delta against an earlier copy. If set, every key of the logical cluster is still returned, but only entries modified after this etcd revision carry a value. Keys missing from the page were deleted on the origin shard, if they fall between spec.continue and status.continue.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "revision pins the page to an etcd revision of the origin shard. Pass the status.revision of the first page on every following page to get a consistent snapshot of a logical cluster that keeps being written to. It is required to paginate through a logical cluster that is not being migrated. The request fails with 410 Gone once the revision has been compacted.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_sdk_apis_migration_v1alpha1_LogicalClusterDumpStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LogicalClusterDumpStatus carries the dump payload populated by the server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"entries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "entries is the page of etcd key/value pairs belonging to the logical cluster, in the origin shard's etcd encoding (proto for built-ins, JSON for CRs).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(migrationv1alpha1.EtcdEntry{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"continue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "continue is set when there are more entries to fetch. Pass it as spec.continue on the next LogicalClusterDump request to get the next page. Empty means this was the last page.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "revision is the origin shard's etcd revision at the time the page was read, or spec.revision if set. Every change made after it will be seen by a later delta page that passes it as spec.sinceRevision.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			migrationv1alpha1.EtcdEntry{}.OpenAPIModelName()},
+	}
+}
+
+func schema_sdk_apis_migration_v1alpha1_LogicalClusterMigration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LogicalClusterMigration describes a migration of a logical cluster from one shard to another.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(migrationv1alpha1.LogicalClusterMigrationSpec{}.OpenAPIModelName()),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(migrationv1alpha1.LogicalClusterMigrationStatus{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			migrationv1alpha1.LogicalClusterMigrationSpec{}.OpenAPIModelName(), migrationv1alpha1.LogicalClusterMigrationStatus{}.OpenAPIModelName(), v1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_sdk_apis_migration_v1alpha1_LogicalClusterMigrationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LogicalClusterMigrationList is a list of LogicalClusterMigration resources.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1.ListMeta{}.OpenAPIModelName()),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(migrationv1alpha1.LogicalClusterMigration{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			migrationv1alpha1.LogicalClusterMigration{}.OpenAPIModelName(), v1.ListMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_sdk_apis_migration_v1alpha1_LogicalClusterMigrationPreCopy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LogicalClusterMigrationPreCopy configures when the PreCopy strategy stops copying in the background and freezes the logical cluster for the final round.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxRounds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maxRounds is the maximum number of copy rounds, including the initial bulk copy, run while the logical cluster is writable. Once reached the logical cluster is frozen for the final round, even if the last round still copied more than freezeThreshold entries. Defaults to 5 if unset.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"freezeThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "freezeThreshold is the number of changed entries at or below which a completed copy round is considered small enough to freeze the logical cluster and copy the final delta. Defaults to 100 if unset.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_sdk_apis_migration_v1alpha1_LogicalClusterMigrationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LogicalClusterMigrationSpec holds the desired state of the migration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"logicalCluster": {
+						SchemaProps: spec.SchemaProps{
+							Description: "logicalCluster is the name of the logical cluster to migrate.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"destinationShard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "destinationShard is the name of the shard to migrate the logical cluster to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"strategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "strategy selects how the data is moved to the destination shard.\n\nFreeze blocks all access to the logical cluster for the whole copy.\n\nPreCopy copies the data in rounds while the logical cluster stays writable, each round only transferring what changed on the origin shard since the previous one. The logical cluster is only blocked for the final round, which copies the remaining delta.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"preCopy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "preCopy tunes the PreCopy strategy. Ignored for other strategies.",
+							Ref:         ref(migrationv1alpha1.LogicalClusterMigrationPreCopy{}.OpenAPIModelName()),
+						},
+					},
+					"abort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "abort requests the migration to be rolled back. The data copied to the destination shard is deleted and the logical cluster is made available on the origin shard again.\n\nA migration can only be aborted until the origin shard starts deleting its data, i.e. before the OriginCleanup phase. Once set, abort cannot be unset.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"logicalCluster", "destinationShard"},
+			},
+		},
+		Dependencies: []string{
+			migrationv1alpha1.LogicalClusterMigrationPreCopy{}.OpenAPIModelName()},
+	}
+}
+
+func schema_sdk_apis_migration_v1alpha1_LogicalClusterMigrationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LogicalClusterMigrationStatus communicates the observed state of the migration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "phase is the current phase of the migration.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"originShard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "originShard is the name of the shard to migrate the logical cluster from. Set by the origin shard controller during preparation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"entriesCopied": {
+						SchemaProps: spec.SchemaProps{
+							Description: "entriesCopied is the number of etcd entries copied from the origin shard to the destination shard so far during the Migrating phase.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"dumpContinue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "dumpContinue is the continue token for the next LogicalClusterDump page to fetch from the origin shard. Set by the destination shard controller as it works through the data copy, and cleared once the copy is complete. Used to resume the copy from where it left off after a destination shard restart, instead of starting over.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"copyRounds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "copyRounds is the number of copy rounds completed so far. Only used by the PreCopy strategy.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"roundRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "roundRevision is the origin shard's etcd revision at the start of the copy round in progress. Only used by the PreCopy strategy.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"roundEntriesCopied": {
+						SchemaProps: spec.SchemaProps{
+							Description: "roundEntriesCopied is the number of changed etcd entries copied in the copy round in progress, or in the last completed one if no round is in progress. Only used by the PreCopy strategy.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"syncedRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "syncedRevision is the origin shard's etcd revision up to which all changes have been copied to the destination shard. The next copy round only transfers entries modified after it. Only used by the PreCopy strategy.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Current processing state of the migration.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(conditionsv1alpha1.Condition{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			conditionsv1alpha1.Condition{}.OpenAPIModelName()},
+	}
+}
+
+func schema_sdk_apis_migration_v1alpha1_LogicalClusterRestore(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LogicalClusterRestore is an ephemeral request/response type used to import a page of LogicalClusterDump entries, e.g. from a backup, into a logical cluster on the shard receiving the request.\n\nThe server writes Spec.Entries on Create and populates Status. The object is not persisted.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(migrationv1alpha1.LogicalClusterRestoreSpec{}.OpenAPIModelName()),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(migrationv1alpha1.LogicalClusterRestoreStatus{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			migrationv1alpha1.LogicalClusterRestoreSpec{}.OpenAPIModelName(), migrationv1alpha1.LogicalClusterRestoreStatus{}.OpenAPIModelName(), v1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_sdk_apis_migration_v1alpha1_LogicalClusterRestoreSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LogicalClusterRestoreSpec is the desired state for a restore request.\n\nThe logical cluster to restore into is taken from the request's cluster context (i.e. the URL the request arrived on).",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sourceLogicalCluster": {
+						SchemaProps: spec.SchemaProps{
+							Description: "sourceLogicalCluster is the name of the logical cluster the entries were dumped from. Their keys are rewritten from it to the target logical cluster.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"entries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "entries are the etcd key/value pairs to write, as returned in the status.entries of a LogicalClusterDump without spec.sinceRevision.\n\nAn entry whose key already exists with the same value is skipped, so a page can be retried. A key that exists with a different value fails the request with 409 Conflict.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(migrationv1alpha1.EtcdEntry{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"sourceLogicalCluster"},
+			},
+		},
+		Dependencies: []string{
+			migrationv1alpha1.EtcdEntry{}.OpenAPIModelName()},
+	}
+}
+
+func schema_sdk_apis_migration_v1alpha1_LogicalClusterRestoreStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LogicalClusterRestoreStatus carries the result of the restore request.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"restored": {
+						SchemaProps: spec.SchemaProps{
+							Description: "restored is the number of entries written. Entries skipped because they already existed with the same value are not counted.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_sdk_apis_migration_v1alpha1_ShardDrain(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShardDrain moves all logical clusters off a shard by creating one LogicalClusterMigration per logical cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(migrationv1alpha1.ShardDrainSpec{}.OpenAPIModelName()),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(migrationv1alpha1.ShardDrainStatus{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			migrationv1alpha1.ShardDrainSpec{}.OpenAPIModelName(), migrationv1alpha1.ShardDrainStatus{}.OpenAPIModelName(), v1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_sdk_apis_migration_v1alpha1_ShardDrainList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShardDrainList is a list of ShardDrain resources.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1.ListMeta{}.OpenAPIModelName()),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(migrationv1alpha1.ShardDrain{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			migrationv1alpha1.ShardDrain{}.OpenAPIModelName(), v1.ListMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_sdk_apis_migration_v1alpha1_ShardDrainSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShardDrainSpec holds the desired state of the drain.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sourceShard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "sourceShard is the name of the shard to move all logical clusters off.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"destinationShardSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "destinationShardSelector selects the shards the logical clusters are migrated to. The source shard is never selected. All other shards are selected if unset.\n\nAmong the selected shards the least used one with capacity left is picked for every logical cluster, see ShardCapacityLogicalClusters.",
+							Ref:         ref(v1.LabelSelector{}.OpenAPIModelName()),
+						},
+					},
+					"concurrency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "concurrency is the maximum number of logical clusters migrated at the same time.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"strategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "strategy is passed on to the created LogicalClusterMigrations.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"sourceShard"},
+			},
+		},
+		Dependencies: []string{
+			v1.LabelSelector{}.OpenAPIModelName()},
+	}
+}
+
+func schema_sdk_apis_migration_v1alpha1_ShardDrainStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShardDrainStatus communicates the observed state of the drain.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "phase is the current phase of the drain. It is Failed once all migrations have finished and at least one of them failed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"logicalClusters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "logicalClusters is the number of logical clusters found on the source shard, including those already migrated by this drain.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pending": {
+						SchemaProps: spec.SchemaProps{
+							Description: "pending is the number of logical clusters a migration hasn't been started for yet.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"migrating": {
+						SchemaProps: spec.SchemaProps{
+							Description: "migrating is the number of logical clusters currently being migrated.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"migrated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "migrated is the number of logical clusters migrated successfully.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "failed is the number of logical clusters whose migration failed or was rolled back. These are not retried.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Current processing state of the drain.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(conditionsv1alpha1.Condition{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			conditionsv1alpha1.Condition{}.OpenAPIModelName()},
+	}
+}
+
+func schema_sdk_apis_tenancy_v1alpha1_APIExportReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIExportReference provides the fields necessary to resolve an APIExport.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "path is the fully-qualified path to the workspace containing the APIExport. If it is empty, the current workspace is assumed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"export": {
+						SchemaProps: spec.SchemaProps{
+							Description: "export is the name of the APIExport.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"export"},
+			},
+		},
+	}
+}
+
+func schema_sdk_apis_tenancy_v1alpha1_AuthenticationConfigurationReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -4499,95 +5897,335 @@ func schema_sdk_apis_tenancy_v1alpha1_AuthenticationConfigurationReference(ref c
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "name is the name of the WorkspaceAuthenticationConfiguration.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "name is the name of the WorkspaceAuthenticationConfiguration.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_sdk_apis_tenancy_v1alpha1_ClaimMappings(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClaimMappings provides the configuration for claim mapping.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(tenancyv1alpha1.PrefixedClaimOrExpression{}.OpenAPIModelName()),
+						},
+					},
+					"groups": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(tenancyv1alpha1.PrefixedClaimOrExpression{}.OpenAPIModelName()),
+						},
+					},
+					"uid": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(tenancyv1alpha1.ClaimOrExpression{}.OpenAPIModelName()),
+						},
+					},
+					"extra": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(tenancyv1alpha1.ExtraMapping{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			tenancyv1alpha1.ClaimOrExpression{}.OpenAPIModelName(), tenancyv1alpha1.ExtraMapping{}.OpenAPIModelName(), tenancyv1alpha1.PrefixedClaimOrExpression{}.OpenAPIModelName()},
+	}
+}
+
+func schema_sdk_apis_tenancy_v1alpha1_ClaimOrExpression(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClaimOrExpression provides the configuration for a single claim or expression.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"claim": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_sdk_apis_tenancy_v1alpha1_ClaimValidationRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClaimValidationRule provides the configuration for a single claim validation rule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"claim": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"requiredValue": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_sdk_apis_tenancy_v1alpha1_ExtraMapping(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExtraMapping provides the configuration for a single extra mapping.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"valueExpression": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"key", "valueExpression"},
+			},
+		},
+	}
+}
+
+func schema_sdk_apis_tenancy_v1alpha1_Issuer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Issuer provides the configuration for an external provider's specific settings.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "url points to the issuer URL in a format https://url or https://url/path. This must match the \"iss\" claim in the presented JWT, and the issuer returned from discovery. Same value as the --oidc-issuer-url flag. Discovery information is fetched from \"{url}/.well-known/openid-configuration\" unless overridden by discoveryURL. Required to be unique across all JWT authenticators. Note that egress selection configuration is not used for this network connection.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"discoveryURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "discoveryURL, if specified, overrides the URL used to fetch discovery information instead of using \"{url}/.well-known/openid-configuration\". The exact value specified is used, so \"/.well-known/openid-configuration\" must be included in discoveryURL if needed.\n\nThe \"issuer\" field in the fetched discovery information must match the \"issuer.url\" field in the AuthenticationConfiguration and will be used to validate the \"iss\" claim in the presented JWT. This is for scenarios where the well-known and jwks endpoints are hosted at a different location than the issuer (such as locally in the cluster).\n\nExample: A discovery url that is exposed using kubernetes service 'oidc' in namespace 'oidc-namespace' and discovery information is available at '/.well-known/openid-configuration'. discoveryURL: \"https://oidc.oidc-namespace/.well-known/openid-configuration\" certificateAuthority is used to verify the TLS connection and the hostname on the leaf certificate must be set to 'oidc.oidc-namespace'.\n\ncurl https://oidc.oidc-namespace/.well-known/openid-configuration (.discoveryURL field) {\n    issuer: \"https://oidc.example.com\" (.url field)\n}\n\ndiscoveryURL must be different from url. Required to be unique across all JWT authenticators. Note that egress selection configuration is not used for this network connection.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"certificateAuthority": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"audiences": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"audienceMatchPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"url"},
 			},
 		},
 	}
 }
 
-func schema_sdk_apis_tenancy_v1alpha1_ClaimMappings(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_tenancy_v1alpha1_JWTAuthenticator(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClaimMappings provides the configuration for claim mapping.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"username": {
+					"issuer": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref(tenancyv1alpha1.PrefixedClaimOrExpression{}.OpenAPIModelName()),
+							Ref:     ref(tenancyv1alpha1.Issuer{}.OpenAPIModelName()),
 						},
 					},
-					"groups": {
+					"claimValidationRules": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref(tenancyv1alpha1.PrefixedClaimOrExpression{}.OpenAPIModelName()),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(tenancyv1alpha1.ClaimValidationRule{}.OpenAPIModelName()),
+									},
+								},
+							},
 						},
 					},
-					"uid": {
+					"claimMappings": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref(tenancyv1alpha1.ClaimOrExpression{}.OpenAPIModelName()),
+							Ref:     ref(tenancyv1alpha1.ClaimMappings{}.OpenAPIModelName()),
 						},
 					},
-					"extra": {
+					"userValidationRules": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref(tenancyv1alpha1.ExtraMapping{}.OpenAPIModelName()),
+										Ref:     ref(tenancyv1alpha1.UserValidationRule{}.OpenAPIModelName()),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"issuer", "claimMappings"},
 			},
 		},
 		Dependencies: []string{
-			tenancyv1alpha1.ClaimOrExpression{}.OpenAPIModelName(), tenancyv1alpha1.ExtraMapping{}.OpenAPIModelName(), tenancyv1alpha1.PrefixedClaimOrExpression{}.OpenAPIModelName()},
+			tenancyv1alpha1.ClaimMappings{}.OpenAPIModelName(), tenancyv1alpha1.ClaimValidationRule{}.OpenAPIModelName(), tenancyv1alpha1.Issuer{}.OpenAPIModelName(), tenancyv1alpha1.UserValidationRule{}.OpenAPIModelName()},
 	}
 }
 
-func schema_sdk_apis_tenancy_v1alpha1_ClaimOrExpression(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_tenancy_v1alpha1_Mount(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClaimOrExpression provides the configuration for a single claim or expression.",
+				Description: "Mount is a reference to an object implementing a mounting feature. It is used to orchestrate where the traffic, intended for the workspace, is sent.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"claim": {
+					"ref": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Reference is an ObjectReference to the object that is mounted.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(tenancyv1alpha1.ObjectReference{}.OpenAPIModelName()),
 						},
 					},
-					"expression": {
+				},
+				Required: []string{"ref"},
+			},
+		},
+		Dependencies: []string{
+			tenancyv1alpha1.ObjectReference{}.OpenAPIModelName()},
+	}
+}
+
+func schema_sdk_apis_tenancy_v1alpha1_ObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "APIVersion is the API group and version of the object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the object.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"apiVersion", "kind", "name"},
 			},
 		},
 	}
 }
 
-func schema_sdk_apis_tenancy_v1alpha1_ClaimValidationRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_tenancy_v1alpha1_PrefixedClaimOrExpression(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClaimValidationRule provides the configuration for a single claim validation rule.",
+				Description: "PrefixedClaimOrExpression provides the configuration for a single prefixed claim or expression.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"claim": {
@@ -4596,7 +6234,7 @@ func schema_sdk_apis_tenancy_v1alpha1_ClaimValidationRule(ref common.ReferenceCa
 							Format: "",
 						},
 					},
-					"requiredValue": {
+					"prefix": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
@@ -4608,33 +6246,27 @@ func schema_sdk_apis_tenancy_v1alpha1_ClaimValidationRule(ref common.ReferenceCa
 							Format: "",
 						},
 					},
-					"message": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
 				},
 			},
 		},
 	}
 }
 
-func schema_sdk_apis_tenancy_v1alpha1_ExtraMapping(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_tenancy_v1alpha1_UserValidationRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExtraMapping provides the configuration for a single extra mapping.",
+				Description: "UserValidationRule provides the configuration for a single user validation rule.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"key": {
+					"expression": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"valueExpression": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
@@ -4642,279 +6274,303 @@ func schema_sdk_apis_tenancy_v1alpha1_ExtraMapping(ref common.ReferenceCallback)
 						},
 					},
 				},
-				Required: []string{"key", "valueExpression"},
+				Required: []string{"expression", "message"},
 			},
 		},
 	}
 }
 
-func schema_sdk_apis_tenancy_v1alpha1_Issuer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_tenancy_v1alpha1_VirtualWorkspace(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Issuer provides the configuration for an external provider's specific settings.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
 					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "url points to the issuer URL in a format https://url or https://url/path. This must match the \"iss\" claim in the presented JWT, and the issuer returned from discovery. Same value as the --oidc-issuer-url flag. Discovery information is fetched from \"{url}/.well-known/openid-configuration\" unless overridden by discoveryURL. Required to be unique across all JWT authenticators. Note that egress selection configuration is not used for this network connection.",
+							Description: "url is a WorkspaceType initialization virtual workspace URL.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"discoveryURL": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "discoveryURL, if specified, overrides the URL used to fetch discovery information instead of using \"{url}/.well-known/openid-configuration\". The exact value specified is used, so \"/.well-known/openid-configuration\" must be included in discoveryURL if needed.\n\nThe \"issuer\" field in the fetched discovery information must match the \"issuer.url\" field in the AuthenticationConfiguration and will be used to validate the \"iss\" claim in the presented JWT. This is for scenarios where the well-known and jwks endpoints are hosted at a different location than the issuer (such as locally in the cluster).\n\nExample: A discovery url that is exposed using kubernetes service 'oidc' in namespace 'oidc-namespace' and discovery information is available at '/.well-known/openid-configuration'. discoveryURL: \"https://oidc.oidc-namespace/.well-known/openid-configuration\" certificateAuthority is used to verify the TLS connection and the hostname on the leaf certificate must be set to 'oidc.oidc-namespace'.\n\ncurl https://oidc.oidc-namespace/.well-known/openid-configuration (.discoveryURL field) {\n    issuer: \"https://oidc.example.com\" (.url field)\n}\n\ndiscoveryURL must be different from url. Required to be unique across all JWT authenticators. Note that egress selection configuration is not used for this network connection.",
+							Description: "type indicates the type of virtual workspace this URL represents.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"certificateAuthority": {
+				},
+				Required: []string{"url"},
+			},
+		},
+	}
+}
+
+func schema_sdk_apis_tenancy_v1alpha1_Workspace(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Workspace defines a generic Kubernetes-cluster-like endpoint, with standard Kubernetes discovery APIs, OpenAPI and resource API endpoints.\n\nA workspace can be backed by different concrete types of workspace implementation, depending on access pattern. All workspace implementations share the characteristic that the URL that serves a given workspace can be used with standard Kubernetes API machinery and client libraries and command line tools.\n\nWorkspaces supports mounting, by specifying an Mount object in the spec. If a Mount is specified, the workspace will be mounted to the specified mount object and LogicalCluster will not be created.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"audiences": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"audienceMatchPolicy": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref(v1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(tenancyv1alpha1.WorkspaceSpec{}.OpenAPIModelName()),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(tenancyv1alpha1.WorkspaceStatus{}.OpenAPIModelName()),
 						},
 					},
 				},
-				Required: []string{"url"},
+				Required: []string{"spec"},
 			},
 		},
+		Dependencies: []string{
+			tenancyv1alpha1.WorkspaceSpec{}.OpenAPIModelName(), tenancyv1alpha1.WorkspaceStatus{}.OpenAPIModelName(), v1.ObjectMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_sdk_apis_tenancy_v1alpha1_JWTAuthenticator(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_tenancy_v1alpha1_WorkspaceAuthenticationConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "WorkspaceAuthenticationConfiguration specifies additional authentication options for workspaces.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"issuer": {
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref(tenancyv1alpha1.Issuer{}.OpenAPIModelName()),
+							Ref:     ref(v1.ObjectMeta{}.OpenAPIModelName()),
 						},
 					},
-					"claimValidationRules": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref(tenancyv1alpha1.ClaimValidationRule{}.OpenAPIModelName()),
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref(tenancyv1alpha1.WorkspaceAuthenticationConfigurationSpec{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"metadata", "spec"},
+			},
+		},
+		Dependencies: []string{
+			tenancyv1alpha1.WorkspaceAuthenticationConfigurationSpec{}.OpenAPIModelName(), v1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_sdk_apis_tenancy_v1alpha1_WorkspaceAuthenticationConfigurationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkspaceAuthenticationConfigurationList is a list of WorkspaceAuthenticationConfigurations.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"claimMappings": {
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref(tenancyv1alpha1.ClaimMappings{}.OpenAPIModelName()),
+							Ref:     ref(v1.ListMeta{}.OpenAPIModelName()),
 						},
 					},
-					"userValidationRules": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref(tenancyv1alpha1.UserValidationRule{}.OpenAPIModelName()),
+										Ref:     ref(tenancyv1alpha1.WorkspaceAuthenticationConfiguration{}.OpenAPIModelName()),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"issuer", "claimMappings"},
+				Required: []string{"metadata", "items"},
 			},
 		},
 		Dependencies: []string{
-			tenancyv1alpha1.ClaimMappings{}.OpenAPIModelName(), tenancyv1alpha1.ClaimValidationRule{}.OpenAPIModelName(), tenancyv1alpha1.Issuer{}.OpenAPIModelName(), tenancyv1alpha1.UserValidationRule{}.OpenAPIModelName()},
+			tenancyv1alpha1.WorkspaceAuthenticationConfiguration{}.OpenAPIModelName(), v1.ListMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_sdk_apis_tenancy_v1alpha1_Mount(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_tenancy_v1alpha1_WorkspaceAuthenticationConfigurationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Mount is a reference to an object implementing a mounting feature. It is used to orchestrate where the traffic, intended for the workspace, is sent.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ref": {
+					"jwt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference is an ObjectReference to the object that is mounted.",
-							Default:     map[string]interface{}{},
-							Ref:         ref(tenancyv1alpha1.ObjectReference{}.OpenAPIModelName()),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(tenancyv1alpha1.JWTAuthenticator{}.OpenAPIModelName()),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"ref"},
+				Required: []string{"jwt"},
 			},
 		},
 		Dependencies: []string{
-			tenancyv1alpha1.ObjectReference{}.OpenAPIModelName()},
+			tenancyv1alpha1.JWTAuthenticator{}.OpenAPIModelName()},
 	}
 }
 
-func schema_sdk_apis_tenancy_v1alpha1_ObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_tenancy_v1alpha1_WorkspaceCloneSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "WorkspaceCloneSource references the workspace a workspace is cloned from.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion is the API group and version of the object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is the kind of the object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"name": {
+					"path": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the object.",
+							Description: "path is an absolute reference to the workspace to clone, e.g. root:org:golden.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of the object.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 				},
-				Required: []string{"apiVersion", "kind", "name"},
+				Required: []string{"path"},
 			},
 		},
 	}
 }
 
-func schema_sdk_apis_tenancy_v1alpha1_PrefixedClaimOrExpression(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_tenancy_v1alpha1_WorkspaceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PrefixedClaimOrExpression provides the configuration for a single prefixed claim or expression.",
+				Description: "WorkspaceList is a list of Workspaces",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"claim": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"prefix": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"expression": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_sdk_apis_tenancy_v1alpha1_UserValidationRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "UserValidationRule provides the configuration for a single user validation rule.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"expression": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Default: map[string]interface{}{},
+							Ref:     ref(v1.ListMeta{}.OpenAPIModelName()),
 						},
 					},
-					"message": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(tenancyv1alpha1.Workspace{}.OpenAPIModelName()),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"expression", "message"},
+				Required: []string{"metadata", "items"},
 			},
 		},
+		Dependencies: []string{
+			tenancyv1alpha1.Workspace{}.OpenAPIModelName(), v1.ListMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_sdk_apis_tenancy_v1alpha1_VirtualWorkspace(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_tenancy_v1alpha1_WorkspaceLocation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"url": {
-						SchemaProps: spec.SchemaProps{
-							Description: "url is a WorkspaceType initialization virtual workspace URL.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"type": {
+					"selector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "type indicates the type of virtual workspace this URL represents.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "selector is a label selector that filters workspace scheduling targets.",
+							Ref:         ref(v1.LabelSelector{}.OpenAPIModelName()),
 						},
 					},
 				},
-				Required: []string{"url"},
 			},
 		},
+		Dependencies: []string{
+			v1.LabelSelector{}.OpenAPIModelName()},
 	}
 }
 
-func schema_sdk_apis_tenancy_v1alpha1_Workspace(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_tenancy_v1alpha1_WorkspaceQuota(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Workspace defines a generic Kubernetes-cluster-like endpoint, with standard Kubernetes discovery APIs, OpenAPI and resource API endpoints.\n\nA workspace can be backed by different concrete types of workspace implementation, depending on access pattern. All workspace implementations share the characteristic that the URL that serves a given workspace can be used with standard Kubernetes API machinery and client libraries and command line tools.\n\nWorkspaces supports mounting, by specifying an Mount object in the spec. If a Mount is specified, the workspace will be mounted to the specified mount object and LogicalCluster will not be created.",
+				Description: "WorkspaceQuota limits the aggregate usage of all workspaces below the workspace it lives in, across shards. The usage is reported by every shard through the cache server and summed up in the status. The limits are enforced by the tenancy.kcp.io/WorkspaceQuota admission plugin.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -4940,29 +6596,28 @@ func schema_sdk_apis_tenancy_v1alpha1_Workspace(ref common.ReferenceCallback) co
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref(tenancyv1alpha1.WorkspaceSpec{}.OpenAPIModelName()),
+							Ref:     ref(tenancyv1alpha1.WorkspaceQuotaSpec{}.OpenAPIModelName()),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref(tenancyv1alpha1.WorkspaceStatus{}.OpenAPIModelName()),
+							Ref:     ref(tenancyv1alpha1.WorkspaceQuotaStatus{}.OpenAPIModelName()),
 						},
 					},
 				},
-				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			tenancyv1alpha1.WorkspaceSpec{}.OpenAPIModelName(), tenancyv1alpha1.WorkspaceStatus{}.OpenAPIModelName(), v1.ObjectMeta{}.OpenAPIModelName()},
+			tenancyv1alpha1.WorkspaceQuotaSpec{}.OpenAPIModelName(), tenancyv1alpha1.WorkspaceQuotaStatus{}.OpenAPIModelName(), v1.ObjectMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_sdk_apis_tenancy_v1alpha1_WorkspaceAuthenticationConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_tenancy_v1alpha1_WorkspaceQuotaList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "WorkspaceAuthenticationConfiguration specifies additional authentication options for workspaces.",
+				Description: "WorkspaceQuotaList is a list of WorkspaceQuota resources.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -4982,29 +6637,108 @@ func schema_sdk_apis_tenancy_v1alpha1_WorkspaceAuthenticationConfiguration(ref c
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref(v1.ObjectMeta{}.OpenAPIModelName()),
+							Ref:     ref(v1.ListMeta{}.OpenAPIModelName()),
 						},
 					},
-					"spec": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref(tenancyv1alpha1.WorkspaceAuthenticationConfigurationSpec{}.OpenAPIModelName()),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(tenancyv1alpha1.WorkspaceQuota{}.OpenAPIModelName()),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"metadata", "spec"},
+				Required: []string{"metadata", "items"},
 			},
 		},
 		Dependencies: []string{
-			tenancyv1alpha1.WorkspaceAuthenticationConfigurationSpec{}.OpenAPIModelName(), v1.ObjectMeta{}.OpenAPIModelName()},
+			tenancyv1alpha1.WorkspaceQuota{}.OpenAPIModelName(), v1.ListMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_sdk_apis_tenancy_v1alpha1_WorkspaceAuthenticationConfigurationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_tenancy_v1alpha1_WorkspaceQuotaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "WorkspaceAuthenticationConfigurationList is a list of WorkspaceAuthenticationConfigurations.",
+				Description: "WorkspaceQuotaSpec holds the desired state of the WorkspaceQuota.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "hard is the set of limits on the aggregate usage of all workspaces below the workspace of the WorkspaceQuota. Supported are \"workspaces\", the number of workspaces at any depth, object counts of resources, e.g. \"count/secrets\" or \"count/deployments.apps\", and the compute resources of pods as in a ResourceQuota, e.g. \"pods\", \"requests.cpu\" or \"limits.memory\".",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_sdk_apis_tenancy_v1alpha1_WorkspaceQuotaStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkspaceQuotaStatus communicates the observed state of the WorkspaceQuota.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "hard is the set of enforced limits.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"used": {
+						SchemaProps: spec.SchemaProps{
+							Description: "used is the aggregate usage of all workspaces below the workspace of the WorkspaceQuota, summed up over all shards.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_sdk_apis_tenancy_v1alpha1_WorkspaceQuotaUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkspaceQuotaUsage is the usage of a WorkspaceQuota on a single shard. It only exists in the cache server, under the shard reporting it and the logical cluster of the WorkspaceQuota.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -5024,64 +6758,28 @@ func schema_sdk_apis_tenancy_v1alpha1_WorkspaceAuthenticationConfigurationList(r
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref(v1.ListMeta{}.OpenAPIModelName()),
-						},
-					},
-					"items": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref(tenancyv1alpha1.WorkspaceAuthenticationConfiguration{}.OpenAPIModelName()),
-									},
-								},
-							},
+							Ref:     ref(v1.ObjectMeta{}.OpenAPIModelName()),
 						},
 					},
-				},
-				Required: []string{"metadata", "items"},
-			},
-		},
-		Dependencies: []string{
-			tenancyv1alpha1.WorkspaceAuthenticationConfiguration{}.OpenAPIModelName(), v1.ListMeta{}.OpenAPIModelName()},
-	}
-}
-
-func schema_sdk_apis_tenancy_v1alpha1_WorkspaceAuthenticationConfigurationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"jwt": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref(tenancyv1alpha1.JWTAuthenticator{}.OpenAPIModelName()),
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref(tenancyv1alpha1.WorkspaceQuotaUsageSpec{}.OpenAPIModelName()),
 						},
 					},
 				},
-				Required: []string{"jwt"},
 			},
 		},
 		Dependencies: []string{
-			tenancyv1alpha1.JWTAuthenticator{}.OpenAPIModelName()},
+			tenancyv1alpha1.WorkspaceQuotaUsageSpec{}.OpenAPIModelName(), v1.ObjectMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_sdk_apis_tenancy_v1alpha1_WorkspaceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_tenancy_v1alpha1_WorkspaceQuotaUsageList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "WorkspaceList is a list of Workspaces",
+				Description: "WorkspaceQuotaUsageList is a list of WorkspaceQuotaUsage resources.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -5111,7 +6809,7 @@ func schema_sdk_apis_tenancy_v1alpha1_WorkspaceList(ref common.ReferenceCallback
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref(tenancyv1alpha1.Workspace{}.OpenAPIModelName()),
+										Ref:     ref(tenancyv1alpha1.WorkspaceQuotaUsage{}.OpenAPIModelName()),
 									},
 								},
 							},
@@ -5122,27 +6820,53 @@ func schema_sdk_apis_tenancy_v1alpha1_WorkspaceList(ref common.ReferenceCallback
 			},
 		},
 		Dependencies: []string{
-			tenancyv1alpha1.Workspace{}.OpenAPIModelName(), v1.ListMeta{}.OpenAPIModelName()},
+			tenancyv1alpha1.WorkspaceQuotaUsage{}.OpenAPIModelName(), v1.ListMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_sdk_apis_tenancy_v1alpha1_WorkspaceLocation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_tenancy_v1alpha1_WorkspaceQuotaUsageSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "WorkspaceQuotaUsageSpec holds the usage of a WorkspaceQuota on a shard.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"selector": {
+					"quota": {
 						SchemaProps: spec.SchemaProps{
-							Description: "selector is a label selector that filters workspace scheduling targets.",
-							Ref:         ref(v1.LabelSelector{}.OpenAPIModelName()),
+							Description: "quota is the name of the WorkspaceQuota.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"shard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "shard is the name of the shard reporting the usage.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"used": {
+						SchemaProps: spec.SchemaProps{
+							Description: "used is the usage of the workspaces on the shard.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"quota", "shard"},
 			},
 		},
 		Dependencies: []string{
-			v1.LabelSelector{}.OpenAPIModelName()},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -5185,11 +6909,23 @@ func schema_sdk_apis_tenancy_v1alpha1_WorkspaceSpec(ref common.ReferenceCallback
 							Ref:         ref(tenancyv1alpha1.Mount{}.OpenAPIModelName()),
 						},
 					},
+					"cloneFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "cloneFrom references a workspace whose contents are copied into this workspace while it is initializing. Copied objects get new UIDs, and owner references are updated to point to the copied owners. The owner of the workspace needs the verb 'admin' on the workspaces/content subresource of the source workspace.",
+							Ref:         ref(tenancyv1alpha1.WorkspaceCloneSource{}.OpenAPIModelName()),
+						},
+					},
+					"expiresAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "expiresAt is the time after which the workspace is deleted. If unset on creation, it is defaulted from the defaultTTL of the workspace type. The lease can be extended by updating it, or with the tenancy.kcp.io/extend-lease annotation. The WorkspaceExpiring condition warns an hour before the workspace expires.",
+							Ref:         ref(v1.Time{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			tenancyv1alpha1.Mount{}.OpenAPIModelName(), tenancyv1alpha1.WorkspaceLocation{}.OpenAPIModelName(), tenancyv1alpha1.WorkspaceTypeReference{}.OpenAPIModelName()},
+			tenancyv1alpha1.Mount{}.OpenAPIModelName(), tenancyv1alpha1.WorkspaceCloneSource{}.OpenAPIModelName(), tenancyv1alpha1.WorkspaceLocation{}.OpenAPIModelName(), tenancyv1alpha1.WorkspaceTypeReference{}.OpenAPIModelName(), v1.Time{}.OpenAPIModelName()},
 	}
 }
 
@@ -5259,6 +6995,42 @@ func schema_sdk_apis_tenancy_v1alpha1_WorkspaceStatus(ref common.ReferenceCallba
 	}
 }
 
+func schema_sdk_apis_tenancy_v1alpha1_WorkspaceTopologySpreadConstraint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkspaceTopologySpreadConstraint spreads workspaces across the failure domains given by a shard label.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"spreadBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "spreadBy is the shard label whose values are the failure domains, e.g. topology.kcp.io/region. Shards without this label are not scheduled to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxSkew": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maxSkew is the maximum difference between the number of workspaces in any failure domain and the lowest number of workspaces in a failure domain.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"scope": {
+						SchemaProps: spec.SchemaProps{
+							Description: "scope selects the workspaces being spread. With \"Siblings\" the workspaces of this type with the same parent workspace are spread. With \"Type\" all workspaces of this type are spread, based on the usage periodically reported by the shards.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"spreadBy"},
+			},
+		},
+	}
+}
+
 func schema_sdk_apis_tenancy_v1alpha1_WorkspaceType(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5573,11 +7345,72 @@ func schema_sdk_apis_tenancy_v1alpha1_WorkspaceTypeSpec(ref common.ReferenceCall
 							},
 						},
 					},
+					"topologySpreadConstraints": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"spreadBy",
+									"scope",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "topologySpreadConstraints describe how workspaces of this type are spread across shard failure domains, e.g. regions. A new workspace of this type is only scheduled to a shard satisfying all constraints. Constraints are not inherited from extended types.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(tenancyv1alpha1.WorkspaceTopologySpreadConstraint{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"maxStorageBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maxStorageBytes limits the bytes the objects of each workspace of this type may occupy in storage. Writes that would exceed the limit are rejected. The core.kcp.io/max-storage-bytes annotation on the LogicalCluster of a workspace takes precedence. The limit is not inherited from extended types.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"maxChildren": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maxChildren limits the number of child workspaces created directly in each workspace of this type. 0 allows no child workspaces. The limit is not inherited from extended types.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxDepth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maxDepth limits the nesting of workspaces below each workspace of this type, whatever the types of the workspaces below: 1 allows child workspaces but no grandchildren, 0 allows no child workspaces. The limit is evaluated when a workspace is created, and changes do not affect existing workspaces. It is not inherited from extended types.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"hibernateAfter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "hibernateAfter is the time a workspace of this type may go without requests from users before it is hibernated, e.g. \"24h\". A hibernated workspace is marked Inactive and its per-cluster caches are dropped on its shard. The next request by a user wakes it up transparently. Requests by kcp system components neither count as activity nor wake a workspace. If unset, workspaces of this type are never hibernated. It is not inherited from extended types.",
+							Ref:         ref(v1.Duration{}.OpenAPIModelName()),
+						},
+					},
+					"defaultTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "defaultTTL is the lifetime of new workspaces of this type which do not set spec.expiresAt on creation, e.g. \"72h\". Expired workspaces are deleted. If unset, workspaces of this type do not expire by default. It is not inherited from extended types.",
+							Ref:         ref(v1.Duration{}.OpenAPIModelName()),
+						},
+					},
+					"deletionRetention": {
+						SchemaProps: spec.SchemaProps{
+							Description: "deletionRetention is the time the logical cluster and the content of a deleted workspace of this type are retained, e.g. \"168h\". Meanwhile, the workspace is in phase Deleted, its content cannot be accessed, and it can be undeleted. Terminators run when the retention has passed. If unset, deleted workspaces are purged right away. It is not inherited from extended types.",
+							Ref:         ref(v1.Duration{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			tenancyv1alpha1.APIExportReference{}.OpenAPIModelName(), tenancyv1alpha1.AuthenticationConfigurationReference{}.OpenAPIModelName(), tenancyv1alpha1.WorkspaceTypeExtension{}.OpenAPIModelName(), tenancyv1alpha1.WorkspaceTypeReference{}.OpenAPIModelName(), tenancyv1alpha1.WorkspaceTypeSelector{}.OpenAPIModelName(), "k8s.io/api/rbac/v1.PolicyRule"},
+			tenancyv1alpha1.APIExportReference{}.OpenAPIModelName(), tenancyv1alpha1.AuthenticationConfigurationReference{}.OpenAPIModelName(), tenancyv1alpha1.WorkspaceTopologySpreadConstraint{}.OpenAPIModelName(), tenancyv1alpha1.WorkspaceTypeExtension{}.OpenAPIModelName(), tenancyv1alpha1.WorkspaceTypeReference{}.OpenAPIModelName(), tenancyv1alpha1.WorkspaceTypeSelector{}.OpenAPIModelName(), "k8s.io/api/rbac/v1.PolicyRule", "k8s.io/apimachinery/pkg/api/resource.Quantity", v1.Duration{}.OpenAPIModelName()},
 	}
 }
 
@@ -5717,11 +7550,18 @@ func schema_sdk_apis_topology_v1alpha1_Partition(ref common.ReferenceCallback) c
 							Ref:         ref(topologyv1alpha1.PartitionSpec{}.OpenAPIModelName()),
 						},
 					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "status holds information about the current status",
+							Default:     map[string]interface{}{},
+							Ref:         ref(topologyv1alpha1.PartitionStatus{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			topologyv1alpha1.PartitionSpec{}.OpenAPIModelName(), v1.ObjectMeta{}.OpenAPIModelName()},
+			topologyv1alpha1.PartitionSpec{}.OpenAPIModelName(), topologyv1alpha1.PartitionStatus{}.OpenAPIModelName(), v1.ObjectMeta{}.OpenAPIModelName()},
 	}
 }
 
@@ -5823,6 +7663,29 @@ func schema_sdk_apis_topology_v1alpha1_PartitionSet(ref common.ReferenceCallback
 	}
 }
 
+func schema_sdk_apis_topology_v1alpha1_PartitionSetAPIExportEndpointSlice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PartitionSetAPIExportEndpointSlice configures the APIExportEndpointSlices created for the partitions of a PartitionSet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"export": {
+						SchemaProps: spec.SchemaProps{
+							Description: "export points to the APIExport whose endpoints are sliced.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(v1alpha1.ExportBindingReference{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"export"},
+			},
+		},
+		Dependencies: []string{
+			v1alpha1.ExportBindingReference{}.OpenAPIModelName()},
+	}
+}
+
 func schema_sdk_apis_topology_v1alpha1_PartitionSetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5900,11 +7763,17 @@ func schema_sdk_apis_topology_v1alpha1_PartitionSetSpec(ref common.ReferenceCall
 							Ref:         ref(v1.LabelSelector{}.OpenAPIModelName()),
 						},
 					},
+					"apiExportEndpointSlice": {
+						SchemaProps: spec.SchemaProps{
+							Description: "apiExportEndpointSlice (optional) makes every partition of the set get an APIExportEndpointSlice of the same name, filtered by the partition. Its endpoints are kept up to date as shards join or leave the partition.",
+							Ref:         ref(topologyv1alpha1.PartitionSetAPIExportEndpointSlice{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1.LabelSelector{}.OpenAPIModelName()},
+			topologyv1alpha1.PartitionSetAPIExportEndpointSlice{}.OpenAPIModelName(), v1.LabelSelector{}.OpenAPIModelName()},
 	}
 }
 
@@ -5944,6 +7813,35 @@ func schema_sdk_apis_topology_v1alpha1_PartitionSetStatus(ref common.ReferenceCa
 	}
 }
 
+func schema_sdk_apis_topology_v1alpha1_PartitionShard(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PartitionShard is a shard matching a partition.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the name of the shard.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ready": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ready is true if the shard is ready, i.e. its Ready condition is not false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_sdk_apis_topology_v1alpha1_PartitionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5965,6 +7863,84 @@ func schema_sdk_apis_topology_v1alpha1_PartitionSpec(ref common.ReferenceCallbac
 	}
 }
 
+func schema_sdk_apis_topology_v1alpha1_PartitionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PartitionStatus records the shards currently selected by the partition.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"shards": {
+						SchemaProps: spec.SchemaProps{
+							Description: "shards are the shards matching the selector, sorted by name.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(topologyv1alpha1.PartitionShard{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"shardCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "shardCount is the number of shards matching the selector.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"readyShardCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "readyShardCount is the number of ready shards matching the selector.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"capacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "capacity is the sum of the capacities reported by the ready shards matching the selector.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"apiExportEndpointSlice": {
+						SchemaProps: spec.SchemaProps{
+							Description: "apiExportEndpointSlice is the name of the APIExportEndpointSlice filtered by this partition, if the owning PartitionSet asks for one.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "conditions is a list of conditions that apply to the Partition.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(conditionsv1alpha1.Condition{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			conditionsv1alpha1.Condition{}.OpenAPIModelName(), topologyv1alpha1.PartitionShard{}.OpenAPIModelName(), "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
 func schema_pkg_apis_meta_v1_APIGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{